@@ -1,16 +1,27 @@
 package main
 
 import (
-	"fmt"
 	"log"
 	"murakali/config"
+	"murakali/internal/constant"
+	"murakali/internal/ledger"
+	productRepository "murakali/internal/module/product/repository"
+	productUseCase "murakali/internal/module/product/usecase"
+	sellerRepository "murakali/internal/module/seller/repository"
+	sellerUseCase "murakali/internal/module/seller/usecase"
+	userRepository "murakali/internal/module/user/repository"
+	userUseCase "murakali/internal/module/user/usecase"
+	"murakali/internal/scheduler"
+	"murakali/internal/session"
+	"murakali/internal/twofactor"
+	"murakali/pkg/jwt"
 	"murakali/pkg/logger"
-	"net/http"
+	"murakali/pkg/postgre"
+	"murakali/pkg/redis"
 	"os"
 	"os/signal"
 	"syscall"
-
-	"github.com/robfig/cron/v3"
+	"time"
 )
 
 func main() {
@@ -30,137 +41,107 @@ func main() {
 	appLogger.InitLogger()
 	appLogger.Infof("AppVersion: %s, LogLevel: %s, Mode: %s", cfg.Server.AppVersion, cfg.Logger.Level, cfg.Server.Mode)
 
-	cronJob := cron.New()
-	defer cronJob.Stop()
-
-	_, err = cronJob.AddFunc("@every 1m", func() {
-		updateOnDelivery(cfg, appLogger)
-	})
-	if err != nil {
-		appLogger.Warn("FatalConfig: %v", err)
-	}
-
-	_, err = cronJob.AddFunc("@every 1m", func() {
-		updateExpiredAt(cfg, appLogger)
-	})
+	pgDB, err := postgre.NewPG(cfg, appLogger)
 	if err != nil {
-		appLogger.Warn("FatalConfig: %v", err)
+		appLogger.Fatalf("Postgresql init: %s", err)
 	}
+	defer pgDB.Close()
+	appLogger.Infof("Postgres connected")
 
-	_, err = cronJob.AddFunc("@every 1m", func() {
-		updateRejectedRefund(cfg, appLogger)
-	})
+	redisClient, err := redis.NewRedis(cfg)
 	if err != nil {
-		appLogger.Warn("FatalConfig: %v", err)
+		appLogger.Fatalf("redis init: %s", err)
 	}
+	defer redisClient.Close()
+	appLogger.Infof("Redis connected")
 
-	_, err = cronJob.AddFunc("@every 1h", func() {
-		updateProductMetadata(cfg, appLogger)
-	})
+	keySet, err := jwt.NewKeySet(cfg)
 	if err != nil {
-		appLogger.Warn("FatalConfig: %v", err)
-	}
-
-	go cronJob.Start()
+		appLogger.Fatalf("jwt key set: %s", err)
+	}
+
+	txRepo := postgre.NewTxRepository(pgDB)
+
+	twoFactor := twofactor.NewVerifier(twofactor.NewTwoFactorRepository(pgDB))
+	ledgerRepo := ledger.NewLedgerRepository(pgDB)
+	sessionRevoker := session.NewRevoker(cfg, session.NewSessionRepository(pgDB, redisClient))
+
+	userRepo := userRepository.NewUserRepository(pgDB, redisClient)
+	userUC := userUseCase.NewUserUseCase(cfg, txRepo, userRepo, ledgerRepo, twoFactor, sessionRevoker, keySet)
+
+	productRepo := productRepository.NewProductRepository(pgDB, redisClient)
+	productUC := productUseCase.NewProductUseCase(cfg, txRepo, productRepo, appLogger)
+
+	sellerRepo := sellerRepository.NewSellerRepository(pgDB, redisClient)
+	sellerUC := sellerUseCase.NewSellerUseCase(cfg, txRepo, sellerRepo, ledgerRepo)
+
+	reconciler := ledger.NewReconciler(ledgerRepo, appLogger)
+
+	schedulerRepo := scheduler.NewSchedulerRepository(pgDB, redisClient)
+	jobScheduler := scheduler.NewScheduler(schedulerRepo, appLogger)
+
+	jobs := []scheduler.Job{
+		{
+			Name:    constant.JobUpdateOnDelivery,
+			Spec:    "@every 1m",
+			LockTTL: 5 * time.Minute,
+			Run:     sellerUC.UpdateOnDeliveryOrder,
+		},
+		{
+			Name:    constant.JobUpdateExpiredAt,
+			Spec:    "@every 1m",
+			LockTTL: 5 * time.Minute,
+			Run:     sellerUC.UpdateExpiredAtOrder,
+		},
+		{
+			Name:    constant.JobCompleteRejectRefund,
+			Spec:    "@every 1m",
+			LockTTL: 5 * time.Minute,
+			Run:     userUC.CompletedRejectedRefund,
+		},
+		{
+			Name:    constant.JobUpdateProductMeta,
+			Spec:    "@every 1h",
+			LockTTL: 30 * time.Minute,
+			Run:     productUC.UpdateProductMetadata,
+		},
+		{
+			Name:    constant.JobReconcileLedger,
+			Spec:    "@every 1h",
+			LockTTL: 30 * time.Minute,
+			Run:     reconciler.Run,
+		},
+		{
+			Name:    constant.JobAnonymizeAccounts,
+			Spec:    "@every 1h",
+			LockTTL: 30 * time.Minute,
+			Run:     userUC.AnonymizeDeletedAccounts,
+		},
+		{
+			Name:    constant.JobEvaluateProductAlert,
+			Spec:    "@every 5m",
+			LockTTL: 5 * time.Minute,
+			Run:     productUC.EvaluateProductAlerts,
+		},
+		{
+			Name:    constant.JobFailStaleImports,
+			Spec:    "@every 10m",
+			LockTTL: 5 * time.Minute,
+			Run:     productUC.FailStaleProductImportJobs,
+		},
+	}
+
+	for _, job := range jobs {
+		if err := jobScheduler.Register(job); err != nil {
+			appLogger.Fatalf("register job %s: %s", job.Name, err)
+		}
+	}
+
+	jobScheduler.Start()
+	defer jobScheduler.Stop()
 
 	sig := make(chan os.Signal, 1)
 	signal.Notify(sig, syscall.SIGINT, syscall.SIGTERM)
 	<-sig
 	appLogger.Info("Cron stop")
 }
-
-func updateOnDelivery(cfg *config.Config, appLogger logger.Logger) {
-	appLogger.Info("cron update delivery start")
-	url := fmt.Sprintf("https://%s/api/v1/seller/delivery", cfg.Server.Domain)
-	req, err := http.NewRequest("POST", url, http.NoBody)
-	if err != nil {
-		appLogger.Warnf("request error: ", err.Error())
-		return
-	}
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		appLogger.Warn("response error: ", err.Error())
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		appLogger.Warn("status code error: ", res.StatusCode)
-		return
-	}
-
-	appLogger.Infof("update delivery success")
-}
-
-func updateProductMetadata(cfg *config.Config, appLogger logger.Logger) {
-	appLogger.Info("cron update product metadata")
-	url := fmt.Sprintf("https://%s/api/v1/product/metadata", cfg.Server.Domain)
-	req, err := http.NewRequest("POST", url, http.NoBody)
-	if err != nil {
-		appLogger.Warnf("request error: ", err.Error())
-		return
-	}
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		appLogger.Warn("response error: ", err.Error())
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		appLogger.Warn("status code error: ", res.StatusCode)
-		return
-	}
-
-	appLogger.Infof("update product metadata success")
-}
-
-func updateExpiredAt(cfg *config.Config, appLogger logger.Logger) {
-	appLogger.Info("cron update expired at start")
-	url := fmt.Sprintf("https://%s/api/v1/seller/expired", cfg.Server.Domain)
-	req, err := http.NewRequest("POST", url, http.NoBody)
-	if err != nil {
-		appLogger.Warnf("request error: ", err.Error())
-		return
-	}
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		appLogger.Warn("response error: ", err.Error())
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		appLogger.Warn("status code error: ", res.StatusCode)
-		return
-	}
-
-	appLogger.Infof("update expired success")
-}
-
-func updateRejectedRefund(cfg *config.Config, appLogger logger.Logger) {
-	appLogger.Info("cron update rejected at start")
-	url := fmt.Sprintf("https://%s/api/v1/user/rejected-refund", cfg.Server.Domain)
-	req, err := http.NewRequest("POST", url, http.NoBody)
-	if err != nil {
-		appLogger.Warnf("request error: ", err.Error())
-		return
-	}
-
-	res, err := http.DefaultClient.Do(req)
-	if err != nil {
-		appLogger.Warn("response error: ", err.Error())
-		return
-	}
-	defer res.Body.Close()
-
-	if res.StatusCode != http.StatusOK {
-		appLogger.Warn("status code error: ", res.StatusCode)
-		return
-	}
-
-	appLogger.Infof("update rejected success")
-}
@@ -30,10 +30,14 @@ type ServerConfig struct {
 }
 
 type JWTConfig struct {
-	JwtSecretKey  string `mapstructure:"JWT_SECRET_KEY"`
-	JwtIssuer     string `mapstructure:"JWT_ISSUER"`
-	AccessExpMin  int    `mapstructure:"ACCESS_EXP_MIN"`
-	RefreshExpMin int    `mapstructure:"REFRESH_EXP_MIN"`
+	JwtSecretKey        string `mapstructure:"JWT_SECRET_KEY"`
+	JwtKeyID            string `mapstructure:"JWT_KEY_ID"`
+	JwtPrivateKey       string `mapstructure:"JWT_PRIVATE_KEY"`
+	JwtKeysDir          string `mapstructure:"JWT_KEYS_DIR"`
+	JwtLegacyHS256Until string `mapstructure:"JWT_LEGACY_HS256_UNTIL"`
+	JwtIssuer           string `mapstructure:"JWT_ISSUER"`
+	AccessExpMin        int    `mapstructure:"ACCESS_EXP_MIN"`
+	RefreshExpMin       int    `mapstructure:"REFRESH_EXP_MIN"`
 }
 
 type PostgresConfig struct {
@@ -74,6 +78,7 @@ type ExternalConfig struct {
 	GoogleClientID     string `mapstructure:"GOOGLE_OAUTH_CLIENT_ID"`
 	GoogleClientSecret string `mapstructure:"GOOGLE_OAUTH_CLIENT_SECRET"`
 	GoogleRedirectURL  string `mapstructure:"GOOGLE_OAUTH_REDIRECT_URL"`
+	OIDCProvidersFile  string `mapstructure:"OIDC_PROVIDERS_FILE"`
 }
 
 func LoadConfig() (*viper.Viper, error) {
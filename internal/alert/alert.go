@@ -0,0 +1,84 @@
+package alert
+
+import (
+	"context"
+	"fmt"
+	"murakali/config"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/internal/util"
+	smtp "murakali/pkg/email"
+)
+
+type Store interface {
+	GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error)
+	UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error)
+}
+
+func CurrentPrice(productAlert *model.ProductAlert) model.Money {
+	if productAlert.Discount == nil {
+		return productAlert.Price
+	}
+
+	_, price := util.CalculateDiscount(productAlert.Price, productAlert.Discount)
+	return price
+}
+
+func IsMet(productAlert *model.ProductAlert) bool {
+	switch productAlert.AlertType {
+	case constant.ProductAlertPriceDrop:
+		return productAlert.TargetPrice != nil && CurrentPrice(productAlert) <= *productAlert.TargetPrice
+	case constant.ProductAlertBackInStock:
+		return productAlert.AvailableStock > 0
+	}
+
+	return false
+}
+
+func Evaluate(ctx context.Context, cfg *config.Config, store Store, productIDs ...string) (int64, error) {
+	if len(productIDs) == 0 {
+		return 0, nil
+	}
+
+	alerts, err := store.GetProductAlertsByProductIDs(ctx, productIDs)
+	if err != nil {
+		return 0, err
+	}
+
+	var notified int64
+	for _, productAlert := range alerts {
+		met := IsMet(productAlert)
+		if met == productAlert.IsTriggered {
+			continue
+		}
+
+		updated, err := store.UpdateProductAlertTriggered(ctx, productAlert.ID.String(), met)
+		if err != nil {
+			return notified, err
+		}
+
+		if !updated || !met {
+			continue
+		}
+
+		subject, msg := Message(cfg, productAlert)
+		go smtp.SendEmail(cfg, productAlert.Email, subject, msg)
+		notified++
+	}
+
+	return notified, nil
+}
+
+func Message(cfg *config.Config, productAlert *model.ProductAlert) (subject, msg string) {
+	link := fmt.Sprintf("%s/product/%s", cfg.Server.Origin, productAlert.ProductID)
+	unsubscribeLink := fmt.Sprintf("%s/product/alert/unsubscribe?token=%s", cfg.Server.Origin, productAlert.UnsubscribeToken)
+
+	if productAlert.AlertType == constant.ProductAlertBackInStock {
+		subject = fmt.Sprintf("%s is back in stock", productAlert.Title)
+		return subject, smtp.ProductBackInStockBody(productAlert.Title, link, unsubscribeLink)
+	}
+
+	price := CurrentPrice(productAlert).String()
+	subject = fmt.Sprintf("Price drop on %s", productAlert.Title)
+	return subject, smtp.ProductPriceDropBody(productAlert.Title, price, link, unsubscribeLink)
+}
@@ -0,0 +1,130 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"murakali/config"
+	"murakali/internal/alert/mocks"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIsMet(t *testing.T) {
+	target := model.Money(9000)
+	maxDiscount := model.Money(2000)
+	percentage := 20.0
+	testCase := []struct {
+		name     string
+		alert    *model.ProductAlert
+		expected bool
+	}{
+		{
+			name:     "price above target",
+			alert:    &model.ProductAlert{AlertType: constant.ProductAlertPriceDrop, TargetPrice: &target, Price: 10000},
+			expected: false,
+		},
+		{
+			name:     "price at target",
+			alert:    &model.ProductAlert{AlertType: constant.ProductAlertPriceDrop, TargetPrice: &target, Price: 9000},
+			expected: true,
+		},
+		{
+			name: "promotion brings price below target",
+			alert: &model.ProductAlert{AlertType: constant.ProductAlertPriceDrop, TargetPrice: &target, Price: 10000,
+				Discount: &model.Discount{DiscountPercentage: &percentage, MaxDiscountPrice: &maxDiscount}},
+			expected: true,
+		},
+		{
+			name:     "price drop without target",
+			alert:    &model.ProductAlert{AlertType: constant.ProductAlertPriceDrop, Price: 1},
+			expected: false,
+		},
+		{
+			name:     "out of stock",
+			alert:    &model.ProductAlert{AlertType: constant.ProductAlertBackInStock, AvailableStock: 0},
+			expected: false,
+		},
+		{
+			name:     "back in stock",
+			alert:    &model.ProductAlert{AlertType: constant.ProductAlertBackInStock, AvailableStock: 1},
+			expected: true,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, IsMet(tc.alert))
+		})
+	}
+}
+
+func TestCurrentPrice(t *testing.T) {
+	maxDiscount := model.Money(2000)
+	fixPrice := model.Money(1500)
+	assert.Equal(t, model.Money(10000), CurrentPrice(&model.ProductAlert{Price: 10000}))
+	assert.Equal(t, model.Money(8500), CurrentPrice(&model.ProductAlert{Price: 10000,
+		Discount: &model.Discount{DiscountFixPrice: &fixPrice, MaxDiscountPrice: &maxDiscount}}))
+}
+
+func TestEvaluate(t *testing.T) {
+	target := model.Money(9000)
+	metID := uuid.New()
+	rearmID := uuid.New()
+	raceID := uuid.New()
+	unchangedID := uuid.New()
+	testCase := []struct {
+		name        string
+		productIDs  []string
+		mock        func(t *testing.T, s *mocks.Store)
+		expected    int64
+		expectedErr error
+	}{
+		{
+			name:       "no products",
+			productIDs: []string{},
+			mock:       func(t *testing.T, s *mocks.Store) {},
+			expected:   0,
+		},
+		{
+			name:       "notify once per transition",
+			productIDs: []string{"product"},
+			mock: func(t *testing.T, s *mocks.Store) {
+				s.On("GetProductAlertsByProductIDs", mock.Anything, []string{"product"}).Return([]*model.ProductAlert{
+					{ID: metID, AlertType: constant.ProductAlertPriceDrop, TargetPrice: &target, Price: 8000},
+					{ID: rearmID, AlertType: constant.ProductAlertBackInStock, IsTriggered: true},
+					{ID: raceID, AlertType: constant.ProductAlertBackInStock, AvailableStock: 3},
+					{ID: unchangedID, AlertType: constant.ProductAlertBackInStock, IsTriggered: true, AvailableStock: 3},
+				}, nil)
+				s.On("UpdateProductAlertTriggered", mock.Anything, metID.String(), true).Return(true, nil)
+				s.On("UpdateProductAlertTriggered", mock.Anything, rearmID.String(), false).Return(true, nil)
+				s.On("UpdateProductAlertTriggered", mock.Anything, raceID.String(), true).Return(false, nil)
+			},
+			expected: 1,
+		},
+		{
+			name:       "error get alerts",
+			productIDs: []string{"product"},
+			mock: func(t *testing.T, s *mocks.Store) {
+				s.On("GetProductAlertsByProductIDs", mock.Anything, []string{"product"}).Return(nil, errors.New("test"))
+			},
+			expected:    0,
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			s := mocks.NewStore(t)
+
+			tc.mock(t, s)
+			notified, err := Evaluate(context.Background(), &config.Config{}, s, tc.productIDs...)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, notified)
+		})
+	}
+}
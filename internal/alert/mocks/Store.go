@@ -0,0 +1,74 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "murakali/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Store is an autogenerated mock type for the Store type
+type Store struct {
+	mock.Mock
+}
+
+// GetProductAlertsByProductIDs provides a mock function with given fields: ctx, productIDs
+func (_m *Store) GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	var r0 []*model.ProductAlert
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.ProductAlert); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ProductAlert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProductAlertTriggered provides a mock function with given fields: ctx, alertID, triggered
+func (_m *Store) UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error) {
+	ret := _m.Called(ctx, alertID, triggered)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) bool); ok {
+		r0 = rf(ctx, alertID, triggered)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, alertID, triggered)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewStore interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewStore creates a new instance of Store. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewStore(t mockConstructorTestingTNewStore) *Store {
+	mock := &Store{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -1,5 +1,7 @@
 package constant
 
+import "time"
+
 const (
 	AdminMarketplaceID = "4df967a8-5b05-4d2a-bb72-da3921dce8fb"
 
@@ -10,25 +12,143 @@ const (
 	ResetPasswordTokenCookie   = "reset_password_token"
 	ChangePasswordTokenCookie  = "change_password_token"
 
-	ProvinceKey    = "location:province"
-	CityKey        = "location:city"
-	SubDistrictKey = "location:subdistrict"
-	UrbanKey       = "location:urban"
-	OtpKey         = "user:otp"
-	OtpDuration    = "30m"
-	AddressDefault = "true"
+	ProvinceKey      = "location:province"
+	CityKey          = "location:city"
+	SubDistrictKey   = "location:subdistrict"
+	UrbanKey         = "location:urban"
+	OtpKey           = "user:otp"
+	SessionAccessKey = "session:access"
+	OtpDuration      = "30m"
+	AddressDefault   = "true"
+
+	BruteForceKey            = "bruteforce"
+	BruteForceLock           = "lock"
+	BruteForceWindow         = "window"
+	BruteForceLevel          = "level"
+	BruteForceScopeIP        = "ip"
+	BruteForceScopeLogin     = "login"
+	BruteForceScopeOTP       = "otp"
+	BruteForceScopeWalletPin = "wallet-pin"
+
+	TOTPIssuer           = "Murakali"
+	PreAuthScope         = "mfa"
+	PreAuthTokenDuration = "5m"
+	TOTPRecoveryCodes    = 10
+	WalletTOTPThreshold  = 1000000
+
+	JWKSCacheControl = "public, max-age=300"
+
+	OIDCStateKey      = "oidc:state"
+	OIDCStateDuration = "10m"
+
+	RoleUser    = 1
+	RoleSeller  = 2
+	RoleAdmin   = 3
+	RoleFinance = 4
+
+	PermissionKey           = "permission"
+	PermissionCacheDuration = "5m"
+
+	PermissionVoucherRead        = "voucher:read"
+	PermissionVoucherWrite       = "voucher:write"
+	PermissionRefundRead         = "refund:read"
+	PermissionRefundWrite        = "refund:write"
+	PermissionTransactionRead    = "transaction:read"
+	PermissionPayoutRead         = "payout:read"
+	PermissionPayoutWrite        = "payout:write"
+	PermissionSessionRead        = "session:read"
+	PermissionSessionWrite       = "session:write"
+	PermissionLockoutRead        = "lockout:read"
+	PermissionLockoutWrite       = "lockout:write"
+	PermissionCategoryWrite      = "category:write"
+	PermissionBannerWrite        = "banner:write"
+	PermissionPictureWrite       = "picture:write"
+	PermissionRoleRead           = "role:read"
+	PermissionRoleWrite          = "role:write"
+	PermissionShopManage         = "shop:manage"
+	PermissionUserRead           = "user:read"
+	PermissionUserWrite          = "user:write"
+	PermissionUserImpersonate    = "user:impersonate"
+	PermissionUserModerateExempt = "user:moderate-exempt"
 
-	RoleUser   = 1
-	RoleSeller = 2
-	RoleAdmin  = 3
+	AccountStatusKey       = "account:status"
+	AccountStatusActive    = "active"
+	AccountStatusSuspended = "suspended"
+	AccountStatusBanned    = "banned"
+
+	ImpersonationKey             = "impersonation"
+	ImpersonationDefaultDuration = 15
+	ImpersonationMaxDuration     = 60
+
+	AdminActionSuspend              = "user.suspend"
+	AdminActionBan                  = "user.ban"
+	AdminActionReactivate           = "user.reactivate"
+	AdminActionForcePasswordReset   = "user.force_password_reset"
+	AdminActionImpersonationStart   = "impersonation.start"
+	AdminActionImpersonationEnd     = "impersonation.end"
+	AdminActionImpersonationRequest = "impersonation.request"
+
+	ShopScopeOrders             = "orders"
+	ShopScopeProducts           = "products"
+	ShopScopeVouchers           = "vouchers"
+	ShopScopeFinance            = "finance"
+	ShopStaffInvitationDuration = "168h"
 
 	ImgMaxSize = 500000
 
+	ProductImportMaxSize         = 2000000
+	ProductImportMaxRows         = 1000
+	ProductImportStatusRunning   = "running"
+	ProductImportStatusCompleted = "completed"
+	ProductImportStatusFailed    = "failed"
+
+	ProductVariantMaxAxes         = 3
+	ProductVariantMaxOptions      = 20
+	ProductVariantMaxCombinations = 100
+
+	ProductPriceTierMax = 5
+
+	ProductPriceHistoryDays = 90
+
+	ProductAlertPriceDrop   = "price_drop"
+	ProductAlertBackInStock = "back_in_stock"
+
+	ProductFacetCategory = "category"
+	ProductFacetProvince = "province"
+	ProductFacetRating   = "rating"
+	ProductFacetPrice    = "price"
+
 	SLPStatusPaid      = "TXN_PAID"
 	SlPMessagePaid     = "Payment successful"
 	SLPStatusCanceled  = "TXN_FAILED"
 	SLPMessageCanceled = "Transaction Canceled by user"
 
+	SLPCallbackNonceKey = "slp:callback:nonce"
+
+	PaymentCallbackSLP        = "slp"
+	PaymentCallbackWallet     = "wallet"
+	PaymentCallbackProcessing = "processing"
+	PaymentCallbackProcessed  = "processed"
+	PaymentCallbackRejected   = "rejected"
+	PaymentCallbackError      = "error"
+	PaymentCallbackDuplicate  = "duplicate"
+
+	JobLockKey              = "job:lock"
+	JobUpdateOnDelivery     = "update-on-delivery-order"
+	JobUpdateExpiredAt      = "update-expired-at-order"
+	JobCompleteRejectRefund = "complete-rejected-refund"
+	JobUpdateProductMeta    = "update-product-metadata"
+	JobReconcileLedger      = "reconcile-ledger"
+	JobAnonymizeAccounts    = "anonymize-deleted-accounts"
+	JobEvaluateProductAlert = "evaluate-product-alerts"
+	JobFailStaleImports     = "fail-stale-product-imports"
+
+	AccountDeletionGracePeriod = "336h"
+	AccountDeletionRetryDelay  = "24h"
+	ProductImportStaleAfter    = "1h"
+	ExportFormatJSON           = "json"
+	ExportFormatZIP            = "zip"
+
 	TRUE  = "true"
 	FALSE = "false"
 	ASC   = "asc"
@@ -49,4 +169,44 @@ const (
 	OrderStatusCompleted        = 7
 	OrderStatusCanceled         = 8
 	OrderStatusRefunded         = 9
+
+	OrderActorBuyer  = "buyer"
+	OrderActorSeller = "seller"
+	OrderActorSystem = "system"
+	OrderActorAdmin  = "admin"
+
+	StockReservationActive    = "active"
+	StockReservationConverted = "converted"
+	StockReservationReleased  = "released"
+
+	LedgerAccountWallet        = "wallet"
+	LedgerAccountEscrow        = "escrow"
+	LedgerAccountSellerPayable = "seller_payable"
+	LedgerAccountRefund        = "refund"
+	LedgerAccountExternal      = "external"
+	LedgerAccountOpening       = "opening"
+
+	LedgerOwnerSealabsPay = "sealabs_pay"
+	LedgerOwnerOpening    = "opening"
+
+	LedgerDebit  = "debit"
+	LedgerCredit = "credit"
+
+	LedgerReferenceTopUp      = "topup"
+	LedgerReferencePayment    = "payment"
+	LedgerReferenceWithdrawal = "withdrawal"
+	LedgerReferenceRefund     = "refund"
+	LedgerReferencePayout     = "payout"
+
+	PayoutStatusRequested = "requested"
+	PayoutStatusBatched   = "batched"
+	PayoutStatusApproved  = "approved"
+	PayoutStatusRejected  = "rejected"
+
+	PayoutBatchStatusPending  = "pending"
+	PayoutBatchStatusApproved = "approved"
+)
+
+const (
+	SLPCallbackTolerance = 5 * time.Minute
 )
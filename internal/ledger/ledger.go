@@ -0,0 +1,114 @@
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/pkg/postgre"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func WalletAccount(walletID uuid.UUID) *model.LedgerAccount {
+	return &model.LedgerAccount{
+		AccountType: constant.LedgerAccountWallet,
+		OwnerID:     walletID.String(),
+		WalletID:    &walletID,
+	}
+}
+
+func EscrowAccount(walletID uuid.UUID) *model.LedgerAccount {
+	return &model.LedgerAccount{
+		AccountType: constant.LedgerAccountEscrow,
+		OwnerID:     walletID.String(),
+		WalletID:    &walletID,
+	}
+}
+
+func SellerPayableAccount(sellerID string) *model.LedgerAccount {
+	return &model.LedgerAccount{
+		AccountType: constant.LedgerAccountSellerPayable,
+		OwnerID:     sellerID,
+	}
+}
+
+func RefundAccount() *model.LedgerAccount {
+	return &model.LedgerAccount{
+		AccountType: constant.LedgerAccountRefund,
+		OwnerID:     constant.AdminMarketplaceID,
+	}
+}
+
+func ExternalAccount() *model.LedgerAccount {
+	return &model.LedgerAccount{
+		AccountType: constant.LedgerAccountExternal,
+		OwnerID:     constant.LedgerOwnerSealabsPay,
+	}
+}
+
+func NewTransaction(reference, description string, transactionID *uuid.UUID) *model.LedgerTransaction {
+	return &model.LedgerTransaction{
+		Reference:     reference,
+		Description:   description,
+		TransactionID: transactionID,
+		CreatedAt:     time.Now(),
+		Entries:       make([]*model.LedgerEntry, 0),
+	}
+}
+
+func Transfer(ledgerTx *model.LedgerTransaction, from, to *model.LedgerAccount, amount model.Money) {
+	if amount == 0 {
+		return
+	}
+
+	ledgerTx.Entries = append(ledgerTx.Entries,
+		&model.LedgerEntry{Account: from, Direction: constant.LedgerDebit, Amount: amount},
+		&model.LedgerEntry{Account: to, Direction: constant.LedgerCredit, Amount: amount},
+	)
+}
+
+func Validate(ledgerTx *model.LedgerTransaction) error {
+	if len(ledgerTx.Entries) < 2 {
+		return fmt.Errorf("ledger %s: needs at least two entries", ledgerTx.Reference)
+	}
+
+	var debit, credit model.Money
+	for _, entry := range ledgerTx.Entries {
+		if entry.Account == nil {
+			return fmt.Errorf("ledger %s: entry without account", ledgerTx.Reference)
+		}
+
+		if entry.Amount <= 0 {
+			return fmt.Errorf("ledger %s: entry amount must be positive", ledgerTx.Reference)
+		}
+
+		switch entry.Direction {
+		case constant.LedgerDebit:
+			debit += entry.Amount
+		case constant.LedgerCredit:
+			credit += entry.Amount
+		default:
+			return fmt.Errorf("ledger %s: unknown direction %s", ledgerTx.Reference, entry.Direction)
+		}
+	}
+
+	if debit != credit {
+		return fmt.Errorf("ledger %s: unbalanced, debit %s credit %s", ledgerTx.Reference, debit, credit)
+	}
+
+	return nil
+}
+
+func Post(ctx context.Context, tx postgre.Transaction, repo Repository, ledgerTx *model.LedgerTransaction) error {
+	if len(ledgerTx.Entries) == 0 {
+		return nil
+	}
+
+	if err := Validate(ledgerTx); err != nil {
+		return err
+	}
+
+	return repo.CreateLedgerTransaction(ctx, tx, ledgerTx)
+}
@@ -0,0 +1,141 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"murakali/config"
+	"murakali/internal/constant"
+	"murakali/internal/ledger/mocks"
+	"murakali/internal/model"
+	"murakali/pkg/logger"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidate(t *testing.T) {
+	walletID := uuid.New()
+	escrowID := uuid.New()
+	testCase := []struct {
+		name        string
+		ledgerTx    func() *model.LedgerTransaction
+		expectedErr bool
+	}{
+		{
+			name: "balanced transfer",
+			ledgerTx: func() *model.LedgerTransaction {
+				ledgerTx := NewTransaction(constant.LedgerReferencePayment, "test", nil)
+				Transfer(ledgerTx, WalletAccount(walletID), EscrowAccount(escrowID), 15000)
+				return ledgerTx
+			},
+		},
+		{
+			name: "balanced transfer through clearing account",
+			ledgerTx: func() *model.LedgerTransaction {
+				ledgerTx := NewTransaction(constant.LedgerReferenceRefund, "test", nil)
+				Transfer(ledgerTx, EscrowAccount(escrowID), RefundAccount(), 15000)
+				Transfer(ledgerTx, RefundAccount(), WalletAccount(walletID), 15000)
+				return ledgerTx
+			},
+		},
+		{
+			name: "unbalanced entries",
+			ledgerTx: func() *model.LedgerTransaction {
+				ledgerTx := NewTransaction(constant.LedgerReferencePayment, "test", nil)
+				Transfer(ledgerTx, WalletAccount(walletID), EscrowAccount(escrowID), 15000)
+				ledgerTx.Entries[1].Amount = 10000
+				return ledgerTx
+			},
+			expectedErr: true,
+		},
+		{
+			name: "single entry",
+			ledgerTx: func() *model.LedgerTransaction {
+				ledgerTx := NewTransaction(constant.LedgerReferencePayment, "test", nil)
+				Transfer(ledgerTx, WalletAccount(walletID), EscrowAccount(escrowID), 15000)
+				ledgerTx.Entries = ledgerTx.Entries[:1]
+				return ledgerTx
+			},
+			expectedErr: true,
+		},
+		{
+			name: "negative amount",
+			ledgerTx: func() *model.LedgerTransaction {
+				ledgerTx := NewTransaction(constant.LedgerReferencePayment, "test", nil)
+				Transfer(ledgerTx, WalletAccount(walletID), EscrowAccount(escrowID), -15000)
+				return ledgerTx
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			err := Validate(tc.ledgerTx())
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+}
+
+func TestTransfer_ZeroAmount(t *testing.T) {
+	ledgerTx := NewTransaction(constant.LedgerReferenceRefund, "test", nil)
+	Transfer(ledgerTx, RefundAccount(), WalletAccount(uuid.New()), 0)
+
+	assert.Empty(t, ledgerTx.Entries)
+	assert.NoError(t, Post(context.Background(), nil, nil, ledgerTx))
+}
+
+func TestReconciler_Run(t *testing.T) {
+	testCase := []struct {
+		name         string
+		mock         func(r *mocks.Repository)
+		expectedRows int64
+		expectedErr  error
+	}{
+		{
+			name: "no drift",
+			mock: func(r *mocks.Repository) {
+				r.On("GetWalletDrifts", context.Background()).Return([]*model.LedgerDrift{}, nil)
+			},
+		},
+		{
+			name: "drift reported",
+			mock: func(r *mocks.Repository) {
+				r.On("GetWalletDrifts", context.Background()).Return([]*model.LedgerDrift{
+					{WalletID: uuid.New(), UserID: uuid.New(), Balance: 20000, LedgerBalance: 15000},
+				}, nil)
+			},
+			expectedRows: 1,
+		},
+		{
+			name: "error get drifts",
+			mock: func(r *mocks.Repository) {
+				r.On("GetWalletDrifts", context.Background()).Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Logger: config.LoggerConfig{
+					Development: true,
+					Encoding:    "json",
+					Level:       "info",
+				},
+			}
+
+			appLogger := logger.NewAPILogger(cfg)
+			appLogger.InitLogger()
+
+			r := mocks.NewRepository(t)
+			tc.mock(r)
+
+			rows, err := NewReconciler(r, appLogger).Run(context.Background())
+			assert.Equal(t, tc.expectedRows, rows)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
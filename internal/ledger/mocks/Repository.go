@@ -0,0 +1,69 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+
+	model "murakali/internal/model"
+	postgre "murakali/pkg/postgre"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// CreateLedgerTransaction provides a mock function with given fields: ctx, tx, ledgerTx
+func (_m *Repository) CreateLedgerTransaction(ctx context.Context, tx postgre.Transaction, ledgerTx *model.LedgerTransaction) error {
+	ret := _m.Called(ctx, tx, ledgerTx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.LedgerTransaction) error); ok {
+		r0 = rf(ctx, tx, ledgerTx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetWalletDrifts provides a mock function with given fields: ctx
+func (_m *Repository) GetWalletDrifts(ctx context.Context) ([]*model.LedgerDrift, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.LedgerDrift
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.LedgerDrift); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.LedgerDrift)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
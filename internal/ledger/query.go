@@ -0,0 +1,22 @@
+package ledger
+
+const (
+	CreateLedgerTransactionQuery = `INSERT INTO "ledger_transaction"
+	(reference, description, transaction_id, created_at) VALUES ($1, $2, $3, $4) RETURNING "id"`
+
+	UpsertLedgerAccountQuery = `INSERT INTO "ledger_account" (account_type, owner_id, wallet_id) VALUES ($1, $2, $3)
+	ON CONFLICT (account_type, owner_id) DO UPDATE SET "account_type" = EXCLUDED."account_type" RETURNING "id"`
+
+	CreateLedgerEntryQuery = `INSERT INTO "ledger_entry"
+	(ledger_transaction_id, account_id, direction, amount, created_at) VALUES ($1, $2, $3, $4, $5)`
+
+	GetWalletDriftsQuery = `
+		SELECT "w"."id", "w"."user_id", "w"."balance",
+			COALESCE(SUM(CASE WHEN "e"."direction" = $1 THEN "e"."amount" ELSE -"e"."amount" END), 0) AS "ledger_balance"
+		FROM "wallet" AS "w"
+		LEFT JOIN "ledger_account" AS "a" ON "a"."wallet_id" = "w"."id"
+		LEFT JOIN "ledger_entry" AS "e" ON "e"."account_id" = "a"."id"
+		GROUP BY "w"."id", "w"."user_id", "w"."balance"
+		HAVING "w"."balance" <> COALESCE(SUM(CASE WHEN "e"."direction" = $1 THEN "e"."amount" ELSE -"e"."amount" END), 0)
+		ORDER BY "w"."id"`
+)
@@ -0,0 +1,36 @@
+package ledger
+
+import (
+	"context"
+	"murakali/pkg/logger"
+)
+
+type Reconciler struct {
+	repo Repository
+	log  logger.Logger
+}
+
+func NewReconciler(repo Repository, log logger.Logger) *Reconciler {
+	return &Reconciler{
+		repo: repo,
+		log:  log,
+	}
+}
+
+func (r *Reconciler) Run(ctx context.Context) (int64, error) {
+	drifts, err := r.repo.GetWalletDrifts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, drift := range drifts {
+		r.log.Warnf("ledger drift: wallet %s user %s balance %s ledger %s diff %s",
+			drift.WalletID.String(),
+			drift.UserID.String(),
+			drift.Balance,
+			drift.LedgerBalance,
+			drift.Balance-drift.LedgerBalance)
+	}
+
+	return int64(len(drifts)), nil
+}
@@ -0,0 +1,84 @@
+package ledger
+
+import (
+	"context"
+	"database/sql"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/pkg/postgre"
+)
+
+type Repository interface {
+	CreateLedgerTransaction(ctx context.Context, tx postgre.Transaction, ledgerTx *model.LedgerTransaction) error
+	GetWalletDrifts(ctx context.Context) ([]*model.LedgerDrift, error)
+}
+
+type ledgerRepo struct {
+	PSQL *sql.DB
+}
+
+func NewLedgerRepository(psql *sql.DB) Repository {
+	return &ledgerRepo{
+		PSQL: psql,
+	}
+}
+
+func (r *ledgerRepo) CreateLedgerTransaction(ctx context.Context, tx postgre.Transaction, ledgerTx *model.LedgerTransaction) error {
+	if err := tx.QueryRowContext(ctx, CreateLedgerTransactionQuery,
+		ledgerTx.Reference,
+		ledgerTx.Description,
+		ledgerTx.TransactionID,
+		ledgerTx.CreatedAt).Scan(&ledgerTx.ID); err != nil {
+		return err
+	}
+
+	for _, entry := range ledgerTx.Entries {
+		if err := tx.QueryRowContext(ctx, UpsertLedgerAccountQuery,
+			entry.Account.AccountType,
+			entry.Account.OwnerID,
+			entry.Account.WalletID).Scan(&entry.AccountID); err != nil {
+			return err
+		}
+
+		entry.LedgerTransactionID = ledgerTx.ID
+		entry.CreatedAt = ledgerTx.CreatedAt
+		if _, err := tx.ExecContext(ctx, CreateLedgerEntryQuery,
+			entry.LedgerTransactionID,
+			entry.AccountID,
+			entry.Direction,
+			entry.Amount,
+			entry.CreatedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *ledgerRepo) GetWalletDrifts(ctx context.Context) ([]*model.LedgerDrift, error) {
+	drifts := make([]*model.LedgerDrift, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetWalletDriftsQuery, constant.LedgerCredit)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var drift model.LedgerDrift
+		if errScan := res.Scan(
+			&drift.WalletID,
+			&drift.UserID,
+			&drift.Balance,
+			&drift.LedgerBalance); errScan != nil {
+			return nil, errScan
+		}
+
+		drifts = append(drifts, &drift)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return drifts, nil
+}
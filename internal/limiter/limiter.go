@@ -0,0 +1,166 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"murakali/internal/constant"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type Rule struct {
+	Scope    string
+	Limit    int64
+	Window   time.Duration
+	Lockouts []time.Duration
+}
+
+type Lockout struct {
+	Scope     string    `json:"scope"`
+	Key       string    `json:"key"`
+	Level     int64     `json:"level"`
+	ExpiredAt time.Time `json:"expired_at"`
+}
+
+var (
+	progressiveLockouts = []time.Duration{time.Minute, 5 * time.Minute, 15 * time.Minute, time.Hour, 24 * time.Hour}
+
+	IPRule        = Rule{Scope: constant.BruteForceScopeIP, Limit: 30, Window: 15 * time.Minute, Lockouts: progressiveLockouts}
+	LoginRule     = Rule{Scope: constant.BruteForceScopeLogin, Limit: 5, Window: 15 * time.Minute, Lockouts: progressiveLockouts}
+	OTPRule       = Rule{Scope: constant.BruteForceScopeOTP, Limit: 5, Window: 15 * time.Minute, Lockouts: progressiveLockouts}
+	WalletPinRule = Rule{Scope: constant.BruteForceScopeWalletPin, Limit: 5, Window: 15 * time.Minute, Lockouts: progressiveLockouts}
+)
+
+type Limiter struct {
+	repo Repository
+}
+
+func NewLimiter(repo Repository) *Limiter {
+	return &Limiter{
+		repo: repo,
+	}
+}
+
+func IPKey(ip string) string {
+	return fmt.Sprintf("ip:%s", ip)
+}
+
+func EmailKey(email string) string {
+	return fmt.Sprintf("email:%s", strings.ToLower(strings.TrimSpace(email)))
+}
+
+func UserKey(userID string) string {
+	return fmt.Sprintf("user:%s", userID)
+}
+
+func (l *Limiter) Check(ctx context.Context, rule Rule, keys ...string) error {
+	for _, key := range keys {
+		ttl, err := l.repo.GetLockTTL(ctx, redisKey(constant.BruteForceLock, rule.Scope, key))
+		if err != nil {
+			return err
+		}
+
+		if ttl > 0 {
+			return httperror.New(http.StatusTooManyRequests, response.TooManyAttemptsMessage)
+		}
+	}
+
+	return nil
+}
+
+func (l *Limiter) Hit(ctx context.Context, rule Rule, keys ...string) error {
+	now := time.Now()
+	for _, key := range keys {
+		windowKey := redisKey(constant.BruteForceWindow, rule.Scope, key)
+		count, err := l.repo.AddAttempt(ctx, windowKey, now, rule.Window)
+		if err != nil {
+			return err
+		}
+
+		if count < rule.Limit {
+			continue
+		}
+
+		level, err := l.repo.IncrementLevel(ctx, redisKey(constant.BruteForceLevel, rule.Scope, key), rule.Lockouts[len(rule.Lockouts)-1])
+		if err != nil {
+			return err
+		}
+
+		index := int(level) - 1
+		if index >= len(rule.Lockouts) {
+			index = len(rule.Lockouts) - 1
+		}
+
+		if err := l.repo.SetLock(ctx, redisKey(constant.BruteForceLock, rule.Scope, key), level, rule.Lockouts[index]); err != nil {
+			return err
+		}
+
+		if err := l.repo.DeleteKeys(ctx, windowKey); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (l *Limiter) Reset(ctx context.Context, rule Rule, keys ...string) error {
+	redisKeys := make([]string, 0, len(keys))
+	for _, key := range keys {
+		redisKeys = append(redisKeys, redisKey(constant.BruteForceWindow, rule.Scope, key))
+	}
+
+	return l.repo.DeleteKeys(ctx, redisKeys...)
+}
+
+func (l *Limiter) GetLockouts(ctx context.Context) ([]*Lockout, error) {
+	prefix := fmt.Sprintf("%s:%s:", constant.BruteForceKey, constant.BruteForceLock)
+	keys, err := l.repo.GetLockKeys(ctx, prefix+"*")
+	if err != nil {
+		return nil, err
+	}
+
+	lockouts := make([]*Lockout, 0)
+	for _, key := range keys {
+		scopeKey := strings.SplitN(strings.TrimPrefix(key, prefix), ":", 2)
+		if len(scopeKey) != 2 {
+			continue
+		}
+
+		ttl, errTTL := l.repo.GetLockTTL(ctx, key)
+		if errTTL != nil {
+			return nil, errTTL
+		}
+
+		if ttl <= 0 {
+			continue
+		}
+
+		level, errLevel := l.repo.GetLockLevel(ctx, key)
+		if errLevel != nil {
+			return nil, errLevel
+		}
+
+		lockouts = append(lockouts, &Lockout{
+			Scope:     scopeKey[0],
+			Key:       scopeKey[1],
+			Level:     level,
+			ExpiredAt: time.Now().Add(ttl),
+		})
+	}
+
+	return lockouts, nil
+}
+
+func (l *Limiter) Unlock(ctx context.Context, scope, key string) error {
+	return l.repo.DeleteKeys(ctx,
+		redisKey(constant.BruteForceLock, scope, key),
+		redisKey(constant.BruteForceWindow, scope, key),
+		redisKey(constant.BruteForceLevel, scope, key))
+}
+
+func redisKey(kind, scope, key string) string {
+	return fmt.Sprintf("%s:%s:%s:%s", constant.BruteForceKey, kind, scope, key)
+}
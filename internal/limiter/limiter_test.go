@@ -0,0 +1,146 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"murakali/internal/limiter/mocks"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestLimiter_Check(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "not locked",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetLockTTL", mock.Anything, "bruteforce:lock:login:email:a@test.com").Return(time.Duration(0), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "locked",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetLockTTL", mock.Anything, "bruteforce:lock:login:email:a@test.com").Return(time.Minute, nil)
+			},
+			expectedErr: httperror.New(http.StatusTooManyRequests, response.TooManyAttemptsMessage),
+		},
+		{
+			name: "error redis",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			l := NewLimiter(r)
+
+			tc.mock(t, r)
+			err := l.Check(context.Background(), LoginRule, EmailKey(" A@test.com "))
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestLimiter_Hit(t *testing.T) {
+	windowKey := "bruteforce:window:otp:user:1"
+	lockKey := "bruteforce:lock:otp:user:1"
+	levelKey := "bruteforce:level:otp:user:1"
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "below limit",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("AddAttempt", mock.Anything, windowKey, mock.Anything, OTPRule.Window).Return(int64(4), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "first lockout",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("AddAttempt", mock.Anything, windowKey, mock.Anything, OTPRule.Window).Return(int64(5), nil)
+				r.On("IncrementLevel", mock.Anything, levelKey, 24*time.Hour).Return(int64(1), nil)
+				r.On("SetLock", mock.Anything, lockKey, int64(1), time.Minute).Return(nil)
+				r.On("DeleteKeys", mock.Anything, windowKey).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "lockout capped at last level",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("AddAttempt", mock.Anything, windowKey, mock.Anything, OTPRule.Window).Return(int64(5), nil)
+				r.On("IncrementLevel", mock.Anything, levelKey, 24*time.Hour).Return(int64(9), nil)
+				r.On("SetLock", mock.Anything, lockKey, int64(9), 24*time.Hour).Return(nil)
+				r.On("DeleteKeys", mock.Anything, windowKey).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error add attempt",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("AddAttempt", mock.Anything, windowKey, mock.Anything, OTPRule.Window).Return(int64(0), fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			l := NewLimiter(r)
+
+			tc.mock(t, r)
+			err := l.Hit(context.Background(), OTPRule, UserKey("1"))
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestLimiter_GetLockouts(t *testing.T) {
+	r := mocks.NewRepository(t)
+	l := NewLimiter(r)
+
+	r.On("GetLockKeys", mock.Anything, "bruteforce:lock:*").Return([]string{
+		"bruteforce:lock:login:email:a@test.com",
+		"bruteforce:lock:ip:ip:127.0.0.1",
+	}, nil)
+	r.On("GetLockTTL", mock.Anything, "bruteforce:lock:login:email:a@test.com").Return(time.Minute, nil)
+	r.On("GetLockLevel", mock.Anything, "bruteforce:lock:login:email:a@test.com").Return(int64(2), nil)
+	r.On("GetLockTTL", mock.Anything, "bruteforce:lock:ip:ip:127.0.0.1").Return(time.Duration(0), nil)
+
+	lockouts, err := l.GetLockouts(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, lockouts, 1)
+	assert.Equal(t, "login", lockouts[0].Scope)
+	assert.Equal(t, "email:a@test.com", lockouts[0].Key)
+	assert.Equal(t, int64(2), lockouts[0].Level)
+}
+
+func TestLimiter_Unlock(t *testing.T) {
+	r := mocks.NewRepository(t)
+	l := NewLimiter(r)
+
+	r.On("DeleteKeys", mock.Anything,
+		"bruteforce:lock:login:email:a@test.com",
+		"bruteforce:window:login:email:a@test.com",
+		"bruteforce:level:login:email:a@test.com").Return(nil)
+
+	err := l.Unlock(context.Background(), "login", "email:a@test.com")
+	assert.NoError(t, err)
+}
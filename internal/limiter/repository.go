@@ -0,0 +1,109 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type Repository interface {
+	GetLockTTL(ctx context.Context, key string) (time.Duration, error)
+	GetLockLevel(ctx context.Context, key string) (int64, error)
+	GetLockKeys(ctx context.Context, pattern string) ([]string, error)
+	AddAttempt(ctx context.Context, key string, at time.Time, window time.Duration) (int64, error)
+	IncrementLevel(ctx context.Context, key string, ttl time.Duration) (int64, error)
+	SetLock(ctx context.Context, key string, level int64, duration time.Duration) error
+	DeleteKeys(ctx context.Context, keys ...string) error
+}
+
+type limiterRepo struct {
+	RedisClient *redis.Client
+}
+
+func NewLimiterRepository(client *redis.Client) Repository {
+	return &limiterRepo{
+		RedisClient: client,
+	}
+}
+
+func (r *limiterRepo) GetLockTTL(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := r.RedisClient.PTTL(ctx, key).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+func (r *limiterRepo) GetLockLevel(ctx context.Context, key string) (int64, error) {
+	value, err := r.RedisClient.Get(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	return strconv.ParseInt(value, 10, 64)
+}
+
+func (r *limiterRepo) GetLockKeys(ctx context.Context, pattern string) ([]string, error) {
+	keys := make([]string, 0)
+
+	iter := r.RedisClient.Scan(ctx, 0, pattern, 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		return keys, err
+	}
+
+	return keys, nil
+}
+
+func (r *limiterRepo) AddAttempt(ctx context.Context, key string, at time.Time, window time.Duration) (int64, error) {
+	var count *redis.IntCmd
+	_, err := r.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZRemRangeByScore(ctx, key, "-inf", fmt.Sprintf("%d", at.Add(-window).UnixNano()))
+		pipe.ZAdd(ctx, key, &redis.Z{Score: float64(at.UnixNano()), Member: at.UnixNano()})
+		count = pipe.ZCard(ctx, key)
+		pipe.Expire(ctx, key, window)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count.Val(), nil
+}
+
+func (r *limiterRepo) IncrementLevel(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	var level *redis.IntCmd
+	_, err := r.RedisClient.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		level = pipe.Incr(ctx, key)
+		pipe.Expire(ctx, key, ttl)
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return level.Val(), nil
+}
+
+func (r *limiterRepo) SetLock(ctx context.Context, key string, level int64, duration time.Duration) error {
+	return r.RedisClient.Set(ctx, key, level, duration).Err()
+}
+
+func (r *limiterRepo) DeleteKeys(ctx context.Context, keys ...string) error {
+	return r.RedisClient.Del(ctx, keys...).Err()
+}
@@ -1,16 +1,21 @@
 package middleware
 
 import (
+	"fmt"
+	"murakali/internal/constant"
+	"murakali/internal/model"
 	"murakali/pkg/jwt"
 	"murakali/pkg/response"
 	"net/http"
 
 	"github.com/gin-gonic/gin"
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
 )
 
 func (mw *MWManager) AuthJWTMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		claim, err := jwt.ExtractJWTFromRequest(c.Request, mw.RedisClient, mw.cfg.JWT.JwtSecretKey)
+		claim, err := jwt.ExtractJWTFromRequest(c.Request, mw.RedisClient, mw.keySet)
 		if err != nil {
 			response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 			c.Abort()
@@ -23,9 +28,76 @@ func (mw *MWManager) AuthJWTMiddleware() gin.HandlerFunc {
 			return
 		}
 
-		mw.log.Infof("body middleware bearerHeader %s", claim["id"].(string))
-		c.Set("userID", claim["id"].(string))
+		userID := claim["id"].(string)
+		status, err := mw.RedisClient.Get(c, fmt.Sprintf("%s:%s", constant.AccountStatusKey, userID)).Result()
+		if err != nil && err != redis.Nil {
+			mw.log.Errorf("AuthJWTMiddleware, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			c.Abort()
+			return
+		}
+
+		switch status {
+		case constant.AccountStatusSuspended:
+			response.ErrorResponse(c.Writer, response.AccountSuspendedMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		case constant.AccountStatusBanned:
+			response.ErrorResponse(c.Writer, response.AccountBannedMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		}
+
+		mw.log.Infof("body middleware bearerHeader %s", userID)
+		c.Set("userID", userID)
 		c.Set("roleID", claim["role_id"].(float64))
+		if sessionID, ok := claim["sid"].(string); ok {
+			c.Set("sessionID", sessionID)
+		}
+
+		actorID, ok := claim["act"].(string)
+		if !ok || actorID == "" {
+			c.Next()
+			return
+		}
+
+		if c.Request.Method != http.MethodGet {
+			response.ErrorResponse(c.Writer, response.ImpersonationReadOnlyMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		}
+
+		c.Set("impersonatorID", actorID)
 		c.Next()
+
+		mw.auditImpersonation(c, actorID, userID, claim["sid"])
+	}
+}
+
+func (mw *MWManager) auditImpersonation(c *gin.Context, actorID, userID string, sessionID interface{}) {
+	adminID, err := uuid.Parse(actorID)
+	if err != nil {
+		return
+	}
+
+	targetID, err := uuid.Parse(userID)
+	if err != nil {
+		return
+	}
+
+	auditLog := &model.AdminAuditLog{
+		AdminID: adminID,
+		UserID:  targetID,
+		Action:  constant.AdminActionImpersonationRequest,
+		Detail:  fmt.Sprintf("%s %s %d", c.Request.Method, c.Request.URL.Path, c.Writer.Status()),
+	}
+	if sid, ok := sessionID.(string); ok {
+		if impersonationID, errParse := uuid.Parse(sid); errParse == nil {
+			auditLog.ImpersonationID = &impersonationID
+		}
+	}
+
+	if err := mw.authorizer.AuditAdminAction(c, auditLog); err != nil {
+		mw.log.Errorf("AuthJWTMiddleware, Error: %s", err)
 	}
 }
@@ -0,0 +1,57 @@
+package middleware
+
+import (
+	"errors"
+	"murakali/internal/limiter"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (mw *MWManager) BruteForceMiddleware(rule limiter.Rule) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ipKey := limiter.IPKey(c.ClientIP())
+		userKey := ""
+		if userID, exist := c.Get("userID"); exist {
+			userKey = limiter.UserKey(userID.(string))
+		}
+
+		err := mw.limiter.Check(c, limiter.IPRule, ipKey)
+		if err == nil && userKey != "" {
+			err = mw.limiter.Check(c, rule, userKey)
+		}
+
+		if err != nil {
+			var e *httperror.Error
+			if !errors.As(err, &e) {
+				mw.log.Errorf("BruteForceMiddleware, Error: %s", err)
+				response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+				c.Abort()
+				return
+			}
+
+			response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+
+		status := c.Writer.Status()
+		if status != http.StatusUnauthorized && status != http.StatusForbidden {
+			return
+		}
+
+		if errHit := mw.limiter.Hit(c, limiter.IPRule, ipKey); errHit != nil {
+			mw.log.Errorf("BruteForceMiddleware, Error: %s", errHit)
+		}
+
+		if userKey != "" {
+			if errHit := mw.limiter.Hit(c, rule, userKey); errHit != nil {
+				mw.log.Errorf("BruteForceMiddleware, Error: %s", errHit)
+			}
+		}
+	}
+}
@@ -3,6 +3,9 @@ package middleware
 import (
 	"github.com/go-redis/redis/v8"
 	"murakali/config"
+	"murakali/internal/limiter"
+	"murakali/internal/rbac"
+	"murakali/pkg/jwt"
 	"murakali/pkg/logger"
 )
 
@@ -11,8 +14,13 @@ type MWManager struct {
 	origins     []string
 	log         logger.Logger
 	RedisClient *redis.Client
+	limiter     *limiter.Limiter
+	authorizer  *rbac.Authorizer
+	keySet      *jwt.KeySet
 }
 
-func NewMiddlewareManager(cfg *config.Config, origins []string, log logger.Logger, redisClient *redis.Client) *MWManager {
-	return &MWManager{cfg: cfg, origins: origins, log: log, RedisClient: redisClient}
+func NewMiddlewareManager(cfg *config.Config, origins []string, log logger.Logger, redisClient *redis.Client,
+	bruteForceLimiter *limiter.Limiter, authorizer *rbac.Authorizer, keySet *jwt.KeySet) *MWManager {
+	return &MWManager{cfg: cfg, origins: origins, log: log, RedisClient: redisClient, limiter: bruteForceLimiter, authorizer: authorizer,
+		keySet: keySet}
 }
@@ -0,0 +1,35 @@
+package middleware
+
+import (
+	"murakali/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+func (mw *MWManager) RequirePermission(permission string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exist := c.Get("userID")
+		if !exist {
+			response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		}
+
+		allowed, err := mw.authorizer.HasPermission(c, userID.(string), permission)
+		if err != nil {
+			mw.log.Errorf("RequirePermission, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			c.Abort()
+			return
+		}
+
+		if !allowed {
+			response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,84 @@
+package middleware
+
+import (
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/pkg/response"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+func (mw *MWManager) RequireShopScope(scope string) gin.HandlerFunc {
+	return mw.requireShop(scope, false)
+}
+
+func (mw *MWManager) RequireShopOwner() gin.HandlerFunc {
+	return mw.requireShop("", true)
+}
+
+func (mw *MWManager) requireShop(scope string, ownerOnly bool) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, exist := c.Get("userID")
+		if !exist {
+			response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		}
+
+		access, err := mw.authorizer.ShopAccess(c, userID.(string))
+		if err != nil {
+			mw.log.Errorf("RequireShopScope, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			c.Abort()
+			return
+		}
+
+		if access == nil || (ownerOnly && !access.IsOwner()) || !access.HasScope(scope) {
+			response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+			c.Abort()
+			return
+		}
+
+		if access.IsOwner() {
+			allowed, errPermission := mw.authorizer.HasPermission(c, userID.(string), constant.PermissionShopManage)
+			if errPermission != nil {
+				mw.log.Errorf("RequireShopScope, Error: %s", errPermission)
+				response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+				c.Abort()
+				return
+			}
+
+			if !allowed {
+				response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+				c.Abort()
+				return
+			}
+		}
+
+		c.Set("shopID", access.ShopID.String())
+		c.Next()
+
+		if c.Request.Method == http.MethodGet || c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+
+		actorID, err := uuid.Parse(userID.(string))
+		if err != nil {
+			return
+		}
+
+		auditLog := &model.ShopAuditLog{
+			ShopID:     access.ShopID,
+			ActorID:    actorID,
+			StaffID:    access.StaffID,
+			Action:     c.Request.Method + " " + c.FullPath(),
+			Path:       c.Request.URL.Path,
+			StatusCode: c.Writer.Status(),
+		}
+		if err := mw.authorizer.AuditShopAction(c, auditLog); err != nil {
+			mw.log.Errorf("RequireShopScope, Error: %s", err)
+		}
+	}
+}
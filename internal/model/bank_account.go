@@ -0,0 +1,19 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type BankAccount struct {
+	ID            uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	ShopID        uuid.UUID    `json:"shop_id" db:"shop_id" binding:"omitempty"`
+	BankName      string       `json:"bank_name" db:"bank_name" binding:"omitempty"`
+	AccountNumber string       `json:"account_number" db:"account_number" binding:"omitempty"`
+	AccountHolder string       `json:"account_holder" db:"account_holder" binding:"omitempty"`
+	CreatedAt     time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
+	UpdatedAt     sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
+	DeletedAt     sql.NullTime `json:"deleted_at" db:"deleted_at" binding:"omitempty"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ImpersonationSession struct {
+	ID        uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	AdminID   uuid.UUID    `json:"admin_id" db:"admin_id" binding:"omitempty"`
+	UserID    uuid.UUID    `json:"user_id" db:"user_id" binding:"omitempty"`
+	Reason    string       `json:"reason" db:"reason" binding:"omitempty"`
+	ExpiredAt time.Time    `json:"expired_at" db:"expired_at" binding:"omitempty"`
+	EndedAt   sql.NullTime `json:"ended_at" db:"ended_at" binding:"omitempty"`
+	CreatedAt time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
+}
+
+type AdminAuditLog struct {
+	ID              uuid.UUID  `json:"id" db:"id" binding:"omitempty"`
+	AdminID         uuid.UUID  `json:"admin_id" db:"admin_id" binding:"omitempty"`
+	AdminEmail      string     `json:"admin_email" db:"admin_email" binding:"omitempty"`
+	UserID          uuid.UUID  `json:"user_id" db:"user_id" binding:"omitempty"`
+	ImpersonationID *uuid.UUID `json:"impersonation_id" db:"impersonation_id" binding:"omitempty"`
+	Action          string     `json:"action" db:"action" binding:"omitempty"`
+	Detail          string     `json:"detail" db:"detail" binding:"omitempty"`
+	CreatedAt       time.Time  `json:"created_at" db:"created_at" binding:"omitempty"`
+}
@@ -0,0 +1,18 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type JobRun struct {
+	ID           uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	JobName      string       `json:"job_name" db:"job_name" binding:"omitempty"`
+	Instance     string       `json:"instance" db:"instance" binding:"omitempty"`
+	StartedAt    time.Time    `json:"started_at" db:"started_at" binding:"omitempty"`
+	FinishedAt   sql.NullTime `json:"finished_at" db:"finished_at" binding:"omitempty"`
+	RowsAffected int64        `json:"rows_affected" db:"rows_affected" binding:"omitempty"`
+	Error        string       `json:"error" db:"error" binding:"omitempty"`
+}
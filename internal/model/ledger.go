@@ -0,0 +1,41 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type LedgerAccount struct {
+	ID          uuid.UUID  `json:"id" db:"id" binding:"omitempty"`
+	AccountType string     `json:"account_type" db:"account_type" binding:"omitempty"`
+	OwnerID     string     `json:"owner_id" db:"owner_id" binding:"omitempty"`
+	WalletID    *uuid.UUID `json:"wallet_id" db:"wallet_id" binding:"omitempty"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at" binding:"omitempty"`
+}
+
+type LedgerTransaction struct {
+	ID            uuid.UUID      `json:"id" db:"id" binding:"omitempty"`
+	Reference     string         `json:"reference" db:"reference" binding:"omitempty"`
+	Description   string         `json:"description" db:"description" binding:"omitempty"`
+	TransactionID *uuid.UUID     `json:"transaction_id" db:"transaction_id" binding:"omitempty"`
+	CreatedAt     time.Time      `json:"created_at" db:"created_at" binding:"omitempty"`
+	Entries       []*LedgerEntry `json:"entries"`
+}
+
+type LedgerEntry struct {
+	ID                  uuid.UUID      `json:"id" db:"id" binding:"omitempty"`
+	LedgerTransactionID uuid.UUID      `json:"ledger_transaction_id" db:"ledger_transaction_id" binding:"omitempty"`
+	AccountID           uuid.UUID      `json:"account_id" db:"account_id" binding:"omitempty"`
+	Account             *LedgerAccount `json:"account"`
+	Direction           string         `json:"direction" db:"direction" binding:"omitempty"`
+	Amount              Money          `json:"amount" db:"amount" binding:"omitempty"`
+	CreatedAt           time.Time      `json:"created_at" db:"created_at" binding:"omitempty"`
+}
+
+type LedgerDrift struct {
+	WalletID      uuid.UUID `json:"wallet_id" db:"wallet_id" binding:"omitempty"`
+	UserID        uuid.UUID `json:"user_id" db:"user_id" binding:"omitempty"`
+	Balance       Money     `json:"balance" db:"balance" binding:"omitempty"`
+	LedgerBalance Money     `json:"ledger_balance" db:"ledger_balance" binding:"omitempty"`
+}
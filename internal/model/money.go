@@ -0,0 +1,79 @@
+package model
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+)
+
+type Money int64
+
+func NewMoney(amount float64) Money {
+	return Money(math.Round(amount))
+}
+
+func (m Money) Int64() int64 {
+	return int64(m)
+}
+
+func (m Money) Float64() float64 {
+	return float64(m)
+}
+
+func (m Money) String() string {
+	return strconv.FormatInt(int64(m), 10)
+}
+
+func (m Money) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(m), 10), nil
+}
+
+func (m *Money) UnmarshalJSON(data []byte) error {
+	data = bytes.Trim(bytes.TrimSpace(data), `"`)
+	if len(data) == 0 || string(data) == "null" {
+		*m = 0
+		return nil
+	}
+
+	return m.scanString(string(data))
+}
+
+func (m *Money) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case nil:
+		*m = 0
+	case int64:
+		*m = Money(v)
+	case float64:
+		*m = NewMoney(v)
+	case []byte:
+		return m.scanString(string(v))
+	case string:
+		return m.scanString(v)
+	default:
+		return fmt.Errorf("cannot scan %T into Money", src)
+	}
+
+	return nil
+}
+
+func (m *Money) scanString(s string) error {
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		*m = Money(i)
+		return nil
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("cannot scan %q into Money", s)
+	}
+
+	*m = NewMoney(f)
+	return nil
+}
+
+func (m Money) Value() (driver.Value, error) {
+	return int64(m), nil
+}
@@ -8,33 +8,34 @@ import (
 )
 
 type Order struct {
-	OrderID            string         `json:"order_id"`
-	TransactionID      string         `json:"transaction_id"`
-	OrderStatus        int            `json:"order_status"`
-	TotalPrice         *float64       `json:"total_price"`
-	DeliveryFee        *float64       `json:"delivery_fee"`
-	ResiNumber         *string        `json:"resi_no"`
-	ShopID             string         `json:"shop_id"`
-	ShopName           string         `json:"shop_name"`
-	ShopPhoneNumber    *string        `json:"shop_phone_number"`
-	SellerName         string         `json:"seller_name"`
-	VoucherCode        *string        `json:"voucher_code"`
-	CreatedAt          time.Time      `json:"created_at"`
-	Invoice            *string        `json:"invoice"`
-	CourierName        string         `json:"courier_name"`
-	CourierCode        string         `json:"courier_code"`
-	CourierService     string         `json:"courier_service"`
-	CourierETD         string         `json:"courier_etd"`
-	CourierDescription string         `json:"courier_description"`
-	BuyerUsername      string         `json:"buyer_username"`
-	BuyerPhoneNumber   *string        `json:"buyer_phone_number"`
-	BuyerAddress       *Address       `json:"buyer_address"`
-	SellerAddress      *Address       `json:"seller_address"`
-	StrBuyerAddress    string         `json:"str_buyer_address"`
-	StrSellerAddress   string         `json:"str_seller_address"`
-	IsWithdraw         bool           `json:"is_withdraw"`
-	IsRefund           bool           `json:"is_refund"`
-	Detail             []*OrderDetail `json:"detail"`
+	OrderID            string                `json:"order_id"`
+	TransactionID      string                `json:"transaction_id"`
+	OrderStatus        int                   `json:"order_status"`
+	TotalPrice         *Money                `json:"total_price"`
+	DeliveryFee        *Money                `json:"delivery_fee"`
+	ResiNumber         *string               `json:"resi_no"`
+	ShopID             string                `json:"shop_id"`
+	ShopName           string                `json:"shop_name"`
+	ShopPhoneNumber    *string               `json:"shop_phone_number"`
+	SellerName         string                `json:"seller_name"`
+	VoucherCode        *string               `json:"voucher_code"`
+	CreatedAt          time.Time             `json:"created_at"`
+	Invoice            *string               `json:"invoice"`
+	CourierName        string                `json:"courier_name"`
+	CourierCode        string                `json:"courier_code"`
+	CourierService     string                `json:"courier_service"`
+	CourierETD         string                `json:"courier_etd"`
+	CourierDescription string                `json:"courier_description"`
+	BuyerUsername      string                `json:"buyer_username"`
+	BuyerPhoneNumber   *string               `json:"buyer_phone_number"`
+	BuyerAddress       *Address              `json:"buyer_address"`
+	SellerAddress      *Address              `json:"seller_address"`
+	StrBuyerAddress    string                `json:"str_buyer_address"`
+	StrSellerAddress   string                `json:"str_seller_address"`
+	IsWithdraw         bool                  `json:"is_withdraw"`
+	IsRefund           bool                  `json:"is_refund"`
+	Detail             []*OrderDetail        `json:"detail"`
+	StatusHistory      []*OrderStatusHistory `json:"status_history"`
 }
 
 type OrderModel struct {
@@ -45,8 +46,8 @@ type OrderModel struct {
 	CourierID     uuid.UUID    `json:"courier_id" db:"courier_id" binding:"omitempty"`
 	VoucherShopID *uuid.UUID   `json:"voucher_shop_id" db:"courier_id" binding:"omitempty"`
 	OrderStatusID int          `json:"order_status_id" db:"order_status_id" binding:"omitempty"`
-	TotalPrice    float64      `json:"total_price" db:"total_price" binding:"omitempty"`
-	DeliveryFee   float64      `json:"delivery_fee" db:"delivery_fee" binding:"omitempty"`
+	TotalPrice    Money        `json:"total_price" db:"total_price" binding:"omitempty"`
+	DeliveryFee   Money        `json:"delivery_fee" db:"delivery_fee" binding:"omitempty"`
 	ResiNo        *string      `json:"resi_no" db:"resi_no" binding:"omitempty"`
 	BuyerAddress  string       `json:"buyer_address" db:"buyer_address" binding:"omitempty"`
 	ShopAddress   string       `json:"shop_address" db:"shop_address" binding:"omitempty"`
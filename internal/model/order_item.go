@@ -9,8 +9,8 @@ type OrderItem struct {
 	OrderID         uuid.UUID `json:"order_id" db:"order_id" binding:"omitempty"`
 	ProductDetailID uuid.UUID `json:"product_detail_id" db:"product_detail_id" binding:"omitempty"`
 	Quantity        int       `json:"quantity" db:"quantity" binding:"omitempty"`
-	ItemPrice       float64   `json:"item_price" db:"item_price" binding:"omitempty"`
-	TotalPrice      float64   `json:"total_price" db:"total_price" binding:"omitempty"`
+	ItemPrice       Money     `json:"item_price" db:"item_price" binding:"omitempty"`
+	TotalPrice      Money     `json:"total_price" db:"total_price" binding:"omitempty"`
 	Note            string    `json:"note" db:"note" binding:"omitempty"`
 	IsReview        bool      `json:"is_review" db:"is_review" binding:"omitempty"`
 }
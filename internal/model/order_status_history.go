@@ -0,0 +1,17 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderStatusHistory struct {
+	ID                uuid.UUID  `json:"id" db:"id" binding:"omitempty"`
+	OrderID           uuid.UUID  `json:"order_id" db:"order_id" binding:"omitempty"`
+	FromOrderStatusID int        `json:"from_order_status_id" db:"from_order_status_id" binding:"omitempty"`
+	ToOrderStatusID   int        `json:"to_order_status_id" db:"to_order_status_id" binding:"omitempty"`
+	Actor             string     `json:"actor" db:"actor" binding:"omitempty"`
+	ActorID           *uuid.UUID `json:"actor_id" db:"actor_id" binding:"omitempty"`
+	CreatedAt         time.Time  `json:"created_at" db:"created_at" binding:"omitempty"`
+}
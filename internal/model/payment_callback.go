@@ -0,0 +1,26 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PaymentCallback struct {
+	ID              uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	TransactionID   uuid.UUID    `json:"transaction_id" db:"transaction_id" binding:"omitempty"`
+	OriginalID      *uuid.UUID   `json:"original_id" db:"original_id" binding:"omitempty"`
+	IdempotencyKey  string       `json:"idempotency_key" db:"idempotency_key" binding:"omitempty"`
+	CallbackType    string       `json:"callback_type" db:"callback_type" binding:"omitempty"`
+	TxnID           string       `json:"txn_id" db:"txn_id" binding:"omitempty"`
+	Nonce           string       `json:"nonce" db:"nonce" binding:"omitempty"`
+	Status          string       `json:"status" db:"status" binding:"omitempty"`
+	Message         string       `json:"message" db:"message" binding:"omitempty"`
+	RawBody         string       `json:"raw_body" db:"raw_body" binding:"omitempty"`
+	Outcome         string       `json:"outcome" db:"outcome" binding:"omitempty"`
+	ResponseStatus  *int         `json:"response_status" db:"response_status" binding:"omitempty"`
+	ResponseMessage string       `json:"response_message" db:"response_message" binding:"omitempty"`
+	ReceivedAt      time.Time    `json:"received_at" db:"received_at" binding:"omitempty"`
+	ProcessedAt     sql.NullTime `json:"processed_at" db:"processed_at" binding:"omitempty"`
+}
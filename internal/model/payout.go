@@ -0,0 +1,36 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Payout struct {
+	ID            uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	ShopID        uuid.UUID    `json:"shop_id" db:"shop_id" binding:"omitempty"`
+	ShopName      string       `json:"shop_name" db:"shop_name" binding:"omitempty"`
+	SellerID      uuid.UUID    `json:"seller_id" db:"seller_id" binding:"omitempty"`
+	BankAccountID uuid.UUID    `json:"bank_account_id" db:"bank_account_id" binding:"omitempty"`
+	BankAccount   *BankAccount `json:"bank_account"`
+	PayoutBatchID *uuid.UUID   `json:"payout_batch_id" db:"payout_batch_id" binding:"omitempty"`
+	Amount        Money        `json:"amount" db:"amount" binding:"omitempty"`
+	OrderCount    int          `json:"order_count" db:"order_count" binding:"omitempty"`
+	Status        string       `json:"status" db:"status" binding:"omitempty"`
+	Note          *string      `json:"note" db:"note" binding:"omitempty"`
+	RequestedAt   time.Time    `json:"requested_at" db:"requested_at" binding:"omitempty"`
+	ApprovedAt    sql.NullTime `json:"approved_at" db:"approved_at" binding:"omitempty"`
+	RejectedAt    sql.NullTime `json:"rejected_at" db:"rejected_at" binding:"omitempty"`
+}
+
+type PayoutBatch struct {
+	ID          uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	Status      string       `json:"status" db:"status" binding:"omitempty"`
+	TotalAmount Money        `json:"total_amount" db:"total_amount" binding:"omitempty"`
+	PayoutCount int          `json:"payout_count" db:"payout_count" binding:"omitempty"`
+	CreatedBy   uuid.UUID    `json:"created_by" db:"created_by" binding:"omitempty"`
+	ApprovedBy  *uuid.UUID   `json:"approved_by" db:"approved_by" binding:"omitempty"`
+	ApprovedAt  sql.NullTime `json:"approved_at" db:"approved_at" binding:"omitempty"`
+	CreatedAt   time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
+}
@@ -0,0 +1,7 @@
+package model
+
+type PriceTier struct {
+	MinQuantity int   `json:"min_quantity" db:"min_quantity" binding:"omitempty"`
+	MaxQuantity *int  `json:"max_quantity" db:"max_quantity" binding:"omitempty"`
+	Price       Money `json:"price" db:"price" binding:"omitempty"`
+}
@@ -20,8 +20,8 @@ type Product struct {
 	ListedStatus  bool         `json:"listed_status" db:"listed_status" binding:"omitempty"`
 	ThumbnailURL  string       `json:"thumbnail_url" db:"thumbnail_url" binding:"omitempty"`
 	RatingAvg     float64      `json:"rating_avg" db:"rating_avg" binding:"omitempty"`
-	MinPrice      float64      `json:"min_price" db:"min_price" binding:"omitempty"`
-	MaxPrice      float64      `json:"max_price" db:"max_price" binding:"omitempty"`
+	MinPrice      Money        `json:"min_price" db:"min_price" binding:"omitempty"`
+	MaxPrice      Money        `json:"max_price" db:"max_price" binding:"omitempty"`
 	CreatedAt     time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
 	UpdatedAt     sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
 	DeletedAt     sql.NullTime `json:"deleted_at" db:"deleted_at" binding:"omitempty"`
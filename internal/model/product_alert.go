@@ -0,0 +1,28 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductAlert struct {
+	ID               uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	UserID           uuid.UUID    `json:"user_id" db:"user_id" binding:"omitempty"`
+	ProductDetailID  uuid.UUID    `json:"product_detail_id" db:"product_detail_id" binding:"omitempty"`
+	AlertType        string       `json:"alert_type" db:"alert_type" binding:"omitempty"`
+	TargetPrice      *Money       `json:"target_price" db:"target_price" binding:"omitempty"`
+	IsTriggered      bool         `json:"is_triggered" db:"is_triggered" binding:"omitempty"`
+	UnsubscribeToken uuid.UUID    `json:"-" db:"unsubscribe_token" binding:"omitempty"`
+	NotifiedAt       sql.NullTime `json:"notified_at" db:"notified_at" binding:"omitempty"`
+	CreatedAt        time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
+	Email            string       `json:"-" db:"email" binding:"omitempty"`
+	ProductID        uuid.UUID    `json:"product_id" db:"product_id" binding:"omitempty"`
+	Title            string       `json:"title" db:"title" binding:"omitempty"`
+	ThumbnailURL     string       `json:"thumbnail_url" db:"thumbnail_url" binding:"omitempty"`
+	Price            Money        `json:"price" db:"price" binding:"omitempty"`
+	CurrentPrice     Money        `json:"current_price" db:"-" binding:"omitempty"`
+	AvailableStock   float64      `json:"available_stock" db:"available_stock" binding:"omitempty"`
+	Discount         *Discount    `json:"-" db:"-" binding:"omitempty"`
+}
@@ -10,7 +10,7 @@ import (
 type ProductDetail struct {
 	ID        uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
 	ProductID uuid.UUID    `json:"product_id" db:"product_id" binding:"omitempty"`
-	Price     float64      `json:"price" db:"price" binding:"omitempty"`
+	Price     Money        `json:"price" db:"price" binding:"omitempty"`
 	Stock     float64      `json:"stock" db:"stock" binding:"omitempty"`
 	Weight    float64      `json:"weight" db:"weight" binding:"omitempty"`
 	Size      float64      `json:"size" db:"size" binding:"omitempty"`
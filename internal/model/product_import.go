@@ -0,0 +1,28 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductImportJob struct {
+	ID               uuid.UUID             `json:"id" db:"id" binding:"omitempty"`
+	ShopID           uuid.UUID             `json:"shop_id" db:"shop_id" binding:"omitempty"`
+	UserID           uuid.UUID             `json:"user_id" db:"user_id" binding:"omitempty"`
+	Status           string                `json:"status" db:"status" binding:"omitempty"`
+	TotalRows        int                   `json:"total_rows" db:"total_rows" binding:"omitempty"`
+	TotalProducts    int                   `json:"total_products" db:"total_products" binding:"omitempty"`
+	ImportedProducts int                   `json:"imported_products" db:"imported_products" binding:"omitempty"`
+	FailedRows       int                   `json:"failed_rows" db:"failed_rows" binding:"omitempty"`
+	Errors           []*ProductImportError `json:"errors" db:"errors" binding:"omitempty"`
+	CreatedAt        time.Time             `json:"created_at" db:"created_at" binding:"omitempty"`
+	FinishedAt       sql.NullTime          `json:"finished_at" db:"finished_at" binding:"omitempty"`
+}
+
+type ProductImportError struct {
+	Row     int    `json:"row"`
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ProductVersion struct {
+	ID        uuid.UUID        `json:"id" db:"id" binding:"omitempty"`
+	ProductID uuid.UUID        `json:"product_id" db:"product_id" binding:"omitempty"`
+	Version   int              `json:"version" db:"version" binding:"omitempty"`
+	UserID    *uuid.UUID       `json:"user_id" db:"user_id" binding:"omitempty"`
+	Note      string           `json:"note" db:"note" binding:"omitempty"`
+	Snapshot  *ProductSnapshot `json:"snapshot" db:"snapshot" binding:"omitempty"`
+	Changes   []*ProductChange `json:"changes" db:"changes" binding:"omitempty"`
+	CreatedAt time.Time        `json:"created_at" db:"created_at" binding:"omitempty"`
+}
+
+type ProductSnapshot struct {
+	Title         string                   `json:"title"`
+	Description   string                   `json:"description"`
+	Thumbnail     string                   `json:"thumbnail"`
+	ListedStatus  bool                     `json:"listed_status"`
+	ProductDetail []*ProductDetailSnapshot `json:"products_detail"`
+}
+
+type ProductDetailSnapshot struct {
+	ID         string             `json:"id"`
+	Price      Money              `json:"price"`
+	Stock      float64            `json:"stock"`
+	Weight     float64            `json:"weight"`
+	Size       float64            `json:"size"`
+	Hazardous  bool               `json:"hazardous"`
+	Condition  string             `json:"condition"`
+	BulkPrice  bool               `json:"bulk_price"`
+	Photo      []string           `json:"photo"`
+	PriceTiers []*PriceTier       `json:"price_tiers"`
+	Variant    []*VariantSnapshot `json:"variant"`
+}
+
+type VariantSnapshot struct {
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type ProductChange struct {
+	Field string      `json:"field"`
+	Old   interface{} `json:"old"`
+	New   interface{} `json:"new"`
+}
+
+type PriceHistory struct {
+	ProductDetailID string    `json:"product_detail_id" db:"product_detail_id" binding:"omitempty"`
+	Price           Money     `json:"price" db:"price" binding:"omitempty"`
+	CreatedAt       time.Time `json:"created_at" db:"created_at" binding:"omitempty"`
+}
@@ -12,9 +12,9 @@ type Promotion struct {
 	Name               string       `json:"name" db:"name" binding:"omitempty"`
 	ProductID          uuid.UUID    `json:"product_id" db:"product_id" binding:"omitempty"`
 	DiscountPercentage *float64     `json:"discount_percentage" db:"discount_percentage" binding:"omitempty"`
-	DiscountFixPrice   *float64     `json:"discount_fix_price" db:"discount_fix_price" binding:"omitempty"`
-	MinProductPrice    *float64     `json:"min_product_price" db:"min_product_price" binding:"omitempty"`
-	MaxDiscountPrice   *float64     `json:"max_discount_price" db:"max_discount_price" binding:"omitempty"`
+	DiscountFixPrice   *Money       `json:"discount_fix_price" db:"discount_fix_price" binding:"omitempty"`
+	MinProductPrice    *Money       `json:"min_product_price" db:"min_product_price" binding:"omitempty"`
+	MaxDiscountPrice   *Money       `json:"max_discount_price" db:"max_discount_price" binding:"omitempty"`
 	Quota              int          `json:"quota" db:"quota" binding:"omitempty"`
 	MaxQuantity        int          `json:"max_quantity" db:"max_quantity" binding:"omitempty"`
 	ActivedDate        time.Time    `json:"actived_date" db:"actived_date" binding:"omitempty"`
@@ -11,3 +11,8 @@ type Role struct {
 	CreatedAt time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
 	UpdatedAt sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
 }
+
+type RolePermission struct {
+	RoleID     int64  `json:"role_id" db:"role_id"`
+	Permission string `json:"permission" db:"permission"`
+}
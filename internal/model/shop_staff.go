@@ -0,0 +1,59 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ShopStaff struct {
+	ID          uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	ShopID      uuid.UUID    `json:"shop_id" db:"shop_id" binding:"omitempty"`
+	UserID      *uuid.UUID   `json:"user_id" db:"user_id" binding:"omitempty"`
+	Email       string       `json:"email" db:"email" binding:"omitempty"`
+	Scopes      []string     `json:"scopes" db:"scopes" binding:"omitempty"`
+	InviteToken *string      `json:"-" db:"invite_token" binding:"omitempty"`
+	InvitedBy   uuid.UUID    `json:"invited_by" db:"invited_by" binding:"omitempty"`
+	ExpiredAt   sql.NullTime `json:"expired_at" db:"expired_at" binding:"omitempty"`
+	AcceptedAt  sql.NullTime `json:"accepted_at" db:"accepted_at" binding:"omitempty"`
+	CreatedAt   time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
+	UpdatedAt   sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
+	DeletedAt   sql.NullTime `json:"deleted_at" db:"deleted_at" binding:"omitempty"`
+}
+
+type ShopAuditLog struct {
+	ID         uuid.UUID  `json:"id" db:"id" binding:"omitempty"`
+	ShopID     uuid.UUID  `json:"shop_id" db:"shop_id" binding:"omitempty"`
+	ActorID    uuid.UUID  `json:"actor_id" db:"actor_id" binding:"omitempty"`
+	ActorEmail string     `json:"actor_email" db:"actor_email" binding:"omitempty"`
+	StaffID    *uuid.UUID `json:"staff_id" db:"staff_id" binding:"omitempty"`
+	Action     string     `json:"action" db:"action" binding:"omitempty"`
+	Path       string     `json:"path" db:"path" binding:"omitempty"`
+	StatusCode int        `json:"status_code" db:"status_code" binding:"omitempty"`
+	CreatedAt  time.Time  `json:"created_at" db:"created_at" binding:"omitempty"`
+}
+
+type ShopAccess struct {
+	ShopID  uuid.UUID
+	StaffID *uuid.UUID
+	Scopes  []string
+}
+
+func (a *ShopAccess) IsOwner() bool {
+	return a.StaffID == nil
+}
+
+func (a *ShopAccess) HasScope(scope string) bool {
+	if a.IsOwner() || scope == "" {
+		return true
+	}
+
+	for _, s := range a.Scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
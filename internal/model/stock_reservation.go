@@ -0,0 +1,20 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StockReservation struct {
+	ID              uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
+	OrderID         uuid.UUID    `json:"order_id" db:"order_id" binding:"omitempty"`
+	OrderItemID     *uuid.UUID   `json:"order_item_id" db:"order_item_id" binding:"omitempty"`
+	ProductDetailID uuid.UUID    `json:"product_detail_id" db:"product_detail_id" binding:"omitempty"`
+	Quantity        int          `json:"quantity" db:"quantity" binding:"omitempty"`
+	Status          string       `json:"status" db:"status" binding:"omitempty"`
+	ExpiredAt       time.Time    `json:"expired_at" db:"expired_at" binding:"omitempty"`
+	CreatedAt       time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
+	UpdatedAt       sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
+}
@@ -10,6 +10,7 @@ type GoogleAuthToken struct {
 type Token struct {
 	AccessToken  *AccessToken
 	RefreshToken *RefreshToken
+	PreAuthToken *PreAuthToken
 }
 
 type AccessToken struct {
@@ -18,6 +19,19 @@ type AccessToken struct {
 }
 
 type RefreshToken struct {
+	ID        string
 	Token     string
 	ExpiredAt time.Time
 }
+
+type PreAuthToken struct {
+	Token     string
+	ExpiredAt time.Time
+}
+
+type OIDCAuthToken struct {
+	Token         *Token
+	RegisterToken *string
+	PathURL       string
+	LinkedUserID  string
+}
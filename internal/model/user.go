@@ -3,6 +3,7 @@ package model
 import (
 	"database/sql"
 	"github.com/google/uuid"
+	"murakali/internal/constant"
 	"time"
 )
 
@@ -22,4 +23,21 @@ type User struct {
 	CreatedAt time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
 	UpdatedAt sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
 	DeletedAt sql.NullTime `json:"deleted_at" db:"deleted_at" binding:"omitempty"`
+
+	Status                string       `json:"status" db:"status" binding:"omitempty"`
+	StatusReason          string       `json:"status_reason" db:"status_reason" binding:"omitempty"`
+	SuspendedUntil        sql.NullTime `json:"suspended_until" db:"suspended_until" binding:"omitempty"`
+	PasswordResetRequired bool         `json:"password_reset_required" db:"password_reset_required" binding:"omitempty"`
+}
+
+func (u *User) AccountStatus() string {
+	if u.Status == constant.AccountStatusSuspended && u.SuspendedUntil.Valid && !u.SuspendedUntil.Time.After(time.Now()) {
+		return constant.AccountStatusActive
+	}
+
+	if u.Status == "" {
+		return constant.AccountStatusActive
+	}
+
+	return u.Status
 }
@@ -0,0 +1,29 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserSession struct {
+	ID         uuid.UUID    `json:"id" db:"id"`
+	UserID     uuid.UUID    `json:"user_id" db:"user_id"`
+	UserAgent  string       `json:"user_agent" db:"user_agent"`
+	IPAddress  string       `json:"ip_address" db:"ip_address"`
+	CreatedAt  time.Time    `json:"created_at" db:"created_at"`
+	LastUsedAt time.Time    `json:"last_used_at" db:"last_used_at"`
+	ExpiredAt  time.Time    `json:"expired_at" db:"expired_at"`
+	RevokedAt  sql.NullTime `json:"revoked_at" db:"revoked_at"`
+}
+
+type SessionRefreshToken struct {
+	ID        uuid.UUID    `json:"id" db:"id"`
+	SessionID uuid.UUID    `json:"session_id" db:"session_id"`
+	ParentID  *uuid.UUID   `json:"parent_id" db:"parent_id"`
+	TokenHash string       `json:"-" db:"token_hash"`
+	CreatedAt time.Time    `json:"created_at" db:"created_at"`
+	ExpiredAt time.Time    `json:"expired_at" db:"expired_at"`
+	UsedAt    sql.NullTime `json:"used_at" db:"used_at"`
+}
@@ -0,0 +1,25 @@
+package model
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserTOTP struct {
+	UserID       uuid.UUID     `json:"user_id" db:"user_id"`
+	Secret       string        `json:"-" db:"secret"`
+	ConfirmedAt  sql.NullTime  `json:"confirmed_at" db:"confirmed_at"`
+	LastUsedStep sql.NullInt64 `json:"-" db:"last_used_step"`
+	CreatedAt    time.Time     `json:"created_at" db:"created_at"`
+	UpdatedAt    sql.NullTime  `json:"updated_at" db:"updated_at"`
+}
+
+type UserRecoveryCode struct {
+	ID        uuid.UUID    `json:"id" db:"id"`
+	UserID    uuid.UUID    `json:"user_id" db:"user_id"`
+	CodeHash  string       `json:"-" db:"code_hash"`
+	CreatedAt time.Time    `json:"created_at" db:"created_at"`
+	UsedAt    sql.NullTime `json:"used_at" db:"used_at"`
+}
@@ -15,9 +15,9 @@ type Voucher struct {
 	ActivedDate        time.Time    `json:"actived_date" db:"actived_date" binding:"omitempty"`
 	ExpiredDate        time.Time    `json:"expired_date" db:"expired_date" binding:"omitempty"`
 	DiscountPercentage *float64     `json:"discount_percentage" db:"discount_percentage" binding:"omitempty"`
-	DiscountFixPrice   *float64     `json:"discount_fix_price" db:"discount_fix_price" binding:"omitempty"`
-	MinProductPrice    *float64     `json:"min_product_price" db:"min_product_price" binding:"omitempty"`
-	MaxDiscountPrice   *float64     `json:"max_discount_price" db:"max_discount_price" binding:"omitempty"`
+	DiscountFixPrice   *Money       `json:"discount_fix_price" db:"discount_fix_price" binding:"omitempty"`
+	MinProductPrice    *Money       `json:"min_product_price" db:"min_product_price" binding:"omitempty"`
+	MaxDiscountPrice   *Money       `json:"max_discount_price" db:"max_discount_price" binding:"omitempty"`
 	CreatedAt          time.Time    `json:"created_at" db:"created_at" binding:"omitempty"`
 	UpdatedAt          sql.NullTime `json:"updated_at" db:"updated_at" binding:"omitempty"`
 	DeletedAt          sql.NullTime `json:"deleted_at" db:"deleted_at" binding:"omitempty"`
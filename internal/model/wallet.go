@@ -8,7 +8,7 @@ import (
 type Wallet struct {
 	ID           uuid.UUID    `json:"id" db:"id" binding:"omitempty"`
 	UserID       uuid.UUID    `json:"user_id" db:"user_id" binding:"omitempty"`
-	Balance      float64      `json:"balance" db:"balance" binding:"omitempty"`
+	Balance      Money        `json:"balance" db:"balance" binding:"omitempty"`
 	PIN          string       `json:"pin" db:"pin" binding:"omitempty"`
 	AttemptCount int          `json:"attempt_count" db:"attempt_count" binding:"omitempty"`
 	AttemptAt    sql.NullTime `json:"attempt_at" db:"attempt_at" binding:"omitempty"`
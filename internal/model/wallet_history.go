@@ -12,6 +12,6 @@ type WalletHistory struct {
 	From          string    `json:"from" db:"from" binding:"omitempty"`
 	To            string    `json:"to" db:"to" binding:"omitempty"`
 	Description   string    `json:"description" db:"description" binding:"omitempty"`
-	Amount        float64   `json:"amount" db:"amount" binding:"omitempty"`
+	Amount        Money     `json:"amount" db:"amount" binding:"omitempty"`
 	CreatedAt     time.Time `json:"created_at" db:"created_at" binding:"omitempty"`
 }
@@ -19,4 +19,32 @@ type Handlers interface {
 	AddBanner(c *gin.Context)
 	DeleteBanner(c *gin.Context)
 	EditBanner(c *gin.Context)
+	GetPaymentCallbacks(c *gin.Context)
+	GetPayouts(c *gin.Context)
+	RejectPayout(c *gin.Context)
+	CreatePayoutBatch(c *gin.Context)
+	GetPayoutBatches(c *gin.Context)
+	ApprovePayoutBatch(c *gin.Context)
+	ExportPayoutBatch(c *gin.Context)
+	GetUserSessions(c *gin.Context)
+	RevokeUserSessions(c *gin.Context)
+	GetLockouts(c *gin.Context)
+	ClearLockout(c *gin.Context)
+	GetRoles(c *gin.Context)
+	GetUserRoles(c *gin.Context)
+	AssignUserRole(c *gin.Context)
+	RemoveUserRole(c *gin.Context)
+	SearchUsers(c *gin.Context)
+	GetUserDetail(c *gin.Context)
+	GetUserOrders(c *gin.Context)
+	GetUserWallet(c *gin.Context)
+	GetUserWalletHistory(c *gin.Context)
+	SearchShops(c *gin.Context)
+	SuspendUser(c *gin.Context)
+	BanUser(c *gin.Context)
+	ReactivateUser(c *gin.Context)
+	ForcePasswordReset(c *gin.Context)
+	ImpersonateUser(c *gin.Context)
+	EndImpersonation(c *gin.Context)
+	GetUserAuditLogs(c *gin.Context)
 }
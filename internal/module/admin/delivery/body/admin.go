@@ -17,6 +17,11 @@ const (
 	UpdateProductFailed                   = "Update product failed"
 	ImageIsEmpty                          = "image cannot be empty"
 	CategoryIsBeingUsed                   = "Category is being used"
+	InvalidLockoutScopeMessage            = "Invalid lockout scope."
+	InvalidSuspensionDurationMessage      = "Suspension must last between 1 hour and 1 year."
+	InvalidImpersonationDurationMessage   = "Impersonation must last at most 60 minutes."
+
+	MaxSuspensionHours = 8760
 )
 
 type UnprocessableEntity struct {
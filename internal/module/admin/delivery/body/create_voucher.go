@@ -1,6 +1,7 @@
 package body
 
 import (
+	"murakali/internal/model"
 	"murakali/pkg/httperror"
 	"murakali/pkg/response"
 	"net/http"
@@ -9,14 +10,14 @@ import (
 )
 
 type CreateVoucherRequest struct {
-	Code               string  `json:"code"`
-	Quota              int     `json:"quota"`
-	ActivedDate        string  `json:"actived_date"`
-	ExpiredDate        string  `json:"expired_date"`
-	DiscountPercentage float64 `json:"discount_percentage"`
-	DiscountFixPrice   float64 `json:"discount_fix_price"`
-	MinProductPrice    float64 `json:"min_product_price"`
-	MaxDiscountPrice   float64 `json:"max_discount_price"`
+	Code               string      `json:"code"`
+	Quota              int         `json:"quota"`
+	ActivedDate        string      `json:"actived_date"`
+	ExpiredDate        string      `json:"expired_date"`
+	DiscountPercentage float64     `json:"discount_percentage"`
+	DiscountFixPrice   model.Money `json:"discount_fix_price"`
+	MinProductPrice    model.Money `json:"min_product_price"`
+	MaxDiscountPrice   model.Money `json:"max_discount_price"`
 	ActiveDateTime     time.Time
 	ExpiredDateTime    time.Time
 }
@@ -0,0 +1,47 @@
+package body
+
+import (
+	"murakali/internal/constant"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+)
+
+type ClearLockoutRequest struct {
+	Scope string `json:"scope"`
+	Key   string `json:"key"`
+}
+
+func (r *ClearLockoutRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"scope": "",
+			"key":   "",
+		},
+	}
+
+	r.Scope = strings.TrimSpace(r.Scope)
+	switch r.Scope {
+	case constant.BruteForceScopeIP, constant.BruteForceScopeLogin, constant.BruteForceScopeOTP, constant.BruteForceScopeWalletPin:
+	default:
+		unprocessableEntity = true
+		entity.Fields["scope"] = InvalidLockoutScopeMessage
+	}
+
+	r.Key = strings.TrimSpace(r.Key)
+	if r.Key == "" {
+		unprocessableEntity = true
+		entity.Fields["key"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
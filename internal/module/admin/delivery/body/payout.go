@@ -0,0 +1,36 @@
+package body
+
+import (
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+)
+
+type RejectPayoutRequest struct {
+	Note string `json:"note"`
+}
+
+func (r *RejectPayoutRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"note": "",
+		},
+	}
+
+	r.Note = strings.TrimSpace(r.Note)
+	if r.Note == "" {
+		unprocessableEntity = true
+		entity.Fields["note"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
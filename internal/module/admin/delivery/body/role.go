@@ -0,0 +1,45 @@
+package body
+
+import (
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+)
+
+type RoleResponse struct {
+	ID          int64    `json:"id"`
+	Name        string   `json:"name"`
+	Permissions []string `json:"permissions"`
+}
+
+type UserRolesResponse struct {
+	PrimaryRoleID int64           `json:"primary_role_id"`
+	Roles         []*RoleResponse `json:"roles"`
+}
+
+type AssignRoleRequest struct {
+	RoleID int64 `json:"role_id"`
+}
+
+func (r *AssignRoleRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"role_id": "",
+		},
+	}
+
+	if r.RoleID <= 0 {
+		unprocessableEntity = true
+		entity.Fields["role_id"] = IDNotValidMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
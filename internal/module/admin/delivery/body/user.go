@@ -0,0 +1,177 @@
+package body
+
+import (
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UserResponse struct {
+	ID                    uuid.UUID  `json:"id"`
+	RoleID                int        `json:"role_id"`
+	Email                 string     `json:"email"`
+	Username              *string    `json:"username"`
+	FullName              *string    `json:"fullname"`
+	PhoneNo               *string    `json:"phone_no"`
+	IsVerify              bool       `json:"is_verify"`
+	IsSSO                 bool       `json:"is_sso"`
+	Status                string     `json:"status"`
+	StatusReason          string     `json:"status_reason"`
+	SuspendedUntil        *time.Time `json:"suspended_until"`
+	PasswordResetRequired bool       `json:"password_reset_required"`
+	CreatedAt             time.Time  `json:"created_at"`
+	DeletedAt             *time.Time `json:"deleted_at"`
+}
+
+type UserDetailResponse struct {
+	*UserResponse
+	Shop   *ShopResponse   `json:"shop"`
+	Wallet *WalletResponse `json:"wallet"`
+}
+
+type ShopResponse struct {
+	ID           uuid.UUID  `json:"id"`
+	Name         string     `json:"name"`
+	UserID       uuid.UUID  `json:"user_id"`
+	OwnerEmail   string     `json:"owner_email"`
+	OwnerStatus  string     `json:"owner_status"`
+	TotalProduct int        `json:"total_product"`
+	RatingAVG    float64    `json:"rating_avg"`
+	CreatedAt    time.Time  `json:"created_at"`
+	DeletedAt    *time.Time `json:"deleted_at"`
+}
+
+type WalletResponse struct {
+	ID         uuid.UUID   `json:"id"`
+	Balance    model.Money `json:"balance"`
+	ActiveDate *time.Time  `json:"active_date"`
+	UnlockedAt *time.Time  `json:"unlocked_at"`
+}
+
+type UserOrderResponse struct {
+	ID            uuid.UUID   `json:"id"`
+	TransactionID uuid.UUID   `json:"transaction_id"`
+	ShopID        uuid.UUID   `json:"shop_id"`
+	ShopName      string      `json:"shop_name"`
+	OrderStatusID int         `json:"order_status_id"`
+	TotalPrice    model.Money `json:"total_price"`
+	DeliveryFee   model.Money `json:"delivery_fee"`
+	ResiNo        *string     `json:"resi_no"`
+	IsRefund      bool        `json:"is_refund"`
+	CreatedAt     time.Time   `json:"created_at"`
+}
+
+type ImpersonationResponse struct {
+	ID          uuid.UUID `json:"id"`
+	UserID      uuid.UUID `json:"user_id"`
+	AccessToken string    `json:"access_token"`
+	ExpiredAt   time.Time `json:"expired_at"`
+}
+
+type UserModerationRequest struct {
+	Reason string `json:"reason"`
+}
+
+func (r *UserModerationRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"reason": "",
+		},
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	if r.Reason == "" {
+		unprocessableEntity = true
+		entity.Fields["reason"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+type SuspendUserRequest struct {
+	Reason        string `json:"reason"`
+	DurationHours int    `json:"duration_hours"`
+}
+
+func (r *SuspendUserRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"reason":         "",
+			"duration_hours": "",
+		},
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	if r.Reason == "" {
+		unprocessableEntity = true
+		entity.Fields["reason"] = FieldCannotBeEmptyMessage
+	}
+
+	if r.DurationHours <= 0 || r.DurationHours > MaxSuspensionHours {
+		unprocessableEntity = true
+		entity.Fields["duration_hours"] = InvalidSuspensionDurationMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+type ImpersonateUserRequest struct {
+	Reason          string `json:"reason"`
+	DurationMinutes int    `json:"duration_minutes"`
+}
+
+func (r *ImpersonateUserRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"reason":           "",
+			"duration_minutes": "",
+		},
+	}
+
+	r.Reason = strings.TrimSpace(r.Reason)
+	if r.Reason == "" {
+		unprocessableEntity = true
+		entity.Fields["reason"] = FieldCannotBeEmptyMessage
+	}
+
+	if r.DurationMinutes == 0 {
+		r.DurationMinutes = constant.ImpersonationDefaultDuration
+	}
+
+	if r.DurationMinutes < 0 || r.DurationMinutes > constant.ImpersonationMaxDuration {
+		unprocessableEntity = true
+		entity.Fields["duration_minutes"] = InvalidImpersonationDurationMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
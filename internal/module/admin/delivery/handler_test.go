@@ -1594,11 +1594,18 @@ func TestAdminHandlers_RefundOrder(t *testing.T) {
 			name:  "Success Refund Order",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
 			mock: func(s *mocks.UseCase) {
-				s.On("RefundOrder", mock.Anything, mock.Anything).Return(nil)
+				s.On("RefundOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expected:   http.StatusOK,
 			authorized: true,
 		},
+		{
+			name:       "Unauthorized Refund Order",
+			param:      "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			mock:       func(s *mocks.UseCase) {},
+			expected:   http.StatusUnauthorized,
+			authorized: false,
+		},
 		{
 			name:       "Failed Refund Order",
 			param:      "",
@@ -1610,7 +1617,7 @@ func TestAdminHandlers_RefundOrder(t *testing.T) {
 			name:  "Failed Refund Order",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
 			mock: func(s *mocks.UseCase) {
-				s.On("RefundOrder", mock.Anything, mock.Anything).Return(fmt.Errorf("error"))
+				s.On("RefundOrder", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("error"))
 			},
 			expected:   http.StatusInternalServerError,
 			authorized: true,
@@ -1619,7 +1626,7 @@ func TestAdminHandlers_RefundOrder(t *testing.T) {
 			name:  "Custom Refund Order",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
 			mock: func(s *mocks.UseCase) {
-				s.On("RefundOrder", mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
+				s.On("RefundOrder", mock.Anything, mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected:   http.StatusBadRequest,
 			authorized: true,
@@ -1672,3 +1679,84 @@ func TestAdminHandlers_RefundOrder(t *testing.T) {
 		})
 	}
 }
+
+func TestAdminHandlers_GetPaymentCallbacks(t *testing.T) {
+	testCase := []struct {
+		name     string
+		param    string
+		mock     func(s *mocks.UseCase)
+		expected int
+	}{
+		{
+			name:     "Invalid Transaction ID",
+			param:    "8302755e-25c5-4523-8498-7dc8b9e3a09",
+			mock:     func(s *mocks.UseCase) {},
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:  "Success Get Payment Callbacks",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			mock: func(s *mocks.UseCase) {
+				s.On("GetPaymentCallbacks", mock.Anything, mock.Anything).Return([]*model.PaymentCallback{{}}, nil)
+			},
+			expected: http.StatusOK,
+		},
+		{
+			name:  "Failed Get Payment Callbacks Custom Error",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			mock: func(s *mocks.UseCase) {
+				s.On("GetPaymentCallbacks", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
+			},
+			expected: http.StatusBadRequest,
+		},
+		{
+			name:  "Failed Get Payment Callbacks Internal Error",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			mock: func(s *mocks.UseCase) {
+				s.On("GetPaymentCallbacks", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("error"))
+			},
+			expected: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			rr := httptest.NewRecorder()
+			c, _ := gin.CreateTestContext(rr)
+
+			r := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v1/admin/transaction/%s/payment-callback", tc.param), nil)
+			r.Header = make(http.Header)
+
+			c.Request = r
+			c.Set("userID", "123456")
+			c.Params = []gin.Param{
+				{
+					Key:   "id",
+					Value: tc.param,
+				},
+			}
+
+			s := mocks.NewUseCase(t)
+
+			cfg := &config.Config{
+				Logger: config.LoggerConfig{
+					Development:       true,
+					DisableCaller:     false,
+					DisableStacktrace: false,
+					Encoding:          "json",
+					Level:             "info",
+				},
+			}
+
+			appLogger := logger.NewAPILogger(cfg)
+			appLogger.InitLogger()
+
+			h := NewAdminHandlers(cfg, s, appLogger)
+
+			tc.mock(s)
+			h.GetPaymentCallbacks(c)
+
+			assert.Equal(t, rr.Code, tc.expected)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"murakali/internal/constant"
 	"murakali/internal/middleware"
 	"murakali/internal/module/admin"
 
@@ -10,24 +11,57 @@ import (
 func MapAdminRoutes(adminGroup *gin.RouterGroup, h admin.Handlers, mw *middleware.MWManager) {
 	adminGroup.GET("/banner", h.GetBanner)
 	adminGroup.Use(mw.AuthJWTMiddleware())
-	adminGroup.Use(mw.AdminJWTMiddleware())
-	adminGroup.GET("/voucher", h.GetAllVoucher)
-	adminGroup.POST("/voucher", h.CreateVoucher)
-	adminGroup.PUT("/voucher", h.UpdateVoucher)
-	adminGroup.GET("/voucher/:id", h.GetDetailVoucher)
-	adminGroup.DELETE("/voucher/:id", h.DeleteVoucher)
-
-	adminGroup.GET("/refund", h.GetRefunds)
-	adminGroup.POST("/refund/:id", h.RefundOrder)
-
-	adminGroup.GET("/category", h.GetCategories)
-	adminGroup.POST("/category", h.AddCategory)
-	adminGroup.PUT("/category", h.EditCategory)
-	adminGroup.DELETE("/category/:id", h.DeleteCategory)
-
-	adminGroup.POST("/banner", h.AddBanner)
-	adminGroup.PUT("/banner", h.EditBanner)
-	adminGroup.DELETE("/banner/:id", h.DeleteBanner)
-
-	adminGroup.POST("/picture", h.UploadProductPicture)
+	adminGroup.GET("/voucher", mw.RequirePermission(constant.PermissionVoucherRead), h.GetAllVoucher)
+	adminGroup.POST("/voucher", mw.RequirePermission(constant.PermissionVoucherWrite), h.CreateVoucher)
+	adminGroup.PUT("/voucher", mw.RequirePermission(constant.PermissionVoucherWrite), h.UpdateVoucher)
+	adminGroup.GET("/voucher/:id", mw.RequirePermission(constant.PermissionVoucherRead), h.GetDetailVoucher)
+	adminGroup.DELETE("/voucher/:id", mw.RequirePermission(constant.PermissionVoucherWrite), h.DeleteVoucher)
+
+	adminGroup.GET("/refund", mw.RequirePermission(constant.PermissionRefundRead), h.GetRefunds)
+	adminGroup.POST("/refund/:id", mw.RequirePermission(constant.PermissionRefundWrite), h.RefundOrder)
+
+	adminGroup.GET("/transaction/:id/payment-callback", mw.RequirePermission(constant.PermissionTransactionRead), h.GetPaymentCallbacks)
+
+	adminGroup.GET("/payout", mw.RequirePermission(constant.PermissionPayoutRead), h.GetPayouts)
+	adminGroup.PATCH("/payout/:id/reject", mw.RequirePermission(constant.PermissionPayoutWrite), h.RejectPayout)
+	adminGroup.GET("/payout-batch", mw.RequirePermission(constant.PermissionPayoutRead), h.GetPayoutBatches)
+	adminGroup.POST("/payout-batch", mw.RequirePermission(constant.PermissionPayoutWrite), h.CreatePayoutBatch)
+	adminGroup.PATCH("/payout-batch/:id/approve", mw.RequirePermission(constant.PermissionPayoutWrite), h.ApprovePayoutBatch)
+	adminGroup.GET("/payout-batch/:id/export", mw.RequirePermission(constant.PermissionPayoutRead), h.ExportPayoutBatch)
+
+	adminGroup.GET("/user/:id/session", mw.RequirePermission(constant.PermissionSessionRead), h.GetUserSessions)
+	adminGroup.DELETE("/user/:id/session", mw.RequirePermission(constant.PermissionSessionWrite), h.RevokeUserSessions)
+
+	adminGroup.GET("/role", mw.RequirePermission(constant.PermissionRoleRead), h.GetRoles)
+	adminGroup.GET("/user/:id/role", mw.RequirePermission(constant.PermissionRoleRead), h.GetUserRoles)
+	adminGroup.POST("/user/:id/role", mw.RequirePermission(constant.PermissionRoleWrite), h.AssignUserRole)
+	adminGroup.DELETE("/user/:id/role/:role_id", mw.RequirePermission(constant.PermissionRoleWrite), h.RemoveUserRole)
+
+	adminGroup.GET("/user", mw.RequirePermission(constant.PermissionUserRead), h.SearchUsers)
+	adminGroup.GET("/user/:id", mw.RequirePermission(constant.PermissionUserRead), h.GetUserDetail)
+	adminGroup.GET("/user/:id/order", mw.RequirePermission(constant.PermissionUserRead), h.GetUserOrders)
+	adminGroup.GET("/user/:id/wallet", mw.RequirePermission(constant.PermissionUserRead), h.GetUserWallet)
+	adminGroup.GET("/user/:id/wallet/history", mw.RequirePermission(constant.PermissionUserRead), h.GetUserWalletHistory)
+	adminGroup.GET("/user/:id/audit-log", mw.RequirePermission(constant.PermissionUserRead), h.GetUserAuditLogs)
+	adminGroup.POST("/user/:id/suspend", mw.RequirePermission(constant.PermissionUserWrite), h.SuspendUser)
+	adminGroup.POST("/user/:id/ban", mw.RequirePermission(constant.PermissionUserWrite), h.BanUser)
+	adminGroup.POST("/user/:id/reactivate", mw.RequirePermission(constant.PermissionUserWrite), h.ReactivateUser)
+	adminGroup.POST("/user/:id/password-reset", mw.RequirePermission(constant.PermissionUserWrite), h.ForcePasswordReset)
+	adminGroup.POST("/user/:id/impersonate", mw.RequirePermission(constant.PermissionUserImpersonate), h.ImpersonateUser)
+	adminGroup.DELETE("/impersonation/:id", mw.RequirePermission(constant.PermissionUserImpersonate), h.EndImpersonation)
+	adminGroup.GET("/shop", mw.RequirePermission(constant.PermissionUserRead), h.SearchShops)
+
+	adminGroup.GET("/lockout", mw.RequirePermission(constant.PermissionLockoutRead), h.GetLockouts)
+	adminGroup.DELETE("/lockout", mw.RequirePermission(constant.PermissionLockoutWrite), h.ClearLockout)
+
+	adminGroup.GET("/category", mw.RequirePermission(constant.PermissionCategoryWrite), h.GetCategories)
+	adminGroup.POST("/category", mw.RequirePermission(constant.PermissionCategoryWrite), h.AddCategory)
+	adminGroup.PUT("/category", mw.RequirePermission(constant.PermissionCategoryWrite), h.EditCategory)
+	adminGroup.DELETE("/category/:id", mw.RequirePermission(constant.PermissionCategoryWrite), h.DeleteCategory)
+
+	adminGroup.POST("/banner", mw.RequirePermission(constant.PermissionBannerWrite), h.AddBanner)
+	adminGroup.PUT("/banner", mw.RequirePermission(constant.PermissionBannerWrite), h.EditBanner)
+	adminGroup.DELETE("/banner/:id", mw.RequirePermission(constant.PermissionBannerWrite), h.DeleteBanner)
+
+	adminGroup.POST("/picture", mw.RequirePermission(constant.PermissionPictureWrite), h.UploadProductPicture)
 }
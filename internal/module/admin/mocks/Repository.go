@@ -4,15 +4,13 @@ package mocks
 
 import (
 	context "context"
-	body "murakali/internal/module/admin/delivery/body"
-
-	mock "github.com/stretchr/testify/mock"
-
 	model "murakali/internal/model"
-
+	body "murakali/internal/module/admin/delivery/body"
 	pagination "murakali/pkg/pagination"
-
 	postgre "murakali/pkg/postgre"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -48,6 +46,34 @@ func (_m *Repository) AddCategory(ctx context.Context, requestBody body.Category
 	return r0
 }
 
+// AssignPayoutsToBatch provides a mock function with given fields: ctx, tx, batchID
+func (_m *Repository) AssignPayoutsToBatch(ctx context.Context, tx postgre.Transaction, batchID string) (model.Money, int, error) {
+	ret := _m.Called(ctx, tx, batchID)
+
+	var r0 model.Money
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) model.Money); ok {
+		r0 = rf(ctx, tx, batchID)
+	} else {
+		r0 = ret.Get(0).(model.Money)
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) int); ok {
+		r1 = rf(ctx, tx, batchID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, postgre.Transaction, string) error); ok {
+		r2 = rf(ctx, tx, batchID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // CountCategoryParent provides a mock function with given fields: ctx, userid
 func (_m *Repository) CountCategoryParent(ctx context.Context, userid string) (int, error) {
 	ret := _m.Called(ctx, userid)
@@ -111,6 +137,76 @@ func (_m *Repository) CountProductCategory(ctx context.Context, userid string) (
 	return r0, r1
 }
 
+// CreateAdminAuditLog provides a mock function with given fields: ctx, auditLog
+func (_m *Repository) CreateAdminAuditLog(ctx context.Context, auditLog *model.AdminAuditLog) error {
+	ret := _m.Called(ctx, auditLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AdminAuditLog) error); ok {
+		r0 = rf(ctx, auditLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateImpersonationSession provides a mock function with given fields: ctx, session
+func (_m *Repository) CreateImpersonationSession(ctx context.Context, session *model.ImpersonationSession) error {
+	ret := _m.Called(ctx, session)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ImpersonationSession) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateOrderStatusHistory provides a mock function with given fields: ctx, tx, history
+func (_m *Repository) CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error {
+	ret := _m.Called(ctx, tx, history)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.OrderStatusHistory) error); ok {
+		r0 = rf(ctx, tx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePayoutBatch provides a mock function with given fields: ctx, tx, batch
+func (_m *Repository) CreatePayoutBatch(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error {
+	ret := _m.Called(ctx, tx, batch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.PayoutBatch) error); ok {
+		r0 = rf(ctx, tx, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateUserRole provides a mock function with given fields: ctx, userID, roleID
+func (_m *Repository) CreateUserRole(ctx context.Context, userID string, roleID int64) error {
+	ret := _m.Called(ctx, userID, roleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) error); ok {
+		r0 = rf(ctx, userID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateVoucher provides a mock function with given fields: ctx, voucherShop
 func (_m *Repository) CreateVoucher(ctx context.Context, voucherShop *model.Voucher) error {
 	ret := _m.Called(ctx, voucherShop)
@@ -125,6 +221,20 @@ func (_m *Repository) CreateVoucher(ctx context.Context, voucherShop *model.Vouc
 	return r0
 }
 
+// DeleteAccountStatusRedis provides a mock function with given fields: ctx, userID
+func (_m *Repository) DeleteAccountStatusRedis(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteBanner provides a mock function with given fields: ctx, bannerID
 func (_m *Repository) DeleteBanner(ctx context.Context, bannerID string) error {
 	ret := _m.Called(ctx, bannerID)
@@ -153,6 +263,41 @@ func (_m *Repository) DeleteCategory(ctx context.Context, categoryID string) err
 	return r0
 }
 
+// DeletePermissionCacheRedis provides a mock function with given fields: ctx, userID
+func (_m *Repository) DeletePermissionCacheRedis(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserRole provides a mock function with given fields: ctx, userID, roleID
+func (_m *Repository) DeleteUserRole(ctx context.Context, userID string, roleID int64) (int64, error) {
+	ret := _m.Called(ctx, userID, roleID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) int64); ok {
+		r0 = rf(ctx, userID, roleID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, userID, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteVoucher provides a mock function with given fields: ctx, voucherID
 func (_m *Repository) DeleteVoucher(ctx context.Context, voucherID string) error {
 	ret := _m.Called(ctx, voucherID)
@@ -195,6 +340,73 @@ func (_m *Repository) EditCategory(ctx context.Context, requestBody body.Categor
 	return r0
 }
 
+// EndImpersonationSession provides a mock function with given fields: ctx, impersonationID
+func (_m *Repository) EndImpersonationSession(ctx context.Context, impersonationID string) (int64, error) {
+	ret := _m.Called(ctx, impersonationID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, impersonationID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, impersonationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActiveUserSessions provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetActiveUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.UserSession
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.UserSession); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserSession)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAdminAuditLogs provides a mock function with given fields: ctx, userID, pgn
+func (_m *Repository) GetAdminAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*model.AdminAuditLog, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 []*model.AdminAuditLog
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.AdminAuditLog); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AdminAuditLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllVoucher provides a mock function with given fields: ctx, voucherStatusID, sortFilter, pgn
 func (_m *Repository) GetAllVoucher(ctx context.Context, voucherStatusID string, sortFilter string, pgn *pagination.Pagination) ([]*model.Voucher, error) {
 	ret := _m.Called(ctx, voucherStatusID, sortFilter, pgn)
@@ -264,6 +476,50 @@ func (_m *Repository) GetCategories(ctx context.Context) ([]*body.CategoryRespon
 	return r0, r1
 }
 
+// GetImpersonationRedis provides a mock function with given fields: ctx, impersonationID
+func (_m *Repository) GetImpersonationRedis(ctx context.Context, impersonationID string) (string, error) {
+	ret := _m.Called(ctx, impersonationID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, impersonationID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, impersonationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImpersonationSessionByID provides a mock function with given fields: ctx, impersonationID
+func (_m *Repository) GetImpersonationSessionByID(ctx context.Context, impersonationID string) (*model.ImpersonationSession, error) {
+	ret := _m.Called(ctx, impersonationID)
+
+	var r0 *model.ImpersonationSession
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ImpersonationSession); ok {
+		r0 = rf(ctx, impersonationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ImpersonationSession)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, impersonationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOrderByID provides a mock function with given fields: ctx, orderID
 func (_m *Repository) GetOrderByID(ctx context.Context, orderID string) (*model.OrderModel, error) {
 	ret := _m.Called(ctx, orderID)
@@ -310,22 +566,22 @@ func (_m *Repository) GetOrderItemsByOrderID(ctx context.Context, tx postgre.Tra
 	return r0, r1
 }
 
-// GetProductDetailByID provides a mock function with given fields: ctx, tx, productDetailID
-func (_m *Repository) GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error) {
-	ret := _m.Called(ctx, tx, productDetailID)
+// GetPaymentCallbacksByTransactionID provides a mock function with given fields: ctx, transactionID
+func (_m *Repository) GetPaymentCallbacksByTransactionID(ctx context.Context, transactionID string) ([]*model.PaymentCallback, error) {
+	ret := _m.Called(ctx, transactionID)
 
-	var r0 *model.ProductDetail
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) *model.ProductDetail); ok {
-		r0 = rf(ctx, tx, productDetailID)
+	var r0 []*model.PaymentCallback
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PaymentCallback); ok {
+		r0 = rf(ctx, transactionID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.ProductDetail)
+			r0 = ret.Get(0).([]*model.PaymentCallback)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
-		r1 = rf(ctx, tx, productDetailID)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -333,22 +589,22 @@ func (_m *Repository) GetProductDetailByID(ctx context.Context, tx postgre.Trans
 	return r0, r1
 }
 
-// GetRefundByID provides a mock function with given fields: ctx, refundID
-func (_m *Repository) GetRefundByID(ctx context.Context, refundID string) (*model.Refund, error) {
-	ret := _m.Called(ctx, refundID)
+// GetPayoutBatchByID provides a mock function with given fields: ctx, batchID
+func (_m *Repository) GetPayoutBatchByID(ctx context.Context, batchID string) (*model.PayoutBatch, error) {
+	ret := _m.Called(ctx, batchID)
 
-	var r0 *model.Refund
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Refund); ok {
-		r0 = rf(ctx, refundID)
+	var r0 *model.PayoutBatch
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PayoutBatch); ok {
+		r0 = rf(ctx, batchID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Refund)
+			r0 = ret.Get(0).(*model.PayoutBatch)
 		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, refundID)
+		r1 = rf(ctx, batchID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -356,22 +612,22 @@ func (_m *Repository) GetRefundByID(ctx context.Context, refundID string) (*mode
 	return r0, r1
 }
 
-// GetRefunds provides a mock function with given fields: ctx, sortFilter, pgn
-func (_m *Repository) GetRefunds(ctx context.Context, sortFilter string, pgn *pagination.Pagination) ([]*model.RefundOrder, error) {
-	ret := _m.Called(ctx, sortFilter, pgn)
+// GetPayoutBatches provides a mock function with given fields: ctx, pgn
+func (_m *Repository) GetPayoutBatches(ctx context.Context, pgn *pagination.Pagination) ([]*model.PayoutBatch, error) {
+	ret := _m.Called(ctx, pgn)
 
-	var r0 []*model.RefundOrder
-	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.RefundOrder); ok {
-		r0 = rf(ctx, sortFilter, pgn)
+	var r0 []*model.PayoutBatch
+	if rf, ok := ret.Get(0).(func(context.Context, *pagination.Pagination) []*model.PayoutBatch); ok {
+		r0 = rf(ctx, pgn)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.RefundOrder)
+			r0 = ret.Get(0).([]*model.PayoutBatch)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
-		r1 = rf(ctx, sortFilter, pgn)
+	if rf, ok := ret.Get(1).(func(context.Context, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, pgn)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -379,20 +635,22 @@ func (_m *Repository) GetRefunds(ctx context.Context, sortFilter string, pgn *pa
 	return r0, r1
 }
 
-// GetTotalRefunds provides a mock function with given fields: ctx
-func (_m *Repository) GetTotalRefunds(ctx context.Context) (int64, error) {
-	ret := _m.Called(ctx)
+// GetPayoutByID provides a mock function with given fields: ctx, payoutID
+func (_m *Repository) GetPayoutByID(ctx context.Context, payoutID string) (*model.Payout, error) {
+	ret := _m.Called(ctx, payoutID)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
-		r0 = rf(ctx)
+	var r0 *model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Payout); ok {
+		r0 = rf(ctx, payoutID)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payout)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, payoutID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -400,20 +658,22 @@ func (_m *Repository) GetTotalRefunds(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
-// GetTotalVoucher provides a mock function with given fields: ctx, voucherStatusID
-func (_m *Repository) GetTotalVoucher(ctx context.Context, voucherStatusID string) (int64, error) {
-	ret := _m.Called(ctx, voucherStatusID)
+// GetPayouts provides a mock function with given fields: ctx, status, pgn
+func (_m *Repository) GetPayouts(ctx context.Context, status string, pgn *pagination.Pagination) ([]*model.Payout, error) {
+	ret := _m.Called(ctx, status, pgn)
 
-	var r0 int64
-	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
-		r0 = rf(ctx, voucherStatusID)
+	var r0 []*model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.Payout); ok {
+		r0 = rf(ctx, status, pgn)
 	} else {
-		r0 = ret.Get(0).(int64)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Payout)
+		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, voucherStatusID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, status, pgn)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -421,22 +681,22 @@ func (_m *Repository) GetTotalVoucher(ctx context.Context, voucherStatusID strin
 	return r0, r1
 }
 
-// GetVoucherByID provides a mock function with given fields: ctx, voucherID
-func (_m *Repository) GetVoucherByID(ctx context.Context, voucherID string) (*model.Voucher, error) {
-	ret := _m.Called(ctx, voucherID)
+// GetPayoutsByBatchID provides a mock function with given fields: ctx, batchID
+func (_m *Repository) GetPayoutsByBatchID(ctx context.Context, batchID string) ([]*model.Payout, error) {
+	ret := _m.Called(ctx, batchID)
 
-	var r0 *model.Voucher
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Voucher); ok {
-		r0 = rf(ctx, voucherID)
+	var r0 []*model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Payout); ok {
+		r0 = rf(ctx, batchID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Voucher)
+			r0 = ret.Get(0).([]*model.Payout)
 		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, voucherID)
+		r1 = rf(ctx, batchID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -444,22 +704,22 @@ func (_m *Repository) GetVoucherByID(ctx context.Context, voucherID string) (*mo
 	return r0, r1
 }
 
-// GetWalletByUserID provides a mock function with given fields: ctx, tx, userID
-func (_m *Repository) GetWalletByUserID(ctx context.Context, tx postgre.Transaction, userID string) (*model.Wallet, error) {
-	ret := _m.Called(ctx, tx, userID)
+// GetProductDetailByID provides a mock function with given fields: ctx, tx, productDetailID
+func (_m *Repository) GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error) {
+	ret := _m.Called(ctx, tx, productDetailID)
 
-	var r0 *model.Wallet
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) *model.Wallet); ok {
-		r0 = rf(ctx, tx, userID)
+	var r0 *model.ProductDetail
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) *model.ProductDetail); ok {
+		r0 = rf(ctx, tx, productDetailID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.Wallet)
+			r0 = ret.Get(0).(*model.ProductDetail)
 		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
-		r1 = rf(ctx, tx, userID)
+		r1 = rf(ctx, tx, productDetailID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -467,11 +727,562 @@ func (_m *Repository) GetWalletByUserID(ctx context.Context, tx postgre.Transact
 	return r0, r1
 }
 
-// InsertWalletHistory provides a mock function with given fields: ctx, tx, walletHistory
-func (_m *Repository) InsertWalletHistory(ctx context.Context, tx postgre.Transaction, walletHistory *model.WalletHistory) error {
-	ret := _m.Called(ctx, tx, walletHistory)
+// GetRefundByID provides a mock function with given fields: ctx, refundID
+func (_m *Repository) GetRefundByID(ctx context.Context, refundID string) (*model.Refund, error) {
+	ret := _m.Called(ctx, refundID)
 
-	var r0 error
+	var r0 *model.Refund
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Refund); ok {
+		r0 = rf(ctx, refundID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Refund)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, refundID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRefunds provides a mock function with given fields: ctx, sortFilter, pgn
+func (_m *Repository) GetRefunds(ctx context.Context, sortFilter string, pgn *pagination.Pagination) ([]*model.RefundOrder, error) {
+	ret := _m.Called(ctx, sortFilter, pgn)
+
+	var r0 []*model.RefundOrder
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.RefundOrder); ok {
+		r0 = rf(ctx, sortFilter, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RefundOrder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, sortFilter, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoleByID provides a mock function with given fields: ctx, roleID
+func (_m *Repository) GetRoleByID(ctx context.Context, roleID int64) (*model.Role, error) {
+	ret := _m.Called(ctx, roleID)
+
+	var r0 *model.Role
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *model.Role); ok {
+		r0 = rf(ctx, roleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, roleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRolePermissions provides a mock function with given fields: ctx
+func (_m *Repository) GetRolePermissions(ctx context.Context) ([]*model.RolePermission, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.RolePermission
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.RolePermission); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.RolePermission)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRoles provides a mock function with given fields: ctx
+func (_m *Repository) GetRoles(ctx context.Context) ([]*model.Role, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*model.Role
+	if rf, ok := ret.Get(0).(func(context.Context) []*model.Role); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopByUserID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetShopByUserID(ctx context.Context, userID string) (*body.ShopResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.ShopResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.ShopResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.ShopResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalAdminAuditLogs provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetTotalAdminAuditLogs(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalPayoutBatches provides a mock function with given fields: ctx
+func (_m *Repository) GetTotalPayoutBatches(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalPayouts provides a mock function with given fields: ctx, status
+func (_m *Repository) GetTotalPayouts(ctx context.Context, status string) (int64, error) {
+	ret := _m.Called(ctx, status)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalRefunds provides a mock function with given fields: ctx
+func (_m *Repository) GetTotalRefunds(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalShops provides a mock function with given fields: ctx, query
+func (_m *Repository) GetTotalShops(ctx context.Context, query string) (int64, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, query)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalUserOrders provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetTotalUserOrders(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalUsers provides a mock function with given fields: ctx, query, status
+func (_m *Repository) GetTotalUsers(ctx context.Context, query string, status string) (int64, error) {
+	ret := _m.Called(ctx, query, status)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, query, status)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, query, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalVoucher provides a mock function with given fields: ctx, voucherStatusID
+func (_m *Repository) GetTotalVoucher(ctx context.Context, voucherStatusID string) (int64, error) {
+	ret := _m.Called(ctx, voucherStatusID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, voucherStatusID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, voucherStatusID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalWalletHistory provides a mock function with given fields: ctx, walletID
+func (_m *Repository) GetTotalWalletHistory(ctx context.Context, walletID string) (int64, error) {
+	ret := _m.Called(ctx, walletID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, walletID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, walletID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *model.User
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.User); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserOrders provides a mock function with given fields: ctx, userID, pgn
+func (_m *Repository) GetUserOrders(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*body.UserOrderResponse, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 []*body.UserOrderResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*body.UserOrderResponse); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.UserOrderResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserPrimaryRoleID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserPrimaryRoleID(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserRoles provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserRoles(ctx context.Context, userID string) ([]*model.Role, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.Role
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Role); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Role)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserWallet provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserWallet(ctx context.Context, userID string) (*model.Wallet, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *model.Wallet
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Wallet); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wallet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVoucherByID provides a mock function with given fields: ctx, voucherID
+func (_m *Repository) GetVoucherByID(ctx context.Context, voucherID string) (*model.Voucher, error) {
+	ret := _m.Called(ctx, voucherID)
+
+	var r0 *model.Voucher
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.Voucher); ok {
+		r0 = rf(ctx, voucherID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Voucher)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, voucherID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWalletByUserID provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) GetWalletByUserID(ctx context.Context, tx postgre.Transaction, userID string) (*model.Wallet, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	var r0 *model.Wallet
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) *model.Wallet); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wallet)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWalletHistory provides a mock function with given fields: ctx, walletID, pgn
+func (_m *Repository) GetWalletHistory(ctx context.Context, walletID string, pgn *pagination.Pagination) ([]*model.WalletHistory, error) {
+	ret := _m.Called(ctx, walletID, pgn)
+
+	var r0 []*model.WalletHistory
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.WalletHistory); ok {
+		r0 = rf(ctx, walletID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.WalletHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, walletID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InsertAccountStatusRedis provides a mock function with given fields: ctx, userID, status, duration
+func (_m *Repository) InsertAccountStatusRedis(ctx context.Context, userID string, status string, duration time.Duration) error {
+	ret := _m.Called(ctx, userID, status, duration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, userID, status, duration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertImpersonationRedis provides a mock function with given fields: ctx, impersonationID, sessionKey, duration
+func (_m *Repository) InsertImpersonationRedis(ctx context.Context, impersonationID string, sessionKey string, duration time.Duration) error {
+	ret := _m.Called(ctx, impersonationID, sessionKey, duration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, impersonationID, sessionKey, duration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertSessionRedis provides a mock function with given fields: ctx, duration, key, status
+func (_m *Repository) InsertSessionRedis(ctx context.Context, duration int, key string, status string) error {
+	ret := _m.Called(ctx, duration, key, status)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) error); ok {
+		r0 = rf(ctx, duration, key, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// InsertWalletHistory provides a mock function with given fields: ctx, tx, walletHistory
+func (_m *Repository) InsertWalletHistory(ctx context.Context, tx postgre.Transaction, walletHistory *model.WalletHistory) error {
+	ret := _m.Called(ctx, tx, walletHistory)
+
+	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.WalletHistory) error); ok {
 		r0 = rf(ctx, tx, walletHistory)
 	} else {
@@ -481,6 +1292,66 @@ func (_m *Repository) InsertWalletHistory(ctx context.Context, tx postgre.Transa
 	return r0
 }
 
+// ReleasePayoutOrders provides a mock function with given fields: ctx, tx, payoutID
+func (_m *Repository) ReleasePayoutOrders(ctx context.Context, tx postgre.Transaction, payoutID string) error {
+	ret := _m.Called(ctx, tx, payoutID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, payoutID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchShops provides a mock function with given fields: ctx, query, pgn
+func (_m *Repository) SearchShops(ctx context.Context, query string, pgn *pagination.Pagination) ([]*body.ShopResponse, error) {
+	ret := _m.Called(ctx, query, pgn)
+
+	var r0 []*body.ShopResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*body.ShopResponse); ok {
+		r0 = rf(ctx, query, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.ShopResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, query, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUsers provides a mock function with given fields: ctx, query, status, pgn
+func (_m *Repository) SearchUsers(ctx context.Context, query string, status string, pgn *pagination.Pagination) ([]*model.User, error) {
+	ret := _m.Called(ctx, query, status, pgn)
+
+	var r0 []*model.User
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *pagination.Pagination) []*model.User); ok {
+		r0 = rf(ctx, query, status, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, query, status, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateOrderStatus provides a mock function with given fields: ctx, tx, order
 func (_m *Repository) UpdateOrderStatus(ctx context.Context, tx postgre.Transaction, order *model.OrderModel) error {
 	ret := _m.Called(ctx, tx, order)
@@ -495,6 +1366,90 @@ func (_m *Repository) UpdateOrderStatus(ctx context.Context, tx postgre.Transact
 	return r0
 }
 
+// UpdateOrdersWithdrawnByBatch provides a mock function with given fields: ctx, tx, batchID
+func (_m *Repository) UpdateOrdersWithdrawnByBatch(ctx context.Context, tx postgre.Transaction, batchID string) error {
+	ret := _m.Called(ctx, tx, batchID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, batchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePayoutBatchApproved provides a mock function with given fields: ctx, tx, batch
+func (_m *Repository) UpdatePayoutBatchApproved(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) (int64, error) {
+	ret := _m.Called(ctx, tx, batch)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.PayoutBatch) int64); ok {
+		r0 = rf(ctx, tx, batch)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, *model.PayoutBatch) error); ok {
+		r1 = rf(ctx, tx, batch)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePayoutBatchTotal provides a mock function with given fields: ctx, tx, batch
+func (_m *Repository) UpdatePayoutBatchTotal(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error {
+	ret := _m.Called(ctx, tx, batch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.PayoutBatch) error); ok {
+		r0 = rf(ctx, tx, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePayoutRejected provides a mock function with given fields: ctx, tx, payout
+func (_m *Repository) UpdatePayoutRejected(ctx context.Context, tx postgre.Transaction, payout *model.Payout) (int64, error) {
+	ret := _m.Called(ctx, tx, payout)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.Payout) int64); ok {
+		r0 = rf(ctx, tx, payout)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, *model.Payout) error); ok {
+		r1 = rf(ctx, tx, payout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePayoutsApproved provides a mock function with given fields: ctx, tx, batch
+func (_m *Repository) UpdatePayoutsApproved(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error {
+	ret := _m.Called(ctx, tx, batch)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.PayoutBatch) error); ok {
+		r0 = rf(ctx, tx, batch)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateProductDetailStock provides a mock function with given fields: ctx, tx, productDetailData
 func (_m *Repository) UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction, productDetailData *model.ProductDetail) error {
 	ret := _m.Called(ctx, tx, productDetailData)
@@ -523,6 +1478,48 @@ func (_m *Repository) UpdateRefund(ctx context.Context, tx postgre.Transaction,
 	return r0
 }
 
+// UpdateUserPasswordResetRequired provides a mock function with given fields: ctx, userID
+func (_m *Repository) UpdateUserPasswordResetRequired(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUserStatus provides a mock function with given fields: ctx, userID, status, reason, suspendedUntil
+func (_m *Repository) UpdateUserStatus(ctx context.Context, userID string, status string, reason string, suspendedUntil *time.Time) (int64, error) {
+	ret := _m.Called(ctx, userID, status, reason, suspendedUntil)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, *time.Time) int64); ok {
+		r0 = rf(ctx, userID, status, reason, suspendedUntil)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, *time.Time) error); ok {
+		r1 = rf(ctx, userID, status, reason, suspendedUntil)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateVoucher provides a mock function with given fields: ctx, voucherShop
 func (_m *Repository) UpdateVoucher(ctx context.Context, voucherShop *model.Voucher) error {
 	ret := _m.Called(ctx, voucherShop)
@@ -4,13 +4,12 @@ package mocks
 
 import (
 	context "context"
+	limiter "murakali/internal/limiter"
+	model "murakali/internal/model"
 	body "murakali/internal/module/admin/delivery/body"
+	pagination "murakali/pkg/pagination"
 
 	mock "github.com/stretchr/testify/mock"
-
-	model "murakali/internal/model"
-
-	pagination "murakali/pkg/pagination"
 )
 
 // UseCase is an autogenerated mock type for the UseCase type
@@ -46,6 +45,85 @@ func (_m *UseCase) AddCategory(ctx context.Context, requestBody body.CategoryReq
 	return r0
 }
 
+// ApprovePayoutBatch provides a mock function with given fields: ctx, adminID, batchID
+func (_m *UseCase) ApprovePayoutBatch(ctx context.Context, adminID string, batchID string) error {
+	ret := _m.Called(ctx, adminID, batchID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, adminID, batchID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AssignUserRole provides a mock function with given fields: ctx, adminID, userID, roleID
+func (_m *UseCase) AssignUserRole(ctx context.Context, adminID string, userID string, roleID int64) error {
+	ret := _m.Called(ctx, adminID, userID, roleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) error); ok {
+		r0 = rf(ctx, adminID, userID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// BanUser provides a mock function with given fields: ctx, adminID, userID, requestBody
+func (_m *UseCase) BanUser(ctx context.Context, adminID string, userID string, requestBody body.UserModerationRequest) error {
+	ret := _m.Called(ctx, adminID, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.UserModerationRequest) error); ok {
+		r0 = rf(ctx, adminID, userID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ClearLockout provides a mock function with given fields: ctx, requestBody
+func (_m *UseCase) ClearLockout(ctx context.Context, requestBody body.ClearLockoutRequest) error {
+	ret := _m.Called(ctx, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, body.ClearLockoutRequest) error); ok {
+		r0 = rf(ctx, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePayoutBatch provides a mock function with given fields: ctx, adminID
+func (_m *UseCase) CreatePayoutBatch(ctx context.Context, adminID string) (*model.PayoutBatch, error) {
+	ret := _m.Called(ctx, adminID)
+
+	var r0 *model.PayoutBatch
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PayoutBatch); ok {
+		r0 = rf(ctx, adminID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PayoutBatch)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, adminID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVoucher provides a mock function with given fields: ctx, requestBody
 func (_m *UseCase) CreateVoucher(ctx context.Context, requestBody body.CreateVoucherRequest) error {
 	ret := _m.Called(ctx, requestBody)
@@ -130,6 +208,57 @@ func (_m *UseCase) EditCategory(ctx context.Context, requestBody body.CategoryRe
 	return r0
 }
 
+// EndImpersonation provides a mock function with given fields: ctx, adminID, impersonationID
+func (_m *UseCase) EndImpersonation(ctx context.Context, adminID string, impersonationID string) error {
+	ret := _m.Called(ctx, adminID, impersonationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, adminID, impersonationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ExportPayoutBatch provides a mock function with given fields: ctx, batchID
+func (_m *UseCase) ExportPayoutBatch(ctx context.Context, batchID string) ([]*model.Payout, error) {
+	ret := _m.Called(ctx, batchID)
+
+	var r0 []*model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.Payout); ok {
+		r0 = rf(ctx, batchID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Payout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, batchID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ForcePasswordReset provides a mock function with given fields: ctx, adminID, userID, requestBody
+func (_m *UseCase) ForcePasswordReset(ctx context.Context, adminID string, userID string, requestBody body.UserModerationRequest) error {
+	ret := _m.Called(ctx, adminID, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.UserModerationRequest) error); ok {
+		r0 = rf(ctx, adminID, userID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetAllVoucher provides a mock function with given fields: ctx, voucherStatusID, sortFilter, pgn
 func (_m *UseCase) GetAllVoucher(ctx context.Context, voucherStatusID string, sortFilter string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
 	ret := _m.Called(ctx, voucherStatusID, sortFilter, pgn)
@@ -222,6 +351,98 @@ func (_m *UseCase) GetDetailVoucher(ctx context.Context, voucherID string) (*mod
 	return r0, r1
 }
 
+// GetLockouts provides a mock function with given fields: ctx
+func (_m *UseCase) GetLockouts(ctx context.Context) ([]*limiter.Lockout, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*limiter.Lockout
+	if rf, ok := ret.Get(0).(func(context.Context) []*limiter.Lockout); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*limiter.Lockout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaymentCallbacks provides a mock function with given fields: ctx, transactionID
+func (_m *UseCase) GetPaymentCallbacks(ctx context.Context, transactionID string) ([]*model.PaymentCallback, error) {
+	ret := _m.Called(ctx, transactionID)
+
+	var r0 []*model.PaymentCallback
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PaymentCallback); ok {
+		r0 = rf(ctx, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PaymentCallback)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoutBatches provides a mock function with given fields: ctx, pgn
+func (_m *UseCase) GetPayoutBatches(ctx context.Context, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayouts provides a mock function with given fields: ctx, status, pgn
+func (_m *UseCase) GetPayouts(ctx context.Context, status string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, status, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, status, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, status, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRefunds provides a mock function with given fields: ctx, sortFilter, pgn
 func (_m *UseCase) GetRefunds(ctx context.Context, sortFilter string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
 	ret := _m.Called(ctx, sortFilter, pgn)
@@ -245,13 +466,336 @@ func (_m *UseCase) GetRefunds(ctx context.Context, sortFilter string, pgn *pagin
 	return r0, r1
 }
 
-// RefundOrder provides a mock function with given fields: ctx, refundID
-func (_m *UseCase) RefundOrder(ctx context.Context, refundID string) error {
-	ret := _m.Called(ctx, refundID)
+// GetRoles provides a mock function with given fields: ctx
+func (_m *UseCase) GetRoles(ctx context.Context) ([]*body.RoleResponse, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*body.RoleResponse
+	if rf, ok := ret.Get(0).(func(context.Context) []*body.RoleResponse); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.RoleResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserAuditLogs provides a mock function with given fields: ctx, userID, pgn
+func (_m *UseCase) GetUserAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserDetail provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetUserDetail(ctx context.Context, userID string) (*body.UserDetailResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.UserDetailResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.UserDetailResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.UserDetailResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserOrders provides a mock function with given fields: ctx, userID, pgn
+func (_m *UseCase) GetUserOrders(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserRoles provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetUserRoles(ctx context.Context, userID string) (*body.UserRolesResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.UserRolesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.UserRolesResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.UserRolesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserSessions provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.UserSession
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.UserSession); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserSession)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserWallet provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetUserWallet(ctx context.Context, userID string) (*body.WalletResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.WalletResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.WalletResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.WalletResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserWalletHistory provides a mock function with given fields: ctx, userID, pgn
+func (_m *UseCase) GetUserWalletHistory(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImpersonateUser provides a mock function with given fields: ctx, adminID, userID, requestBody
+func (_m *UseCase) ImpersonateUser(ctx context.Context, adminID string, userID string, requestBody body.ImpersonateUserRequest) (*body.ImpersonationResponse, error) {
+	ret := _m.Called(ctx, adminID, userID, requestBody)
+
+	var r0 *body.ImpersonationResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.ImpersonateUserRequest) *body.ImpersonationResponse); ok {
+		r0 = rf(ctx, adminID, userID, requestBody)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.ImpersonationResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, body.ImpersonateUserRequest) error); ok {
+		r1 = rf(ctx, adminID, userID, requestBody)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReactivateUser provides a mock function with given fields: ctx, adminID, userID, requestBody
+func (_m *UseCase) ReactivateUser(ctx context.Context, adminID string, userID string, requestBody body.UserModerationRequest) error {
+	ret := _m.Called(ctx, adminID, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.UserModerationRequest) error); ok {
+		r0 = rf(ctx, adminID, userID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefundOrder provides a mock function with given fields: ctx, userID, refundID
+func (_m *UseCase) RefundOrder(ctx context.Context, userID string, refundID string) error {
+	ret := _m.Called(ctx, userID, refundID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, refundID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RejectPayout provides a mock function with given fields: ctx, payoutID, requestBody
+func (_m *UseCase) RejectPayout(ctx context.Context, payoutID string, requestBody body.RejectPayoutRequest) error {
+	ret := _m.Called(ctx, payoutID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.RejectPayoutRequest) error); ok {
+		r0 = rf(ctx, payoutID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveUserRole provides a mock function with given fields: ctx, adminID, userID, roleID
+func (_m *UseCase) RemoveUserRole(ctx context.Context, adminID string, userID string, roleID int64) error {
+	ret := _m.Called(ctx, adminID, userID, roleID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) error); ok {
+		r0 = rf(ctx, adminID, userID, roleID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokeUserSessions provides a mock function with given fields: ctx, userID
+func (_m *UseCase) RevokeUserSessions(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, refundID)
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchShops provides a mock function with given fields: ctx, query, pgn
+func (_m *UseCase) SearchShops(ctx context.Context, query string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, query, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, query, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, query, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SearchUsers provides a mock function with given fields: ctx, query, status, pgn
+func (_m *UseCase) SearchUsers(ctx context.Context, query string, status string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, query, status, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, query, status, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, query, status, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SuspendUser provides a mock function with given fields: ctx, adminID, userID, requestBody
+func (_m *UseCase) SuspendUser(ctx context.Context, adminID string, userID string, requestBody body.SuspendUserRequest) error {
+	ret := _m.Called(ctx, adminID, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.SuspendUserRequest) error); ok {
+		r0 = rf(ctx, adminID, userID, requestBody)
 	} else {
 		r0 = ret.Error(0)
 	}
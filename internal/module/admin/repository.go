@@ -6,6 +6,7 @@ import (
 	"murakali/internal/module/admin/delivery/body"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
+	"time"
 )
 
 type Repository interface {
@@ -38,4 +39,54 @@ type Repository interface {
 	AddBanner(ctx context.Context, requestBody body.BannerRequest) error
 	DeleteBanner(ctx context.Context, bannerID string) error
 	EditBanner(ctx context.Context, requestBody body.BannerIDRequest) error
+	GetPaymentCallbacksByTransactionID(ctx context.Context, transactionID string) ([]*model.PaymentCallback, error)
+	CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error
+	GetTotalPayouts(ctx context.Context, status string) (int64, error)
+	GetPayouts(ctx context.Context, status string, pgn *pagination.Pagination) ([]*model.Payout, error)
+	GetPayoutByID(ctx context.Context, payoutID string) (*model.Payout, error)
+	GetPayoutsByBatchID(ctx context.Context, batchID string) ([]*model.Payout, error)
+	UpdatePayoutRejected(ctx context.Context, tx postgre.Transaction, payout *model.Payout) (int64, error)
+	ReleasePayoutOrders(ctx context.Context, tx postgre.Transaction, payoutID string) error
+	CreatePayoutBatch(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error
+	AssignPayoutsToBatch(ctx context.Context, tx postgre.Transaction, batchID string) (model.Money, int, error)
+	UpdatePayoutBatchTotal(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error
+	GetTotalPayoutBatches(ctx context.Context) (int64, error)
+	GetPayoutBatches(ctx context.Context, pgn *pagination.Pagination) ([]*model.PayoutBatch, error)
+	GetPayoutBatchByID(ctx context.Context, batchID string) (*model.PayoutBatch, error)
+	UpdatePayoutsApproved(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error
+	UpdateOrdersWithdrawnByBatch(ctx context.Context, tx postgre.Transaction, batchID string) error
+	UpdatePayoutBatchApproved(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) (int64, error)
+	GetActiveUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error)
+	InsertSessionRedis(ctx context.Context, duration int, key, status string) error
+	GetRoles(ctx context.Context) ([]*model.Role, error)
+	GetRoleByID(ctx context.Context, roleID int64) (*model.Role, error)
+	GetRolePermissions(ctx context.Context) ([]*model.RolePermission, error)
+	GetUserPrimaryRoleID(ctx context.Context, userID string) (int64, error)
+	GetUserRoles(ctx context.Context, userID string) ([]*model.Role, error)
+	CreateUserRole(ctx context.Context, userID string, roleID int64) error
+	DeleteUserRole(ctx context.Context, userID string, roleID int64) (int64, error)
+	DeletePermissionCacheRedis(ctx context.Context, userID string) error
+	GetTotalUsers(ctx context.Context, query, status string) (int64, error)
+	SearchUsers(ctx context.Context, query, status string, pgn *pagination.Pagination) ([]*model.User, error)
+	GetUserByID(ctx context.Context, userID string) (*model.User, error)
+	GetTotalShops(ctx context.Context, query string) (int64, error)
+	SearchShops(ctx context.Context, query string, pgn *pagination.Pagination) ([]*body.ShopResponse, error)
+	GetShopByUserID(ctx context.Context, userID string) (*body.ShopResponse, error)
+	GetUserWallet(ctx context.Context, userID string) (*model.Wallet, error)
+	GetTotalUserOrders(ctx context.Context, userID string) (int64, error)
+	GetUserOrders(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*body.UserOrderResponse, error)
+	GetTotalWalletHistory(ctx context.Context, walletID string) (int64, error)
+	GetWalletHistory(ctx context.Context, walletID string, pgn *pagination.Pagination) ([]*model.WalletHistory, error)
+	UpdateUserStatus(ctx context.Context, userID, status, reason string, suspendedUntil *time.Time) (int64, error)
+	UpdateUserPasswordResetRequired(ctx context.Context, userID string) (int64, error)
+	InsertAccountStatusRedis(ctx context.Context, userID, status string, duration time.Duration) error
+	DeleteAccountStatusRedis(ctx context.Context, userID string) error
+	CreateAdminAuditLog(ctx context.Context, auditLog *model.AdminAuditLog) error
+	GetTotalAdminAuditLogs(ctx context.Context, userID string) (int64, error)
+	GetAdminAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*model.AdminAuditLog, error)
+	CreateImpersonationSession(ctx context.Context, session *model.ImpersonationSession) error
+	GetImpersonationSessionByID(ctx context.Context, impersonationID string) (*model.ImpersonationSession, error)
+	EndImpersonationSession(ctx context.Context, impersonationID string) (int64, error)
+	InsertImpersonationRedis(ctx context.Context, impersonationID, sessionKey string, duration time.Duration) error
+	GetImpersonationRedis(ctx context.Context, impersonationID string) (string, error)
 }
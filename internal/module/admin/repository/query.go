@@ -100,4 +100,169 @@ const (
 	VALUES ($1, $2, $3, $4, $5)`
 	DeleteBannerQuery = `DELETE FROM "banner" WHERE id = $1`
 	EditBannerQuery   = `UPDATE "banner" set is_active = $1 WHERE "id" = $2`
+
+	GetPaymentCallbacksByTransactionIDQuery = `SELECT "id", "transaction_id", "original_id", "idempotency_key", "callback_type", "txn_id", "status", "message",
+	"raw_body", "outcome", "response_status", "response_message", "received_at", "processed_at"
+	FROM "payment_callback" WHERE "transaction_id" = $1 ORDER BY "received_at" ASC`
+
+	CreateOrderStatusHistoryQuery = `INSERT INTO "order_status_history"
+	(order_id, from_order_status_id, to_order_status_id, actor, actor_id) VALUES ($1, $2, $3, $4, $5)`
+
+	GetTotalPayoutsQuery = `SELECT count(id) FROM "payout" WHERE ($1 = '' OR "status" = $1)`
+
+	GetPayoutsQuery = `SELECT "p"."id", "p"."shop_id", "s"."name", "s"."user_id", "p"."bank_account_id", "p"."payout_batch_id", "p"."amount",
+	"p"."order_count", "p"."status", "p"."note", "p"."requested_at", "p"."approved_at", "p"."rejected_at",
+	"b"."bank_name", "b"."account_number", "b"."account_holder"
+	FROM "payout" AS "p"
+	INNER JOIN "shop" AS "s" ON "s"."id" = "p"."shop_id"
+	INNER JOIN "bank_account" AS "b" ON "b"."id" = "p"."bank_account_id"
+	WHERE ($1 = '' OR "p"."status" = $1)
+	ORDER BY "p"."requested_at" DESC LIMIT $2 OFFSET $3`
+
+	GetPayoutByIDQuery = `SELECT "p"."id", "p"."shop_id", "s"."name", "s"."user_id", "p"."bank_account_id", "p"."payout_batch_id", "p"."amount",
+	"p"."order_count", "p"."status", "p"."note", "p"."requested_at", "p"."approved_at", "p"."rejected_at",
+	"b"."bank_name", "b"."account_number", "b"."account_holder"
+	FROM "payout" AS "p"
+	INNER JOIN "shop" AS "s" ON "s"."id" = "p"."shop_id"
+	INNER JOIN "bank_account" AS "b" ON "b"."id" = "p"."bank_account_id"
+	WHERE "p"."id" = $1`
+
+	GetPayoutsByBatchIDQuery = `SELECT "p"."id", "p"."shop_id", "s"."name", "s"."user_id", "p"."bank_account_id", "p"."payout_batch_id", "p"."amount",
+	"p"."order_count", "p"."status", "p"."note", "p"."requested_at", "p"."approved_at", "p"."rejected_at",
+	"b"."bank_name", "b"."account_number", "b"."account_holder"
+	FROM "payout" AS "p"
+	INNER JOIN "shop" AS "s" ON "s"."id" = "p"."shop_id"
+	INNER JOIN "bank_account" AS "b" ON "b"."id" = "p"."bank_account_id"
+	WHERE "p"."payout_batch_id" = $1
+	ORDER BY "p"."requested_at" ASC`
+
+	UpdatePayoutRejectedQuery = `UPDATE "payout" SET "status" = $1, "note" = $2, "rejected_at" = $3
+	WHERE "id" = $4 AND "status" = $5`
+
+	ReleasePayoutOrdersQuery = `UPDATE "order" SET "payout_id" = NULL WHERE "payout_id" = $1 AND "is_withdraw" = false`
+
+	CreatePayoutBatchQuery = `INSERT INTO "payout_batch" (status, created_by) VALUES ($1, $2) RETURNING "id", "created_at"`
+
+	AssignPayoutsToBatchQuery = `UPDATE "payout" SET "payout_batch_id" = $1, "status" = $2
+	WHERE "status" = $3 AND "payout_batch_id" IS NULL
+	RETURNING "amount"`
+
+	UpdatePayoutBatchTotalQuery = `UPDATE "payout_batch" SET "total_amount" = $1, "payout_count" = $2 WHERE "id" = $3`
+
+	GetTotalPayoutBatchesQuery = `SELECT count(id) FROM "payout_batch"`
+
+	GetPayoutBatchesQuery = `SELECT "id", "status", "total_amount", "payout_count", "created_by", "approved_by", "approved_at", "created_at"
+	FROM "payout_batch"
+	ORDER BY "created_at" DESC LIMIT $1 OFFSET $2`
+
+	GetPayoutBatchByIDQuery = `SELECT "id", "status", "total_amount", "payout_count", "created_by", "approved_by", "approved_at", "created_at"
+	FROM "payout_batch"
+	WHERE "id" = $1`
+
+	UpdatePayoutsApprovedQuery = `UPDATE "payout" SET "status" = $1, "approved_at" = $2 WHERE "payout_batch_id" = $3`
+
+	UpdateOrdersWithdrawnByBatchQuery = `UPDATE "order" AS "o" SET "is_withdraw" = true
+	FROM "payout" AS "p"
+	WHERE "o"."payout_id" = "p"."id" AND "p"."payout_batch_id" = $1 AND "o"."is_withdraw" = false`
+
+	UpdatePayoutBatchApprovedQuery = `UPDATE "payout_batch" SET "status" = $1, "approved_by" = $2, "approved_at" = $3
+	WHERE "id" = $4 AND "status" = $5`
+
+	GetActiveUserSessionsQuery = `SELECT "id", "user_id", "user_agent", "ip_address", "created_at", "last_used_at", "expired_at", "revoked_at"
+	FROM "user_session" WHERE "user_id" = $1 AND "revoked_at" IS NULL AND "expired_at" > now() ORDER BY "last_used_at" DESC`
+
+	GetRolesQuery = `SELECT "id", "name", "created_at", "updated_at" FROM "role" ORDER BY "id"`
+
+	GetRoleByIDQuery = `SELECT "id", "name", "created_at", "updated_at" FROM "role" WHERE "id" = $1`
+
+	GetRolePermissionsQuery = `SELECT "rp"."role_id", "p"."name" FROM "role_permission" AS "rp"
+	INNER JOIN "permission" AS "p" ON "p"."id" = "rp"."permission_id" ORDER BY "p"."name"`
+
+	GetUserPrimaryRoleIDQuery = `SELECT "role_id" FROM "user" WHERE "id" = $1`
+
+	GetUserRolesQuery = `SELECT "r"."id", "r"."name", "r"."created_at", "r"."updated_at" FROM "user_role" AS "ur"
+	INNER JOIN "role" AS "r" ON "r"."id" = "ur"."role_id" WHERE "ur"."user_id" = $1 ORDER BY "r"."id"`
+
+	CreateUserRoleQuery = `INSERT INTO "user_role" (user_id, role_id) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+
+	DeleteUserRoleQuery = `DELETE FROM "user_role" WHERE "user_id" = $1 AND "role_id" = $2`
+
+	UserEffectiveStatus = `CASE WHEN "u"."status" = 'suspended' AND "u"."suspended_until" <= now() THEN 'active' ELSE "u"."status" END`
+
+	UserSearchCondition = `("u"."email" ILIKE $1 OR "u"."username" ILIKE $1 OR "u"."fullname" ILIKE $1 OR "u"."phone_no" ILIKE $1)
+	AND ($2 = '' OR ` + UserEffectiveStatus + ` = $2)`
+
+	GetTotalUsersQuery = `SELECT count("u"."id") FROM "user" AS "u" WHERE ` + UserSearchCondition
+
+	SearchUsersQuery = `SELECT "u"."id", "u"."role_id", "u"."email", "u"."username", "u"."fullname", "u"."phone_no", "u"."is_verify",
+	"u"."is_sso", ` + UserEffectiveStatus + `, "u"."status_reason", "u"."suspended_until", "u"."password_reset_required",
+	"u"."created_at", "u"."deleted_at"
+	FROM "user" AS "u" WHERE ` + UserSearchCondition + `
+	ORDER BY "u"."created_at" DESC LIMIT $3 OFFSET $4`
+
+	GetUserByIDQuery = `SELECT "u"."id", "u"."role_id", "u"."email", "u"."username", "u"."fullname", "u"."phone_no", "u"."is_verify",
+	"u"."is_sso", ` + UserEffectiveStatus + `, "u"."status_reason", "u"."suspended_until", "u"."password_reset_required",
+	"u"."created_at", "u"."deleted_at"
+	FROM "user" AS "u" WHERE "u"."id" = $1`
+
+	ShopSearchFields = `"s"."id", "s"."name", "s"."user_id", "u"."email", ` + UserEffectiveStatus + `,
+	COALESCE("s"."total_product", 0), COALESCE("s"."rating_avg", 0), "s"."created_at", "s"."deleted_at"`
+
+	GetTotalShopsQuery = `SELECT count("s"."id") FROM "shop" AS "s"
+	INNER JOIN "user" AS "u" ON "u"."id" = "s"."user_id"
+	WHERE "s"."name" ILIKE $1 OR "u"."email" ILIKE $1`
+
+	SearchShopsQuery = `SELECT ` + ShopSearchFields + ` FROM "shop" AS "s"
+	INNER JOIN "user" AS "u" ON "u"."id" = "s"."user_id"
+	WHERE "s"."name" ILIKE $1 OR "u"."email" ILIKE $1
+	ORDER BY "s"."created_at" DESC LIMIT $2 OFFSET $3`
+
+	GetShopByUserIDQuery = `SELECT ` + ShopSearchFields + ` FROM "shop" AS "s"
+	INNER JOIN "user" AS "u" ON "u"."id" = "s"."user_id"
+	WHERE "s"."user_id" = $1 AND "s"."deleted_at" IS NULL`
+
+	GetUserWalletQuery = `SELECT "id", "balance", "active_date", "unlocked_at" FROM "wallet"
+	WHERE "user_id" = $1 AND "deleted_at" IS NULL`
+
+	GetTotalUserOrdersQuery = `SELECT count("id") FROM "order" WHERE "user_id" = $1`
+
+	GetUserOrdersQuery = `SELECT "o"."id", "o"."transaction_id", "o"."shop_id", COALESCE("s"."name", ''), "o"."order_status_id",
+	"o"."total_price", "o"."delivery_fee", "o"."resi_no", "o"."is_refund", "o"."created_at"
+	FROM "order" AS "o"
+	LEFT JOIN "shop" AS "s" ON "s"."id" = "o"."shop_id"
+	WHERE "o"."user_id" = $1
+	ORDER BY "o"."created_at" DESC LIMIT $2 OFFSET $3`
+
+	GetTotalWalletHistoryQuery = `SELECT count("id") FROM "wallet_history" WHERE "wallet_id" = $1`
+
+	GetWalletHistoryQuery = `SELECT "id", "transaction_id", "wallet_id", "from", "to", "description", "amount", "created_at"
+	FROM "wallet_history" WHERE "wallet_id" = $1
+	ORDER BY "created_at" DESC LIMIT $2 OFFSET $3`
+
+	UpdateUserStatusQuery = `UPDATE "user" SET "status" = $1, "status_reason" = $2, "suspended_until" = $3, "updated_at" = now()
+	WHERE "id" = $4 AND "deleted_at" IS NULL`
+
+	UpdateUserPasswordResetRequiredQuery = `UPDATE "user" SET "password_reset_required" = true, "updated_at" = now()
+	WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	CreateAdminAuditLogQuery = `INSERT INTO "admin_audit_log" (admin_id, user_id, impersonation_id, action, detail)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	GetTotalAdminAuditLogsQuery = `SELECT count("id") FROM "admin_audit_log" WHERE "user_id" = $1`
+
+	GetAdminAuditLogsQuery = `SELECT "l"."id", "l"."admin_id", "a"."email", "l"."user_id", "l"."impersonation_id", "l"."action",
+	"l"."detail", "l"."created_at"
+	FROM "admin_audit_log" AS "l"
+	INNER JOIN "user" AS "a" ON "a"."id" = "l"."admin_id"
+	WHERE "l"."user_id" = $1
+	ORDER BY "l"."created_at" DESC LIMIT $2 OFFSET $3`
+
+	CreateImpersonationSessionQuery = `INSERT INTO "impersonation_session" (admin_id, user_id, reason, expired_at)
+	VALUES ($1, $2, $3, $4) RETURNING "id", "created_at"`
+
+	GetImpersonationSessionByIDQuery = `SELECT "id", "admin_id", "user_id", "reason", "expired_at", "ended_at", "created_at"
+	FROM "impersonation_session" WHERE "id" = $1`
+
+	EndImpersonationSessionQuery = `UPDATE "impersonation_session" SET "ended_at" = now()
+	WHERE "id" = $1 AND "ended_at" IS NULL AND "expired_at" > now()`
 )
@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"murakali/internal/constant"
 	"murakali/internal/model"
 	"murakali/internal/module/admin"
 	"murakali/internal/module/admin/delivery/body"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 )
@@ -469,3 +471,820 @@ func (r *adminRepo) EditBanner(ctx context.Context, requestBody body.BannerIDReq
 	}
 	return nil
 }
+
+func (r *adminRepo) GetPaymentCallbacksByTransactionID(ctx context.Context, transactionID string) ([]*model.PaymentCallback, error) {
+	callbacks := make([]*model.PaymentCallback, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPaymentCallbacksByTransactionIDQuery, transactionID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var callback model.PaymentCallback
+		if errScan := res.Scan(
+			&callback.ID,
+			&callback.TransactionID,
+			&callback.OriginalID,
+			&callback.IdempotencyKey,
+			&callback.CallbackType,
+			&callback.TxnID,
+			&callback.Status,
+			&callback.Message,
+			&callback.RawBody,
+			&callback.Outcome,
+			&callback.ResponseStatus,
+			&callback.ResponseMessage,
+			&callback.ReceivedAt,
+			&callback.ProcessedAt); errScan != nil {
+			return nil, errScan
+		}
+		callbacks = append(callbacks, &callback)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return callbacks, nil
+}
+
+func (r *adminRepo) CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error {
+	_, err := tx.ExecContext(ctx, CreateOrderStatusHistoryQuery,
+		history.OrderID,
+		history.FromOrderStatusID,
+		history.ToOrderStatusID,
+		history.Actor,
+		history.ActorID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) GetTotalPayouts(ctx context.Context, status string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalPayoutsQuery, status).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) GetPayouts(ctx context.Context, status string, pgn *pagination.Pagination) ([]*model.Payout, error) {
+	payouts := make([]*model.Payout, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPayoutsQuery, status, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		payout := &model.Payout{BankAccount: &model.BankAccount{}}
+		if errScan := res.Scan(
+			&payout.ID,
+			&payout.ShopID,
+			&payout.ShopName,
+			&payout.SellerID,
+			&payout.BankAccountID,
+			&payout.PayoutBatchID,
+			&payout.Amount,
+			&payout.OrderCount,
+			&payout.Status,
+			&payout.Note,
+			&payout.RequestedAt,
+			&payout.ApprovedAt,
+			&payout.RejectedAt,
+			&payout.BankAccount.BankName,
+			&payout.BankAccount.AccountNumber,
+			&payout.BankAccount.AccountHolder); errScan != nil {
+			return nil, errScan
+		}
+
+		payout.BankAccount.ID = payout.BankAccountID
+
+		payouts = append(payouts, payout)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return payouts, nil
+}
+
+func (r *adminRepo) GetPayoutByID(ctx context.Context, payoutID string) (*model.Payout, error) {
+	payout := &model.Payout{BankAccount: &model.BankAccount{}}
+	if err := r.PSQL.QueryRowContext(ctx, GetPayoutByIDQuery, payoutID).Scan(
+		&payout.ID,
+		&payout.ShopID,
+		&payout.ShopName,
+		&payout.SellerID,
+		&payout.BankAccountID,
+		&payout.PayoutBatchID,
+		&payout.Amount,
+		&payout.OrderCount,
+		&payout.Status,
+		&payout.Note,
+		&payout.RequestedAt,
+		&payout.ApprovedAt,
+		&payout.RejectedAt,
+		&payout.BankAccount.BankName,
+		&payout.BankAccount.AccountNumber,
+		&payout.BankAccount.AccountHolder); err != nil {
+		return nil, err
+	}
+
+	payout.BankAccount.ID = payout.BankAccountID
+	return payout, nil
+}
+
+func (r *adminRepo) GetPayoutsByBatchID(ctx context.Context, batchID string) ([]*model.Payout, error) {
+	payouts := make([]*model.Payout, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPayoutsByBatchIDQuery, batchID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		payout := &model.Payout{BankAccount: &model.BankAccount{}}
+		if errScan := res.Scan(
+			&payout.ID,
+			&payout.ShopID,
+			&payout.ShopName,
+			&payout.SellerID,
+			&payout.BankAccountID,
+			&payout.PayoutBatchID,
+			&payout.Amount,
+			&payout.OrderCount,
+			&payout.Status,
+			&payout.Note,
+			&payout.RequestedAt,
+			&payout.ApprovedAt,
+			&payout.RejectedAt,
+			&payout.BankAccount.BankName,
+			&payout.BankAccount.AccountNumber,
+			&payout.BankAccount.AccountHolder); errScan != nil {
+			return nil, errScan
+		}
+
+		payout.BankAccount.ID = payout.BankAccountID
+
+		payouts = append(payouts, payout)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return payouts, nil
+}
+
+func (r *adminRepo) UpdatePayoutRejected(ctx context.Context, tx postgre.Transaction, payout *model.Payout) (int64, error) {
+	res, err := tx.ExecContext(ctx, UpdatePayoutRejectedQuery,
+		constant.PayoutStatusRejected,
+		payout.Note,
+		payout.RejectedAt,
+		payout.ID,
+		constant.PayoutStatusRequested)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *adminRepo) ReleasePayoutOrders(ctx context.Context, tx postgre.Transaction, payoutID string) error {
+	if _, err := tx.ExecContext(ctx, ReleasePayoutOrdersQuery, payoutID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) CreatePayoutBatch(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error {
+	if err := tx.QueryRowContext(ctx, CreatePayoutBatchQuery, batch.Status, batch.CreatedBy).Scan(
+		&batch.ID,
+		&batch.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) AssignPayoutsToBatch(ctx context.Context, tx postgre.Transaction, batchID string) (model.Money, int, error) {
+	res, err := tx.QueryContext(ctx, AssignPayoutsToBatchQuery, batchID, constant.PayoutStatusBatched, constant.PayoutStatusRequested)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer res.Close()
+
+	var total model.Money
+	var count int
+	for res.Next() {
+		var amount model.Money
+		if errScan := res.Scan(&amount); errScan != nil {
+			return 0, 0, errScan
+		}
+
+		total += amount
+		count++
+	}
+
+	if res.Err() != nil {
+		return 0, 0, res.Err()
+	}
+
+	return total, count, nil
+}
+
+func (r *adminRepo) UpdatePayoutBatchTotal(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error {
+	if _, err := tx.ExecContext(ctx, UpdatePayoutBatchTotalQuery, batch.TotalAmount, batch.PayoutCount, batch.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) GetTotalPayoutBatches(ctx context.Context) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalPayoutBatchesQuery).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) GetPayoutBatches(ctx context.Context, pgn *pagination.Pagination) ([]*model.PayoutBatch, error) {
+	batchs := make([]*model.PayoutBatch, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPayoutBatchesQuery, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		batch := &model.PayoutBatch{}
+		if errScan := res.Scan(
+			&batch.ID,
+			&batch.Status,
+			&batch.TotalAmount,
+			&batch.PayoutCount,
+			&batch.CreatedBy,
+			&batch.ApprovedBy,
+			&batch.ApprovedAt,
+			&batch.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		batchs = append(batchs, batch)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return batchs, nil
+}
+
+func (r *adminRepo) GetPayoutBatchByID(ctx context.Context, batchID string) (*model.PayoutBatch, error) {
+	batch := &model.PayoutBatch{}
+	if err := r.PSQL.QueryRowContext(ctx, GetPayoutBatchByIDQuery, batchID).Scan(
+		&batch.ID,
+		&batch.Status,
+		&batch.TotalAmount,
+		&batch.PayoutCount,
+		&batch.CreatedBy,
+		&batch.ApprovedBy,
+		&batch.ApprovedAt,
+		&batch.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (r *adminRepo) UpdatePayoutsApproved(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) error {
+	if _, err := tx.ExecContext(ctx, UpdatePayoutsApprovedQuery, constant.PayoutStatusApproved, batch.ApprovedAt, batch.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) UpdateOrdersWithdrawnByBatch(ctx context.Context, tx postgre.Transaction, batchID string) error {
+	if _, err := tx.ExecContext(ctx, UpdateOrdersWithdrawnByBatchQuery, batchID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) UpdatePayoutBatchApproved(ctx context.Context, tx postgre.Transaction, batch *model.PayoutBatch) (int64, error) {
+	res, err := tx.ExecContext(ctx, UpdatePayoutBatchApprovedQuery,
+		constant.PayoutBatchStatusApproved,
+		batch.ApprovedBy,
+		batch.ApprovedAt,
+		batch.ID,
+		constant.PayoutBatchStatusPending)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *adminRepo) GetActiveUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	sessions := make([]*model.UserSession, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetActiveUserSessionsQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var session model.UserSession
+		if errScan := res.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.CreatedAt,
+			&session.LastUsedAt,
+			&session.ExpiredAt,
+			&session.RevokedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		sessions = append(sessions, &session)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return sessions, nil
+}
+
+func (r *adminRepo) InsertSessionRedis(ctx context.Context, duration int, key, status string) error {
+	if err := r.RedisClient.Set(ctx, key, status, time.Duration(duration)*time.Minute); err != nil {
+		return err.Err()
+	}
+
+	return nil
+}
+
+func (r *adminRepo) GetRoles(ctx context.Context) ([]*model.Role, error) {
+	roles := make([]*model.Role, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetRolesQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var role model.Role
+		if errScan := res.Scan(&role.ID, &role.Name, &role.CreatedAt, &role.UpdatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		roles = append(roles, &role)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return roles, nil
+}
+
+func (r *adminRepo) GetRoleByID(ctx context.Context, roleID int64) (*model.Role, error) {
+	var role model.Role
+	if err := r.PSQL.QueryRowContext(ctx, GetRoleByIDQuery, roleID).
+		Scan(&role.ID, &role.Name, &role.CreatedAt, &role.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &role, nil
+}
+
+func (r *adminRepo) GetRolePermissions(ctx context.Context) ([]*model.RolePermission, error) {
+	rolePermissions := make([]*model.RolePermission, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetRolePermissionsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var rolePermission model.RolePermission
+		if errScan := res.Scan(&rolePermission.RoleID, &rolePermission.Permission); errScan != nil {
+			return nil, errScan
+		}
+
+		rolePermissions = append(rolePermissions, &rolePermission)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return rolePermissions, nil
+}
+
+func (r *adminRepo) GetUserPrimaryRoleID(ctx context.Context, userID string) (int64, error) {
+	var roleID int64
+	if err := r.PSQL.QueryRowContext(ctx, GetUserPrimaryRoleIDQuery, userID).Scan(&roleID); err != nil {
+		return 0, err
+	}
+
+	return roleID, nil
+}
+
+func (r *adminRepo) GetUserRoles(ctx context.Context, userID string) ([]*model.Role, error) {
+	roles := make([]*model.Role, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetUserRolesQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var role model.Role
+		if errScan := res.Scan(&role.ID, &role.Name, &role.CreatedAt, &role.UpdatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		roles = append(roles, &role)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return roles, nil
+}
+
+func (r *adminRepo) CreateUserRole(ctx context.Context, userID string, roleID int64) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateUserRoleQuery, userID, roleID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) DeleteUserRole(ctx context.Context, userID string, roleID int64) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, DeleteUserRoleQuery, userID, roleID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *adminRepo) DeletePermissionCacheRedis(ctx context.Context, userID string) error {
+	return r.RedisClient.Del(ctx, fmt.Sprintf("%s:%s", constant.PermissionKey, userID)).Err()
+}
+
+func (r *adminRepo) GetTotalUsers(ctx context.Context, query, status string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalUsersQuery, query, status).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) SearchUsers(ctx context.Context, query, status string, pgn *pagination.Pagination) ([]*model.User, error) {
+	users := make([]*model.User, 0)
+	res, err := r.PSQL.QueryContext(ctx, SearchUsersQuery, query, status, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var user model.User
+		if errScan := res.Scan(userFields(&user)...); errScan != nil {
+			return nil, errScan
+		}
+
+		users = append(users, &user)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return users, nil
+}
+
+func (r *adminRepo) GetUserByID(ctx context.Context, userID string) (*model.User, error) {
+	var user model.User
+	if err := r.PSQL.QueryRowContext(ctx, GetUserByIDQuery, userID).Scan(userFields(&user)...); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+func (r *adminRepo) GetTotalShops(ctx context.Context, query string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalShopsQuery, query).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) SearchShops(ctx context.Context, query string, pgn *pagination.Pagination) ([]*body.ShopResponse, error) {
+	shops := make([]*body.ShopResponse, 0)
+	res, err := r.PSQL.QueryContext(ctx, SearchShopsQuery, query, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var shop body.ShopResponse
+		if errScan := res.Scan(shopFields(&shop)...); errScan != nil {
+			return nil, errScan
+		}
+
+		shops = append(shops, &shop)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return shops, nil
+}
+
+func (r *adminRepo) GetShopByUserID(ctx context.Context, userID string) (*body.ShopResponse, error) {
+	var shop body.ShopResponse
+	if err := r.PSQL.QueryRowContext(ctx, GetShopByUserIDQuery, userID).Scan(shopFields(&shop)...); err != nil {
+		return nil, err
+	}
+
+	return &shop, nil
+}
+
+func (r *adminRepo) GetUserWallet(ctx context.Context, userID string) (*model.Wallet, error) {
+	var wallet model.Wallet
+	if err := r.PSQL.QueryRowContext(ctx, GetUserWalletQuery, userID).
+		Scan(&wallet.ID, &wallet.Balance, &wallet.ActiveDate, &wallet.UnlockedAt); err != nil {
+		return nil, err
+	}
+
+	return &wallet, nil
+}
+
+func (r *adminRepo) GetTotalUserOrders(ctx context.Context, userID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalUserOrdersQuery, userID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) GetUserOrders(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*body.UserOrderResponse, error) {
+	orders := make([]*body.UserOrderResponse, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetUserOrdersQuery, userID, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var order body.UserOrderResponse
+		if errScan := res.Scan(
+			&order.ID,
+			&order.TransactionID,
+			&order.ShopID,
+			&order.ShopName,
+			&order.OrderStatusID,
+			&order.TotalPrice,
+			&order.DeliveryFee,
+			&order.ResiNo,
+			&order.IsRefund,
+			&order.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		orders = append(orders, &order)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return orders, nil
+}
+
+func (r *adminRepo) GetTotalWalletHistory(ctx context.Context, walletID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalWalletHistoryQuery, walletID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) GetWalletHistory(ctx context.Context, walletID string, pgn *pagination.Pagination) ([]*model.WalletHistory, error) {
+	histories := make([]*model.WalletHistory, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetWalletHistoryQuery, walletID, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var history model.WalletHistory
+		if errScan := res.Scan(
+			&history.ID,
+			&history.TransactionID,
+			&history.WalletID,
+			&history.From,
+			&history.To,
+			&history.Description,
+			&history.Amount,
+			&history.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		histories = append(histories, &history)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return histories, nil
+}
+
+func (r *adminRepo) UpdateUserStatus(ctx context.Context, userID, status, reason string, suspendedUntil *time.Time) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, UpdateUserStatusQuery, status, reason, suspendedUntil, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *adminRepo) UpdateUserPasswordResetRequired(ctx context.Context, userID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, UpdateUserPasswordResetRequiredQuery, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *adminRepo) InsertAccountStatusRedis(ctx context.Context, userID, status string, duration time.Duration) error {
+	return r.RedisClient.Set(ctx, fmt.Sprintf("%s:%s", constant.AccountStatusKey, userID), status, duration).Err()
+}
+
+func (r *adminRepo) DeleteAccountStatusRedis(ctx context.Context, userID string) error {
+	return r.RedisClient.Del(ctx, fmt.Sprintf("%s:%s", constant.AccountStatusKey, userID)).Err()
+}
+
+func (r *adminRepo) CreateAdminAuditLog(ctx context.Context, auditLog *model.AdminAuditLog) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateAdminAuditLogQuery,
+		auditLog.AdminID,
+		auditLog.UserID,
+		auditLog.ImpersonationID,
+		auditLog.Action,
+		auditLog.Detail); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) GetTotalAdminAuditLogs(ctx context.Context, userID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalAdminAuditLogsQuery, userID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *adminRepo) GetAdminAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*model.AdminAuditLog, error) {
+	auditLogs := make([]*model.AdminAuditLog, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetAdminAuditLogsQuery, userID, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var auditLog model.AdminAuditLog
+		if errScan := res.Scan(
+			&auditLog.ID,
+			&auditLog.AdminID,
+			&auditLog.AdminEmail,
+			&auditLog.UserID,
+			&auditLog.ImpersonationID,
+			&auditLog.Action,
+			&auditLog.Detail,
+			&auditLog.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		auditLogs = append(auditLogs, &auditLog)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return auditLogs, nil
+}
+
+func (r *adminRepo) CreateImpersonationSession(ctx context.Context, session *model.ImpersonationSession) error {
+	if err := r.PSQL.QueryRowContext(ctx, CreateImpersonationSessionQuery,
+		session.AdminID,
+		session.UserID,
+		session.Reason,
+		session.ExpiredAt).Scan(&session.ID, &session.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *adminRepo) GetImpersonationSessionByID(ctx context.Context, impersonationID string) (*model.ImpersonationSession, error) {
+	var session model.ImpersonationSession
+	if err := r.PSQL.QueryRowContext(ctx, GetImpersonationSessionByIDQuery, impersonationID).Scan(
+		&session.ID,
+		&session.AdminID,
+		&session.UserID,
+		&session.Reason,
+		&session.ExpiredAt,
+		&session.EndedAt,
+		&session.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *adminRepo) EndImpersonationSession(ctx context.Context, impersonationID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, EndImpersonationSessionQuery, impersonationID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *adminRepo) InsertImpersonationRedis(ctx context.Context, impersonationID, sessionKey string, duration time.Duration) error {
+	return r.RedisClient.Set(ctx, fmt.Sprintf("%s:%s", constant.ImpersonationKey, impersonationID), sessionKey, duration).Err()
+}
+
+func (r *adminRepo) GetImpersonationRedis(ctx context.Context, impersonationID string) (string, error) {
+	sessionKey, err := r.RedisClient.Get(ctx, fmt.Sprintf("%s:%s", constant.ImpersonationKey, impersonationID)).Result()
+	if err == redis.Nil {
+		return "", nil
+	}
+
+	return sessionKey, err
+}
+
+func userFields(user *model.User) []interface{} {
+	return []interface{}{
+		&user.ID,
+		&user.RoleID,
+		&user.Email,
+		&user.Username,
+		&user.FullName,
+		&user.PhoneNo,
+		&user.IsVerify,
+		&user.IsSSO,
+		&user.Status,
+		&user.StatusReason,
+		&user.SuspendedUntil,
+		&user.PasswordResetRequired,
+		&user.CreatedAt,
+		&user.DeletedAt,
+	}
+}
+
+func shopFields(shop *body.ShopResponse) []interface{} {
+	return []interface{}{
+		&shop.ID,
+		&shop.Name,
+		&shop.UserID,
+		&shop.OwnerEmail,
+		&shop.OwnerStatus,
+		&shop.TotalProduct,
+		&shop.RatingAVG,
+		&shop.CreatedAt,
+		&shop.DeletedAt,
+	}
+}
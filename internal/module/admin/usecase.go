@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"murakali/internal/limiter"
 	"murakali/internal/model"
 	"murakali/internal/module/admin/delivery/body"
 	"murakali/pkg/pagination"
@@ -14,7 +15,7 @@ type UseCase interface {
 	GetDetailVoucher(ctx context.Context, voucherID string) (*model.Voucher, error)
 	DeleteVoucher(ctx context.Context, voucherID string) error
 	GetRefunds(ctx context.Context, sortFilter string, pgn *pagination.Pagination) (*pagination.Pagination, error)
-	RefundOrder(ctx context.Context, refundID string) error
+	RefundOrder(ctx context.Context, userID, refundID string) error
 	GetCategories(ctx context.Context) ([]*body.CategoryResponse, error)
 	AddCategory(ctx context.Context, requestBody body.CategoryRequest) error
 	DeleteCategory(ctx context.Context, categoryID string) error
@@ -23,4 +24,32 @@ type UseCase interface {
 	AddBanner(ctx context.Context, requestBody body.BannerRequest) error
 	DeleteBanner(ctx context.Context, bannerID string) error
 	EditBanner(ctx context.Context, requestBody body.BannerIDRequest) error
+	GetPaymentCallbacks(ctx context.Context, transactionID string) ([]*model.PaymentCallback, error)
+	GetPayouts(ctx context.Context, status string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	RejectPayout(ctx context.Context, payoutID string, requestBody body.RejectPayoutRequest) error
+	CreatePayoutBatch(ctx context.Context, adminID string) (*model.PayoutBatch, error)
+	GetPayoutBatches(ctx context.Context, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	ApprovePayoutBatch(ctx context.Context, adminID, batchID string) error
+	ExportPayoutBatch(ctx context.Context, batchID string) ([]*model.Payout, error)
+	GetUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error)
+	RevokeUserSessions(ctx context.Context, userID string) error
+	GetLockouts(ctx context.Context) ([]*limiter.Lockout, error)
+	ClearLockout(ctx context.Context, requestBody body.ClearLockoutRequest) error
+	GetRoles(ctx context.Context) ([]*body.RoleResponse, error)
+	GetUserRoles(ctx context.Context, userID string) (*body.UserRolesResponse, error)
+	AssignUserRole(ctx context.Context, adminID, userID string, roleID int64) error
+	RemoveUserRole(ctx context.Context, adminID, userID string, roleID int64) error
+	SearchUsers(ctx context.Context, query, status string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	GetUserDetail(ctx context.Context, userID string) (*body.UserDetailResponse, error)
+	GetUserOrders(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	GetUserWallet(ctx context.Context, userID string) (*body.WalletResponse, error)
+	GetUserWalletHistory(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	SearchShops(ctx context.Context, query string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	SuspendUser(ctx context.Context, adminID, userID string, requestBody body.SuspendUserRequest) error
+	BanUser(ctx context.Context, adminID, userID string, requestBody body.UserModerationRequest) error
+	ReactivateUser(ctx context.Context, adminID, userID string, requestBody body.UserModerationRequest) error
+	ForcePasswordReset(ctx context.Context, adminID, userID string, requestBody body.UserModerationRequest) error
+	ImpersonateUser(ctx context.Context, adminID, userID string, requestBody body.ImpersonateUserRequest) (*body.ImpersonationResponse, error)
+	EndImpersonation(ctx context.Context, adminID, impersonationID string) error
+	GetUserAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
 }
@@ -3,28 +3,46 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 	"math"
 	"murakali/config"
 	"murakali/internal/constant"
+	"murakali/internal/ledger"
+	"murakali/internal/limiter"
 	"murakali/internal/model"
 	"murakali/internal/module/admin"
 	"murakali/internal/module/admin/delivery/body"
+	"murakali/internal/orderstatus"
+	"murakali/internal/rbac"
+	"murakali/internal/session"
+	smtp "murakali/pkg/email"
 	"murakali/pkg/httperror"
+	"murakali/pkg/jwt"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
 	"net/http"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type adminUC struct {
-	cfg       *config.Config
-	txRepo    *postgre.TxRepo
-	adminRepo admin.Repository
+	cfg        *config.Config
+	txRepo     *postgre.TxRepo
+	adminRepo  admin.Repository
+	ledgerRepo ledger.Repository
+	limiter    *limiter.Limiter
+	authorizer *rbac.Authorizer
+	sessions   *session.Revoker
+	keySet     *jwt.KeySet
 }
 
-func NewAdminUseCase(cfg *config.Config, txRepo *postgre.TxRepo, adminRepo admin.Repository) admin.UseCase {
-	return &adminUC{cfg: cfg, txRepo: txRepo, adminRepo: adminRepo}
+func NewAdminUseCase(cfg *config.Config, txRepo *postgre.TxRepo, adminRepo admin.Repository, ledgerRepo ledger.Repository,
+	bruteForceLimiter *limiter.Limiter, authorizer *rbac.Authorizer, sessionRevoker *session.Revoker, keySet *jwt.KeySet) admin.UseCase {
+	return &adminUC{cfg: cfg, txRepo: txRepo, adminRepo: adminRepo, ledgerRepo: ledgerRepo, limiter: bruteForceLimiter,
+		authorizer: authorizer, sessions: sessionRevoker, keySet: keySet}
 }
 
 func (u *adminUC) GetAllVoucher(ctx context.Context, voucherStatusID, sortFilter string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
@@ -66,7 +84,7 @@ func (u *adminUC) GetRefunds(ctx context.Context, sortFilter string, pgn *pagina
 	return pgn, nil
 }
 
-func (u *adminUC) RefundOrder(ctx context.Context, refundID string) error {
+func (u *adminUC) RefundOrder(ctx context.Context, userID, refundID string) error {
 	refund, err := u.adminRepo.GetRefundByID(ctx, refundID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -89,6 +107,10 @@ func (u *adminUC) RefundOrder(ctx context.Context, refundID string) error {
 		return err
 	}
 
+	if err := orderstatus.ValidateTransition(constant.OrderActorAdmin, order.OrderStatusID, constant.OrderStatusRefunded); err != nil {
+		return err
+	}
+
 	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
 		refund.RefundedAt.Valid = true
 		refund.RefundedAt.Time = time.Now()
@@ -97,11 +119,16 @@ func (u *adminUC) RefundOrder(ctx context.Context, refundID string) error {
 			return errRefund
 		}
 
+		history := orderstatus.NewHistory(order.ID, order.OrderStatusID, constant.OrderStatusRefunded, constant.OrderActorAdmin, userID)
 		order.OrderStatusID = constant.OrderStatusRefunded
 		if errStatus := u.adminRepo.UpdateOrderStatus(ctx, tx, order); errStatus != nil {
 			return errStatus
 		}
 
+		if errHistory := u.adminRepo.CreateOrderStatusHistory(ctx, tx, history); errHistory != nil {
+			return errHistory
+		}
+
 		orderItems, err := u.adminRepo.GetOrderItemsByOrderID(ctx, tx, order.ID.String())
 		if err != nil {
 			return err
@@ -177,6 +204,13 @@ func (u *adminUC) RefundOrder(ctx context.Context, refundID string) error {
 			return err
 		}
 
+		ledgerTx := ledger.NewTransaction(constant.LedgerReferenceRefund, walletUserHistory.Description, &order.TransactionID)
+		ledger.Transfer(ledgerTx, ledger.EscrowAccount(walletMarketplace.ID), ledger.RefundAccount(), totalReduce)
+		ledger.Transfer(ledgerTx, ledger.RefundAccount(), ledger.WalletAccount(walletUser.ID), totalReduce)
+		if err := ledger.Post(ctx, tx, u.ledgerRepo, ledgerTx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 	if errTx != nil {
@@ -344,3 +378,765 @@ func (u *adminUC) EditBanner(ctx context.Context, requestBody body.BannerIDReque
 	}
 	return nil
 }
+
+func (u *adminUC) GetPaymentCallbacks(ctx context.Context, transactionID string) ([]*model.PaymentCallback, error) {
+	callbacks, err := u.adminRepo.GetPaymentCallbacksByTransactionID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	return callbacks, nil
+}
+
+func (u *adminUC) GetPayouts(ctx context.Context, status string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	totalRows, err := u.adminRepo.GetTotalPayouts(ctx, status)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	payouts, err := u.adminRepo.GetPayouts(ctx, status, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = payouts
+	return pgn, nil
+}
+
+func (u *adminUC) RejectPayout(ctx context.Context, payoutID string, requestBody body.RejectPayoutRequest) error {
+	payout, err := u.adminRepo.GetPayoutByID(ctx, payoutID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.PayoutNotFound)
+		}
+		return err
+	}
+
+	if payout.Status != constant.PayoutStatusRequested {
+		return httperror.New(http.StatusBadRequest, response.PayoutNotRequested)
+	}
+
+	payout.Note = &requestBody.Note
+	payout.RejectedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		rows, err := u.adminRepo.UpdatePayoutRejected(ctx, tx, payout)
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return httperror.New(http.StatusBadRequest, response.PayoutNotRequested)
+		}
+
+		return u.adminRepo.ReleasePayoutOrders(ctx, tx, payoutID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *adminUC) CreatePayoutBatch(ctx context.Context, adminID string) (*model.PayoutBatch, error) {
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &model.PayoutBatch{
+		Status:    constant.PayoutBatchStatusPending,
+		CreatedBy: adminUUID,
+	}
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		if err := u.adminRepo.CreatePayoutBatch(ctx, tx, batch); err != nil {
+			return err
+		}
+
+		total, count, err := u.adminRepo.AssignPayoutsToBatch(ctx, tx, batch.ID.String())
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return httperror.New(http.StatusBadRequest, response.PayoutBatchEmpty)
+		}
+
+		batch.TotalAmount = total
+		batch.PayoutCount = count
+		return u.adminRepo.UpdatePayoutBatchTotal(ctx, tx, batch)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+func (u *adminUC) GetPayoutBatches(ctx context.Context, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	totalRows, err := u.adminRepo.GetTotalPayoutBatches(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	batches, err := u.adminRepo.GetPayoutBatches(ctx, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = batches
+	return pgn, nil
+}
+
+func (u *adminUC) ApprovePayoutBatch(ctx context.Context, adminID, batchID string) error {
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return err
+	}
+
+	batch, err := u.adminRepo.GetPayoutBatchByID(ctx, batchID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.PayoutBatchNotFound)
+		}
+		return err
+	}
+
+	if batch.Status != constant.PayoutBatchStatusPending {
+		return httperror.New(http.StatusBadRequest, response.PayoutBatchNotPending)
+	}
+
+	payouts, err := u.adminRepo.GetPayoutsByBatchID(ctx, batchID)
+	if err != nil {
+		return err
+	}
+
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		walletMarketplace, err := u.adminRepo.GetWalletByUserID(ctx, tx, constant.AdminMarketplaceID)
+		if err != nil {
+			return err
+		}
+
+		if walletMarketplace.Balance < batch.TotalAmount {
+			return httperror.New(http.StatusBadRequest, response.MarketplaceBalanceNotEnough)
+		}
+
+		walletMarketplace.Balance -= batch.TotalAmount
+		walletMarketplace.UpdatedAt.Valid = true
+		walletMarketplace.UpdatedAt.Time = time.Now()
+		if errWallet := u.adminRepo.UpdateWalletBalance(ctx, tx, walletMarketplace); errWallet != nil {
+			return errWallet
+		}
+
+		ledgerTx := ledger.NewTransaction(constant.LedgerReferencePayout, "Payout batch "+batchID, nil)
+		for _, payout := range payouts {
+			sellerPayable := ledger.SellerPayableAccount(payout.SellerID.String())
+			ledger.Transfer(ledgerTx, ledger.EscrowAccount(walletMarketplace.ID), sellerPayable, payout.Amount)
+			ledger.Transfer(ledgerTx, sellerPayable, ledger.ExternalAccount(), payout.Amount)
+		}
+
+		if err := ledger.Post(ctx, tx, u.ledgerRepo, ledgerTx); err != nil {
+			return err
+		}
+
+		batch.ApprovedBy = &adminUUID
+		batch.ApprovedAt = sql.NullTime{Time: time.Now(), Valid: true}
+		rows, err := u.adminRepo.UpdatePayoutBatchApproved(ctx, tx, batch)
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return httperror.New(http.StatusBadRequest, response.PayoutBatchNotPending)
+		}
+
+		if err := u.adminRepo.UpdatePayoutsApproved(ctx, tx, batch); err != nil {
+			return err
+		}
+
+		return u.adminRepo.UpdateOrdersWithdrawnByBatch(ctx, tx, batchID)
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (u *adminUC) ExportPayoutBatch(ctx context.Context, batchID string) ([]*model.Payout, error) {
+	batch, err := u.adminRepo.GetPayoutBatchByID(ctx, batchID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.PayoutBatchNotFound)
+		}
+		return nil, err
+	}
+
+	if batch.Status != constant.PayoutBatchStatusApproved {
+		return nil, httperror.New(http.StatusBadRequest, response.PayoutBatchNotApproved)
+	}
+
+	return u.adminRepo.GetPayoutsByBatchID(ctx, batchID)
+}
+
+func (u *adminUC) GetUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	return u.adminRepo.GetActiveUserSessions(ctx, userID)
+}
+
+func (u *adminUC) RevokeUserSessions(ctx context.Context, userID string) error {
+	return u.sessions.RevokeUserSessions(ctx, userID)
+}
+
+func (u *adminUC) GetLockouts(ctx context.Context) ([]*limiter.Lockout, error) {
+	return u.limiter.GetLockouts(ctx)
+}
+
+func (u *adminUC) ClearLockout(ctx context.Context, requestBody body.ClearLockoutRequest) error {
+	return u.limiter.Unlock(ctx, requestBody.Scope, requestBody.Key)
+}
+
+func (u *adminUC) GetRoles(ctx context.Context) ([]*body.RoleResponse, error) {
+	roles, err := u.adminRepo.GetRoles(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePermissions, err := u.adminRepo.GetRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.toRoleResponses(roles, rolePermissions), nil
+}
+
+func (u *adminUC) GetUserRoles(ctx context.Context, userID string) (*body.UserRolesResponse, error) {
+	primaryRoleID, err := u.adminRepo.GetUserPrimaryRoleID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, response.UserNotExistMessage)
+		}
+
+		return nil, err
+	}
+
+	roles, err := u.adminRepo.GetUserRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	rolePermissions, err := u.adminRepo.GetRolePermissions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &body.UserRolesResponse{
+		PrimaryRoleID: primaryRoleID,
+		Roles:         u.toRoleResponses(roles, rolePermissions),
+	}, nil
+}
+
+func (u *adminUC) AssignUserRole(ctx context.Context, adminID, userID string, roleID int64) error {
+	if adminID == userID {
+		return httperror.New(http.StatusBadRequest, response.RoleSelfChangeMessage)
+	}
+
+	if _, err := u.adminRepo.GetUserPrimaryRoleID(ctx, userID); err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, response.UserNotExistMessage)
+		}
+
+		return err
+	}
+
+	if _, err := u.adminRepo.GetRoleByID(ctx, roleID); err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, response.RoleNotFoundMessage)
+		}
+
+		return err
+	}
+
+	if err := u.adminRepo.CreateUserRole(ctx, userID, roleID); err != nil {
+		return err
+	}
+
+	return u.adminRepo.DeletePermissionCacheRedis(ctx, userID)
+}
+
+func (u *adminUC) RemoveUserRole(ctx context.Context, adminID, userID string, roleID int64) error {
+	if adminID == userID {
+		return httperror.New(http.StatusBadRequest, response.RoleSelfChangeMessage)
+	}
+
+	rows, err := u.adminRepo.DeleteUserRole(ctx, userID, roleID)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusNotFound, response.RoleNotAssignedMessage)
+	}
+
+	return u.adminRepo.DeletePermissionCacheRedis(ctx, userID)
+}
+
+func (u *adminUC) toRoleResponses(roles []*model.Role, rolePermissions []*model.RolePermission) []*body.RoleResponse {
+	permissions := make(map[int64][]string)
+	for _, rolePermission := range rolePermissions {
+		permissions[rolePermission.RoleID] = append(permissions[rolePermission.RoleID], rolePermission.Permission)
+	}
+
+	resultRoles := make([]*body.RoleResponse, 0)
+	for _, role := range roles {
+		rolePermission := permissions[role.ID]
+		if rolePermission == nil {
+			rolePermission = make([]string, 0)
+		}
+
+		resultRoles = append(resultRoles, &body.RoleResponse{
+			ID:          role.ID,
+			Name:        role.Name,
+			Permissions: rolePermission,
+		})
+	}
+
+	return resultRoles
+}
+
+func (u *adminUC) SearchUsers(ctx context.Context, query, status string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	query = "%" + query + "%"
+	totalRows, err := u.adminRepo.GetTotalUsers(ctx, query, status)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	users, err := u.adminRepo.SearchUsers(ctx, query, status, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	resultUsers := make([]*body.UserResponse, 0)
+	for _, user := range users {
+		resultUsers = append(resultUsers, u.toUserResponse(user))
+	}
+
+	pgn.Rows = resultUsers
+	return pgn, nil
+}
+
+func (u *adminUC) GetUserDetail(ctx context.Context, userID string) (*body.UserDetailResponse, error) {
+	user, err := u.adminRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, response.UserNotExistMessage)
+		}
+
+		return nil, err
+	}
+
+	shop, err := u.adminRepo.GetShopByUserID(ctx, userID)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	wallet, err := u.GetUserWallet(ctx, userID)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			return nil, err
+		}
+	}
+
+	return &body.UserDetailResponse{
+		UserResponse: u.toUserResponse(user),
+		Shop:         shop,
+		Wallet:       wallet,
+	}, nil
+}
+
+func (u *adminUC) GetUserOrders(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	totalRows, err := u.adminRepo.GetTotalUserOrders(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	orders, err := u.adminRepo.GetUserOrders(ctx, userID, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = orders
+	return pgn, nil
+}
+
+func (u *adminUC) GetUserWallet(ctx context.Context, userID string) (*body.WalletResponse, error) {
+	wallet, err := u.adminRepo.GetUserWallet(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, response.WalletIsNotActivated)
+		}
+
+		return nil, err
+	}
+
+	walletResponse := &body.WalletResponse{
+		ID:      wallet.ID,
+		Balance: wallet.Balance,
+	}
+	if wallet.ActiveDate.Valid {
+		walletResponse.ActiveDate = &wallet.ActiveDate.Time
+	}
+
+	if wallet.UnlockedAt.Valid {
+		walletResponse.UnlockedAt = &wallet.UnlockedAt.Time
+	}
+
+	return walletResponse, nil
+}
+
+func (u *adminUC) GetUserWalletHistory(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	wallet, err := u.adminRepo.GetUserWallet(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, response.WalletIsNotActivated)
+		}
+
+		return nil, err
+	}
+
+	totalRows, err := u.adminRepo.GetTotalWalletHistory(ctx, wallet.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	histories, err := u.adminRepo.GetWalletHistory(ctx, wallet.ID.String(), pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = histories
+	return pgn, nil
+}
+
+func (u *adminUC) SearchShops(ctx context.Context, query string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	query = "%" + query + "%"
+	totalRows, err := u.adminRepo.GetTotalShops(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	shops, err := u.adminRepo.SearchShops(ctx, query, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = shops
+	return pgn, nil
+}
+
+func (u *adminUC) SuspendUser(ctx context.Context, adminID, userID string, requestBody body.SuspendUserRequest) error {
+	user, err := u.GetModeratableUser(ctx, adminID, userID)
+	if err != nil {
+		return err
+	}
+
+	duration := time.Duration(requestBody.DurationHours) * time.Hour
+	suspendedUntil := time.Now().Add(duration)
+	if _, err := u.adminRepo.UpdateUserStatus(ctx, userID, constant.AccountStatusSuspended, requestBody.Reason, &suspendedUntil); err != nil {
+		return err
+	}
+
+	if err := u.adminRepo.InsertAccountStatusRedis(ctx, userID, constant.AccountStatusSuspended, duration); err != nil {
+		return err
+	}
+
+	if err := u.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	until := suspendedUntil.Format("02 January 2006 15:04 MST")
+	if err := u.AuditUserAction(ctx, adminID, userID, nil, constant.AdminActionSuspend,
+		fmt.Sprintf("%s (until %s)", requestBody.Reason, until)); err != nil {
+		return err
+	}
+
+	go smtp.SendEmail(u.cfg, user.Email, "Account Suspended", smtp.AccountSuspendedBody(requestBody.Reason, until))
+
+	return nil
+}
+
+func (u *adminUC) BanUser(ctx context.Context, adminID, userID string, requestBody body.UserModerationRequest) error {
+	user, err := u.GetModeratableUser(ctx, adminID, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.adminRepo.UpdateUserStatus(ctx, userID, constant.AccountStatusBanned, requestBody.Reason, nil); err != nil {
+		return err
+	}
+
+	if err := u.adminRepo.InsertAccountStatusRedis(ctx, userID, constant.AccountStatusBanned, 0); err != nil {
+		return err
+	}
+
+	if err := u.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := u.AuditUserAction(ctx, adminID, userID, nil, constant.AdminActionBan, requestBody.Reason); err != nil {
+		return err
+	}
+
+	go smtp.SendEmail(u.cfg, user.Email, "Account Banned", smtp.AccountBannedBody(requestBody.Reason))
+
+	return nil
+}
+
+func (u *adminUC) ReactivateUser(ctx context.Context, adminID, userID string, requestBody body.UserModerationRequest) error {
+	user, err := u.GetModeratableUser(ctx, adminID, userID)
+	if err != nil {
+		return err
+	}
+
+	if user.AccountStatus() == constant.AccountStatusActive {
+		return httperror.New(http.StatusBadRequest, response.UserNotSuspendedMessage)
+	}
+
+	if _, err := u.adminRepo.UpdateUserStatus(ctx, userID, constant.AccountStatusActive, requestBody.Reason, nil); err != nil {
+		return err
+	}
+
+	if err := u.adminRepo.DeleteAccountStatusRedis(ctx, userID); err != nil {
+		return err
+	}
+
+	return u.AuditUserAction(ctx, adminID, userID, nil, constant.AdminActionReactivate, requestBody.Reason)
+}
+
+func (u *adminUC) ForcePasswordReset(ctx context.Context, adminID, userID string, requestBody body.UserModerationRequest) error {
+	user, err := u.GetModeratableUser(ctx, adminID, userID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := u.adminRepo.UpdateUserPasswordResetRequired(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := u.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := u.AuditUserAction(ctx, adminID, userID, nil, constant.AdminActionForcePasswordReset, requestBody.Reason); err != nil {
+		return err
+	}
+
+	go smtp.SendEmail(u.cfg, user.Email, "Password Reset Required", smtp.PasswordResetRequiredBody())
+
+	return nil
+}
+
+func (u *adminUC) ImpersonateUser(ctx context.Context, adminID, userID string,
+	requestBody body.ImpersonateUserRequest) (*body.ImpersonationResponse, error) {
+	user, err := u.GetModeratableUser(ctx, adminID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.AccountStatus() != constant.AccountStatusActive {
+		return nil, httperror.New(http.StatusBadRequest, response.UserNotActiveMessage)
+	}
+
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	session := &model.ImpersonationSession{
+		AdminID:   adminUUID,
+		UserID:    user.ID,
+		Reason:    requestBody.Reason,
+		ExpiredAt: time.Now().Add(time.Duration(requestBody.DurationMinutes) * time.Minute),
+	}
+	if err := u.adminRepo.CreateImpersonationSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	accessToken, err := jwt.GenerateJWTImpersonationToken(userID, user.RoleID, session.ID.String(), adminID, session.ExpiredAt, u.keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("session:%s:%s", userID, accessToken.Token)
+	if err := u.adminRepo.InsertSessionRedis(ctx, requestBody.DurationMinutes, key, constant.TRUE); err != nil {
+		return nil, err
+	}
+
+	duration := time.Duration(requestBody.DurationMinutes) * time.Minute
+	if err := u.adminRepo.InsertImpersonationRedis(ctx, session.ID.String(), key, duration); err != nil {
+		return nil, err
+	}
+
+	if err := u.AuditUserAction(ctx, adminID, userID, &session.ID, constant.AdminActionImpersonationStart, requestBody.Reason); err != nil {
+		return nil, err
+	}
+
+	return &body.ImpersonationResponse{
+		ID:          session.ID,
+		UserID:      user.ID,
+		AccessToken: accessToken.Token,
+		ExpiredAt:   accessToken.ExpiredAt,
+	}, nil
+}
+
+func (u *adminUC) EndImpersonation(ctx context.Context, adminID, impersonationID string) error {
+	session, err := u.adminRepo.GetImpersonationSessionByID(ctx, impersonationID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, response.ImpersonationNotFoundMessage)
+		}
+
+		return err
+	}
+
+	rows, err := u.adminRepo.EndImpersonationSession(ctx, impersonationID)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusBadRequest, response.ImpersonationEndedMessage)
+	}
+
+	key, err := u.adminRepo.GetImpersonationRedis(ctx, impersonationID)
+	if err != nil {
+		return err
+	}
+
+	if key != "" {
+		if err := u.adminRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, key, constant.FALSE); err != nil {
+			return err
+		}
+	}
+
+	return u.AuditUserAction(ctx, adminID, session.UserID.String(), &session.ID, constant.AdminActionImpersonationEnd, "")
+}
+
+func (u *adminUC) GetUserAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	totalRows, err := u.adminRepo.GetTotalAdminAuditLogs(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	auditLogs, err := u.adminRepo.GetAdminAuditLogs(ctx, userID, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = auditLogs
+	return pgn, nil
+}
+
+func (u *adminUC) GetModeratableUser(ctx context.Context, adminID, userID string) (*model.User, error) {
+	if adminID == userID {
+		return nil, httperror.New(http.StatusBadRequest, response.UserModerationSelfMessage)
+	}
+
+	user, err := u.adminRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, response.UserNotExistMessage)
+		}
+
+		return nil, err
+	}
+
+	if user.DeletedAt.Valid {
+		return nil, httperror.New(http.StatusNotFound, response.UserNotExistMessage)
+	}
+
+	exempt, err := u.authorizer.HasPermission(ctx, userID, constant.PermissionUserModerateExempt)
+	if err != nil {
+		return nil, err
+	}
+
+	if exempt {
+		return nil, httperror.New(http.StatusForbidden, response.UserModerationAdminMessage)
+	}
+
+	return user, nil
+}
+
+func (u *adminUC) AuditUserAction(ctx context.Context, adminID, userID string, impersonationID *uuid.UUID,
+	action, detail string) error {
+	adminUUID, err := uuid.Parse(adminID)
+	if err != nil {
+		return err
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+
+	return u.adminRepo.CreateAdminAuditLog(ctx, &model.AdminAuditLog{
+		AdminID:         adminUUID,
+		UserID:          userUUID,
+		ImpersonationID: impersonationID,
+		Action:          action,
+		Detail:          detail,
+	})
+}
+
+func (u *adminUC) toUserResponse(user *model.User) *body.UserResponse {
+	userResponse := &body.UserResponse{
+		ID:                    user.ID,
+		RoleID:                user.RoleID,
+		Email:                 user.Email,
+		Username:              user.Username,
+		FullName:              user.FullName,
+		PhoneNo:               user.PhoneNo,
+		IsVerify:              user.IsVerify,
+		IsSSO:                 user.IsSSO,
+		Status:                user.AccountStatus(),
+		StatusReason:          user.StatusReason,
+		PasswordResetRequired: user.PasswordResetRequired,
+		CreatedAt:             user.CreatedAt,
+	}
+	if userResponse.Status == constant.AccountStatusSuspended && user.SuspendedUntil.Valid {
+		userResponse.SuspendedUntil = &user.SuspendedUntil.Time
+	}
+
+	if user.DeletedAt.Valid {
+		userResponse.DeletedAt = &user.DeletedAt.Time
+	}
+
+	return userResponse
+}
@@ -5,9 +5,15 @@ import (
 	"database/sql"
 	"fmt"
 	"murakali/config"
+	"murakali/internal/constant"
+	ledgerMocks "murakali/internal/ledger/mocks"
 	"murakali/internal/model"
 	"murakali/internal/module/admin/delivery/body"
 	"murakali/internal/module/admin/mocks"
+	"murakali/internal/rbac"
+	rbacMocks "murakali/internal/rbac/mocks"
+	"murakali/internal/session"
+	sessionMocks "murakali/internal/session/mocks"
 	"murakali/pkg/httperror"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
@@ -59,7 +65,7 @@ func TestAdminUC_GetAllVoucher(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAllVoucher(context.Background(), "123", "123", &pagination.Pagination{})
@@ -107,7 +113,7 @@ func TestAdminUC_GetRefunds(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetRefunds(context.Background(), "123", &pagination.Pagination{})
@@ -120,6 +126,7 @@ func TestAdminUC_GetRefunds(t *testing.T) {
 
 func TestAdminUC_CreateVoucher(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -137,9 +144,9 @@ func TestAdminUC_CreateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountCodeVoucher", mock.Anything, mock.Anything).Return(int64(0), nil)
@@ -155,9 +162,9 @@ func TestAdminUC_CreateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountCodeVoucher", mock.Anything, mock.Anything).Return(int64(1), nil)
@@ -172,9 +179,9 @@ func TestAdminUC_CreateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountCodeVoucher", mock.Anything, mock.Anything).Return(int64(0), nil)
@@ -189,7 +196,7 @@ func TestAdminUC_CreateVoucher(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.CreateVoucher(context.Background(), body.CreateVoucherRequest{
@@ -198,9 +205,9 @@ func TestAdminUC_CreateVoucher(t *testing.T) {
 				ActivedDate:        "02-01-2006 15:04:05",
 				ExpiredDate:        "02-01-2006 15:04:05",
 				DiscountPercentage: temp,
-				DiscountFixPrice:   temp,
-				MinProductPrice:    temp,
-				MaxDiscountPrice:   temp,
+				DiscountFixPrice:   tempMoney,
+				MinProductPrice:    tempMoney,
+				MaxDiscountPrice:   tempMoney,
 			})
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -211,6 +218,7 @@ func TestAdminUC_CreateVoucher(t *testing.T) {
 
 func TestAdminUC_UpdateVoucher(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -228,9 +236,9 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(&model.Voucher{}, nil)
@@ -246,9 +254,9 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, body.VoucherSellerNotFoundMessage))
@@ -263,9 +271,9 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
@@ -280,9 +288,9 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(&model.Voucher{}, nil)
@@ -297,7 +305,7 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateVoucher(context.Background(), body.UpdateVoucherRequest{
@@ -306,9 +314,9 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 				ActivedDate:        "02-01-2006 15:04:05",
 				ExpiredDate:        "02-01-2006 15:04:05",
 				DiscountPercentage: temp,
-				DiscountFixPrice:   temp,
-				MinProductPrice:    temp,
-				MaxDiscountPrice:   temp,
+				DiscountFixPrice:   tempMoney,
+				MinProductPrice:    tempMoney,
+				MaxDiscountPrice:   tempMoney,
 			})
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -319,6 +327,7 @@ func TestAdminUC_UpdateVoucher(t *testing.T) {
 
 func TestAdminUC_GetDetailVoucher(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -336,9 +345,9 @@ func TestAdminUC_GetDetailVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(&model.Voucher{}, nil)
@@ -353,9 +362,9 @@ func TestAdminUC_GetDetailVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, body.VoucherSellerNotFoundMessage))
@@ -370,9 +379,9 @@ func TestAdminUC_GetDetailVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
@@ -386,7 +395,7 @@ func TestAdminUC_GetDetailVoucher(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetDetailVoucher(context.Background(), "123")
@@ -399,6 +408,7 @@ func TestAdminUC_GetDetailVoucher(t *testing.T) {
 
 func TestAdminUC_DeleteVoucher(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -416,9 +426,9 @@ func TestAdminUC_DeleteVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(&model.Voucher{}, nil)
@@ -434,9 +444,9 @@ func TestAdminUC_DeleteVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, body.VoucherSellerNotFoundMessage))
@@ -451,9 +461,9 @@ func TestAdminUC_DeleteVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
@@ -468,9 +478,9 @@ func TestAdminUC_DeleteVoucher(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetVoucherByID", mock.Anything, mock.Anything).Return(&model.Voucher{}, nil)
@@ -485,7 +495,7 @@ func TestAdminUC_DeleteVoucher(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteVoucher(context.Background(), "123")
@@ -498,6 +508,7 @@ func TestAdminUC_DeleteVoucher(t *testing.T) {
 
 func TestAdminUC_GetCategories(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -515,9 +526,9 @@ func TestAdminUC_GetCategories(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetCategories", mock.Anything).Return([]*body.CategoryResponse{}, nil)
@@ -532,9 +543,9 @@ func TestAdminUC_GetCategories(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetCategories", mock.Anything).Return([]*body.CategoryResponse{}, fmt.Errorf("test"))
@@ -548,7 +559,7 @@ func TestAdminUC_GetCategories(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetCategories(context.Background())
@@ -561,6 +572,7 @@ func TestAdminUC_GetCategories(t *testing.T) {
 
 func TestAdminUC_AddCategory(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -578,9 +590,9 @@ func TestAdminUC_AddCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("AddCategory", mock.Anything, mock.Anything).Return(nil)
@@ -595,9 +607,9 @@ func TestAdminUC_AddCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("AddCategory", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
@@ -611,7 +623,7 @@ func TestAdminUC_AddCategory(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.AddCategory(context.Background(), body.CategoryRequest{
@@ -630,6 +642,7 @@ func TestAdminUC_AddCategory(t *testing.T) {
 
 func TestAdminUC_DeleteCategory(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -647,9 +660,9 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountProductCategory", mock.Anything, mock.Anything).Return(0, nil)
@@ -666,9 +679,9 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountProductCategory", mock.Anything, mock.Anything).Return(0, fmt.Errorf("test"))
@@ -683,9 +696,9 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountProductCategory", mock.Anything, mock.Anything).Return(1, nil)
@@ -700,9 +713,9 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountProductCategory", mock.Anything, mock.Anything).Return(0, nil)
@@ -718,9 +731,9 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountProductCategory", mock.Anything, mock.Anything).Return(0, nil)
@@ -736,9 +749,9 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("CountProductCategory", mock.Anything, mock.Anything).Return(0, nil)
@@ -754,7 +767,7 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteCategory(context.Background(), "asd")
@@ -767,6 +780,7 @@ func TestAdminUC_DeleteCategory(t *testing.T) {
 
 func TestAdminUC_GetBanner(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -784,9 +798,9 @@ func TestAdminUC_GetBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetBanner", mock.Anything).Return([]*body.BannerResponse{}, nil)
@@ -801,9 +815,9 @@ func TestAdminUC_GetBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetBanner", mock.Anything).Return(nil, fmt.Errorf("test"))
@@ -817,7 +831,7 @@ func TestAdminUC_GetBanner(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetBanner(context.Background())
@@ -830,6 +844,7 @@ func TestAdminUC_GetBanner(t *testing.T) {
 
 func TestAdminUC_EditCategory(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -847,9 +862,9 @@ func TestAdminUC_EditCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("EditCategory", mock.Anything, mock.Anything).Return(nil)
@@ -864,9 +879,9 @@ func TestAdminUC_EditCategory(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("EditCategory", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
@@ -880,7 +895,7 @@ func TestAdminUC_EditCategory(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.EditCategory(context.Background(), body.CategoryRequest{
@@ -899,6 +914,7 @@ func TestAdminUC_EditCategory(t *testing.T) {
 
 func TestAdminUC_AddBanner(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -916,9 +932,9 @@ func TestAdminUC_AddBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("AddBanner", mock.Anything, mock.Anything).Return(nil)
@@ -933,9 +949,9 @@ func TestAdminUC_AddBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("AddBanner", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
@@ -949,7 +965,7 @@ func TestAdminUC_AddBanner(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.AddBanner(context.Background(), body.BannerRequest{})
@@ -962,6 +978,7 @@ func TestAdminUC_AddBanner(t *testing.T) {
 
 func TestAdminUC_DeleteBanner(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -979,9 +996,9 @@ func TestAdminUC_DeleteBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("DeleteBanner", mock.Anything, mock.Anything).Return(nil)
@@ -996,9 +1013,9 @@ func TestAdminUC_DeleteBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("DeleteBanner", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
@@ -1012,7 +1029,7 @@ func TestAdminUC_DeleteBanner(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteBanner(context.Background(), "123")
@@ -1025,6 +1042,7 @@ func TestAdminUC_DeleteBanner(t *testing.T) {
 
 func TestAdminUC_EditBanner(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -1042,9 +1060,9 @@ func TestAdminUC_EditBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("EditBanner", mock.Anything, mock.Anything).Return(nil)
@@ -1059,9 +1077,9 @@ func TestAdminUC_EditBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("EditBanner", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
@@ -1075,7 +1093,7 @@ func TestAdminUC_EditBanner(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.EditBanner(context.Background(), body.BannerIDRequest{})
@@ -1091,6 +1109,7 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 	var booll *bool
 	boolltrue := true
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	var test *string
 	var date2 sql.NullTime
 	var date3 sql.NullTime
@@ -1105,7 +1124,7 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 		name        string
 		body        model.Voucher
 		userID      string
-		mock        func(t *testing.T, r *mocks.Repository)
+		mock        func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository)
 		expectedErr error
 	}{
 		{
@@ -1116,11 +1135,11 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{
 					ID:             ID,
 					OrderID:        ID,
@@ -1132,9 +1151,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 					RefundedAt:     date3,
 					RejectedAt:     date3,
 				}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					OrderStatusID: constant.OrderStatusReceived,
+					TotalPrice:    100,
+					DeliveryFee:   10,
+				}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.MatchedBy(func(history *model.OrderStatusHistory) bool {
+					return history.ActorID != nil && *history.ActorID == ID
+				})).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1152,6 +1178,13 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				lr.On("CreateLedgerTransaction", mock.Anything, mock.Anything, mock.MatchedBy(func(ledgerTx *model.LedgerTransaction) bool {
+					return ledgerTx.Reference == constant.LedgerReferenceRefund &&
+						len(ledgerTx.Entries) == 4 &&
+						ledgerTx.Entries[0].Account.AccountType == constant.LedgerAccountEscrow &&
+						ledgerTx.Entries[3].Account.AccountType == constant.LedgerAccountWallet &&
+						ledgerTx.Entries[3].Amount == 110
+				})).Return(nil)
 			},
 			expectedErr: nil,
 		},
@@ -1163,11 +1196,11 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("123"))
 			},
 			expectedErr: fmt.Errorf("123"),
@@ -1180,11 +1213,11 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
 			},
 			expectedErr: httperror.New(http.StatusBadRequest, response.RefundNotFound),
@@ -1197,11 +1230,11 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{
 					ID:             ID,
 					OrderID:        ID,
@@ -1224,11 +1257,11 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{
 					ID:             ID,
 					OrderID:        ID,
@@ -1251,16 +1284,24 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
 				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
 		},
+		{
+			name: "error order status transition",
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusCompleted}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, "Order status cannot be changed from completed to refunded."),
+		},
 		{
 			name: "success update voucher",
 			body: model.Voucher{
@@ -1269,13 +1310,13 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
@@ -1288,13 +1329,13 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 			},
@@ -1308,15 +1349,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 
 			},
@@ -1330,15 +1372,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1362,15 +1405,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1396,15 +1440,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1430,15 +1475,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1465,15 +1511,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1501,15 +1548,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1537,15 +1585,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1573,15 +1622,16 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetRefundByID", mock.Anything, mock.Anything).Return(&model.Refund{}, nil)
-				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{}, nil)
+				r.On("GetOrderByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
 				r.On("UpdateRefund", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetOrderItemsByOrderID", mock.Anything, mock.Anything, mock.Anything).Return([]*model.OrderItem{
 					{
 						OrderID:         ID,
@@ -1610,14 +1660,394 @@ func TestAdminUC_RefundOrder(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			lr := ledgerMocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, lr, nil, nil, nil, nil)
+
+			tc.mock(t, r, lr)
+			err := u.RefundOrder(context.Background(), ID.String(), "123")
+			if err != nil {
+				assert.Equal(t, err.Error(), tc.expectedErr.Error())
+			}
+		})
+	}
+
+}
+
+func TestAdminUC_GetPaymentCallbacks(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success get payment callbacks",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetPaymentCallbacksByTransactionID", mock.Anything, mock.Anything).Return([]*model.PaymentCallback{{}}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "failed get payment callbacks",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetPaymentCallbacksByTransactionID", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+			r := mocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
-			err := u.RefundOrder(context.Background(), "123")
+			_, err := u.GetPaymentCallbacks(context.Background(), "8302755e-25c5-4523-8498-7dc8b9e3a098")
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
 			}
 		})
 	}
+}
+
+func TestAdminUC_ApprovePayoutBatch(t *testing.T) {
+	ID, _ := uuid.Parse("123e4567-e89b-12d3-a456-426614174000")
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success approve payout batch",
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPayoutBatchByID", mock.Anything, mock.Anything).Return(&model.PayoutBatch{
+					ID:          ID,
+					Status:      constant.PayoutBatchStatusPending,
+					TotalAmount: 300,
+				}, nil)
+				r.On("GetPayoutsByBatchID", mock.Anything, mock.Anything).Return([]*model.Payout{
+					{ID: ID, SellerID: ID, Amount: 100},
+					{ID: ID, SellerID: ID, Amount: 200},
+				}, nil)
+				r.On("GetWalletByUserID", mock.Anything, mock.Anything, mock.Anything).Return(&model.Wallet{Balance: 500}, nil)
+				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.MatchedBy(func(wallet *model.Wallet) bool {
+					return wallet.Balance == 200
+				})).Return(nil)
+				lr.On("CreateLedgerTransaction", mock.Anything, mock.Anything, mock.MatchedBy(func(ledgerTx *model.LedgerTransaction) bool {
+					return ledgerTx.Reference == constant.LedgerReferencePayout &&
+						len(ledgerTx.Entries) == 8 &&
+						ledgerTx.Entries[7].Account.AccountType == constant.LedgerAccountExternal &&
+						ledgerTx.Entries[7].Amount == 200
+				})).Return(nil)
+				r.On("UpdatePayoutBatchApproved", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("UpdatePayoutsApproved", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("UpdateOrdersWithdrawnByBatch", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error batch not pending",
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPayoutBatchByID", mock.Anything, mock.Anything).Return(&model.PayoutBatch{
+					ID:     ID,
+					Status: constant.PayoutBatchStatusApproved,
+				}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.PayoutBatchNotPending),
+		},
+		{
+			name: "error marketplace balance not enough",
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPayoutBatchByID", mock.Anything, mock.Anything).Return(&model.PayoutBatch{
+					ID:          ID,
+					Status:      constant.PayoutBatchStatusPending,
+					TotalAmount: 300,
+				}, nil)
+				r.On("GetPayoutsByBatchID", mock.Anything, mock.Anything).Return([]*model.Payout{}, nil)
+				r.On("GetWalletByUserID", mock.Anything, mock.Anything, mock.Anything).Return(&model.Wallet{Balance: 100}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.MarketplaceBalanceNotEnough),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+			if tc.expectedErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+			r := mocks.NewRepository(t)
+			lr := ledgerMocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, lr, nil, nil, nil, nil)
+
+			tc.mock(t, r, lr)
+			err := u.ApprovePayoutBatch(context.Background(), "8302755e-25c5-4523-8498-7dc8b9e3a098", ID.String())
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAdminUC_AssignUserRole(t *testing.T) {
+	testCase := []struct {
+		name        string
+		adminID     string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:    "success assign role",
+			adminID: "admin-id",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserPrimaryRoleID", mock.Anything, "user-id").Return(int64(constant.RoleUser), nil)
+				r.On("GetRoleByID", mock.Anything, int64(constant.RoleFinance)).Return(&model.Role{ID: constant.RoleFinance}, nil)
+				r.On("CreateUserRole", mock.Anything, "user-id", int64(constant.RoleFinance)).Return(nil)
+				r.On("DeletePermissionCacheRedis", mock.Anything, "user-id").Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "failed change own role",
+			adminID:     "user-id",
+			mock:        func(t *testing.T, r *mocks.Repository) {},
+			expectedErr: httperror.New(http.StatusBadRequest, response.RoleSelfChangeMessage),
+		},
+		{
+			name:    "failed user not found",
+			adminID: "admin-id",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserPrimaryRoleID", mock.Anything, "user-id").Return(int64(0), sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.UserNotExistMessage),
+		},
+		{
+			name:    "failed role not found",
+			adminID: "admin-id",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserPrimaryRoleID", mock.Anything, "user-id").Return(int64(constant.RoleUser), nil)
+				r.On("GetRoleByID", mock.Anything, int64(constant.RoleFinance)).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.RoleNotFoundMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, nil, r, nil, nil, nil, nil, nil)
+
+			tc.mock(t, r)
+			err := u.AssignUserRole(context.Background(), tc.adminID, "user-id", constant.RoleFinance)
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAdminUC_RemoveUserRole(t *testing.T) {
+	testCase := []struct {
+		name        string
+		adminID     string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:    "success remove role",
+			adminID: "admin-id",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("DeleteUserRole", mock.Anything, "user-id", int64(constant.RoleFinance)).Return(int64(1), nil)
+				r.On("DeletePermissionCacheRedis", mock.Anything, "user-id").Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "failed change own role",
+			adminID:     "user-id",
+			mock:        func(t *testing.T, r *mocks.Repository) {},
+			expectedErr: httperror.New(http.StatusBadRequest, response.RoleSelfChangeMessage),
+		},
+		{
+			name:    "failed role not assigned",
+			adminID: "admin-id",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("DeleteUserRole", mock.Anything, "user-id", int64(constant.RoleFinance)).Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.RoleNotAssignedMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, nil, r, nil, nil, nil, nil, nil)
+
+			tc.mock(t, r)
+			err := u.RemoveUserRole(context.Background(), tc.adminID, "user-id", constant.RoleFinance)
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAdminUC_SuspendUser(t *testing.T) {
+	adminID := uuid.NewString()
+	userID := uuid.NewString()
+	testCase := []struct {
+		name        string
+		adminID     string
+		mock        func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository, sr *sessionMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:    "success suspend user",
+			adminID: adminID,
+			mock: func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository, sr *sessionMocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{Email: "user@murakali.com"}, nil)
+				rr.On("GetCachedPermissions", mock.Anything, userID).Return([]string{}, true, nil)
+				r.On("UpdateUserStatus", mock.Anything, userID, constant.AccountStatusSuspended, "spam", mock.Anything).Return(int64(1), nil)
+				r.On("InsertAccountStatusRedis", mock.Anything, userID, constant.AccountStatusSuspended, 24*time.Hour).Return(nil)
+				sr.On("RevokeUserSessions", mock.Anything, userID).Return(nil)
+				sr.On("GetSessionKeyRedis", mock.Anything, mock.Anything).Return([]string{}, nil)
+				r.On("CreateAdminAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *model.AdminAuditLog) bool {
+					return auditLog.Action == constant.AdminActionSuspend && auditLog.UserID.String() == userID
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "failed suspend own account",
+			adminID:     userID,
+			mock:        func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository, sr *sessionMocks.Repository) {},
+			expectedErr: httperror.New(http.StatusBadRequest, response.UserModerationSelfMessage),
+		},
+		{
+			name:    "failed user not found",
+			adminID: adminID,
+			mock: func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository, sr *sessionMocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.UserNotExistMessage),
+		},
+		{
+			name:    "failed suspend moderation exempt account",
+			adminID: adminID,
+			mock: func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository, sr *sessionMocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{}, nil)
+				rr.On("GetCachedPermissions", mock.Anything, userID).Return([]string{constant.PermissionUserModerateExempt}, true, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.UserModerationAdminMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			rr := rbacMocks.NewRepository(t)
+			sr := sessionMocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, nil, r, nil, nil, rbac.NewAuthorizer(rr), session.NewRevoker(&config.Config{}, sr), nil)
+
+			tc.mock(t, r, rr, sr)
+			err := u.SuspendUser(context.Background(), tc.adminID, userID, body.SuspendUserRequest{Reason: "spam", DurationHours: 24})
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAdminUC_ReactivateUser(t *testing.T) {
+	adminID := uuid.NewString()
+	userID := uuid.NewString()
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success reactivate banned user",
+			mock: func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{Status: constant.AccountStatusBanned}, nil)
+				rr.On("GetCachedPermissions", mock.Anything, userID).Return([]string{constant.PermissionUserRead}, true, nil)
+				r.On("UpdateUserStatus", mock.Anything, userID, constant.AccountStatusActive, "appeal", mock.Anything).Return(int64(1), nil)
+				r.On("DeleteAccountStatusRedis", mock.Anything, userID).Return(nil)
+				r.On("CreateAdminAuditLog", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "failed user is active",
+			mock: func(t *testing.T, r *mocks.Repository, rr *rbacMocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{Status: constant.AccountStatusActive}, nil)
+				rr.On("GetCachedPermissions", mock.Anything, userID).Return([]string{constant.PermissionUserRead}, true, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.UserNotSuspendedMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			rr := rbacMocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, nil, r, nil, nil, rbac.NewAuthorizer(rr), nil, nil)
+
+			tc.mock(t, r, rr)
+			err := u.ReactivateUser(context.Background(), adminID, userID, body.UserModerationRequest{Reason: "appeal"})
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAdminUC_EndImpersonation(t *testing.T) {
+	adminID := uuid.NewString()
+	impersonationID := uuid.New()
+	session := &model.ImpersonationSession{ID: impersonationID, UserID: uuid.New()}
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success end impersonation",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetImpersonationSessionByID", mock.Anything, impersonationID.String()).Return(session, nil)
+				r.On("EndImpersonationSession", mock.Anything, impersonationID.String()).Return(int64(1), nil)
+				r.On("GetImpersonationRedis", mock.Anything, impersonationID.String()).Return("session:key", nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, "session:key", constant.FALSE).Return(nil)
+				r.On("CreateAdminAuditLog", mock.Anything, mock.MatchedBy(func(auditLog *model.AdminAuditLog) bool {
+					return auditLog.Action == constant.AdminActionImpersonationEnd && *auditLog.ImpersonationID == impersonationID
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "failed impersonation not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetImpersonationSessionByID", mock.Anything, impersonationID.String()).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.ImpersonationNotFoundMessage),
+		},
+		{
+			name: "failed impersonation already ended",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetImpersonationSessionByID", mock.Anything, impersonationID.String()).Return(session, nil)
+				r.On("EndImpersonationSession", mock.Anything, impersonationID.String()).Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.ImpersonationEndedMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewAdminUseCase(&config.Config{}, nil, r, nil, nil, nil, nil, nil)
 
+			tc.mock(t, r)
+			err := u.EndImpersonation(context.Background(), adminID, impersonationID.String())
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
 }
@@ -7,6 +7,7 @@ type Handlers interface {
 	RegisterUser(c *gin.Context)
 	VerifyOTP(c *gin.Context)
 	Login(c *gin.Context)
+	LoginTOTP(c *gin.Context)
 	Logout(c *gin.Context)
 	RefreshToken(c *gin.Context)
 	ResetPasswordEmail(c *gin.Context)
@@ -15,4 +16,13 @@ type Handlers interface {
 	CheckUniqueUsername(c *gin.Context)
 	CheckUniquePhoneNo(c *gin.Context)
 	GoogleAuth(c *gin.Context)
+	GetSessions(c *gin.Context)
+	DeleteSession(c *gin.Context)
+	DeleteOtherSessions(c *gin.Context)
+	JWKS(c *gin.Context)
+	OIDCAuth(c *gin.Context)
+	OIDCCallback(c *gin.Context)
+	GetIdentities(c *gin.Context)
+	LinkIdentity(c *gin.Context)
+	DeleteIdentity(c *gin.Context)
 }
@@ -0,0 +1,18 @@
+package body
+
+import "time"
+
+type OIDCAuthURLResponse struct {
+	URL string `json:"url"`
+}
+
+type OIDCLinkResponse struct {
+	Provider string `json:"provider"`
+	PathURL  string `json:"path_url"`
+}
+
+type IdentityResponse struct {
+	Provider  string    `json:"provider"`
+	Email     *string   `json:"email"`
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -19,6 +19,12 @@ type LoginResponse struct {
 	ExpiredAt   time.Time `json:"expired_at"`
 }
 
+type LoginMFAResponse struct {
+	MFARequired  bool      `json:"mfa_required"`
+	PreAuthToken string    `json:"pre_auth_token"`
+	ExpiredAt    time.Time `json:"expired_at"`
+}
+
 func (r *LoginRequest) Validate() (UnprocessableEntity, error) {
 	unprocessableEntity := false
 	entity := UnprocessableEntity{
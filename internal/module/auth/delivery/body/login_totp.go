@@ -0,0 +1,48 @@
+package body
+
+import (
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+)
+
+type LoginTOTPRequest struct {
+	PreAuthToken string `json:"pre_auth_token"`
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+func (r *LoginTOTPRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"pre_auth_token": "",
+			"code":           "",
+			"recovery_code":  "",
+		},
+	}
+
+	r.PreAuthToken = strings.TrimSpace(r.PreAuthToken)
+	if r.PreAuthToken == "" {
+		unprocessableEntity = true
+		entity.Fields["pre_auth_token"] = FieldCannotBeEmptyMessage
+	}
+
+	r.Code = strings.TrimSpace(r.Code)
+	r.RecoveryCode = strings.TrimSpace(strings.ToLower(r.RecoveryCode))
+	if r.Code == "" && r.RecoveryCode == "" {
+		unprocessableEntity = true
+		entity.Fields["code"] = FieldCannotBeEmptyMessage
+		entity.Fields["recovery_code"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
@@ -0,0 +1,22 @@
+package body
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type SessionDevice struct {
+	UserAgent string
+	IPAddress string
+}
+
+type SessionResponse struct {
+	ID         uuid.UUID `json:"id"`
+	UserAgent  string    `json:"user_agent"`
+	IPAddress  string    `json:"ip_address"`
+	CreatedAt  time.Time `json:"created_at"`
+	LastUsedAt time.Time `json:"last_used_at"`
+	ExpiredAt  time.Time `json:"expired_at"`
+	IsCurrent  bool      `json:"is_current"`
+}
@@ -16,19 +16,30 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 )
 
 type authHandlers struct {
 	cfg    *config.Config
 	authUC auth.UseCase
+	keySet *jwt.KeySet
 	logger logger.Logger
 }
 
-func NewAuthHandlers(cfg *config.Config, authUC auth.UseCase, log logger.Logger) auth.Handlers {
-	return &authHandlers{cfg: cfg, authUC: authUC, logger: log}
+func NewAuthHandlers(cfg *config.Config, authUC auth.UseCase, keySet *jwt.KeySet, log logger.Logger) auth.Handlers {
+	return &authHandlers{cfg: cfg, authUC: authUC, keySet: keySet, logger: log}
 }
 
 func (h *authHandlers) Logout(c *gin.Context) {
+	refreshToken, err := c.Cookie(constant.RefreshTokenCookie)
+	if err == nil {
+		if err := h.authUC.Logout(c, refreshToken); err != nil {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+	}
+
 	c.SetSameSite(http.SameSiteNoneMode)
 	c.SetCookie(constant.RefreshTokenCookie, "", -1, "/", h.cfg.Server.Domain, true, true)
 	response.SuccessResponse(c.Writer, nil, http.StatusOK)
@@ -47,7 +58,58 @@ func (h *authHandlers) Login(c *gin.Context) {
 		return
 	}
 
-	token, err := h.authUC.Login(c, requestBody)
+	token, err := h.authUC.Login(c, requestBody, body.SessionDevice{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()})
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	if token.PreAuthToken != nil {
+		response.SuccessResponse(c.Writer, body.LoginMFAResponse{
+			MFARequired:  true,
+			PreAuthToken: token.PreAuthToken.Token,
+			ExpiredAt:    token.PreAuthToken.ExpiredAt}, http.StatusOK)
+		return
+	}
+
+	c.SetSameSite(http.SameSiteNoneMode)
+	c.SetCookie(constant.RefreshTokenCookie, token.RefreshToken.Token, h.cfg.JWT.RefreshExpMin*60, "/", h.cfg.Server.Domain, true, true)
+	response.SuccessResponse(c.Writer, body.LoginResponse{AccessToken: token.AccessToken.Token, ExpiredAt: token.AccessToken.ExpiredAt}, http.StatusOK)
+}
+
+func (h *authHandlers) LoginTOTP(c *gin.Context) {
+	var requestBody body.LoginTOTPRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	invalidFields, err := requestBody.Validate()
+	if err != nil {
+		response.ErrorResponseData(c.Writer, invalidFields, response.UnprocessableEntityMessage, http.StatusUnprocessableEntity)
+		return
+	}
+
+	claims, err := jwt.ExtractJWT(requestBody.PreAuthToken, h.keySet)
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	if claims["scope"] != constant.PreAuthScope {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	token, err := h.authUC.LoginTOTP(c, claims["id"].(string), requestBody,
+		body.SessionDevice{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()})
 	if err != nil {
 		var e *httperror.Error
 		if !errors.As(err, &e) {
@@ -72,13 +134,14 @@ func (h *authHandlers) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	claims, err := jwt.ExtractJWT(refreshToken, h.cfg.JWT.JwtSecretKey)
+	claims, err := jwt.ExtractJWT(refreshToken, h.keySet)
 	if err != nil {
 		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 		return
 	}
 
-	accessToken, err := h.authUC.RefreshToken(c, refreshToken, claims["id"].(string))
+	token, err := h.authUC.RefreshToken(c, refreshToken, claims["id"].(string),
+		body.SessionDevice{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()})
 	if err != nil {
 		var e *httperror.Error
 		if !errors.As(err, &e) {
@@ -91,7 +154,9 @@ func (h *authHandlers) RefreshToken(c *gin.Context) {
 		return
 	}
 
-	response.SuccessResponse(c.Writer, body.LoginResponse{AccessToken: accessToken.Token, ExpiredAt: accessToken.ExpiredAt}, http.StatusOK)
+	c.SetSameSite(http.SameSiteNoneMode)
+	c.SetCookie(constant.RefreshTokenCookie, token.RefreshToken.Token, h.cfg.JWT.RefreshExpMin*60, "/", h.cfg.Server.Domain, true, true)
+	response.SuccessResponse(c.Writer, body.LoginResponse{AccessToken: token.AccessToken.Token, ExpiredAt: token.AccessToken.ExpiredAt}, http.StatusOK)
 }
 
 func (h *authHandlers) RegisterEmail(c *gin.Context) {
@@ -130,7 +195,7 @@ func (h *authHandlers) RegisterUser(c *gin.Context) {
 		return
 	}
 
-	claims, err := jwt.ExtractJWT(registerToken, h.cfg.JWT.JwtSecretKey)
+	claims, err := jwt.ExtractJWT(registerToken, h.keySet)
 	if err != nil {
 		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 		return
@@ -232,7 +297,7 @@ func (h *authHandlers) ResetPasswordUser(c *gin.Context) {
 		return
 	}
 
-	claims, err := jwt.ExtractJWT(ResetPasswordToken, h.cfg.JWT.JwtSecretKey)
+	claims, err := jwt.ExtractJWT(ResetPasswordToken, h.keySet)
 	if err != nil {
 		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 		return
@@ -364,7 +429,7 @@ func (h *authHandlers) GoogleAuth(c *gin.Context) {
 		return
 	}
 
-	token, err := h.authUC.GoogleAuth(c, code, pathURL)
+	token, err := h.authUC.GoogleAuth(c, code, pathURL, body.SessionDevice{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()})
 	if err != nil {
 		var e *httperror.Error
 		if !errors.As(err, &e) {
@@ -385,8 +450,242 @@ func (h *authHandlers) GoogleAuth(c *gin.Context) {
 		return
 	}
 
+	if token.Token.PreAuthToken != nil {
+		response.SuccessResponse(c.Writer, body.LoginMFAResponse{
+			MFARequired:  true,
+			PreAuthToken: token.Token.PreAuthToken.Token,
+			ExpiredAt:    token.Token.PreAuthToken.ExpiredAt}, http.StatusOK)
+		return
+	}
+
 	c.SetCookie(constant.RefreshTokenCookie, token.Token.RefreshToken.Token, h.cfg.JWT.RefreshExpMin*60, "/", h.cfg.Server.Domain, true, true)
 	response.SuccessResponse(c.Writer, body.LoginResponse{
 		AccessToken: token.Token.AccessToken.Token,
 		ExpiredAt:   token.Token.AccessToken.ExpiredAt}, http.StatusOK)
 }
+
+func (h *authHandlers) GetSessions(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	sessionID := c.GetString("sessionID")
+	sessions, err := h.authUC.GetSessions(c, userID.(string), sessionID)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, sessions, http.StatusOK)
+}
+
+func (h *authHandlers) DeleteSession(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	id := c.Param("id")
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	if err := h.authUC.DeleteSession(c, userID.(string), sessionID.String()); err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
+
+func (h *authHandlers) DeleteOtherSessions(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	sessionID, exist := c.Get("sessionID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+		return
+	}
+
+	if err := h.authUC.DeleteOtherSessions(c, userID.(string), sessionID.(string)); err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
+
+func (h *authHandlers) JWKS(c *gin.Context) {
+	c.Header("Cache-Control", constant.JWKSCacheControl)
+	c.JSON(http.StatusOK, h.keySet.JWKS())
+}
+
+func (h *authHandlers) OIDCAuth(c *gin.Context) {
+	pathURL := "/"
+	if c.Query("state") != "" {
+		pathURL = c.Query("state")
+	}
+
+	url, err := h.authUC.OIDCAuthURL(c, c.Param("provider"), "", pathURL)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, body.OIDCAuthURLResponse{URL: url}, http.StatusOK)
+}
+
+func (h *authHandlers) OIDCCallback(c *gin.Context) {
+	code := c.Query("code")
+	state := c.Query("state")
+	if code == "" || state == "" {
+		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
+		return
+	}
+
+	token, err := h.authUC.OIDCCallback(c, c.Param("provider"), code, state,
+		body.SessionDevice{UserAgent: c.Request.UserAgent(), IPAddress: c.ClientIP()})
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	if token.LinkedUserID != "" {
+		response.SuccessResponse(c.Writer, body.OIDCLinkResponse{
+			Provider: c.Param("provider"),
+			PathURL:  token.PathURL}, http.StatusOK)
+		return
+	}
+
+	c.SetSameSite(http.SameSiteNoneMode)
+
+	if token.RegisterToken != nil {
+		c.SetCookie(constant.RegisterTokenCookie, *token.RegisterToken, h.cfg.JWT.RefreshExpMin*60, "/", h.cfg.Server.Domain, true, true)
+		response.SuccessResponse(c.Writer, nil, http.StatusOK)
+		return
+	}
+
+	if token.Token.PreAuthToken != nil {
+		response.SuccessResponse(c.Writer, body.LoginMFAResponse{
+			MFARequired:  true,
+			PreAuthToken: token.Token.PreAuthToken.Token,
+			ExpiredAt:    token.Token.PreAuthToken.ExpiredAt}, http.StatusOK)
+		return
+	}
+
+	c.SetCookie(constant.RefreshTokenCookie, token.Token.RefreshToken.Token, h.cfg.JWT.RefreshExpMin*60, "/", h.cfg.Server.Domain, true, true)
+	response.SuccessResponse(c.Writer, body.LoginResponse{
+		AccessToken: token.Token.AccessToken.Token,
+		ExpiredAt:   token.Token.AccessToken.ExpiredAt}, http.StatusOK)
+}
+
+func (h *authHandlers) GetIdentities(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	identities, err := h.authUC.GetIdentities(c, userID.(string))
+	if err != nil {
+		h.logger.Errorf("HandlerAuth, Error: %s", err)
+		response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, identities, http.StatusOK)
+}
+
+func (h *authHandlers) LinkIdentity(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	pathURL := "/"
+	if c.Query("state") != "" {
+		pathURL = c.Query("state")
+	}
+
+	url, err := h.authUC.OIDCAuthURL(c, c.Param("provider"), userID.(string), pathURL)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, body.OIDCAuthURLResponse{URL: url}, http.StatusOK)
+}
+
+func (h *authHandlers) DeleteIdentity(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.authUC.DeleteIdentity(c, userID.(string), c.Param("provider")); err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerAuth, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
@@ -73,7 +73,8 @@ func TestAuthHandlers_Logout(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.Logout(c)
@@ -101,7 +102,7 @@ func TestAuthHandlers_Login(t *testing.T) {
 				Password: "Tested8*",
 			},
 			mock: func(s *mocks.UseCase) {
-				s.On("Login", mock.Anything, mock.Anything).Return(&model.Token{AccessToken: &model.AccessToken{}, RefreshToken: &model.RefreshToken{}}, nil)
+				s.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(&model.Token{AccessToken: &model.AccessToken{}, RefreshToken: &model.RefreshToken{}}, nil)
 			},
 			expected: http.StatusOK,
 		},
@@ -127,7 +128,7 @@ func TestAuthHandlers_Login(t *testing.T) {
 				Password: "Tested8*",
 			},
 			mock: func(s *mocks.UseCase) {
-				s.On("Login", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+				s.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("test"))
 			},
 			expected: http.StatusInternalServerError,
 		},
@@ -138,7 +139,7 @@ func TestAuthHandlers_Login(t *testing.T) {
 				Password: "Tested8*",
 			},
 			mock: func(s *mocks.UseCase) {
-				s.On("Login", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
+				s.On("Login", mock.Anything, mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected: http.StatusBadRequest,
 		},
@@ -176,7 +177,8 @@ func TestAuthHandlers_Login(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.Login(c)
@@ -199,7 +201,7 @@ func TestAuthHandlers_RefreshToken(t *testing.T) {
 			name: "success refresh",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("RefreshToken", mock.Anything, mock.Anything, mock.Anything).Return(&model.AccessToken{}, nil)
+				s.On("RefreshToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.Token{AccessToken: &model.AccessToken{}, RefreshToken: &model.RefreshToken{}}, nil)
 			},
 			expected:  http.StatusOK,
 			isCookie:  true,
@@ -225,7 +227,7 @@ func TestAuthHandlers_RefreshToken(t *testing.T) {
 			name: "internal error refresh",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("RefreshToken", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+				s.On("RefreshToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("test"))
 			},
 			expected:  http.StatusInternalServerError,
 			isCookie:  true,
@@ -235,7 +237,7 @@ func TestAuthHandlers_RefreshToken(t *testing.T) {
 			name: "custom error refresh",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("RefreshToken", mock.Anything, mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
+				s.On("RefreshToken", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected:  http.StatusBadRequest,
 			isCookie:  true,
@@ -273,7 +275,8 @@ func TestAuthHandlers_RefreshToken(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.RefreshToken(c)
@@ -372,7 +375,8 @@ func TestAuthHandlers_RegisterEmail(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.RegisterEmail(c)
@@ -525,7 +529,8 @@ func TestAuthHandlers_RegisterUser(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.RegisterUser(c)
@@ -628,7 +633,8 @@ func TestAuthHandlers_VerifyOTP(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.VerifyOTP(c)
@@ -727,7 +733,8 @@ func TestAuthHandlers_ResetPasswordEmail(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ResetPasswordEmail(c)
@@ -850,7 +857,8 @@ func TestAuthHandlers_ResetPasswordUser(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ResetPasswordUser(c)
@@ -934,7 +942,8 @@ func TestAuthHandlers_ResetPasswordVerifyOTP(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ResetPasswordVerifyOTP(c)
@@ -1001,7 +1010,8 @@ func TestAuthHandlers_CheckUniqueUsername(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CheckUniqueUsername(c)
@@ -1093,7 +1103,8 @@ func TestAuthHandlers_CheckUniquePhoneNo(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CheckUniquePhoneNo(c)
@@ -1117,7 +1128,7 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			name: "success google auth register",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, nil)
+				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, nil)
 			},
 			expected:   http.StatusOK,
 			queryCode:  "123456",
@@ -1127,7 +1138,7 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			name: "success google auth login",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: nil, Token: &model.Token{&model.AccessToken{}, &model.RefreshToken{}}}, nil)
+				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: nil, Token: &model.Token{AccessToken: &model.AccessToken{}, RefreshToken: &model.RefreshToken{}}}, nil)
 			},
 			expected:   http.StatusOK,
 			queryCode:  "123456",
@@ -1137,7 +1148,7 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			name: "internal error google auth register",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, errors.New("test"))
+				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, errors.New("test"))
 			},
 			expected:   http.StatusInternalServerError,
 			queryCode:  "123456",
@@ -1147,7 +1158,7 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			name: "custom error google auth register",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, httperror.New(http.StatusBadRequest, "test"))
+				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected:   http.StatusBadRequest,
 			queryCode:  "123456",
@@ -1157,7 +1168,7 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			name: "failed google auth",
 			body: nil,
 			mock: func(s *mocks.UseCase) {
-				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, nil)
+				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, nil)
 			},
 			expected:   http.StatusOK,
 			queryCode:  "123456",
@@ -1168,7 +1179,7 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			body: nil,
 			mock: func(s *mocks.UseCase) {
 
-				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, nil)
+				s.On("GoogleAuth", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.GoogleAuthToken{RegisterToken: &registerToken}, nil)
 			},
 			expected:   http.StatusOK,
 			queryCode:  "123456",
@@ -1213,7 +1224,8 @@ func TestAuthHandlers_GoogleAuth(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewAuthHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewAuthHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GoogleAuth(c)
@@ -1,17 +1,20 @@
 package delivery
 
 import (
+	"murakali/internal/limiter"
+	"murakali/internal/middleware"
 	"murakali/internal/module/auth"
 
 	"github.com/gin-gonic/gin"
 )
 
-func MapAuthRoutes(authGroup *gin.RouterGroup, h auth.Handlers) {
+func MapAuthRoutes(authGroup *gin.RouterGroup, h auth.Handlers, mw *middleware.MWManager) {
 	authGroup.POST("/register", h.RegisterEmail)
 	authGroup.PUT("/register", h.RegisterUser)
-	authGroup.POST("/verify", h.VerifyOTP)
-	authGroup.GET("/verify", h.ResetPasswordVerifyOTP)
-	authGroup.POST("/login", h.Login)
+	authGroup.POST("/verify", mw.BruteForceMiddleware(limiter.OTPRule), h.VerifyOTP)
+	authGroup.GET("/verify", mw.BruteForceMiddleware(limiter.OTPRule), h.ResetPasswordVerifyOTP)
+	authGroup.POST("/login", mw.BruteForceMiddleware(limiter.LoginRule), h.Login)
+	authGroup.POST("/login/totp", mw.BruteForceMiddleware(limiter.LoginRule), h.LoginTOTP)
 	authGroup.GET("/logout", h.Logout)
 	authGroup.POST("/reset-password", h.ResetPasswordEmail)
 	authGroup.PATCH("/reset-password", h.ResetPasswordUser)
@@ -19,4 +22,23 @@ func MapAuthRoutes(authGroup *gin.RouterGroup, h auth.Handlers) {
 	authGroup.GET("/unique/username/:username", h.CheckUniqueUsername)
 	authGroup.GET("/unique/phone-no/:phone_no", h.CheckUniquePhoneNo)
 	authGroup.GET("/google-oauth", h.GoogleAuth)
+
+	sessionGroup := authGroup.Group("/session")
+	sessionGroup.Use(mw.AuthJWTMiddleware())
+	sessionGroup.GET("", h.GetSessions)
+	sessionGroup.DELETE("", h.DeleteOtherSessions)
+	sessionGroup.DELETE("/:id", h.DeleteSession)
+
+	authGroup.GET("/oidc/:provider", h.OIDCAuth)
+	authGroup.GET("/oidc/:provider/callback", mw.BruteForceMiddleware(limiter.LoginRule), h.OIDCCallback)
+
+	identityGroup := authGroup.Group("/identity")
+	identityGroup.Use(mw.AuthJWTMiddleware())
+	identityGroup.GET("", h.GetIdentities)
+	identityGroup.POST("/:provider", h.LinkIdentity)
+	identityGroup.DELETE("/:provider", h.DeleteIdentity)
+}
+
+func MapWellKnownRoutes(wellKnownGroup *gin.RouterGroup, h auth.Handlers) {
+	wellKnownGroup.GET("/jwks.json", h.JWKS)
 }
@@ -16,6 +16,20 @@ type Repository struct {
 	mock.Mock
 }
 
+// AddSessionAccessTokenRedis provides a mock function with given fields: ctx, duration, sessionID, key
+func (_m *Repository) AddSessionAccessTokenRedis(ctx context.Context, duration int, sessionID string, key string) error {
+	ret := _m.Called(ctx, duration, sessionID, key)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) error); ok {
+		r0 = rf(ctx, duration, sessionID, key)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CheckEmailHistory provides a mock function with given fields: ctx, email
 func (_m *Repository) CheckEmailHistory(ctx context.Context, email string) (*model.EmailHistory, error) {
 	ret := _m.Called(ctx, email)
@@ -53,6 +67,20 @@ func (_m *Repository) CreateEmailHistory(ctx context.Context, tx postgre.Transac
 	return r0
 }
 
+// CreateSessionRefreshToken provides a mock function with given fields: ctx, token
+func (_m *Repository) CreateSessionRefreshToken(ctx context.Context, token *model.SessionRefreshToken) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SessionRefreshToken) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateUser provides a mock function with given fields: ctx, email
 func (_m *Repository) CreateUser(ctx context.Context, email string) (*model.User, error) {
 	ret := _m.Called(ctx, email)
@@ -99,6 +127,34 @@ func (_m *Repository) CreateUserGoogle(ctx context.Context, tx postgre.Transacti
 	return r0, r1
 }
 
+// CreateUserIdentity provides a mock function with given fields: ctx, tx, identity
+func (_m *Repository) CreateUserIdentity(ctx context.Context, tx postgre.Transaction, identity *model.UserIdentity) error {
+	ret := _m.Called(ctx, tx, identity)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.UserIdentity) error); ok {
+		r0 = rf(ctx, tx, identity)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateUserSession provides a mock function with given fields: ctx, session
+func (_m *Repository) CreateUserSession(ctx context.Context, session *model.UserSession) error {
+	ret := _m.Called(ctx, session)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UserSession) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteOTPValue provides a mock function with given fields: ctx, email
 func (_m *Repository) DeleteOTPValue(ctx context.Context, email string) (int64, error) {
 	ret := _m.Called(ctx, email)
@@ -120,6 +176,71 @@ func (_m *Repository) DeleteOTPValue(ctx context.Context, email string) (int64,
 	return r0, r1
 }
 
+// DeleteUserIdentity provides a mock function with given fields: ctx, userID, provider
+func (_m *Repository) DeleteUserIdentity(ctx context.Context, userID string, provider string) (int64, error) {
+	ret := _m.Called(ctx, userID, provider)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, userID, provider)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetActiveUserSessions provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetActiveUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.UserSession
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.UserSession); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserSession)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDelOIDCStateRedis provides a mock function with given fields: ctx, state
+func (_m *Repository) GetDelOIDCStateRedis(ctx context.Context, state string) (string, error) {
+	ret := _m.Called(ctx, state)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, state)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOTPHashedValue provides a mock function with given fields: ctx, hashedOTP
 func (_m *Repository) GetOTPHashedValue(ctx context.Context, hashedOTP string) (string, error) {
 	ret := _m.Called(ctx, hashedOTP)
@@ -162,13 +283,13 @@ func (_m *Repository) GetOTPValue(ctx context.Context, email string) (string, er
 	return r0, r1
 }
 
-// GetSessionKeyRedis provides a mock function with given fields: ctx, key
-func (_m *Repository) GetSessionKeyRedis(ctx context.Context, key string) ([]string, error) {
-	ret := _m.Called(ctx, key)
+// GetSessionAccessTokensRedis provides a mock function with given fields: ctx, sessionID
+func (_m *Repository) GetSessionAccessTokensRedis(ctx context.Context, sessionID string) ([]string, error) {
+	ret := _m.Called(ctx, sessionID)
 
 	var r0 []string
 	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = rf(ctx, key)
+		r0 = rf(ctx, sessionID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]string)
@@ -177,7 +298,7 @@ func (_m *Repository) GetSessionKeyRedis(ctx context.Context, key string) ([]str
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, key)
+		r1 = rf(ctx, sessionID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -206,6 +327,29 @@ func (_m *Repository) GetSessionRedis(ctx context.Context, key string) (string,
 	return r0, r1
 }
 
+// GetSessionRefreshTokenByHash provides a mock function with given fields: ctx, tokenHash
+func (_m *Repository) GetSessionRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.SessionRefreshToken, error) {
+	ret := _m.Called(ctx, tokenHash)
+
+	var r0 *model.SessionRefreshToken
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.SessionRefreshToken); ok {
+		r0 = rf(ctx, tokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SessionRefreshToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserByEmail provides a mock function with given fields: ctx, email
 func (_m *Repository) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	ret := _m.Called(ctx, email)
@@ -298,6 +442,75 @@ func (_m *Repository) GetUserByUsername(ctx context.Context, username string) (*
 	return r0, r1
 }
 
+// GetUserIdentitiesByUserID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserIdentitiesByUserID(ctx context.Context, userID string) ([]*model.UserIdentity, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.UserIdentity); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserIdentity provides a mock function with given fields: ctx, provider, subject
+func (_m *Repository) GetUserIdentity(ctx context.Context, provider string, subject string) (*model.UserIdentity, error) {
+	ret := _m.Called(ctx, provider, subject)
+
+	var r0 *model.UserIdentity
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.UserIdentity); ok {
+		r0 = rf(ctx, provider, subject)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserIdentity)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserSessionByID provides a mock function with given fields: ctx, sessionID
+func (_m *Repository) GetUserSessionByID(ctx context.Context, sessionID string) (*model.UserSession, error) {
+	ret := _m.Called(ctx, sessionID)
+
+	var r0 *model.UserSession
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.UserSession); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserSession)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertNewOTPHashedKey provides a mock function with given fields: ctx, hashedOTP, email, otp
 func (_m *Repository) InsertNewOTPHashedKey(ctx context.Context, hashedOTP string, email string, otp string) error {
 	ret := _m.Called(ctx, hashedOTP, email, otp)
@@ -326,6 +539,20 @@ func (_m *Repository) InsertNewOTPKey(ctx context.Context, email string, otp str
 	return r0
 }
 
+// InsertOIDCStateRedis provides a mock function with given fields: ctx, state, value
+func (_m *Repository) InsertOIDCStateRedis(ctx context.Context, state string, value string) error {
+	ret := _m.Called(ctx, state, value)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, state, value)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InsertSessionRedis provides a mock function with given fields: ctx, duration, key, status
 func (_m *Repository) InsertSessionRedis(ctx context.Context, duration int, key string, status string) error {
 	ret := _m.Called(ctx, duration, key, status)
@@ -340,6 +567,20 @@ func (_m *Repository) InsertSessionRedis(ctx context.Context, duration int, key
 	return r0
 }
 
+// RevokeUserSession provides a mock function with given fields: ctx, sessionID
+func (_m *Repository) RevokeUserSession(ctx context.Context, sessionID string) error {
+	ret := _m.Called(ctx, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdatePassword provides a mock function with given fields: ctx, user, password
 func (_m *Repository) UpdatePassword(ctx context.Context, user *model.User, password string) (*model.User, error) {
 	ret := _m.Called(ctx, user, password)
@@ -377,6 +618,41 @@ func (_m *Repository) UpdateUser(ctx context.Context, tx postgre.Transaction, us
 	return r0
 }
 
+// UpdateUserSessionLastUsed provides a mock function with given fields: ctx, session
+func (_m *Repository) UpdateUserSessionLastUsed(ctx context.Context, session *model.UserSession) error {
+	ret := _m.Called(ctx, session)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.UserSession) error); ok {
+		r0 = rf(ctx, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UseSessionRefreshToken provides a mock function with given fields: ctx, tokenID
+func (_m *Repository) UseSessionRefreshToken(ctx context.Context, tokenID string) (int64, error) {
+	ret := _m.Called(ctx, tokenID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, tokenID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tokenID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewRepository interface {
 	mock.TestingT
 	Cleanup(func())
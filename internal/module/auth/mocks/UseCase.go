@@ -58,13 +58,101 @@ func (_m *UseCase) CheckUniqueUsername(ctx context.Context, username string) (bo
 	return r0, r1
 }
 
-// GoogleAuth provides a mock function with given fields: ctx, code, state
-func (_m *UseCase) GoogleAuth(ctx context.Context, code string, state string) (*model.GoogleAuthToken, error) {
-	ret := _m.Called(ctx, code, state)
+// DeleteIdentity provides a mock function with given fields: ctx, userID, provider
+func (_m *UseCase) DeleteIdentity(ctx context.Context, userID string, provider string) error {
+	ret := _m.Called(ctx, userID, provider)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteOtherSessions provides a mock function with given fields: ctx, userID, sessionID
+func (_m *UseCase) DeleteOtherSessions(ctx context.Context, userID string, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *UseCase) DeleteSession(ctx context.Context, userID string, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetIdentities provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetIdentities(ctx context.Context, userID string) ([]*body.IdentityResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*body.IdentityResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*body.IdentityResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.IdentityResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSessions provides a mock function with given fields: ctx, userID, sessionID
+func (_m *UseCase) GetSessions(ctx context.Context, userID string, sessionID string) ([]*body.SessionResponse, error) {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	var r0 []*body.SessionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []*body.SessionResponse); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.SessionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, sessionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GoogleAuth provides a mock function with given fields: ctx, code, state, device
+func (_m *UseCase) GoogleAuth(ctx context.Context, code string, state string, device body.SessionDevice) (*model.GoogleAuthToken, error) {
+	ret := _m.Called(ctx, code, state, device)
 
 	var r0 *model.GoogleAuthToken
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.GoogleAuthToken); ok {
-		r0 = rf(ctx, code, state)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.SessionDevice) *model.GoogleAuthToken); ok {
+		r0 = rf(ctx, code, state, device)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.GoogleAuthToken)
@@ -72,8 +160,8 @@ func (_m *UseCase) GoogleAuth(ctx context.Context, code string, state string) (*
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, code, state)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, body.SessionDevice) error); ok {
+		r1 = rf(ctx, code, state, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -81,13 +169,13 @@ func (_m *UseCase) GoogleAuth(ctx context.Context, code string, state string) (*
 	return r0, r1
 }
 
-// Login provides a mock function with given fields: ctx, _a1
-func (_m *UseCase) Login(ctx context.Context, _a1 body.LoginRequest) (*model.Token, error) {
-	ret := _m.Called(ctx, _a1)
+// Login provides a mock function with given fields: ctx, _a1, device
+func (_m *UseCase) Login(ctx context.Context, _a1 body.LoginRequest, device body.SessionDevice) (*model.Token, error) {
+	ret := _m.Called(ctx, _a1, device)
 
 	var r0 *model.Token
-	if rf, ok := ret.Get(0).(func(context.Context, body.LoginRequest) *model.Token); ok {
-		r0 = rf(ctx, _a1)
+	if rf, ok := ret.Get(0).(func(context.Context, body.LoginRequest, body.SessionDevice) *model.Token); ok {
+		r0 = rf(ctx, _a1, device)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*model.Token)
@@ -95,8 +183,8 @@ func (_m *UseCase) Login(ctx context.Context, _a1 body.LoginRequest) (*model.Tok
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, body.LoginRequest) error); ok {
-		r1 = rf(ctx, _a1)
+	if rf, ok := ret.Get(1).(func(context.Context, body.LoginRequest, body.SessionDevice) error); ok {
+		r1 = rf(ctx, _a1, device)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -104,22 +192,103 @@ func (_m *UseCase) Login(ctx context.Context, _a1 body.LoginRequest) (*model.Tok
 	return r0, r1
 }
 
-// RefreshToken provides a mock function with given fields: ctx, refreshToken, id
-func (_m *UseCase) RefreshToken(ctx context.Context, refreshToken string, id string) (*model.AccessToken, error) {
-	ret := _m.Called(ctx, refreshToken, id)
+// LoginTOTP provides a mock function with given fields: ctx, userID, _a2, device
+func (_m *UseCase) LoginTOTP(ctx context.Context, userID string, _a2 body.LoginTOTPRequest, device body.SessionDevice) (*model.Token, error) {
+	ret := _m.Called(ctx, userID, _a2, device)
 
-	var r0 *model.AccessToken
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.AccessToken); ok {
-		r0 = rf(ctx, refreshToken, id)
+	var r0 *model.Token
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.LoginTOTPRequest, body.SessionDevice) *model.Token); ok {
+		r0 = rf(ctx, userID, _a2, device)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.AccessToken)
+			r0 = ret.Get(0).(*model.Token)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, refreshToken, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, body.LoginTOTPRequest, body.SessionDevice) error); ok {
+		r1 = rf(ctx, userID, _a2, device)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Logout provides a mock function with given fields: ctx, refreshToken
+func (_m *UseCase) Logout(ctx context.Context, refreshToken string) error {
+	ret := _m.Called(ctx, refreshToken)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, refreshToken)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// OIDCAuthURL provides a mock function with given fields: ctx, provider, userID, pathURL
+func (_m *UseCase) OIDCAuthURL(ctx context.Context, provider string, userID string, pathURL string) (string, error) {
+	ret := _m.Called(ctx, provider, userID, pathURL)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, provider, userID, pathURL)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, provider, userID, pathURL)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// OIDCCallback provides a mock function with given fields: ctx, provider, code, state, device
+func (_m *UseCase) OIDCCallback(ctx context.Context, provider string, code string, state string, device body.SessionDevice) (*model.OIDCAuthToken, error) {
+	ret := _m.Called(ctx, provider, code, state, device)
+
+	var r0 *model.OIDCAuthToken
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, body.SessionDevice) *model.OIDCAuthToken); ok {
+		r0 = rf(ctx, provider, code, state, device)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OIDCAuthToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, body.SessionDevice) error); ok {
+		r1 = rf(ctx, provider, code, state, device)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefreshToken provides a mock function with given fields: ctx, refreshToken, id, device
+func (_m *UseCase) RefreshToken(ctx context.Context, refreshToken string, id string, device body.SessionDevice) (*model.Token, error) {
+	ret := _m.Called(ctx, refreshToken, id, device)
+
+	var r0 *model.Token
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.SessionDevice) *model.Token); ok {
+		r0 = rf(ctx, refreshToken, id, device)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Token)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, body.SessionDevice) error); ok {
+		r1 = rf(ctx, refreshToken, id, device)
 	} else {
 		r1 = ret.Error(1)
 	}
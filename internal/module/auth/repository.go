@@ -23,6 +23,21 @@ type Repository interface {
 	DeleteOTPValue(ctx context.Context, email string) (int64, error)
 	CreateUserGoogle(ctx context.Context, tx postgre.Transaction, user *model.User) (*model.User, error)
 	InsertSessionRedis(ctx context.Context, duration int, key, status string) error
-	GetSessionKeyRedis(ctx context.Context, key string) ([]string, error)
 	GetSessionRedis(ctx context.Context, key string) (string, error)
+	CreateUserSession(ctx context.Context, session *model.UserSession) error
+	GetUserSessionByID(ctx context.Context, sessionID string) (*model.UserSession, error)
+	GetActiveUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error)
+	UpdateUserSessionLastUsed(ctx context.Context, session *model.UserSession) error
+	RevokeUserSession(ctx context.Context, sessionID string) error
+	CreateSessionRefreshToken(ctx context.Context, token *model.SessionRefreshToken) error
+	GetSessionRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.SessionRefreshToken, error)
+	UseSessionRefreshToken(ctx context.Context, tokenID string) (int64, error)
+	AddSessionAccessTokenRedis(ctx context.Context, duration int, sessionID, key string) error
+	GetSessionAccessTokensRedis(ctx context.Context, sessionID string) ([]string, error)
+	InsertOIDCStateRedis(ctx context.Context, state, value string) error
+	GetDelOIDCStateRedis(ctx context.Context, state string) (string, error)
+	GetUserIdentity(ctx context.Context, provider, subject string) (*model.UserIdentity, error)
+	GetUserIdentitiesByUserID(ctx context.Context, userID string) ([]*model.UserIdentity, error)
+	CreateUserIdentity(ctx context.Context, tx postgre.Transaction, identity *model.UserIdentity) error
+	DeleteUserIdentity(ctx context.Context, userID, provider string) (int64, error)
 }
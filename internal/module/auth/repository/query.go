@@ -2,13 +2,46 @@ package repository
 
 const (
 	CheckEmailHistoryQuery  = `SELECT "id", "email" FROM "email_history" WHERE "email" ILIKE $1`
-	GetUserByIDQuery        = `SELECT "id", "role_id", "email" FROM "user" WHERE "id" = $1`
-	GetUserByEmailQuery     = `SELECT "id", "role_id", "email", "password", "username", "is_verify", "is_sso" FROM "user" WHERE "email" ILIKE $1`
+	GetUserByIDQuery        = `SELECT "id", "role_id", "email", "status", "suspended_until" FROM "user" WHERE "id" = $1`
+	GetUserByEmailQuery     = `SELECT "id", "role_id", "email", "password", "username", "is_verify", "is_sso", "status", "suspended_until", "password_reset_required" FROM "user" WHERE "email" ILIKE $1`
 	GetUserByUsernameQuery  = `SELECT "id", "email", "is_verify" FROM "user" WHERE "username" ILIKE $1`
 	GetUserByPhoneNoQuery   = `SELECT "id", "email", "is_verify" FROM "user" WHERE "phone_no" ILIKE $1`
 	CreateUserQuery         = `INSERT INTO "user" (role_id, email, is_sso, is_verify) VALUES ($1, $2, $3, $4) RETURNING "id", "email"`
 	CreateEmailHistoryQuery = `INSERT INTO "email_history" (email) VALUES ($1)`
-	UpdatePasswordQuery     = `UPDATE "user" SET "password" = $1, "updated_at" = now() WHERE "email" = $2`
+	UpdatePasswordQuery     = `UPDATE "user" SET "password" = $1, "password_reset_required" = false, "updated_at" = now() WHERE "email" = $2`
 	VerifyUserQuery         = `UPDATE "user" SET "phone_no" = $1, "fullname" = $2, "username" = $3, "password" = $4, "is_verify" = $5, "updated_at" = $6 WHERE "email" = $7`
 	CreateUserGoogleQuery   = `INSERT INTO "user" (role_id, username, email, fullname, photo_url, is_sso, is_verify) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING "id", "role_id"`
+
+	CreateUserSessionQuery = `INSERT INTO "user_session" (user_id, user_agent, ip_address, last_used_at, expired_at)
+	VALUES ($1, $2, $3, $4, $5) RETURNING "id", "created_at"`
+
+	GetUserSessionByIDQuery = `SELECT "id", "user_id", "user_agent", "ip_address", "created_at", "last_used_at", "expired_at", "revoked_at"
+	FROM "user_session" WHERE "id" = $1`
+
+	UpdateUserSessionLastUsedQuery = `UPDATE "user_session" SET "user_agent" = $1, "ip_address" = $2, "last_used_at" = $3
+	WHERE "id" = $4`
+
+	RevokeUserSessionQuery = `UPDATE "user_session" SET "revoked_at" = now() WHERE "id" = $1 AND "revoked_at" IS NULL`
+
+	CreateSessionRefreshTokenQuery = `INSERT INTO "session_refresh_token" (id, session_id, parent_id, token_hash, expired_at)
+	VALUES ($1, $2, $3, $4, $5)`
+
+	GetSessionRefreshTokenByHashQuery = `SELECT "id", "session_id", "parent_id", "token_hash", "created_at", "expired_at", "used_at"
+	FROM "session_refresh_token" WHERE "token_hash" = $1`
+
+	UseSessionRefreshTokenQuery = `UPDATE "session_refresh_token" SET "used_at" = now() WHERE "id" = $1 AND "used_at" IS NULL`
+
+	GetActiveUserSessionsQuery = `SELECT "id", "user_id", "user_agent", "ip_address", "created_at", "last_used_at", "expired_at", "revoked_at"
+	FROM "user_session" WHERE "user_id" = $1 AND "revoked_at" IS NULL AND "expired_at" > now() ORDER BY "last_used_at" DESC`
+
+	GetUserIdentityQuery = `SELECT "id", "user_id", "provider", "subject", "email", "created_at"
+	FROM "user_identity" WHERE "provider" = $1 AND "subject" = $2`
+
+	GetUserIdentitiesByUserIDQuery = `SELECT "id", "user_id", "provider", "subject", "email", "created_at"
+	FROM "user_identity" WHERE "user_id" = $1 ORDER BY "created_at"`
+
+	CreateUserIdentityQuery = `INSERT INTO "user_identity" (user_id, provider, subject, email)
+	VALUES ($1, $2, $3, $4) RETURNING "id", "created_at"`
+
+	DeleteUserIdentityQuery = `DELETE FROM "user_identity" WHERE "user_id" = $1 AND "provider" = $2`
 )
@@ -38,7 +38,7 @@ func (r *authRepo) CheckEmailHistory(ctx context.Context, email string) (*model.
 func (r *authRepo) GetUserByID(ctx context.Context, id string) (*model.User, error) {
 	var user model.User
 	if err := r.PSQL.QueryRowContext(ctx, GetUserByIDQuery, id).
-		Scan(&user.ID, &user.RoleID, &user.Email); err != nil {
+		Scan(&user.ID, &user.RoleID, &user.Email, &user.Status, &user.SuspendedUntil); err != nil {
 		return nil, err
 	}
 
@@ -48,7 +48,8 @@ func (r *authRepo) GetUserByID(ctx context.Context, id string) (*model.User, err
 func (r *authRepo) GetUserByEmail(ctx context.Context, email string) (*model.User, error) {
 	var user model.User
 	if err := r.PSQL.QueryRowContext(ctx, GetUserByEmailQuery, email).
-		Scan(&user.ID, &user.RoleID, &user.Email, &user.Password, &user.Username, &user.IsVerify, &user.IsSSO); err != nil {
+		Scan(&user.ID, &user.RoleID, &user.Email, &user.Password, &user.Username, &user.IsVerify, &user.IsSSO,
+			&user.Status, &user.SuspendedUntil, &user.PasswordResetRequired); err != nil {
 		return nil, err
 	}
 
@@ -149,21 +150,6 @@ func (r *authRepo) InsertSessionRedis(ctx context.Context, duration int, key, st
 	return nil
 }
 
-func (r *authRepo) GetSessionKeyRedis(ctx context.Context, key string) ([]string, error) {
-	keys := make([]string, 0)
-
-	iter := r.RedisClient.Scan(ctx, 0, key, 0).Iterator()
-	for iter.Next(ctx) {
-		keys = append(keys, iter.Val())
-	}
-
-	if err := iter.Err(); err != nil {
-		return keys, err
-	}
-
-	return keys, nil
-}
-
 func (r *authRepo) GetSessionRedis(ctx context.Context, key string) (string, error) {
 	res := r.RedisClient.Get(ctx, key)
 	if res.Err() != nil {
@@ -232,3 +218,230 @@ func (r *authRepo) CreateUserGoogle(ctx context.Context, tx postgre.Transaction,
 
 	return user, nil
 }
+
+func (r *authRepo) CreateUserSession(ctx context.Context, session *model.UserSession) error {
+	if err := r.PSQL.QueryRowContext(ctx, CreateUserSessionQuery,
+		session.UserID,
+		session.UserAgent,
+		session.IPAddress,
+		session.LastUsedAt,
+		session.ExpiredAt).Scan(&session.ID, &session.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *authRepo) GetUserSessionByID(ctx context.Context, sessionID string) (*model.UserSession, error) {
+	var session model.UserSession
+	if err := r.PSQL.QueryRowContext(ctx, GetUserSessionByIDQuery, sessionID).Scan(
+		&session.ID,
+		&session.UserID,
+		&session.UserAgent,
+		&session.IPAddress,
+		&session.CreatedAt,
+		&session.LastUsedAt,
+		&session.ExpiredAt,
+		&session.RevokedAt); err != nil {
+		return nil, err
+	}
+
+	return &session, nil
+}
+
+func (r *authRepo) GetActiveUserSessions(ctx context.Context, userID string) ([]*model.UserSession, error) {
+	sessions := make([]*model.UserSession, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetActiveUserSessionsQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var session model.UserSession
+		if errScan := res.Scan(
+			&session.ID,
+			&session.UserID,
+			&session.UserAgent,
+			&session.IPAddress,
+			&session.CreatedAt,
+			&session.LastUsedAt,
+			&session.ExpiredAt,
+			&session.RevokedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		sessions = append(sessions, &session)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return sessions, nil
+}
+
+func (r *authRepo) UpdateUserSessionLastUsed(ctx context.Context, session *model.UserSession) error {
+	if _, err := r.PSQL.ExecContext(ctx, UpdateUserSessionLastUsedQuery,
+		session.UserAgent,
+		session.IPAddress,
+		session.LastUsedAt,
+		session.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *authRepo) RevokeUserSession(ctx context.Context, sessionID string) error {
+	if _, err := r.PSQL.ExecContext(ctx, RevokeUserSessionQuery, sessionID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *authRepo) CreateSessionRefreshToken(ctx context.Context, token *model.SessionRefreshToken) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateSessionRefreshTokenQuery,
+		token.ID,
+		token.SessionID,
+		token.ParentID,
+		token.TokenHash,
+		token.ExpiredAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *authRepo) GetSessionRefreshTokenByHash(ctx context.Context, tokenHash string) (*model.SessionRefreshToken, error) {
+	var token model.SessionRefreshToken
+	if err := r.PSQL.QueryRowContext(ctx, GetSessionRefreshTokenByHashQuery, tokenHash).Scan(
+		&token.ID,
+		&token.SessionID,
+		&token.ParentID,
+		&token.TokenHash,
+		&token.CreatedAt,
+		&token.ExpiredAt,
+		&token.UsedAt); err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+func (r *authRepo) UseSessionRefreshToken(ctx context.Context, tokenID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, UseSessionRefreshTokenQuery, tokenID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *authRepo) AddSessionAccessTokenRedis(ctx context.Context, duration int, sessionID, key string) error {
+	sessionKey := fmt.Sprintf("%s:%s", constant.SessionAccessKey, sessionID)
+	if err := r.RedisClient.SAdd(ctx, sessionKey, key).Err(); err != nil {
+		return err
+	}
+
+	return r.RedisClient.Expire(ctx, sessionKey, time.Duration(duration)*time.Minute).Err()
+}
+
+func (r *authRepo) GetSessionAccessTokensRedis(ctx context.Context, sessionID string) ([]string, error) {
+	return r.RedisClient.SMembers(ctx, fmt.Sprintf("%s:%s", constant.SessionAccessKey, sessionID)).Result()
+}
+
+func (r *authRepo) InsertOIDCStateRedis(ctx context.Context, state, value string) error {
+	duration, err := time.ParseDuration(constant.OIDCStateDuration)
+	if err != nil {
+		return err
+	}
+
+	key := fmt.Sprintf("%s:%s", constant.OIDCStateKey, state)
+	if err := r.RedisClient.Set(ctx, key, value, duration); err.Err() != nil {
+		return err.Err()
+	}
+
+	return nil
+}
+
+func (r *authRepo) GetDelOIDCStateRedis(ctx context.Context, state string) (string, error) {
+	key := fmt.Sprintf("%s:%s", constant.OIDCStateKey, state)
+
+	value, err := r.RedisClient.GetDel(ctx, key).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return "", nil
+		}
+		return "", err
+	}
+
+	return value, nil
+}
+
+func (r *authRepo) GetUserIdentity(ctx context.Context, provider, subject string) (*model.UserIdentity, error) {
+	var identity model.UserIdentity
+	if err := r.PSQL.QueryRowContext(ctx, GetUserIdentityQuery, provider, subject).Scan(
+		&identity.ID,
+		&identity.UserID,
+		&identity.Provider,
+		&identity.Subject,
+		&identity.Email,
+		&identity.CreatedAt); err != nil {
+		return nil, err
+	}
+
+	return &identity, nil
+}
+
+func (r *authRepo) GetUserIdentitiesByUserID(ctx context.Context, userID string) ([]*model.UserIdentity, error) {
+	identities := make([]*model.UserIdentity, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetUserIdentitiesByUserIDQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var identity model.UserIdentity
+		if errScan := res.Scan(
+			&identity.ID,
+			&identity.UserID,
+			&identity.Provider,
+			&identity.Subject,
+			&identity.Email,
+			&identity.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		identities = append(identities, &identity)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return identities, nil
+}
+
+func (r *authRepo) CreateUserIdentity(ctx context.Context, tx postgre.Transaction, identity *model.UserIdentity) error {
+	if err := tx.QueryRowContext(ctx, CreateUserIdentityQuery,
+		identity.UserID,
+		identity.Provider,
+		identity.Subject,
+		identity.Email).Scan(&identity.ID, &identity.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *authRepo) DeleteUserIdentity(ctx context.Context, userID, provider string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, DeleteUserIdentityQuery, userID, provider)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -11,11 +11,20 @@ type UseCase interface {
 	RegisterUser(ctx context.Context, email string, body body2.RegisterUserRequest) error
 	VerifyOTP(ctx context.Context, body body2.VerifyOTPRequest) (string, error)
 	ResetPasswordVerifyOTP(ctx context.Context, body body2.ResetPasswordVerifyOTPRequest) (string, error)
-	Login(ctx context.Context, body body2.LoginRequest) (*model.Token, error)
-	RefreshToken(ctx context.Context, refreshToken, id string) (*model.AccessToken, error)
+	Login(ctx context.Context, body body2.LoginRequest, device body2.SessionDevice) (*model.Token, error)
+	LoginTOTP(ctx context.Context, userID string, body body2.LoginTOTPRequest, device body2.SessionDevice) (*model.Token, error)
+	RefreshToken(ctx context.Context, refreshToken, id string, device body2.SessionDevice) (*model.Token, error)
 	ResetPasswordEmail(ctx context.Context, body body2.ResetPasswordEmailRequest) (*model.User, error)
 	ResetPasswordUser(ctx context.Context, email string, body *body2.ResetPasswordUserRequest) (*model.User, error)
 	CheckUniqueUsername(ctx context.Context, username string) (bool, error)
 	CheckUniquePhoneNo(ctx context.Context, phoneNo string) (bool, error)
-	GoogleAuth(ctx context.Context, code, state string) (*model.GoogleAuthToken, error)
+	GoogleAuth(ctx context.Context, code, state string, device body2.SessionDevice) (*model.GoogleAuthToken, error)
+	Logout(ctx context.Context, refreshToken string) error
+	GetSessions(ctx context.Context, userID, sessionID string) ([]*body2.SessionResponse, error)
+	DeleteSession(ctx context.Context, userID, sessionID string) error
+	DeleteOtherSessions(ctx context.Context, userID, sessionID string) error
+	OIDCAuthURL(ctx context.Context, provider, userID, pathURL string) (string, error)
+	OIDCCallback(ctx context.Context, provider, code, state string, device body2.SessionDevice) (*model.OIDCAuthToken, error)
+	GetIdentities(ctx context.Context, userID string) ([]*body2.IdentityResponse, error)
+	DeleteIdentity(ctx context.Context, userID, provider string) error
 }
@@ -4,12 +4,16 @@ import (
 	"context"
 	"crypto/sha256"
 	"database/sql"
+	"encoding/json"
 	"fmt"
 	"murakali/config"
 	"murakali/internal/constant"
+	"murakali/internal/limiter"
 	"murakali/internal/model"
 	"murakali/internal/module/auth"
 	"murakali/internal/module/auth/delivery/body"
+	"murakali/internal/session"
+	"murakali/internal/twofactor"
 	"murakali/internal/util"
 	smtp "murakali/pkg/email"
 	"murakali/pkg/httperror"
@@ -21,67 +25,184 @@ import (
 	"strings"
 	"time"
 
+	"github.com/google/uuid"
 	"golang.org/x/crypto/bcrypt"
 )
 
 type authUC struct {
-	cfg      *config.Config
-	txRepo   *postgre.TxRepo
-	authRepo auth.Repository
+	cfg       *config.Config
+	txRepo    *postgre.TxRepo
+	authRepo  auth.Repository
+	limiter   *limiter.Limiter
+	twoFactor *twofactor.Verifier
+	sessions  *session.Revoker
+	oidc      map[string]oauth.OIDCClient
+	keySet    *jwt.KeySet
 }
 
-func NewAuthUseCase(cfg *config.Config, txRepo *postgre.TxRepo, authRepo auth.Repository) auth.UseCase {
-	return &authUC{cfg: cfg, txRepo: txRepo, authRepo: authRepo}
+func NewAuthUseCase(cfg *config.Config, txRepo *postgre.TxRepo, authRepo auth.Repository, bruteForceLimiter *limiter.Limiter,
+	twoFactor *twofactor.Verifier, sessionRevoker *session.Revoker, oidcClients map[string]oauth.OIDCClient, keySet *jwt.KeySet) auth.UseCase {
+	return &authUC{cfg: cfg, txRepo: txRepo, authRepo: authRepo, limiter: bruteForceLimiter, twoFactor: twoFactor, sessions: sessionRevoker,
+		oidc: oidcClients, keySet: keySet}
 }
 
-func (u *authUC) Login(ctx context.Context, requestBody body.LoginRequest) (*model.Token, error) {
+func (u *authUC) Login(ctx context.Context, requestBody body.LoginRequest, device body.SessionDevice) (*model.Token, error) {
+	attemptKey := limiter.EmailKey(requestBody.Email)
+	if err := u.limiter.Check(ctx, limiter.LoginRule, attemptKey); err != nil {
+		return nil, err
+	}
+
 	user, err := u.authRepo.GetUserByEmail(ctx, requestBody.Email)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
+			return nil, u.FailAttempt(ctx, limiter.LoginRule, attemptKey,
+				httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage))
 		}
 
 		return nil, err
 	}
 
-	if bcrypt.CompareHashAndPassword([]byte(*user.Password), []byte(requestBody.Password)) != nil {
-		return nil, httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
+	if user.Password == nil || bcrypt.CompareHashAndPassword([]byte(*user.Password), []byte(requestBody.Password)) != nil {
+		return nil, u.FailAttempt(ctx, limiter.LoginRule, attemptKey,
+			httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage))
 	}
 
-	accessToken, err := jwt.GenerateJWTAccessToken(user.ID.String(), user.RoleID, u.cfg)
-	if err != nil {
+	if err := u.limiter.Reset(ctx, limiter.LoginRule, attemptKey); err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := jwt.GenerateJWTRefreshToken(user.ID.String(), u.cfg)
+	if user.PasswordResetRequired {
+		return nil, httperror.New(http.StatusForbidden, response.PasswordResetRequiredMessage)
+	}
+
+	return u.CompleteLogin(ctx, user, device)
+}
+
+func (u *authUC) CheckAccountStatus(user *model.User) error {
+	switch user.AccountStatus() {
+	case constant.AccountStatusSuspended:
+		return httperror.New(http.StatusForbidden, response.AccountSuspendedMessage)
+	case constant.AccountStatusBanned:
+		return httperror.New(http.StatusForbidden, response.AccountBannedMessage)
+	}
+
+	return nil
+}
+
+func (u *authUC) FailAttempt(ctx context.Context, rule limiter.Rule, key string, errAttempt error) error {
+	if err := u.limiter.Hit(ctx, rule, key); err != nil {
+		return err
+	}
+
+	return errAttempt
+}
+
+func (u *authUC) LoginTOTP(ctx context.Context, userID string, requestBody body.LoginTOTPRequest,
+	device body.SessionDevice) (*model.Token, error) {
+	user, err := u.authRepo.GetUserByID(ctx, userID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
+		}
+
 		return nil, err
 	}
 
-	if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, fmt.Sprintf("session:%s:%s",
-		user.ID.String(), accessToken.Token), constant.TRUE); err != nil {
+	if err := u.CheckAccountStatus(user); err != nil {
 		return nil, err
 	}
 
-	if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.RefreshExpMin, fmt.Sprintf("session:%s:%s",
-		user.ID.String(), refreshToken.Token), constant.TRUE); err != nil {
+	attemptKey := limiter.UserKey(userID)
+	if err := u.limiter.Check(ctx, limiter.LoginRule, attemptKey); err != nil {
 		return nil, err
 	}
 
-	return &model.Token{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+	if err := u.twoFactor.Verify(ctx, userID, requestBody.Code, requestBody.RecoveryCode); err != nil {
+		if err == twofactor.ErrInvalidCode {
+			return nil, u.FailAttempt(ctx, limiter.LoginRule, attemptKey, err)
+		}
+
+		return nil, err
+	}
+
+	if err := u.limiter.Reset(ctx, limiter.LoginRule, attemptKey); err != nil {
+		return nil, err
+	}
+
+	return u.CreateSession(ctx, user, device)
 }
 
-func (u *authUC) RefreshToken(ctx context.Context, refreshToken, id string) (*model.AccessToken, error) {
-	key := fmt.Sprintf("session:%s:%s", id, refreshToken)
-	session, err := u.authRepo.GetSessionRedis(ctx, key)
+func (u *authUC) CompleteLogin(ctx context.Context, user *model.User, device body.SessionDevice) (*model.Token, error) {
+	if err := u.CheckAccountStatus(user); err != nil {
+		return nil, err
+	}
+
+	enabled, err := u.twoFactor.IsEnabled(ctx, user.ID.String())
 	if err != nil {
-		return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
+		return nil, err
+	}
+
+	if !enabled {
+		return u.CreateSession(ctx, user, device)
 	}
 
-	if session != constant.TRUE {
+	preAuthToken, err := jwt.GenerateJWTPreAuthToken(user.ID.String(), u.keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Token{PreAuthToken: preAuthToken}, nil
+}
+
+func (u *authUC) RefreshToken(ctx context.Context, refreshToken, id string, device body.SessionDevice) (*model.Token, error) {
+	storedToken, err := u.authRepo.GetSessionRefreshTokenByHash(ctx, HashToken(refreshToken))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
+		}
+
+		return nil, err
+	}
+
+	session, err := u.authRepo.GetUserSessionByID(ctx, storedToken.SessionID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
+		}
+
+		return nil, err
+	}
+
+	if session.UserID.String() != id || session.RevokedAt.Valid {
 		return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
 	}
 
+	if storedToken.UsedAt.Valid {
+		if err := u.RevokeSession(ctx, session); err != nil {
+			return nil, err
+		}
+
+		return nil, httperror.New(http.StatusForbidden, response.RefreshTokenReusedMessage)
+	}
+
+	now := time.Now()
+	if now.After(storedToken.ExpiredAt) || now.After(session.ExpiredAt) {
+		return nil, httperror.New(http.StatusForbidden, response.SessionExpiredMessage)
+	}
+
+	rows, err := u.authRepo.UseSessionRefreshToken(ctx, storedToken.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if rows == 0 {
+		if err := u.RevokeSession(ctx, session); err != nil {
+			return nil, err
+		}
+
+		return nil, httperror.New(http.StatusForbidden, response.RefreshTokenReusedMessage)
+	}
+
 	user, err := u.authRepo.GetUserByID(ctx, id)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -91,17 +212,180 @@ func (u *authUC) RefreshToken(ctx context.Context, refreshToken, id string) (*mo
 		return nil, err
 	}
 
-	accessToken, err := jwt.GenerateJWTAccessToken(user.ID.String(), user.RoleID, u.cfg)
+	if err := u.CheckAccountStatus(user); err != nil {
+		return nil, err
+	}
+
+	session.UserAgent = device.UserAgent
+	session.IPAddress = device.IPAddress
+	session.LastUsedAt = now
+	if err := u.authRepo.UpdateUserSessionLastUsed(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return u.IssueSessionToken(ctx, user, session, &storedToken.ID)
+}
+
+func (u *authUC) CreateSession(ctx context.Context, user *model.User, device body.SessionDevice) (*model.Token, error) {
+	now := time.Now()
+	session := &model.UserSession{
+		UserID:     user.ID,
+		UserAgent:  device.UserAgent,
+		IPAddress:  device.IPAddress,
+		LastUsedAt: now,
+		ExpiredAt:  now.Add(time.Duration(u.cfg.JWT.RefreshExpMin) * time.Minute),
+	}
+	if err := u.authRepo.CreateUserSession(ctx, session); err != nil {
+		return nil, err
+	}
+
+	return u.IssueSessionToken(ctx, user, session, nil)
+}
+
+func (u *authUC) IssueSessionToken(ctx context.Context, user *model.User, session *model.UserSession,
+	parentID *uuid.UUID) (*model.Token, error) {
+	accessToken, err := jwt.GenerateJWTAccessToken(user.ID.String(), user.RoleID, session.ID.String(), u.keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := jwt.GenerateJWTRefreshToken(user.ID.String(), session.ID.String(), u.keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshTokenID, err := uuid.Parse(refreshToken.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, fmt.Sprintf("session:%s:%s",
-		user.ID.String(), accessToken.Token), constant.TRUE); err != nil {
+	if refreshToken.ExpiredAt.After(session.ExpiredAt) {
+		refreshToken.ExpiredAt = session.ExpiredAt
+	}
+
+	if err := u.authRepo.CreateSessionRefreshToken(ctx, &model.SessionRefreshToken{
+		ID:        refreshTokenID,
+		SessionID: session.ID,
+		ParentID:  parentID,
+		TokenHash: HashToken(refreshToken.Token),
+		ExpiredAt: refreshToken.ExpiredAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("session:%s:%s", user.ID.String(), accessToken.Token)
+	if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, key, constant.TRUE); err != nil {
+		return nil, err
+	}
+
+	if err := u.authRepo.AddSessionAccessTokenRedis(ctx, u.cfg.JWT.RefreshExpMin, session.ID.String(), key); err != nil {
+		return nil, err
+	}
+
+	return &model.Token{AccessToken: accessToken, RefreshToken: refreshToken}, nil
+}
+
+func (u *authUC) RevokeSession(ctx context.Context, session *model.UserSession) error {
+	if err := u.authRepo.RevokeUserSession(ctx, session.ID.String()); err != nil {
+		return err
+	}
+
+	keys, err := u.authRepo.GetSessionAccessTokensRedis(ctx, session.ID.String())
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, key, constant.FALSE); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *authUC) Logout(ctx context.Context, refreshToken string) error {
+	storedToken, err := u.authRepo.GetSessionRefreshTokenByHash(ctx, HashToken(refreshToken))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	session, err := u.authRepo.GetUserSessionByID(ctx, storedToken.SessionID.String())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return err
+	}
+
+	return u.RevokeSession(ctx, session)
+}
+
+func (u *authUC) GetSessions(ctx context.Context, userID, sessionID string) ([]*body.SessionResponse, error) {
+	sessions, err := u.authRepo.GetActiveUserSessions(ctx, userID)
+	if err != nil {
 		return nil, err
 	}
 
-	return accessToken, nil
+	resultSessions := make([]*body.SessionResponse, 0)
+	for _, session := range sessions {
+		resultSessions = append(resultSessions, &body.SessionResponse{
+			ID:         session.ID,
+			UserAgent:  session.UserAgent,
+			IPAddress:  session.IPAddress,
+			CreatedAt:  session.CreatedAt,
+			LastUsedAt: session.LastUsedAt,
+			ExpiredAt:  session.ExpiredAt,
+			IsCurrent:  session.ID.String() == sessionID,
+		})
+	}
+
+	return resultSessions, nil
+}
+
+func (u *authUC) DeleteSession(ctx context.Context, userID, sessionID string) error {
+	session, err := u.authRepo.GetUserSessionByID(ctx, sessionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, response.SessionNotFoundMessage)
+		}
+
+		return err
+	}
+
+	if session.UserID.String() != userID || session.RevokedAt.Valid {
+		return httperror.New(http.StatusNotFound, response.SessionNotFoundMessage)
+	}
+
+	return u.RevokeSession(ctx, session)
+}
+
+func (u *authUC) DeleteOtherSessions(ctx context.Context, userID, sessionID string) error {
+	sessions, err := u.authRepo.GetActiveUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if session.ID.String() == sessionID {
+			continue
+		}
+
+		if err := u.RevokeSession(ctx, session); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func HashToken(token string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(token)))
 }
 
 func (u *authUC) RegisterEmail(ctx context.Context, requestBody body.RegisterEmailRequest) (*model.User, error) {
@@ -290,31 +574,30 @@ func (u *authUC) ResetPasswordUser(ctx context.Context, email string, requestBod
 		return nil, err
 	}
 
-	keys, errKey := u.authRepo.GetSessionKeyRedis(ctx, fmt.Sprintf("session:%s:*", user.ID.String()))
-	if errKey != nil {
-		return nil, errKey
-	}
-
-	for _, key := range keys {
-		if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, key, constant.FALSE); err != nil {
-			return nil, err
-		}
+	if err := u.sessions.RevokeUserSessions(ctx, user.ID.String()); err != nil {
+		return nil, err
 	}
 
 	return user, nil
 }
 
 func (u *authUC) VerifyOTP(ctx context.Context, requestBody body.VerifyOTPRequest) (string, error) {
+	attemptKey := limiter.EmailKey(requestBody.Email)
+	if err := u.limiter.Check(ctx, limiter.OTPRule, attemptKey); err != nil {
+		return "", err
+	}
+
 	value, err := u.authRepo.GetOTPValue(ctx, requestBody.Email)
-	if err != nil {
-		return "", httperror.New(http.StatusBadRequest, response.OTPAlreadyExpiredMessage)
+	if err != nil || value != requestBody.OTP {
+		return "", u.FailAttempt(ctx, limiter.OTPRule, attemptKey,
+			httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage))
 	}
 
-	if value != requestBody.OTP {
-		return "", httperror.New(http.StatusBadRequest, response.OTPIsNotValidMessage)
+	if err := u.limiter.Reset(ctx, limiter.OTPRule, attemptKey); err != nil {
+		return "", err
 	}
 
-	registerToken, err := jwt.GenerateJWTRegisterToken(requestBody.Email, u.cfg)
+	registerToken, err := jwt.GenerateJWTRegisterToken(requestBody.Email, u.keySet)
 	if err != nil {
 		return "", err
 	}
@@ -330,7 +613,7 @@ func (u *authUC) VerifyOTP(ctx context.Context, requestBody body.VerifyOTPReques
 func (u *authUC) ResetPasswordVerifyOTP(ctx context.Context, requestBody body.ResetPasswordVerifyOTPRequest) (string, error) {
 	value, err := u.authRepo.GetOTPHashedValue(ctx, requestBody.Code)
 	if err != nil {
-		return "", httperror.New(http.StatusBadRequest, response.OTPAlreadyExpiredMessage)
+		return "", httperror.New(http.StatusForbidden, response.OTPAlreadyExpiredMessage)
 	}
 
 	h := sha256.New()
@@ -338,11 +621,11 @@ func (u *authUC) ResetPasswordVerifyOTP(ctx context.Context, requestBody body.Re
 	hashedOTP := fmt.Sprintf("%x", h.Sum(nil))
 
 	if hashedOTP != requestBody.Code {
-		return "", httperror.New(http.StatusBadRequest, response.OTPIsNotValidMessage)
+		return "", httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage)
 	}
 
 	valueSplit := strings.Split(value, " ")
-	resetPasswordToken, err := jwt.GenerateJWTResetPasswordToken(valueSplit[0], hashedOTP, u.cfg)
+	resetPasswordToken, err := jwt.GenerateJWTResetPasswordToken(valueSplit[0], hashedOTP, u.keySet)
 	if err != nil {
 		return "", err
 	}
@@ -417,7 +700,7 @@ func (u *authUC) CheckUniquePhoneNo(ctx context.Context, phoneNo string) (bool,
 	return false, nil
 }
 
-func (u *authUC) GoogleAuth(ctx context.Context, code, state string) (*model.GoogleAuthToken, error) {
+func (u *authUC) GoogleAuth(ctx context.Context, code, state string, device body.SessionDevice) (*model.GoogleAuthToken, error) {
 	tokenRes, err := oauth.GetGoogleOauthToken(u.cfg, code)
 	if err != nil {
 		return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
@@ -465,7 +748,7 @@ func (u *authUC) GoogleAuth(ctx context.Context, code, state string) (*model.Goo
 				return nil, err
 			}
 
-			registerToken, errToken := jwt.GenerateJWTRegisterToken(userAuth.Email, u.cfg)
+			registerToken, errToken := jwt.GenerateJWTRegisterToken(userAuth.Email, u.keySet)
 			if errToken != nil {
 				return nil, errToken
 			}
@@ -474,7 +757,7 @@ func (u *authUC) GoogleAuth(ctx context.Context, code, state string) (*model.Goo
 		}
 
 		if !user.IsVerify {
-			registerToken, errToken := jwt.GenerateJWTRegisterToken(userAuth.Email, u.cfg)
+			registerToken, errToken := jwt.GenerateJWTRegisterToken(userAuth.Email, u.keySet)
 			if errToken != nil {
 				return nil, errToken
 			}
@@ -485,25 +768,268 @@ func (u *authUC) GoogleAuth(ctx context.Context, code, state string) (*model.Goo
 		return nil, err
 	}
 
-	accessToken, err := jwt.GenerateJWTAccessToken(user.ID.String(), user.RoleID, u.cfg)
+	token, err := u.CompleteLogin(ctx, user, device)
 	if err != nil {
 		return nil, err
 	}
 
-	refreshToken, err := jwt.GenerateJWTRefreshToken(user.ID.String(), u.cfg)
+	return &model.GoogleAuthToken{Token: token}, nil
+}
+
+func (u *authUC) OIDCAuthURL(ctx context.Context, provider, userID, pathURL string) (string, error) {
+	client, ok := u.oidc[provider]
+	if !ok {
+		return "", httperror.New(http.StatusNotFound, response.OIDCProviderNotFoundMessage)
+	}
+
+	if userID != "" {
+		identities, err := u.authRepo.GetUserIdentitiesByUserID(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+
+		for _, identity := range identities {
+			if identity.Provider == provider {
+				return "", httperror.New(http.StatusBadRequest, response.IdentityProviderLinkedMessage)
+			}
+		}
+	}
+
+	state, err := oauth.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := oauth.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	codeVerifier, err := oauth.GenerateRandomString(32)
+	if err != nil {
+		return "", err
+	}
+
+	value, err := json.Marshal(model.OIDCState{
+		Provider:     provider,
+		Nonce:        nonce,
+		CodeVerifier: codeVerifier,
+		PathURL:      pathURL,
+		UserID:       userID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if err := u.authRepo.InsertOIDCStateRedis(ctx, state, string(value)); err != nil {
+		return "", err
+	}
+
+	return client.AuthCodeURL(ctx, state, nonce, oauth.CodeChallenge(codeVerifier))
+}
+
+func (u *authUC) OIDCCallback(ctx context.Context, provider, code, state string,
+	device body.SessionDevice) (*model.OIDCAuthToken, error) {
+	value, err := u.authRepo.GetDelOIDCStateRedis(ctx, state)
 	if err != nil {
 		return nil, err
 	}
 
-	if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, fmt.Sprintf("session:%s:%s",
-		user.ID.String(), accessToken.Token), constant.TRUE); err != nil {
+	var oidcState model.OIDCState
+	if value == "" || json.Unmarshal([]byte(value), &oidcState) != nil || oidcState.Provider != provider {
+		return nil, httperror.New(http.StatusBadRequest, response.OIDCStateInvalidMessage)
+	}
+
+	client, ok := u.oidc[provider]
+	if !ok {
+		return nil, httperror.New(http.StatusNotFound, response.OIDCProviderNotFoundMessage)
+	}
+
+	tokenRes, err := client.Exchange(ctx, code, oidcState.CodeVerifier)
+	if err != nil {
+		return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
+	}
+
+	claims, err := client.VerifyIDToken(ctx, tokenRes.IDToken, oidcState.Nonce)
+	if err != nil {
+		return nil, httperror.New(http.StatusForbidden, response.ForbiddenMessage)
+	}
+
+	result := &model.OIDCAuthToken{PathURL: oidcState.PathURL}
+	identity, err := u.authRepo.GetUserIdentity(ctx, provider, claims.Subject)
+	if err != nil && err != sql.ErrNoRows {
 		return nil, err
 	}
 
-	if err := u.authRepo.InsertSessionRedis(ctx, u.cfg.JWT.RefreshExpMin, fmt.Sprintf("session:%s:%s",
-		user.ID.String(), refreshToken.Token), constant.TRUE); err != nil {
+	if oidcState.UserID != "" {
+		if identity != nil {
+			if identity.UserID.String() != oidcState.UserID {
+				return nil, httperror.New(http.StatusBadRequest, response.IdentityAlreadyLinkedMessage)
+			}
+
+			result.LinkedUserID = oidcState.UserID
+			return result, nil
+		}
+
+		userID, errParse := uuid.Parse(oidcState.UserID)
+		if errParse != nil {
+			return nil, httperror.New(http.StatusBadRequest, response.OIDCStateInvalidMessage)
+		}
+
+		err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+			return u.authRepo.CreateUserIdentity(ctx, tx, &model.UserIdentity{
+				UserID:   userID,
+				Provider: provider,
+				Subject:  claims.Subject,
+				Email:    &claims.Email,
+			})
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		result.LinkedUserID = oidcState.UserID
+		return result, nil
+	}
+
+	if identity != nil {
+		user, errUser := u.authRepo.GetUserByID(ctx, identity.UserID.String())
+		if errUser != nil {
+			return nil, errUser
+		}
+
+		if !user.IsVerify {
+			registerToken, errToken := jwt.GenerateJWTRegisterToken(user.Email, u.keySet)
+			if errToken != nil {
+				return nil, errToken
+			}
+
+			result.RegisterToken = &registerToken
+			return result, nil
+		}
+
+		token, errLogin := u.CompleteLogin(ctx, user, device)
+		if errLogin != nil {
+			return nil, errLogin
+		}
+
+		result.Token = token
+		return result, nil
+	}
+
+	if claims.Email == "" || !claims.EmailVerified {
+		return nil, httperror.New(http.StatusForbidden, response.OIDCEmailNotVerifiedMessage)
+	}
+
+	if _, err = u.authRepo.GetUserByEmail(ctx, claims.Email); err != sql.ErrNoRows {
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, httperror.New(http.StatusBadRequest, response.IdentityEmailExistMessage)
+	}
+
+	emailHistory, err := u.authRepo.CheckEmailHistory(ctx, claims.Email)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if emailHistory != nil {
+		return nil, httperror.New(http.StatusBadRequest, response.EmailAlreadyExistMessage)
+	}
+
+	username := fmt.Sprintf("%s_%s", provider, claims.Subject)
+	user := &model.User{
+		Email:    claims.Email,
+		Username: &username,
+		FullName: &claims.Name,
+		PhotoURL: &claims.Picture,
+		IsSSO:    true,
+	}
+
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		user, err = u.authRepo.CreateUserGoogle(ctx, tx, user)
+		if err != nil {
+			return err
+		}
+
+		return u.authRepo.CreateUserIdentity(ctx, tx, &model.UserIdentity{
+			UserID:   user.ID,
+			Provider: provider,
+			Subject:  claims.Subject,
+			Email:    &claims.Email,
+		})
+	})
+	if err != nil {
 		return nil, err
 	}
 
-	return &model.GoogleAuthToken{Token: &model.Token{AccessToken: accessToken, RefreshToken: refreshToken}}, nil
+	registerToken, err := jwt.GenerateJWTRegisterToken(claims.Email, u.keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	result.RegisterToken = &registerToken
+	return result, nil
+}
+
+func (u *authUC) GetIdentities(ctx context.Context, userID string) ([]*body.IdentityResponse, error) {
+	identities, err := u.authRepo.GetUserIdentitiesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	resultIdentities := make([]*body.IdentityResponse, 0)
+	for _, identity := range identities {
+		resultIdentities = append(resultIdentities, &body.IdentityResponse{
+			Provider:  identity.Provider,
+			Email:     identity.Email,
+			CreatedAt: identity.CreatedAt,
+		})
+	}
+
+	return resultIdentities, nil
+}
+
+func (u *authUC) DeleteIdentity(ctx context.Context, userID, provider string) error {
+	user, err := u.authRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
+		}
+
+		return err
+	}
+
+	identities, err := u.authRepo.GetUserIdentitiesByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	linked := false
+	for _, identity := range identities {
+		if identity.Provider == provider {
+			linked = true
+			break
+		}
+	}
+
+	if !linked {
+		return httperror.New(http.StatusNotFound, response.IdentityNotFoundMessage)
+	}
+
+	if user.Password == nil && len(identities) <= 1 {
+		return httperror.New(http.StatusBadRequest, response.IdentityLastLoginMethodMessage)
+	}
+
+	rows, err := u.authRepo.DeleteUserIdentity(ctx, userID, provider)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusNotFound, response.IdentityNotFoundMessage)
+	}
+
+	return nil
 }
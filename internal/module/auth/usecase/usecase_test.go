@@ -5,18 +5,32 @@ import (
 	"database/sql"
 	"fmt"
 	"murakali/config"
+	"murakali/internal/constant"
+	"murakali/internal/limiter"
+	limiterMocks "murakali/internal/limiter/mocks"
 	"murakali/internal/model"
 	"murakali/internal/module/auth/delivery/body"
 	"murakali/internal/module/auth/mocks"
+	"murakali/internal/session"
+	sessionMocks "murakali/internal/session/mocks"
+	"murakali/internal/twofactor"
+	twoFactorMocks "murakali/internal/twofactor/mocks"
 	"murakali/pkg/httperror"
+	"murakali/pkg/jwt"
+	"murakali/pkg/oauth"
+	oauthMocks "murakali/pkg/oauth/mocks"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
+	"murakali/pkg/totp"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestAuthUseCase_Login(t *testing.T) {
@@ -24,23 +38,34 @@ func TestAuthUseCase_Login(t *testing.T) {
 	testCase := []struct {
 		name        string
 		body        body.LoginRequest
-		mock        func(t *testing.T, r *mocks.Repository)
+		mock        func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository)
 		expectedErr error
 	}{
 		{
 			name: "success login",
 			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreateUserSession", mock.Anything, mock.MatchedBy(func(session *model.UserSession) bool {
+					return session.UserAgent == "test-agent" && session.IPAddress == "127.0.0.1"
+				})).Return(nil)
+				r.On("CreateSessionRefreshToken", mock.Anything, mock.MatchedBy(func(token *model.SessionRefreshToken) bool {
+					return token.ParentID == nil && token.TokenHash != ""
+				})).Return(nil)
 				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
-				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
+				r.On("AddSessionAccessTokenRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedErr: nil,
 		},
 		{
 			name: "email not found",
 			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).
 					Return(nil, sql.ErrNoRows)
 			},
@@ -49,7 +74,8 @@ func TestAuthUseCase_Login(t *testing.T) {
 		{
 			name: "email error",
 			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).
 					Return(nil, fmt.Errorf("test"))
 			},
@@ -58,39 +84,106 @@ func TestAuthUseCase_Login(t *testing.T) {
 		{
 			name: "wrong password login",
 			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8"},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 			},
 			expectedErr: httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage),
 		},
 		{
-			name: "error access redis",
+			name: "wrong password lockout",
+			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(5), nil)
+				lm.On("IncrementLevel", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				lm.On("SetLock", mock.Anything, mock.Anything, int64(1), time.Minute).Return(nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage),
+		},
+		{
+			name: "error login locked",
 			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Minute, nil)
+			},
+			expectedErr: httperror.New(http.StatusTooManyRequests, response.TooManyAttemptsMessage),
+		},
+		{
+			name: "success login mfa required",
+			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
-				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(fmt.Errorf("test"))
+				tf.On("GetUserTOTPByUserID", mock.Anything, mock.Anything).Return(&model.UserTOTP{
+					ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error create session",
+			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreateUserSession", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
 		},
 		{
-			name: "error refresh redis",
+			name: "error access redis",
 			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
-				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreateUserSession", mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateSessionRefreshToken", mock.Anything, mock.Anything).Return(nil)
 				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Once().Return(fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
 		},
+		{
+			name: "failed banned account",
+			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetUserByEmail", mock.Anything, mock.Anything).
+					Return(&model.User{Email: "a@test.com", Password: &passwordHash, Status: constant.AccountStatusBanned}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.AccountBannedMessage),
+		},
+		{
+			name: "failed password reset required",
+			body: body.LoginRequest{Email: "a@test.com", Password: "Tested8*"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetUserByEmail", mock.Anything, mock.Anything).
+					Return(&model.User{Email: "a@test.com", Password: &passwordHash, PasswordResetRequired: true}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.PasswordResetRequiredMessage),
+		},
 	}
 
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			lm := limiterMocks.NewRepository(t)
+			tf := twoFactorMocks.NewRepository(t)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r, limiter.NewLimiter(lm), twofactor.NewVerifier(tf), nil, nil, keySet)
 
-			tc.mock(t, r)
-			_, err := u.Login(context.Background(), tc.body)
+			tc.mock(t, r, lm, tf)
+			_, err := u.Login(context.Background(), tc.body, body.SessionDevice{UserAgent: "test-agent", IPAddress: "127.0.0.1"})
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
 			}
@@ -98,6 +191,105 @@ func TestAuthUseCase_Login(t *testing.T) {
 	}
 }
 
+func TestAuthUseCase_RefreshToken(t *testing.T) {
+	userID, _ := uuid.Parse("8302755e-25c5-4523-8498-7dc8b9e3a098")
+	sessionID, _ := uuid.Parse("123e4567-e89b-12d3-a456-426614174000")
+	tokenID, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
+	expiredAt := time.Now().Add(time.Hour)
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success rotate refresh token",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetSessionRefreshTokenByHash", mock.Anything, mock.Anything).Return(&model.SessionRefreshToken{
+					ID: tokenID, SessionID: sessionID, ExpiredAt: expiredAt}, nil)
+				r.On("GetUserSessionByID", mock.Anything, mock.Anything).Return(&model.UserSession{
+					ID: sessionID, UserID: userID, ExpiredAt: expiredAt}, nil)
+				r.On("UseSessionRefreshToken", mock.Anything, tokenID.String()).Return(int64(1), nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{ID: userID}, nil)
+				r.On("UpdateUserSessionLastUsed", mock.Anything, mock.MatchedBy(func(session *model.UserSession) bool {
+					return session.ExpiredAt.Equal(expiredAt)
+				})).Return(nil)
+				r.On("CreateSessionRefreshToken", mock.Anything, mock.MatchedBy(func(token *model.SessionRefreshToken) bool {
+					return token.ParentID != nil && *token.ParentID == tokenID && token.SessionID == sessionID &&
+						!token.ExpiredAt.After(expiredAt)
+				})).Return(nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("AddSessionAccessTokenRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error refresh token not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetSessionRefreshTokenByHash", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.ForbiddenMessage),
+		},
+		{
+			name: "error session revoked",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetSessionRefreshTokenByHash", mock.Anything, mock.Anything).Return(&model.SessionRefreshToken{
+					ID: tokenID, SessionID: sessionID, ExpiredAt: expiredAt}, nil)
+				r.On("GetUserSessionByID", mock.Anything, mock.Anything).Return(&model.UserSession{
+					ID: sessionID, UserID: userID, ExpiredAt: expiredAt, RevokedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.ForbiddenMessage),
+		},
+		{
+			name: "error reused refresh token revokes family",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetSessionRefreshTokenByHash", mock.Anything, mock.Anything).Return(&model.SessionRefreshToken{
+					ID: tokenID, SessionID: sessionID, ExpiredAt: expiredAt, UsedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+				r.On("GetUserSessionByID", mock.Anything, mock.Anything).Return(&model.UserSession{
+					ID: sessionID, UserID: userID, ExpiredAt: expiredAt}, nil)
+				r.On("RevokeUserSession", mock.Anything, sessionID.String()).Return(nil)
+				r.On("GetSessionAccessTokensRedis", mock.Anything, sessionID.String()).Return([]string{"session:key"}, nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, "session:key", "false").Return(nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.RefreshTokenReusedMessage),
+		},
+		{
+			name: "error session absolute expiry passed",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetSessionRefreshTokenByHash", mock.Anything, mock.Anything).Return(&model.SessionRefreshToken{
+					ID: tokenID, SessionID: sessionID, ExpiredAt: expiredAt}, nil)
+				r.On("GetUserSessionByID", mock.Anything, mock.Anything).Return(&model.UserSession{
+					ID: sessionID, UserID: userID, ExpiredAt: time.Now().Add(-time.Minute)}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.SessionExpiredMessage),
+		},
+		{
+			name: "error concurrent rotation revokes family",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetSessionRefreshTokenByHash", mock.Anything, mock.Anything).Return(&model.SessionRefreshToken{
+					ID: tokenID, SessionID: sessionID, ExpiredAt: expiredAt}, nil)
+				r.On("GetUserSessionByID", mock.Anything, mock.Anything).Return(&model.UserSession{
+					ID: sessionID, UserID: userID, ExpiredAt: expiredAt}, nil)
+				r.On("UseSessionRefreshToken", mock.Anything, tokenID.String()).Return(int64(0), nil)
+				r.On("RevokeUserSession", mock.Anything, sessionID.String()).Return(nil)
+				r.On("GetSessionAccessTokensRedis", mock.Anything, sessionID.String()).Return([]string{}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.RefreshTokenReusedMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil, keySet)
+
+			tc.mock(t, r)
+			_, err := u.RefreshToken(context.Background(), "refresh-token", userID.String(), body.SessionDevice{})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
 func TestAuthUseCase_RegisterEmail(t *testing.T) {
 	testCase := []struct {
 		name        string
@@ -139,7 +331,7 @@ func TestAuthUseCase_RegisterEmail(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.RegisterEmail(context.Background(), body.RegisterEmailRequest{Email: "sammy@gmail.com"})
@@ -248,7 +440,7 @@ func TestAuthUseCase_RegisterUser(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.RegisterUser(context.Background(), "sammy@gmail.com", body.RegisterUserRequest{})
@@ -328,7 +520,7 @@ func TestAuthUseCase_ResetPasswordEmail(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.ResetPasswordEmail(context.Background(), body.ResetPasswordEmailRequest{})
@@ -343,26 +535,29 @@ func TestAuthUseCase_VerifyOTP(t *testing.T) {
 	testCase := []struct {
 		name        string
 		body        interface{}
-		mock        func(t *testing.T, r *mocks.Repository)
+		mock        func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository)
 		expectedErr error
 	}{
 		{
-			name: "success reset password verify otp",
+			name: "error otp not match",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
-				r.On("GetOTPValue", mock.Anything, mock.Anything).Return("654321", nil)
-				r.On("DeleteOTPValue", mock.Anything, mock.Anything).Return(int64(1), nil)
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("GetOTPValue", mock.Anything, mock.Anything).Return("123456", nil)
 			},
-			expectedErr: fmt.Errorf("OTP already expired."),
+			expectedErr: httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage),
 		},
 		{
 			name: "error verify otp",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
 				r.On("GetOTPValue", mock.Anything, mock.Anything).Return("210832", fmt.Errorf("OTP already expired."))
 
 			},
-			expectedErr: fmt.Errorf("OTP already expired."),
+			expectedErr: httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage),
 		},
 	}
 
@@ -371,9 +566,10 @@ func TestAuthUseCase_VerifyOTP(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			lm := limiterMocks.NewRepository(t)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, limiter.NewLimiter(lm), nil, nil, nil, nil)
 
-			tc.mock(t, r)
+			tc.mock(t, r, lm)
 			_, err := u.VerifyOTP(context.Background(), body.VerifyOTPRequest{OTP: "654321"})
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -406,7 +602,7 @@ func TestAuthUseCase_ResetPasswordVerifyOTP(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.ResetPasswordVerifyOTP(context.Background(), body.ResetPasswordVerifyOTPRequest{Code: "123456"})
@@ -422,25 +618,26 @@ func TestAuthUseCase_ResetPasswordUser(t *testing.T) {
 	testCase := []struct {
 		name        string
 		body        interface{}
-		mock        func(t *testing.T, r *mocks.Repository)
+		mock        func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository)
 		expectedErr error
 	}{
 		{
 			name: "error  get user by email user nil",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("CheckEmailHistory", mock.Anything, mock.Anything).Return(&model.EmailHistory{}, nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{IsVerify: true, Password: &pass, Username: &username}, nil)
 				r.On("UpdatePassword", mock.Anything, mock.Anything, mock.Anything).Return(&model.User{IsVerify: true, Password: &pass, Username: &username}, nil)
-				r.On("GetSessionKeyRedis", mock.Anything, mock.Anything, mock.Anything).Return([]string{""}, nil)
-				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				sr.On("GetSessionKeyRedis", mock.Anything, mock.Anything, mock.Anything).Return([]string{""}, nil)
+				sr.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				sr.On("RevokeUserSessions", mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedErr: httperror.New(http.StatusBadRequest, response.UserNotVerifyMessage),
 		},
 		{
 			name: "error check email history",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("CheckEmailHistory", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 
 			},
@@ -449,7 +646,7 @@ func TestAuthUseCase_ResetPasswordUser(t *testing.T) {
 		{
 			name: "error  get user by email",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("CheckEmailHistory", mock.Anything, mock.Anything).Return(&model.EmailHistory{}, nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 			},
@@ -458,7 +655,7 @@ func TestAuthUseCase_ResetPasswordUser(t *testing.T) {
 		{
 			name: "error  get user by email ",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("CheckEmailHistory", mock.Anything, mock.Anything).Return(&model.EmailHistory{}, nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(nil, nil)
 			},
@@ -467,7 +664,7 @@ func TestAuthUseCase_ResetPasswordUser(t *testing.T) {
 		{
 			name: "error  get user by email user nil",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("CheckEmailHistory", mock.Anything, mock.Anything).Return(&model.EmailHistory{}, nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(nil, nil)
 			},
@@ -476,7 +673,7 @@ func TestAuthUseCase_ResetPasswordUser(t *testing.T) {
 		{
 			name: "error  get user by email user nil",
 			body: nil,
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("CheckEmailHistory", mock.Anything, mock.Anything).Return(&model.EmailHistory{}, nil)
 				r.On("GetUserByEmail", mock.Anything, mock.Anything).Return(&model.User{IsVerify: false}, nil)
 			},
@@ -489,9 +686,10 @@ func TestAuthUseCase_ResetPasswordUser(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			sr := sessionMocks.NewRepository(t)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, session.NewRevoker(&config.Config{}, sr), nil, nil)
 
-			tc.mock(t, r)
+			tc.mock(t, r, sr)
 			_, err := u.ResetPasswordUser(context.Background(), "sammy@gmail.com", &body.ResetPasswordUserRequest{Password: pass})
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -550,7 +748,7 @@ func TestAuthUseCase_CheckUniqueUsername(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.CheckUniqueUsername(context.Background(), "87738171235")
@@ -602,7 +800,7 @@ func TestAuthUseCase_CheckUniquePhoneNo(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.CheckUniquePhoneNo(context.Background(), "87738171235")
@@ -612,3 +810,354 @@ func TestAuthUseCase_CheckUniquePhoneNo(t *testing.T) {
 		})
 	}
 }
+
+func TestAuthUseCase_DeleteSession(t *testing.T) {
+	userID, _ := uuid.Parse("8302755e-25c5-4523-8498-7dc8b9e3a098")
+	sessionID, _ := uuid.Parse("123e4567-e89b-12d3-a456-426614174000")
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success delete session",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserSessionByID", mock.Anything, sessionID.String()).Return(&model.UserSession{ID: sessionID, UserID: userID}, nil)
+				r.On("RevokeUserSession", mock.Anything, sessionID.String()).Return(nil)
+				r.On("GetSessionAccessTokensRedis", mock.Anything, sessionID.String()).Return([]string{"session:key"}, nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, "session:key", "false").Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error session not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserSessionByID", mock.Anything, sessionID.String()).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.SessionNotFoundMessage),
+		},
+		{
+			name: "error session belongs to other user",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserSessionByID", mock.Anything, sessionID.String()).Return(&model.UserSession{ID: sessionID, UserID: uuid.New()}, nil)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.SessionNotFoundMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil, nil)
+
+			tc.mock(t, r)
+			err := u.DeleteSession(context.Background(), userID.String(), sessionID.String())
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAuthUseCase_DeleteOtherSessions(t *testing.T) {
+	userID, _ := uuid.Parse("8302755e-25c5-4523-8498-7dc8b9e3a098")
+	currentID, _ := uuid.Parse("123e4567-e89b-12d3-a456-426614174000")
+	otherID, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success keep current session",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetActiveUserSessions", mock.Anything, userID.String()).Return([]*model.UserSession{
+					{ID: currentID, UserID: userID}, {ID: otherID, UserID: userID}}, nil)
+				r.On("RevokeUserSession", mock.Anything, otherID.String()).Return(nil)
+				r.On("GetSessionAccessTokensRedis", mock.Anything, otherID.String()).Return([]string{}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error get active sessions",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetActiveUserSessions", mock.Anything, userID.String()).Return(nil, fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil, nil)
+
+			tc.mock(t, r)
+			err := u.DeleteOtherSessions(context.Background(), userID.String(), currentID.String())
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAuthUseCase_LoginTOTP(t *testing.T) {
+	userID, _ := uuid.Parse("8302755e-25c5-4523-8498-7dc8b9e3a098")
+	secret, _ := totp.GenerateSecret()
+	code, _ := totp.GenerateCode(secret, totp.Step(time.Now()))
+	recoveryHash, _ := bcrypt.GenerateFromPassword([]byte("abcd-efgh"), bcrypt.MinCost)
+	userTOTP := &model.UserTOTP{UserID: userID, Secret: secret, ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+	testCase := []struct {
+		name        string
+		body        body.LoginTOTPRequest
+		mock        func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success login with totp code",
+			body: body.LoginTOTPRequest{Code: code},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(userTOTP, nil)
+				tf.On("UpdateUserTOTPLastUsedStep", mock.Anything, userID.String(), mock.Anything).Return(int64(1), nil)
+				r.On("CreateUserSession", mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateSessionRefreshToken", mock.Anything, mock.Anything).Return(nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("AddSessionAccessTokenRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success login with recovery code",
+			body: body.LoginTOTPRequest{RecoveryCode: "abcd-efgh"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("DeleteKeys", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(userTOTP, nil)
+				tf.On("GetUnusedRecoveryCodes", mock.Anything, userID.String()).Return([]*model.UserRecoveryCode{
+					{ID: uuid.Nil, CodeHash: string(recoveryHash)}}, nil)
+				tf.On("UseRecoveryCode", mock.Anything, uuid.Nil.String()).Return(int64(1), nil)
+				r.On("CreateUserSession", mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateSessionRefreshToken", mock.Anything, mock.Anything).Return(nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("AddSessionAccessTokenRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error invalid totp code",
+			body: body.LoginTOTPRequest{Code: "abcdef"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(userTOTP, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.TOTPInvalidCodeMessage),
+		},
+		{
+			name: "error replayed totp code",
+			body: body.LoginTOTPRequest{Code: code},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(userTOTP, nil)
+				tf.On("UpdateUserTOTPLastUsedStep", mock.Anything, userID.String(), mock.Anything).Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.TOTPInvalidCodeMessage),
+		},
+		{
+			name: "error used recovery code",
+			body: body.LoginTOTPRequest{RecoveryCode: "zzzz-zzzz"},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				lm.On("AddAttempt", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(userTOTP, nil)
+				tf.On("GetUnusedRecoveryCodes", mock.Anything, userID.String()).Return([]*model.UserRecoveryCode{
+					{ID: uuid.Nil, CodeHash: string(recoveryHash)}}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.TOTPInvalidCodeMessage),
+		},
+		{
+			name: "error totp not enabled",
+			body: body.LoginTOTPRequest{Code: code},
+			mock: func(t *testing.T, r *mocks.Repository, lm *limiterMocks.Repository, tf *twoFactorMocks.Repository) {
+				lm.On("GetLockTTL", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.TOTPNotEnabledMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			lm := limiterMocks.NewRepository(t)
+			tf := twoFactorMocks.NewRepository(t)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r, limiter.NewLimiter(lm), twofactor.NewVerifier(tf), nil, nil, keySet)
+
+			tc.mock(t, r, lm, tf)
+			_, err := u.LoginTOTP(context.Background(), userID.String(), tc.body, body.SessionDevice{})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAuthUseCase_OIDCCallback(t *testing.T) {
+	userID, _ := uuid.Parse("8302755e-25c5-4523-8498-7dc8b9e3a098")
+	otherUserID, _ := uuid.Parse("c5a5a6a8-9a43-4f3f-8c3e-7d4fbb9c2d11")
+	loginState := `{"provider":"test","nonce":"nonce","code_verifier":"verifier","path_url":"/"}`
+	linkState := `{"provider":"test","nonce":"nonce","code_verifier":"verifier","path_url":"/","user_id":"` + userID.String() + `"}`
+	claims := &oauth.IDTokenClaims{Email: "a@test.com", EmailVerified: true}
+	claims.Subject = "subject"
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success login linked identity",
+			mock: func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository) {
+				r.On("GetDelOIDCStateRedis", mock.Anything, "state").Return(loginState, nil)
+				c.On("Exchange", mock.Anything, "code", "verifier").Return(&oauth.OIDCToken{IDToken: "id-token"}, nil)
+				c.On("VerifyIDToken", mock.Anything, "id-token", "nonce").Return(claims, nil)
+				r.On("GetUserIdentity", mock.Anything, "test", "subject").Return(&model.UserIdentity{UserID: userID}, nil)
+				r.On("GetUserByID", mock.Anything, userID.String()).Return(&model.User{ID: userID, IsVerify: true}, nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, userID.String()).Return(nil, sql.ErrNoRows)
+				r.On("CreateUserSession", mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateSessionRefreshToken", mock.Anything, mock.Anything).Return(nil)
+				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("AddSessionAccessTokenRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error invalid state",
+			mock: func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository) {
+				r.On("GetDelOIDCStateRedis", mock.Anything, "state").Return("", nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.OIDCStateInvalidMessage),
+		},
+		{
+			name: "error invalid id token",
+			mock: func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository) {
+				r.On("GetDelOIDCStateRedis", mock.Anything, "state").Return(loginState, nil)
+				c.On("Exchange", mock.Anything, "code", "verifier").Return(&oauth.OIDCToken{IDToken: "id-token"}, nil)
+				c.On("VerifyIDToken", mock.Anything, "id-token", "nonce").Return(nil, fmt.Errorf("invalid nonce"))
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.ForbiddenMessage),
+		},
+		{
+			name: "error email already registered",
+			mock: func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository) {
+				r.On("GetDelOIDCStateRedis", mock.Anything, "state").Return(loginState, nil)
+				c.On("Exchange", mock.Anything, "code", "verifier").Return(&oauth.OIDCToken{IDToken: "id-token"}, nil)
+				c.On("VerifyIDToken", mock.Anything, "id-token", "nonce").Return(claims, nil)
+				r.On("GetUserIdentity", mock.Anything, "test", "subject").Return(nil, sql.ErrNoRows)
+				r.On("GetUserByEmail", mock.Anything, "a@test.com").Return(&model.User{ID: userID}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.IdentityEmailExistMessage),
+		},
+		{
+			name: "success link identity",
+			mock: func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository) {
+				r.On("GetDelOIDCStateRedis", mock.Anything, "state").Return(linkState, nil)
+				c.On("Exchange", mock.Anything, "code", "verifier").Return(&oauth.OIDCToken{IDToken: "id-token"}, nil)
+				c.On("VerifyIDToken", mock.Anything, "id-token", "nonce").Return(claims, nil)
+				r.On("GetUserIdentity", mock.Anything, "test", "subject").Return(nil, sql.ErrNoRows)
+				r.On("CreateUserIdentity", mock.Anything, mock.Anything, mock.MatchedBy(func(identity *model.UserIdentity) bool {
+					return identity.UserID == userID && identity.Provider == "test" && identity.Subject == "subject"
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error identity linked to other user",
+			mock: func(t *testing.T, r *mocks.Repository, c *oauthMocks.OIDCClient, tf *twoFactorMocks.Repository) {
+				r.On("GetDelOIDCStateRedis", mock.Anything, "state").Return(linkState, nil)
+				c.On("Exchange", mock.Anything, "code", "verifier").Return(&oauth.OIDCToken{IDToken: "id-token"}, nil)
+				c.On("VerifyIDToken", mock.Anything, "id-token", "nonce").Return(claims, nil)
+				r.On("GetUserIdentity", mock.Anything, "test", "subject").Return(&model.UserIdentity{UserID: otherUserID}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.IdentityAlreadyLinkedMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+			r := mocks.NewRepository(t)
+			c := oauthMocks.NewOIDCClient(t)
+			tf := twoFactorMocks.NewRepository(t)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, twofactor.NewVerifier(tf), nil,
+				map[string]oauth.OIDCClient{"test": c}, keySet)
+
+			tc.mock(t, r, c, tf)
+			_, err := u.OIDCCallback(context.Background(), "test", "code", "state", body.SessionDevice{})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestAuthUseCase_DeleteIdentity(t *testing.T) {
+	userID := "8302755e-25c5-4523-8498-7dc8b9e3a098"
+	password := "hash"
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success delete identity",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{Password: &password}, nil)
+				r.On("GetUserIdentitiesByUserID", mock.Anything, userID).Return([]*model.UserIdentity{{Provider: "test"}}, nil)
+				r.On("DeleteUserIdentity", mock.Anything, userID, "test").Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error identity not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{Password: &password}, nil)
+				r.On("GetUserIdentitiesByUserID", mock.Anything, userID).Return([]*model.UserIdentity{{Provider: "other"}}, nil)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.IdentityNotFoundMessage),
+		},
+		{
+			name: "error last login method",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{}, nil)
+				r.On("GetUserIdentitiesByUserID", mock.Anything, userID).Return([]*model.UserIdentity{{Provider: "test"}}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.IdentityLastLoginMethodMessage),
+		},
+		{
+			name: "success delete identity without password",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserByID", mock.Anything, userID).Return(&model.User{}, nil)
+				r.On("GetUserIdentitiesByUserID", mock.Anything, userID).Return([]*model.UserIdentity{
+					{Provider: "test"}, {Provider: "other"}}, nil)
+				r.On("DeleteUserIdentity", mock.Anything, userID, "test").Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewAuthUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil, nil)
+
+			tc.mock(t, r)
+			err := u.DeleteIdentity(context.Background(), userID, "test")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
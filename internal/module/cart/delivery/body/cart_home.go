@@ -1,6 +1,7 @@
 package body
 
 import (
+	"murakali/internal/model"
 	"murakali/pkg/httperror"
 	"murakali/pkg/response"
 	"net/http"
@@ -19,14 +20,14 @@ type CartHomeResponse struct {
 type CartHome struct {
 	Title              string            `json:"title" db:"title"`
 	ThumbnailURL       string            `json:"thumbnail_url" db:"thumbnail_url"`
-	Price              float64           `json:"price" db:"price"`
+	Price              model.Money       `json:"price" db:"price"`
 	DiscountPercentage *float64          `json:"discount_percentage" db:"discount_percentage"`
-	DiscountFixPrice   *float64          `json:"discount_fix_price" db:"discount_fix_price"`
-	MinProductPrice    *float64          `json:"min_product_price" db:"min_product_price"`
-	MaxDiscountPrice   *float64          `json:"max_discount_price" db:"max_discount_price"`
+	DiscountFixPrice   *model.Money      `json:"discount_fix_price" db:"discount_fix_price"`
+	MinProductPrice    *model.Money      `json:"min_product_price" db:"min_product_price"`
+	MaxDiscountPrice   *model.Money      `json:"max_discount_price" db:"max_discount_price"`
 	Quota              *int              `json:"quota" db:"quota"`
-	ResultDiscount     float64           `json:"result_discount" db:"result_discount"`
-	SubPrice           float64           `json:"sub_price" db:"sub_price"`
+	ResultDiscount     model.Money       `json:"result_discount" db:"result_discount"`
+	SubPrice           model.Money       `json:"sub_price" db:"sub_price"`
 	Quantity           int               `json:"quantity" db:"quantity"`
 	Variant            map[string]string `json:"variant"`
 }
@@ -1,6 +1,7 @@
 package body
 
 import (
+	"murakali/internal/model"
 	"murakali/pkg/httperror"
 	"murakali/pkg/response"
 	"net/http"
@@ -22,25 +23,28 @@ type ShopResponse struct {
 }
 
 type ProductDetailResponse struct {
-	ID           string            `json:"id"`
-	Title        string            `json:"title"`
-	ThumbnailURL string            `json:"thumbnail_url"`
-	ProductPrice float64           `json:"product_price"`
-	ProductStock float64           `json:"product_stock"`
-	Quantity     float64           `json:"quantity"`
-	Weight       float64           `json:"weight"`
-	Variant      map[string]string `json:"variant"`
-	Promo        *PromoResponse    `json:"promo"`
+	ID           string             `json:"id"`
+	Title        string             `json:"title"`
+	ThumbnailURL string             `json:"thumbnail_url"`
+	ProductPrice model.Money        `json:"product_price"`
+	BasePrice    model.Money        `json:"base_price"`
+	BulkPrice    bool               `json:"bulk_price"`
+	PriceTiers   []*model.PriceTier `json:"price_tiers"`
+	ProductStock float64            `json:"product_stock"`
+	Quantity     float64            `json:"quantity"`
+	Weight       float64            `json:"weight"`
+	Variant      map[string]string  `json:"variant"`
+	Promo        *PromoResponse     `json:"promo"`
 }
 
 type PromoResponse struct {
-	DiscountPercentage *float64 `json:"discount_percentage" db:"discount_percentage"`
-	DiscountFixPrice   *float64 `json:"discount_fix_price" db:"discount_fix_price"`
-	MinProductPrice    *float64 `json:"min_product_price" db:"min_product_price"`
-	MaxDiscountPrice   *float64 `json:"max_discount_price" db:"max_discount_price"`
-	ResultDiscount     float64  `json:"result_discount" db:"result_discount"`
-	SubPrice           float64  `json:"sub_price" db:"sub_price"`
-	Quota              *int     `json:"quota" db:"quota"`
+	DiscountPercentage *float64     `json:"discount_percentage" db:"discount_percentage"`
+	DiscountFixPrice   *model.Money `json:"discount_fix_price" db:"discount_fix_price"`
+	MinProductPrice    *model.Money `json:"min_product_price" db:"min_product_price"`
+	MaxDiscountPrice   *model.Money `json:"max_discount_price" db:"max_discount_price"`
+	ResultDiscount     model.Money  `json:"result_discount" db:"result_discount"`
+	SubPrice           model.Money  `json:"sub_price" db:"sub_price"`
+	Quota              *int         `json:"quota" db:"quota"`
 }
 
 type CartItemRequest struct {
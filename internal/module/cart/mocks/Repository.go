@@ -56,6 +56,27 @@ func (_m *Repository) DeleteCartByID(ctx context.Context, cartItem *model.CartIt
 	return r0
 }
 
+// GetAvailableStock provides a mock function with given fields: ctx, productDetailID
+func (_m *Repository) GetAvailableStock(ctx context.Context, productDetailID string) (float64, error) {
+	ret := _m.Called(ctx, productDetailID)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, string) float64); ok {
+		r0 = rf(ctx, productDetailID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productDetailID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCartHoverHome provides a mock function with given fields: ctx, userID, limit
 func (_m *Repository) GetCartHoverHome(ctx context.Context, userID string, limit int) ([]*body.CartHome, error) {
 	ret := _m.Called(ctx, userID, limit)
@@ -143,6 +164,29 @@ func (_m *Repository) GetCartProductDetail(ctx context.Context, userID string, p
 	return r0, r1
 }
 
+// GetPriceTiers provides a mock function with given fields: ctx, productDetailID
+func (_m *Repository) GetPriceTiers(ctx context.Context, productDetailID string) ([]*model.PriceTier, error) {
+	ret := _m.Called(ctx, productDetailID)
+
+	var r0 []*model.PriceTier
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PriceTier); ok {
+		r0 = rf(ctx, productDetailID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceTier)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productDetailID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductDetailByID provides a mock function with given fields: ctx, productDetailID
 func (_m *Repository) GetProductDetailByID(ctx context.Context, productDetailID string) (*model.ProductDetail, error) {
 	ret := _m.Called(ctx, productDetailID)
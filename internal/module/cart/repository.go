@@ -10,6 +10,7 @@ import (
 type Repository interface {
 	GetUserByID(ctx context.Context, id string) (*model.User, error)
 	GetProductDetailByID(ctx context.Context, productDetailID string) (*model.ProductDetail, error)
+	GetAvailableStock(ctx context.Context, productDetailID string) (float64, error)
 	GetCartProductDetail(ctx context.Context, userID, productDetailID string) (*model.CartItem, error)
 	CreateCart(ctx context.Context, userID, productDetailID string, quantity float64) (*model.CartItem, error)
 	UpdateCartByID(ctx context.Context, cartItem *model.CartItem) error
@@ -22,4 +23,5 @@ type Repository interface {
 	GetVoucherShop(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.Voucher, error)
 	GetTotalVoucherMarketplace(ctx context.Context) (int64, error)
 	GetVoucherMarketplace(ctx context.Context, pgn *pagination.Pagination) ([]*model.Voucher, error)
+	GetPriceTiers(ctx context.Context, productDetailID string) ([]*model.PriceTier, error)
 }
@@ -27,6 +27,7 @@ const (
 	GetCartItemsQuery = `
 	SELECT "ci"."id" as "id", "ci"."quantity" as "quantity", "pd"."id" as "product_detail_id", "p"."title" as "product_title", "s"."id" as "shop_id", "s"."name" as "shop_name", "p"."thumbnail_url" as "thumbnail_url", 
 		"pd"."price" as "product_price", "pd"."stock" as "product_stock", "pd"."weight" as "product_weight",
+		"pd"."bulk_price" as "bulk_price",
 		"promo"."discount_percentage" as "promo_discount_percentage", "promo"."discount_fix_price" as "promo_discount_fix_price",
 		"promo"."min_product_price" as "promo_min_product_price", "promo"."max_discount_price" as "promo_max_discount_price", 
 		"promo"."quota" as "quota", array_agg("vd"."name") as "variant_name", array_agg("vd"."type") as "variant_type"
@@ -91,4 +92,16 @@ const (
 	AND "v"."deleted_at" IS NULL
 	ORDER BY "v"."created_at" DESC LIMIT $1 OFFSET $2
 	`
+
+	GetAvailableStockQuery = `
+	SELECT "pd"."stock" - COALESCE((
+		SELECT SUM("sr"."quantity") FROM "stock_reservation" AS "sr"
+		WHERE "sr"."product_detail_id" = "pd"."id" AND "sr"."status" = $2 AND "sr"."expired_at" > now()
+	), 0)
+	FROM "product_detail" AS "pd"
+	WHERE "pd"."id" = $1 AND "pd"."deleted_at" IS NULL;
+	`
+
+	GetPriceTiersQuery = `SELECT "min_quantity", "max_quantity", "price" FROM "product_price_tier"
+	WHERE "product_detail_id" = $1 ORDER BY "min_quantity" ASC`
 )
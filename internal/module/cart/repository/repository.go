@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"murakali/internal/constant"
 	"murakali/internal/model"
 	"murakali/internal/module/cart"
 	"murakali/internal/module/cart/delivery/body"
@@ -46,6 +47,16 @@ func (r *cartRepo) GetProductDetailByID(ctx context.Context, productDetailID str
 	return &ProductDetailData, nil
 }
 
+func (r *cartRepo) GetAvailableStock(ctx context.Context, productDetailID string) (float64, error) {
+	var availableStock float64
+	if err := r.PSQL.QueryRowContext(ctx, GetAvailableStockQuery, productDetailID, constant.StockReservationActive).
+		Scan(&availableStock); err != nil {
+		return 0, err
+	}
+
+	return availableStock, nil
+}
+
 func (r *cartRepo) GetCartProductDetail(ctx context.Context, userID, productDetailID string) (*model.CartItem, error) {
 	var cartModel model.CartItem
 	if err := r.PSQL.QueryRowContext(ctx, GetCartProductDetailQuery, userID, productDetailID).
@@ -176,6 +187,7 @@ func (r *cartRepo) GetCartItems(ctx context.Context, userID string, pgn *paginat
 			&productData.ProductPrice,
 			&productData.ProductStock,
 			&productData.Weight,
+			&productData.BulkPrice,
 			&promo.DiscountPercentage,
 			&promo.DiscountFixPrice,
 			&promo.MinProductPrice,
@@ -303,3 +315,26 @@ func (r *cartRepo) GetVoucherMarketplace(ctx context.Context, pgn *pagination.Pa
 
 	return marketplaceVouchers, nil
 }
+
+func (r *cartRepo) GetPriceTiers(ctx context.Context, productDetailID string) ([]*model.PriceTier, error) {
+	priceTiers := make([]*model.PriceTier, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPriceTiersQuery, productDetailID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var tier model.PriceTier
+		if errScan := res.Scan(&tier.MinQuantity, &tier.MaxQuantity, &tier.Price); errScan != nil {
+			return nil, errScan
+		}
+		priceTiers = append(priceTiers, &tier)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return priceTiers, nil
+}
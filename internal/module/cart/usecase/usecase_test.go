@@ -34,15 +34,16 @@ func TestCartUseCase_GetCartHoverHome(t *testing.T) {
 			body: nil,
 			mock: func(t *testing.T, r *mocks.Repository) {
 				var temp float64 = 10
+				var tempMoney model.Money = 10
 				var tempInt int = 10
 				r.On("GetCartHoverHome", mock.Anything, mock.Anything, mock.Anything).Return([]*body.CartHome{
 					{
-						MaxDiscountPrice:   &temp,
-						MinProductPrice:    &temp,
+						MaxDiscountPrice:   &tempMoney,
+						MinProductPrice:    &tempMoney,
 						Quota:              &tempInt,
-						DiscountFixPrice:   &temp,
-						ResultDiscount:     temp,
-						Price:              temp,
+						DiscountFixPrice:   &tempMoney,
+						ResultDiscount:     tempMoney,
+						Price:              tempMoney,
 						DiscountPercentage: &temp,
 					},
 				}, nil)
@@ -86,6 +87,7 @@ func TestCartUseCase_GetCartHoverHome(t *testing.T) {
 
 func TestCartUseCase_GetCartItems(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	var tempInt int = 10
 	id, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
 	testCase := []struct {
@@ -110,17 +112,17 @@ func TestCartUseCase_GetCartItems(t *testing.T) {
 						{
 							Title:        "test",
 							ThumbnailURL: "asdassd",
-							ProductPrice: temp,
+							ProductPrice: tempMoney,
 							ProductStock: temp,
 							Quantity:     10,
 							Weight:       float64(tempInt),
 							Promo: &body.PromoResponse{
 								DiscountPercentage: &temp,
-								DiscountFixPrice:   &temp,
-								MinProductPrice:    &temp,
-								MaxDiscountPrice:   &temp,
-								ResultDiscount:     temp,
-								SubPrice:           temp,
+								DiscountFixPrice:   &tempMoney,
+								MinProductPrice:    &tempMoney,
+								MaxDiscountPrice:   &tempMoney,
+								ResultDiscount:     tempMoney,
+								SubPrice:           tempMoney,
 								Quota:              &tempInt,
 							},
 							Variant: map[string]string{
@@ -132,7 +134,7 @@ func TestCartUseCase_GetCartItems(t *testing.T) {
 					ID:           "989d94b7-58fc-4a76-ae01-1c1b47a0755c",
 					Title:        "Test",
 					ThumbnailURL: "test",
-					ProductPrice: temp,
+					ProductPrice: tempMoney,
 					ProductStock: temp,
 					Quantity:     temp,
 					Weight:       temp,
@@ -141,21 +143,21 @@ func TestCartUseCase_GetCartItems(t *testing.T) {
 					},
 					Promo: &body.PromoResponse{
 						DiscountPercentage: &temp,
-						DiscountFixPrice:   &temp,
-						MinProductPrice:    &temp,
-						MaxDiscountPrice:   &temp,
-						ResultDiscount:     temp,
-						SubPrice:           temp,
+						DiscountFixPrice:   &tempMoney,
+						MinProductPrice:    &tempMoney,
+						MaxDiscountPrice:   &tempMoney,
+						ResultDiscount:     tempMoney,
+						SubPrice:           tempMoney,
 						Quota:              &tempInt,
 					},
 				}}, []*body.PromoResponse{{
 					DiscountPercentage: &temp,
-					DiscountFixPrice:   &temp,
-					MinProductPrice:    &temp,
-					MaxDiscountPrice:   &temp,
-					ResultDiscount:     temp,
+					DiscountFixPrice:   &tempMoney,
+					MinProductPrice:    &tempMoney,
+					MaxDiscountPrice:   &tempMoney,
+					ResultDiscount:     tempMoney,
 					Quota:              &tempInt,
-					SubPrice:           temp}}, nil)
+					SubPrice:           tempMoney}}, nil)
 
 			},
 			expectedErr: nil,
@@ -195,6 +197,56 @@ func TestCartUseCase_GetCartItems(t *testing.T) {
 	}
 }
 
+func TestCartUseCase_GetCartItemsPriceTier(t *testing.T) {
+	percentage, maxDiscount, quota := float64(10), model.Money(5000), 100
+	maxQuantity := 9
+	id, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
+	testCase := []struct {
+		name             string
+		quantity         float64
+		expectedPrice    model.Money
+		expectedSubPrice model.Money
+	}{
+		{
+			name:             "quantity in first tier",
+			quantity:         5,
+			expectedPrice:    9000,
+			expectedSubPrice: 8100,
+		},
+		{
+			name:             "quantity in open ended tier",
+			quantity:         20,
+			expectedPrice:    8000,
+			expectedSubPrice: 7200,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewCartUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+
+			product := &body.ProductDetailResponse{ID: id.String(), ProductPrice: 10000, BulkPrice: true, Quantity: tc.quantity}
+			promo := &body.PromoResponse{DiscountPercentage: &percentage, MaxDiscountPrice: &maxDiscount, Quota: &quota}
+			r.On("GetTotalCart", mock.Anything, mock.Anything).Return(int64(1), nil)
+			r.On("GetCartItems", mock.Anything, mock.Anything, mock.Anything).Return([]*body.CartItemsResponse{{
+				Shop: &body.ShopResponse{ID: id},
+			}}, []*body.ProductDetailResponse{product}, []*body.PromoResponse{promo}, nil)
+			r.On("GetPriceTiers", mock.Anything, id.String()).Return([]*model.PriceTier{
+				{MinQuantity: 2, MaxQuantity: &maxQuantity, Price: 9000},
+				{MinQuantity: 10, Price: 8000},
+			}, nil)
+
+			_, err := u.GetCartItems(context.Background(), "123456", &pagination.Pagination{})
+
+			assert.NoError(t, err)
+			assert.Equal(t, model.Money(10000), product.BasePrice)
+			assert.Equal(t, tc.expectedPrice, product.ProductPrice)
+			assert.Equal(t, tc.expectedSubPrice, product.Promo.SubPrice)
+		})
+	}
+}
+
 func TestCartUseCase_AddCartItems(t *testing.T) {
 	passwordHash := "$2a$10$WKul/6gjYoYjOXuNVX4XGen1ZkWYb1PKFiI5vlZp5TFerZh6nTujG"
 	testCase := []struct {
@@ -210,6 +262,7 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
 				r.On("CreateCart", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 			},
@@ -243,6 +296,7 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
@@ -254,6 +308,19 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
+				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.QuantityReachedMaximum),
+		},
+		{
+			name: "error add cart stock reserved",
+			body: body.AddCartItemRequest{ProductDetailID: "123456",
+				Quantity: 1},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
+				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(0), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
 			},
 			expectedErr: httperror.New(http.StatusBadRequest, response.QuantityReachedMaximum),
@@ -265,6 +332,7 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
 				r.On("CreateCart", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 			},
@@ -277,6 +345,7 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 				r.On("UpdateCartByID", mock.Anything, mock.Anything).Return(nil)
 			},
@@ -289,6 +358,7 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 			},
 			expectedErr: httperror.New(http.StatusBadRequest, response.QuantityReachedMaximum),
@@ -300,6 +370,7 @@ func TestCartUseCase_AddCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 				r.On("UpdateCartByID", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 			},
@@ -355,6 +426,7 @@ func TestCartUseCase_UpdateCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
@@ -367,6 +439,7 @@ func TestCartUseCase_UpdateCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 				r.On("UpdateCartByID", mock.Anything, mock.Anything).Return(nil)
 			},
@@ -379,6 +452,7 @@ func TestCartUseCase_UpdateCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 			},
 			expectedErr: httperror.New(http.StatusBadRequest, response.QuantityReachedMaximum),
@@ -390,6 +464,7 @@ func TestCartUseCase_UpdateCartItems(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "a@test.com", Password: &passwordHash}, nil)
 				r.On("GetProductDetailByID", mock.Anything, mock.Anything).Return(&model.ProductDetail{Stock: 2}, nil)
+				r.On("GetAvailableStock", mock.Anything, mock.Anything).Return(float64(2), nil)
 				r.On("GetCartProductDetail", mock.Anything, mock.Anything, mock.Anything).Return(&model.CartItem{}, nil)
 				r.On("UpdateCartByID", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 			},
@@ -26,5 +26,15 @@ type Handlers interface {
 	UpdateListedStatusBulk(c *gin.Context)
 	UpdateProduct(c *gin.Context)
 	UploadProductPicture(c *gin.Context)
-	UpdateProductMetadata(c *gin.Context)
+	ImportProducts(c *gin.Context)
+	GetProductImportJob(c *gin.Context)
+	ExportProducts(c *gin.Context)
+	GenerateVariantGrid(c *gin.Context)
+	GetProductVersions(c *gin.Context)
+	RollbackProduct(c *gin.Context)
+	GetPriceHistory(c *gin.Context)
+	CreateProductAlert(c *gin.Context)
+	GetProductAlerts(c *gin.Context)
+	DeleteProductAlert(c *gin.Context)
+	UnsubscribeProductAlert(c *gin.Context)
 }
@@ -2,6 +2,7 @@ package body
 
 import (
 	"mime/multipart"
+	"murakali/internal/model"
 	"murakali/pkg/httperror"
 	"murakali/pkg/response"
 	"net/http"
@@ -16,6 +17,7 @@ type ImageRequest struct {
 
 type CreateProductRequest struct {
 	ProductInfo   CreateProductInfo            `json:"products_info"`
+	VariantAxes   []VariantAxisRequest         `json:"variant_axes"`
 	ProductDetail []CreateProductDetailRequest `json:"products_detail"`
 }
 
@@ -32,15 +34,15 @@ type CreateProductInfoForQuery struct {
 	Description  string
 	Thumbnail    string
 	CategoryID   string
-	MinPrice     float64
-	MaxPrice     float64
+	MinPrice     model.Money
+	MaxPrice     model.Money
 	ShopID       string
 	SKU          string
 	ListedStatus bool
 }
 
 type CreateProductDetailRequest struct {
-	Price         float64                `json:"price"`
+	Price         model.Money            `json:"price"`
 	Stock         float64                `json:"stock"`
 	Weight        float64                `json:"weight"`
 	Size          float64                `json:"size"`
@@ -49,6 +51,7 @@ type CreateProductDetailRequest struct {
 	BulkPrice     bool                   `json:"bulk_price"`
 	Photo         []string               `json:"photo"`
 	VariantDetail []VariantDetailRequest `json:"variant_detail"`
+	PriceTiers    []PriceTierRequest     `json:"price_tiers"`
 }
 
 type VariantDetailRequest struct {
@@ -122,6 +125,11 @@ func (r *CreateProductRequest) ValidateCreateProduct() (UnprocessableEntity, err
 			unprocessableEntity = true
 			entity.Fields["photo"] = FieldCannotBeEmptyMessage
 		}
+		if message := ValidatePriceTiers(r.ProductDetail[i].Price, r.ProductDetail[i].PriceTiers); message != "" {
+			unprocessableEntity = true
+			entity.Fields["price_tiers"] = message
+		}
+		r.ProductDetail[i].BulkPrice = len(r.ProductDetail[i].PriceTiers) > 0
 
 		totalDataVariant := len(r.ProductDetail[i].VariantDetail)
 		if totalDataVariant == 0 {
@@ -144,6 +152,13 @@ func (r *CreateProductRequest) ValidateCreateProduct() (UnprocessableEntity, err
 		}
 	}
 
+	if totalData > 0 && entity.Fields["variant_detail"] == "" && entity.Fields["type"] == "" && entity.Fields["name"] == "" {
+		if field, message := r.ValidateVariantMatrix(); message != "" {
+			unprocessableEntity = true
+			entity.Fields[field] = message
+		}
+	}
+
 	if unprocessableEntity {
 		return entity, httperror.New(
 			http.StatusUnprocessableEntity,
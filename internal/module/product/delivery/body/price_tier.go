@@ -0,0 +1,47 @@
+package body
+
+import (
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"sort"
+)
+
+const (
+	PriceTierLimitMessage    = "Product detail can have at most 5 price tiers."
+	InvalidPriceTiersMessage = "Price tiers must cover consecutive quantity ranges with prices that do not increase."
+)
+
+type PriceTierRequest struct {
+	MinQuantity int         `json:"min_quantity"`
+	MaxQuantity *int        `json:"max_quantity"`
+	Price       model.Money `json:"price"`
+}
+
+func ValidatePriceTiers(price model.Money, tiers []PriceTierRequest) string {
+	if len(tiers) > constant.ProductPriceTierMax {
+		return PriceTierLimitMessage
+	}
+
+	sort.SliceStable(tiers, func(i, j int) bool {
+		return tiers[i].MinQuantity < tiers[j].MinQuantity
+	})
+
+	lastPrice := price
+	for i, tier := range tiers {
+		if tier.MinQuantity < 1 || tier.Price <= 0 || tier.Price > lastPrice {
+			return InvalidPriceTiersMessage
+		}
+
+		if i > 0 && (tiers[i-1].MaxQuantity == nil || tier.MinQuantity != *tiers[i-1].MaxQuantity+1) {
+			return InvalidPriceTiersMessage
+		}
+
+		if tier.MaxQuantity != nil && *tier.MaxQuantity < tier.MinQuantity {
+			return InvalidPriceTiersMessage
+		}
+
+		lastPrice = tier.Price
+	}
+
+	return ""
+}
@@ -0,0 +1,89 @@
+package body
+
+import (
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+const (
+	InvalidProductAlertTypeMessage = "Alert type must be price_drop or back_in_stock."
+	InvalidTargetPriceMessage      = "Target price must be greater than 0."
+	TargetPriceNotBelowMessage     = "Target price must be below the current price."
+	ProductAlertNotFound           = "Product alert not found."
+	ProductDetailNotFound          = "Product detail not found."
+)
+
+type CreateProductAlertRequest struct {
+	ProductDetailID string       `json:"product_detail_id"`
+	AlertType       string       `json:"alert_type"`
+	TargetPrice     *model.Money `json:"target_price"`
+}
+
+type UnsubscribeProductAlertRequest struct {
+	Token string `json:"token"`
+}
+
+func (r *CreateProductAlertRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"product_detail_id": "",
+			"alert_type":        "",
+			"target_price":      "",
+		},
+	}
+
+	r.ProductDetailID = strings.TrimSpace(r.ProductDetailID)
+	if _, err := uuid.Parse(r.ProductDetailID); err != nil {
+		unprocessableEntity = true
+		entity.Fields["product_detail_id"] = FieldCannotBeEmptyMessage
+	}
+
+	r.AlertType = strings.TrimSpace(r.AlertType)
+	switch r.AlertType {
+	case constant.ProductAlertPriceDrop:
+		if r.TargetPrice == nil || *r.TargetPrice <= 0 {
+			unprocessableEntity = true
+			entity.Fields["target_price"] = InvalidTargetPriceMessage
+		}
+	case constant.ProductAlertBackInStock:
+		r.TargetPrice = nil
+	default:
+		unprocessableEntity = true
+		entity.Fields["alert_type"] = InvalidProductAlertTypeMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+func (r *UnsubscribeProductAlertRequest) Validate() (UnprocessableEntity, error) {
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"token": "",
+		},
+	}
+
+	r.Token = strings.TrimSpace(r.Token)
+	if _, err := uuid.Parse(r.Token); err != nil {
+		entity.Fields["token"] = FieldCannotBeEmptyMessage
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
@@ -1,32 +1,37 @@
 package body
 
-import "time"
+import (
+	"murakali/internal/model"
+	"time"
+)
 
 type ProductDetailRequest struct {
 }
 
 type ProductDetailResponse struct {
-	ProductInfo   *ProductInfo     `json:"products_info"`
-	PromotionInfo *PromotionInfo   `json:"promotions_info"`
-	ProductDetail []*ProductDetail `json:"products_detail"`
+	ProductInfo         *ProductInfo          `json:"products_info"`
+	PromotionInfo       *PromotionInfo        `json:"promotions_info"`
+	ProductDetail       []*ProductDetail      `json:"products_detail"`
+	VariantAxes         []*VariantAxis        `json:"variant_axes"`
+	VariantCombinations []*VariantCombination `json:"variant_combinations"`
 }
 
 type ProductInfo struct {
-	ProductID     string   `json:"id"`
-	SKU           string   `json:"sku"`
-	Title         string   `json:"title"`
-	Description   string   `json:"description"`
-	ViewCount     int64    `json:"view_count"`
-	FavoriteCount int64    `json:"favorite_count"`
-	UnitSold      float64  `json:"unit_sold"`
-	ListedStatus  bool     `json:"listed_status"`
-	ThumbnailURL  string   `json:"thumbnail_url"`
-	RatingAVG     *float64 `json:"rating_avg"`
-	MinPrice      *float64 `json:"min_price"`
-	MaxPrice      *float64 `json:"max_price"`
-	ShopID        string   `json:"shop_id"`
-	CategoryName  string   `json:"category_name"`
-	CategoryURL   string   `json:"category_url"`
+	ProductID     string       `json:"id"`
+	SKU           string       `json:"sku"`
+	Title         string       `json:"title"`
+	Description   string       `json:"description"`
+	ViewCount     int64        `json:"view_count"`
+	FavoriteCount int64        `json:"favorite_count"`
+	UnitSold      float64      `json:"unit_sold"`
+	ListedStatus  bool         `json:"listed_status"`
+	ThumbnailURL  string       `json:"thumbnail_url"`
+	RatingAVG     *float64     `json:"rating_avg"`
+	MinPrice      *model.Money `json:"min_price"`
+	MaxPrice      *model.Money `json:"max_price"`
+	ShopID        string       `json:"shop_id"`
+	CategoryName  string       `json:"category_name"`
+	CategoryURL   string       `json:"category_url"`
 }
 
 type PromotionInfo struct {
@@ -42,19 +47,21 @@ type PromotionInfo struct {
 }
 
 type ProductDetail struct {
-	ProductDetailID string            `json:"id"`
-	NormalPrice     *float64          `json:"normal_price"`
-	DiscountPrice   *float64          `json:"discount_price"`
-	Stock           *float64          `json:"stock"`
-	Weight          *float64          `json:"weight"`
-	Size            *float64          `json:"size"`
-	Hazardous       bool              `json:"hazardous"`
-	Condition       *string           `json:"condition"`
-	BulkPrice       bool              `json:"bulk_price"`
-	ShopID          string            `json:"shop_id"`
-	ProductURL      []string          `json:"product_url"`
-	Variant         map[string]string `json:"variant"`
-	VariantInfos    []VariantInfo     `json:"variant_info"`
+	ProductDetailID string             `json:"id"`
+	NormalPrice     *float64           `json:"normal_price"`
+	DiscountPrice   *float64           `json:"discount_price"`
+	Stock           *float64           `json:"stock"`
+	AvailableStock  *float64           `json:"available_stock"`
+	Weight          *float64           `json:"weight"`
+	Size            *float64           `json:"size"`
+	Hazardous       bool               `json:"hazardous"`
+	Condition       *string            `json:"condition"`
+	BulkPrice       bool               `json:"bulk_price"`
+	ShopID          string             `json:"shop_id"`
+	ProductURL      []string           `json:"product_url"`
+	Variant         map[string]string  `json:"variant"`
+	VariantInfos    []VariantInfo      `json:"variant_info"`
+	PriceTiers      []*model.PriceTier `json:"price_tiers"`
 }
 
 type VariantDetail struct {
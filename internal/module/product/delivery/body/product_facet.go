@@ -0,0 +1,22 @@
+package body
+
+import "murakali/internal/model"
+
+type FacetCount struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+	Count int64  `json:"count"`
+}
+
+type PriceBucket struct {
+	From  model.Money `json:"from"`
+	To    model.Money `json:"to"`
+	Count int64       `json:"count"`
+}
+
+type ProductFacets struct {
+	Categories []*FacetCount  `json:"categories"`
+	Provinces  []*FacetCount  `json:"provinces"`
+	Ratings    []*FacetCount  `json:"ratings"`
+	Prices     []*PriceBucket `json:"prices"`
+}
@@ -0,0 +1,92 @@
+package body
+
+import (
+	"fmt"
+	"murakali/internal/model"
+	"reflect"
+	"time"
+)
+
+const (
+	ProductVersionNotFound  = "Product version not found."
+	ProductVersionIsCurrent = "Product is already at this version."
+	ProductRollbackNote     = "Rollback to version %d"
+)
+
+type ProductPriceHistoryResponse struct {
+	ProductDetailID string        `json:"product_detail_id"`
+	Prices          []*PricePoint `json:"prices"`
+}
+
+type PricePoint struct {
+	Price     model.Money `json:"price"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+func DiffProductSnapshots(before, after *model.ProductSnapshot) []*model.ProductChange {
+	changes := make([]*model.ProductChange, 0)
+	changes = appendProductChange(changes, "title", before.Title, after.Title)
+	changes = appendProductChange(changes, "description", before.Description, after.Description)
+	changes = appendProductChange(changes, "thumbnail", before.Thumbnail, after.Thumbnail)
+	changes = appendProductChange(changes, "listed_status", before.ListedStatus, after.ListedStatus)
+
+	afterDetails := make(map[string]*model.ProductDetailSnapshot)
+	for _, detail := range after.ProductDetail {
+		afterDetails[detail.ID] = detail
+	}
+
+	beforeDetails := make(map[string]bool)
+	for _, old := range before.ProductDetail {
+		beforeDetails[old.ID] = true
+		field := fmt.Sprintf("products_detail.%s", old.ID)
+		detail, ok := afterDetails[old.ID]
+		if !ok {
+			changes = append(changes, &model.ProductChange{Field: field, Old: old, New: nil})
+			continue
+		}
+
+		changes = appendProductChange(changes, field+".price", old.Price, detail.Price)
+		changes = appendProductChange(changes, field+".stock", old.Stock, detail.Stock)
+		changes = appendProductChange(changes, field+".weight", old.Weight, detail.Weight)
+		changes = appendProductChange(changes, field+".size", old.Size, detail.Size)
+		changes = appendProductChange(changes, field+".hazardous", old.Hazardous, detail.Hazardous)
+		changes = appendProductChange(changes, field+".condition", old.Condition, detail.Condition)
+		changes = appendProductChange(changes, field+".bulk_price", old.BulkPrice, detail.BulkPrice)
+		changes = appendProductChange(changes, field+".photo", old.Photo, detail.Photo)
+		changes = appendProductChange(changes, field+".price_tiers", old.PriceTiers, detail.PriceTiers)
+		changes = appendProductChange(changes, field+".variant", old.Variant, detail.Variant)
+	}
+
+	for _, detail := range after.ProductDetail {
+		if !beforeDetails[detail.ID] {
+			changes = append(changes, &model.ProductChange{Field: fmt.Sprintf("products_detail.%s", detail.ID), Old: nil, New: detail})
+		}
+	}
+
+	return changes
+}
+
+func GroupPriceHistory(history []*model.PriceHistory) []*ProductPriceHistoryResponse {
+	result := make([]*ProductPriceHistoryResponse, 0)
+	index := make(map[string]*ProductPriceHistoryResponse)
+	for _, point := range history {
+		series, ok := index[point.ProductDetailID]
+		if !ok {
+			series = &ProductPriceHistoryResponse{ProductDetailID: point.ProductDetailID, Prices: make([]*PricePoint, 0)}
+			index[point.ProductDetailID] = series
+			result = append(result, series)
+		}
+
+		series.Prices = append(series.Prices, &PricePoint{Price: point.Price, CreatedAt: point.CreatedAt})
+	}
+
+	return result
+}
+
+func appendProductChange(changes []*model.ProductChange, field string, old, new interface{}) []*model.ProductChange {
+	if reflect.DeepEqual(old, new) {
+		return changes
+	}
+
+	return append(changes, &model.ProductChange{Field: field, Old: old, New: new})
+}
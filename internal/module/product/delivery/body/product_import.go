@@ -0,0 +1,262 @@
+package body
+
+import (
+	"murakali/internal/model"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	InvalidNumberMessage         = "Field must be a number."
+	InvalidBooleanMessage        = "Field must be true or false."
+	InvalidVariantFormatMessage  = "Variants must be written as type:name separated by |."
+	ProductImportSkippedMessage  = "Product skipped because another of its rows is invalid."
+	ProductImportFailedMessage   = "Failed to create product."
+	ProductImportJobNotFound     = "Import job not found."
+	InvalidCSVFileMessage        = "File must be a valid CSV."
+	EmptyCSVFileMessage          = "CSV file has no rows."
+	TooManyCSVRowsMessage        = "CSV file has too many rows."
+	CSVFileTooLargeMessage       = "CSV file is too large."
+	ProductImportListSeparator   = "|"
+	ProductImportVariantSplitter = ":"
+)
+
+var productImportFields = map[string]string{
+	"products_info.title":       "title",
+	"products_info.description": "description",
+	"products_info.thumbnail":   "thumbnail",
+	"products_info.category_id": "category_id",
+	"products_detail":           "product_ref",
+	"photo":                     "photos",
+	"variant_detail":            "variants",
+	"type":                      "variants",
+	"name":                      "variants",
+}
+
+type ProductCSVRow struct {
+	ProductRef   string `csv:"product_ref"`
+	Title        string `csv:"title"`
+	Description  string `csv:"description"`
+	Thumbnail    string `csv:"thumbnail"`
+	CategoryID   string `csv:"category_id"`
+	ListedStatus string `csv:"listed_status"`
+	Price        string `csv:"price"`
+	Stock        string `csv:"stock"`
+	Weight       string `csv:"weight"`
+	Size         string `csv:"size"`
+	Hazardous    string `csv:"hazardous"`
+	Condition    string `csv:"condition"`
+	BulkPrice    string `csv:"bulk_price"`
+	Photos       string `csv:"photos"`
+	Variants     string `csv:"variants"`
+}
+
+type ProductImportGroup struct {
+	Rows    []int
+	Request CreateProductRequest
+}
+
+func (r *ProductCSVRow) ToProductInfo() (CreateProductInfo, map[string]string) {
+	invalidFields := make(map[string]string)
+	listedStatus, ok := parseCSVBool(r.ListedStatus)
+	if !ok {
+		invalidFields["listed_status"] = InvalidBooleanMessage
+	}
+
+	return CreateProductInfo{
+		Title:        r.Title,
+		Description:  r.Description,
+		Thumbnail:    r.Thumbnail,
+		CategoryID:   r.CategoryID,
+		ListedStatus: listedStatus,
+	}, invalidFields
+}
+
+func (r *ProductCSVRow) ToProductDetail() (CreateProductDetailRequest, map[string]string) {
+	invalidFields := make(map[string]string)
+	numbers := map[string]string{"price": r.Price, "stock": r.Stock, "weight": r.Weight, "size": r.Size}
+	values := make(map[string]float64)
+	for field, value := range numbers {
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			invalidFields[field] = InvalidNumberMessage
+			continue
+		}
+
+		values[field] = number
+	}
+
+	hazardous, ok := parseCSVBool(r.Hazardous)
+	if !ok {
+		invalidFields["hazardous"] = InvalidBooleanMessage
+	}
+
+	bulkPrice, ok := parseCSVBool(r.BulkPrice)
+	if !ok {
+		invalidFields["bulk_price"] = InvalidBooleanMessage
+	}
+
+	photos := make([]string, 0)
+	for _, photo := range strings.Split(r.Photos, ProductImportListSeparator) {
+		if photo = strings.TrimSpace(photo); photo != "" {
+			photos = append(photos, photo)
+		}
+	}
+
+	variants := make([]VariantDetailRequest, 0)
+	for _, variant := range strings.Split(r.Variants, ProductImportListSeparator) {
+		if strings.TrimSpace(variant) == "" {
+			continue
+		}
+
+		parts := strings.SplitN(variant, ProductImportVariantSplitter, 2)
+		if len(parts) != 2 {
+			invalidFields["variants"] = InvalidVariantFormatMessage
+			continue
+		}
+
+		variants = append(variants, VariantDetailRequest{Type: parts[0], Name: parts[1]})
+	}
+
+	return CreateProductDetailRequest{
+		Price:         model.NewMoney(values["price"]),
+		Stock:         values["stock"],
+		Weight:        values["weight"],
+		Size:          values["size"],
+		Hazardous:     hazardous,
+		Codition:      r.Condition,
+		BulkPrice:     bulkPrice,
+		Photo:         photos,
+		VariantDetail: variants,
+	}, invalidFields
+}
+
+func GroupProductCSVRows(rows []*ProductCSVRow) ([]*ProductImportGroup, []*model.ProductImportError) {
+	groups := make([]*ProductImportGroup, 0)
+	groupByRef := make(map[string]*ProductImportGroup)
+	invalidGroups := make(map[*ProductImportGroup]bool)
+	invalidRows := make(map[int]bool)
+	rowErrors := make([]*model.ProductImportError, 0)
+
+	for i, row := range rows {
+		rowNumber := i + 2
+		ref := strings.TrimSpace(row.ProductRef)
+		if ref == "" {
+			rowErrors = append(rowErrors, &model.ProductImportError{Row: rowNumber, Field: "product_ref", Message: FieldCannotBeEmptyMessage})
+			continue
+		}
+
+		invalidFields := make(map[string]string)
+		group, exist := groupByRef[ref]
+		if !exist {
+			info, infoFields := row.ToProductInfo()
+			for field, message := range infoFields {
+				invalidFields[field] = message
+			}
+
+			group = &ProductImportGroup{Request: CreateProductRequest{ProductInfo: info}}
+			groupByRef[ref] = group
+			groups = append(groups, group)
+		}
+
+		detail, detailFields := row.ToProductDetail()
+		for field, message := range detailFields {
+			invalidFields[field] = message
+		}
+
+		request := CreateProductRequest{ProductInfo: group.Request.ProductInfo, ProductDetail: []CreateProductDetailRequest{detail}}
+		entity, _ := request.ValidateCreateProduct()
+		for field, message := range entity.Fields {
+			if message == "" || (exist && strings.HasPrefix(field, "products_info.")) {
+				continue
+			}
+
+			if csvField, ok := productImportFields[field]; ok {
+				field = csvField
+			}
+
+			if _, ok := invalidFields[field]; !ok {
+				invalidFields[field] = message
+			}
+		}
+
+		if !exist {
+			group.Request.ProductInfo = request.ProductInfo
+		}
+
+		group.Rows = append(group.Rows, rowNumber)
+		if len(invalidFields) > 0 {
+			invalidGroups[group] = true
+			invalidRows[rowNumber] = true
+			rowErrors = append(rowErrors, toProductImportErrors(rowNumber, invalidFields)...)
+			continue
+		}
+
+		group.Request.ProductDetail = append(group.Request.ProductDetail, request.ProductDetail[0])
+	}
+
+	for _, group := range groups {
+		if invalidGroups[group] {
+			continue
+		}
+
+		if _, message := group.Request.ValidateVariantMatrix(); message != "" {
+			invalidGroups[group] = true
+			for _, rowNumber := range group.Rows {
+				invalidRows[rowNumber] = true
+				rowErrors = append(rowErrors, &model.ProductImportError{Row: rowNumber, Field: "variants", Message: message})
+			}
+		}
+	}
+
+	validGroups := make([]*ProductImportGroup, 0)
+	for _, group := range groups {
+		if !invalidGroups[group] {
+			validGroups = append(validGroups, group)
+			continue
+		}
+
+		for _, rowNumber := range group.Rows {
+			if !invalidRows[rowNumber] {
+				rowErrors = append(rowErrors, &model.ProductImportError{Row: rowNumber, Field: "product_ref", Message: ProductImportSkippedMessage})
+			}
+		}
+	}
+
+	SortProductImportErrors(rowErrors)
+	return validGroups, rowErrors
+}
+
+func SortProductImportErrors(rowErrors []*model.ProductImportError) {
+	sort.SliceStable(rowErrors, func(i, j int) bool {
+		if rowErrors[i].Row != rowErrors[j].Row {
+			return rowErrors[i].Row < rowErrors[j].Row
+		}
+
+		return rowErrors[i].Field < rowErrors[j].Field
+	})
+}
+
+func toProductImportErrors(rowNumber int, invalidFields map[string]string) []*model.ProductImportError {
+	rowErrors := make([]*model.ProductImportError, 0, len(invalidFields))
+	for field, message := range invalidFields {
+		rowErrors = append(rowErrors, &model.ProductImportError{Row: rowNumber, Field: field, Message: message})
+	}
+
+	return rowErrors
+}
+
+func parseCSVBool(value string) (bool, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return false, true
+	}
+
+	parsed, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, false
+	}
+
+	return parsed, true
+}
@@ -1,6 +1,7 @@
 package body
 
 import (
+	"murakali/internal/model"
 	"murakali/pkg/httperror"
 	"murakali/pkg/response"
 	"net/http"
@@ -27,23 +28,24 @@ type UpdateProductInfoForQuery struct {
 	Description  string
 	Thumbnail    string
 	CategoryID   string
-	MinPrice     float64
-	MaxPrice     float64
+	MinPrice     model.Money
+	MaxPrice     model.Money
 	ListedStatus bool
 }
 
 type UpdateProductDetailRequest struct {
-	ProductDetailID string          `json:"product_detail_id"`
-	Price           float64         `json:"price"`
-	Stock           float64         `json:"stock"`
-	Weight          float64         `json:"weight"`
-	Size            float64         `json:"size"`
-	Hazardous       bool            `json:"hazardous"`
-	Codition        string          `json:"condition"`
-	BulkPrice       bool            `json:"bulk_price"`
-	Photo           []string        `json:"photo"`
-	VariantDetailID []UpdateVariant `json:"variant_info_update"`
-	VariantIDRemove []string        `json:"variant_id_remove"`
+	ProductDetailID string             `json:"product_detail_id"`
+	Price           model.Money        `json:"price"`
+	Stock           float64            `json:"stock"`
+	Weight          float64            `json:"weight"`
+	Size            float64            `json:"size"`
+	Hazardous       bool               `json:"hazardous"`
+	Codition        string             `json:"condition"`
+	BulkPrice       bool               `json:"bulk_price"`
+	Photo           []string           `json:"photo"`
+	VariantDetailID []UpdateVariant    `json:"variant_info_update"`
+	VariantIDRemove []string           `json:"variant_id_remove"`
+	PriceTiers      []PriceTierRequest `json:"price_tiers"`
 }
 
 type UpdateVariant struct {
@@ -52,8 +54,8 @@ type UpdateVariant struct {
 }
 
 type RangePrice struct {
-	MinPrice float64
-	MaxPrice float64
+	MinPrice model.Money
+	MaxPrice model.Money
 }
 
 type UpdateProductListedStatusBulkRequest struct {
@@ -123,6 +125,13 @@ func (r *UpdateProductRequest) ValidateUpdateProduct() (UnprocessableEntity, err
 			unprocessableEntity = true
 			entity.Fields["photo"] = FieldCannotBeEmptyMessage
 		}
+		if r.ProductDetail[i].PriceTiers != nil {
+			if message := ValidatePriceTiers(r.ProductDetail[i].Price, r.ProductDetail[i].PriceTiers); message != "" {
+				unprocessableEntity = true
+				entity.Fields["price_tiers"] = message
+			}
+			r.ProductDetail[i].BulkPrice = len(r.ProductDetail[i].PriceTiers) > 0
+		}
 		totalDataVariant := len(r.ProductDetail[i].VariantDetailID)
 		if totalDataVariant > 0 {
 			for j := 0; j < totalDataVariant; j++ {
@@ -0,0 +1,284 @@
+package body
+
+import (
+	"murakali/internal/constant"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+const (
+	VariantAxisLimitMessage            = "Product must have between 1 and 3 variant axes."
+	VariantOptionLimitMessage          = "Each variant axis must have between 1 and 20 options."
+	InvalidVariantAxesMessage          = "Variant axis names and options must be filled and unique."
+	TooManyVariantCombinationsMessage  = "Variant axes produce more than 100 combinations."
+	VariantCombinationMismatchMessage  = "Every product detail must pick exactly one option of each variant axis."
+	DuplicateVariantCombinationMessage = "Variant combination is used by more than one product detail."
+	variantCombinationSeparator        = "\x00"
+)
+
+type VariantAxisRequest struct {
+	Name    string   `json:"name"`
+	Options []string `json:"options"`
+}
+
+type VariantGridRequest struct {
+	VariantAxes []VariantAxisRequest `json:"variant_axes"`
+}
+
+type VariantGridResponse struct {
+	VariantAxes   []VariantAxisRequest         `json:"variant_axes"`
+	ProductDetail []CreateProductDetailRequest `json:"products_detail"`
+}
+
+type VariantAxis struct {
+	Name    string           `json:"name"`
+	Options []*VariantOption `json:"options"`
+}
+
+type VariantOption struct {
+	Name      string `json:"name"`
+	Available bool   `json:"available"`
+}
+
+type VariantCombination struct {
+	ProductDetailID string   `json:"product_detail_id"`
+	Options         []string `json:"options"`
+	AvailableStock  *float64 `json:"available_stock"`
+}
+
+func (r *VariantGridRequest) Validate() (UnprocessableEntity, error) {
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"variant_axes": "",
+		},
+	}
+
+	if message := ValidateVariantAxes(r.VariantAxes); message != "" {
+		entity.Fields["variant_axes"] = message
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+func (r *CreateProductRequest) ResolveVariantAxes() {
+	if len(r.VariantAxes) > 0 {
+		return
+	}
+
+	details := make([][]VariantDetailRequest, 0, len(r.ProductDetail))
+	for i := range r.ProductDetail {
+		details = append(details, r.ProductDetail[i].VariantDetail)
+	}
+	r.VariantAxes = InferVariantAxes(details)
+}
+
+func (r *CreateProductRequest) ValidateVariantMatrix() (field, message string) {
+	r.ResolveVariantAxes()
+	if message = ValidateVariantAxes(r.VariantAxes); message != "" {
+		return "variant_axes", message
+	}
+
+	details := make([][]VariantDetailRequest, 0, len(r.ProductDetail))
+	for i := range r.ProductDetail {
+		details = append(details, r.ProductDetail[i].VariantDetail)
+	}
+	if message = ValidateVariantCombinations(r.VariantAxes, details); message != "" {
+		return "variant_detail", message
+	}
+
+	return "", ""
+}
+
+func ValidateVariantAxes(axes []VariantAxisRequest) string {
+	if len(axes) == 0 || len(axes) > constant.ProductVariantMaxAxes {
+		return VariantAxisLimitMessage
+	}
+
+	names := make(map[string]bool)
+	combinations := 1
+	for i := range axes {
+		axes[i].Name = strings.TrimSpace(axes[i].Name)
+		name := strings.ToLower(axes[i].Name)
+		if name == "" || names[name] {
+			return InvalidVariantAxesMessage
+		}
+		names[name] = true
+
+		if len(axes[i].Options) == 0 || len(axes[i].Options) > constant.ProductVariantMaxOptions {
+			return VariantOptionLimitMessage
+		}
+
+		options := make(map[string]bool)
+		for j := range axes[i].Options {
+			axes[i].Options[j] = strings.TrimSpace(axes[i].Options[j])
+			option := strings.ToLower(axes[i].Options[j])
+			if option == "" || options[option] {
+				return InvalidVariantAxesMessage
+			}
+			options[option] = true
+		}
+
+		combinations *= len(axes[i].Options)
+	}
+
+	if combinations > constant.ProductVariantMaxCombinations {
+		return TooManyVariantCombinationsMessage
+	}
+
+	return ""
+}
+
+func ValidateVariantCombinations(axes []VariantAxisRequest, details [][]VariantDetailRequest) string {
+	axisIndex := make(map[string]int)
+	axisOptions := make([]map[string]bool, len(axes))
+	for i, axis := range axes {
+		axisIndex[axis.Name] = i
+		axisOptions[i] = make(map[string]bool)
+		for _, option := range axis.Options {
+			axisOptions[i][option] = true
+		}
+	}
+
+	combinations := make(map[string]bool)
+	for _, variants := range details {
+		if len(variants) != len(axes) {
+			return VariantCombinationMismatchMessage
+		}
+
+		combination := make([]string, len(axes))
+		for _, variant := range variants {
+			i, ok := axisIndex[variant.Type]
+			if !ok || combination[i] != "" || !axisOptions[i][variant.Name] {
+				return VariantCombinationMismatchMessage
+			}
+			combination[i] = variant.Name
+		}
+
+		key := strings.Join(combination, variantCombinationSeparator)
+		if combinations[key] {
+			return DuplicateVariantCombinationMessage
+		}
+		combinations[key] = true
+	}
+
+	return ""
+}
+
+func InferVariantAxes(details [][]VariantDetailRequest) []VariantAxisRequest {
+	axes := make([]VariantAxisRequest, 0)
+	axisIndex := make(map[string]int)
+	options := make([]map[string]bool, 0)
+	for _, variants := range details {
+		for _, variant := range variants {
+			i, ok := axisIndex[variant.Type]
+			if !ok {
+				i = len(axes)
+				axisIndex[variant.Type] = i
+				axes = append(axes, VariantAxisRequest{Name: variant.Type, Options: make([]string, 0)})
+				options = append(options, make(map[string]bool))
+			}
+
+			if !options[i][variant.Name] {
+				options[i][variant.Name] = true
+				axes[i].Options = append(axes[i].Options, variant.Name)
+			}
+		}
+	}
+
+	return axes
+}
+
+func GenerateVariantGrid(axes []VariantAxisRequest) [][]VariantDetailRequest {
+	grid := [][]VariantDetailRequest{{}}
+	for _, axis := range axes {
+		next := make([][]VariantDetailRequest, 0, len(grid)*len(axis.Options))
+		for _, combination := range grid {
+			for _, option := range axis.Options {
+				variants := make([]VariantDetailRequest, 0, len(combination)+1)
+				variants = append(variants, combination...)
+				variants = append(variants, VariantDetailRequest{Type: axis.Name, Name: option})
+				next = append(next, variants)
+			}
+		}
+		grid = next
+	}
+
+	return grid
+}
+
+func BuildVariantPicker(axes []*VariantAxis, details []*ProductDetail) ([]*VariantAxis, []*VariantCombination) {
+	detailOptions := make([]map[string]string, 0, len(details))
+	for _, detail := range details {
+		options := make(map[string]string)
+		for name, variantType := range detail.Variant {
+			options[variantType] = name
+		}
+		detailOptions = append(detailOptions, options)
+	}
+
+	if len(axes) == 0 {
+		axes = inferVariantPickerAxes(detailOptions)
+	}
+
+	availableOptions := make(map[string]bool)
+	combinations := make([]*VariantCombination, 0, len(details))
+	for i, detail := range details {
+		combination := &VariantCombination{
+			ProductDetailID: detail.ProductDetailID,
+			Options:         make([]string, len(axes)),
+			AvailableStock:  detail.AvailableStock,
+		}
+		available := detail.AvailableStock != nil && *detail.AvailableStock > 0
+		for j, axis := range axes {
+			combination.Options[j] = detailOptions[i][axis.Name]
+			if available {
+				availableOptions[axis.Name+variantCombinationSeparator+combination.Options[j]] = true
+			}
+		}
+		combinations = append(combinations, combination)
+	}
+
+	for _, axis := range axes {
+		for _, option := range axis.Options {
+			option.Available = availableOptions[axis.Name+variantCombinationSeparator+option.Name]
+		}
+	}
+
+	return axes, combinations
+}
+
+func inferVariantPickerAxes(detailOptions []map[string]string) []*VariantAxis {
+	options := make(map[string]map[string]bool)
+	for _, detail := range detailOptions {
+		for variantType, name := range detail {
+			if options[variantType] == nil {
+				options[variantType] = make(map[string]bool)
+			}
+			options[variantType][name] = true
+		}
+	}
+
+	axes := make([]*VariantAxis, 0, len(options))
+	for variantType, names := range options {
+		axis := &VariantAxis{Name: variantType, Options: make([]*VariantOption, 0, len(names))}
+		for name := range names {
+			axis.Options = append(axis.Options, &VariantOption{Name: name})
+		}
+		sort.Slice(axis.Options, func(i, j int) bool {
+			return axis.Options[i].Name < axis.Options[j].Name
+		})
+		axes = append(axes, axis)
+	}
+	sort.Slice(axes, func(i, j int) bool {
+		return axes[i].Name < axes[j].Name
+	})
+
+	return axes
+}
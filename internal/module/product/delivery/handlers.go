@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"murakali/config"
 	"murakali/internal/constant"
+	"murakali/internal/model"
 	"murakali/internal/module/product"
 	"murakali/internal/module/product/delivery/body"
 	"murakali/internal/util"
@@ -17,6 +18,7 @@ import (
 	"strings"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gocarina/gocsv"
 	"github.com/google/uuid"
 )
 
@@ -468,6 +470,12 @@ func (h *productHandlers) ValidateQueryProduct(c *gin.Context) (*pagination.Pagi
 			Page:  pageFilter,
 			Sort:  "listed_status" + " " + sortFilter,
 		}
+	case "relevance":
+		pgn = &pagination.Pagination{
+			Limit: limitFilter,
+			Page:  pageFilter,
+			Sort:  "relevance " + sortFilter + ", " + "unit_sold " + sortFilter,
+		}
 	default:
 		pgn = &pagination.Pagination{
 			Limit: limitFilter,
@@ -512,10 +520,11 @@ func (h *productHandlers) ValidateQueryProduct(c *gin.Context) (*pagination.Pagi
 	}
 	query := &body.GetProductQueryRequest{
 		Search:       searchFilter,
+		Keyword:      search,
 		Shop:         shop,
 		Category:     categoryFilter,
-		MinPrice:     minPriceFilter,
-		MaxPrice:     maxPriceFilter,
+		MinPrice:     model.NewMoney(minPriceFilter),
+		MaxPrice:     model.NewMoney(maxPriceFilter),
 		MinRating:    minRatingFilter,
 		MaxRating:    maxRatingFilter,
 		Province:     provinceFilter,
@@ -524,21 +533,6 @@ func (h *productHandlers) ValidateQueryProduct(c *gin.Context) (*pagination.Pagi
 	return pgn, query
 }
 
-func (h *productHandlers) UpdateProductMetadata(c *gin.Context) {
-	if err := h.productUC.UpdateProductMetadata(c); err != nil {
-		var e *httperror.Error
-		if !errors.As(err, &e) {
-			h.logger.Errorf("HandlerProduct, Error: %s", err)
-			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
-			return
-		}
-		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
-		return
-	}
-
-	response.SuccessResponse(c.Writer, nil, http.StatusOK)
-}
-
 func (h *productHandlers) CreateProduct(c *gin.Context) {
 	userID, exist := c.Get("userID")
 	if !exist {
@@ -867,3 +861,362 @@ func (h *productHandlers) GetTotalReviewRatingByProductID(c *gin.Context) {
 
 	response.SuccessResponse(c.Writer, reviewRating, http.StatusOK)
 }
+
+func (h *productHandlers) ImportProducts(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	file, header, err := c.Request.FormFile("file")
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+	defer file.Close()
+
+	if header.Size > constant.ProductImportMaxSize {
+		response.ErrorResponse(c.Writer, body.CSVFileTooLargeMessage, http.StatusBadRequest)
+		return
+	}
+
+	rows := make([]*body.ProductCSVRow, 0)
+	if err := gocsv.Unmarshal(file, &rows); err != nil {
+		response.ErrorResponse(c.Writer, body.InvalidCSVFileMessage, http.StatusBadRequest)
+		return
+	}
+
+	if len(rows) == 0 {
+		response.ErrorResponse(c.Writer, body.EmptyCSVFileMessage, http.StatusBadRequest)
+		return
+	}
+
+	if len(rows) > constant.ProductImportMaxRows {
+		response.ErrorResponse(c.Writer, body.TooManyCSVRowsMessage, http.StatusBadRequest)
+		return
+	}
+
+	job, err := h.productUC.ImportProducts(c, userID.(string), rows)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, job, http.StatusAccepted)
+}
+
+func (h *productHandlers) GetProductImportJob(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	jobID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	job, err := h.productUC.GetProductImportJob(c, userID.(string), jobID.String())
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, job, http.StatusOK)
+}
+
+func (h *productHandlers) ExportProducts(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	rows, err := h.productUC.ExportProducts(c, userID.(string))
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	c.Header("Content-Type", "text/csv")
+	c.Header("Content-Disposition", "attachment; filename=products.csv")
+	c.Status(http.StatusOK)
+
+	if err := gocsv.Marshal(rows, c.Writer); err != nil {
+		h.logger.Errorf("HandlerProduct, Error: %s", err)
+	}
+}
+
+func (h *productHandlers) GenerateVariantGrid(c *gin.Context) {
+	var requestBody body.VariantGridRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	invalidFields, err := requestBody.Validate()
+	if err != nil {
+		response.ErrorResponseData(c.Writer, invalidFields, response.UnprocessableEntityMessage, http.StatusUnprocessableEntity)
+		return
+	}
+
+	grid := body.GenerateVariantGrid(requestBody.VariantAxes)
+	details := make([]body.CreateProductDetailRequest, 0, len(grid))
+	for _, variants := range grid {
+		details = append(details, body.CreateProductDetailRequest{Photo: make([]string, 0), VariantDetail: variants})
+	}
+
+	response.SuccessResponse(c.Writer, body.VariantGridResponse{
+		VariantAxes:   requestBody.VariantAxes,
+		ProductDetail: details,
+	}, http.StatusOK)
+}
+
+func (h *productHandlers) GetProductVersions(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	productID, err := uuid.Parse(c.Param("product_id"))
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	pgn := h.ValidateQueryPagination(c)
+	versions, err := h.productUC.GetProductVersions(c, userID.(string), productID.String(), pgn)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, versions, http.StatusOK)
+}
+
+func (h *productHandlers) ValidateQueryPagination(c *gin.Context) *pagination.Pagination {
+	limit := strings.TrimSpace(c.Query("limit"))
+	page := strings.TrimSpace(c.Query("page"))
+
+	limitFilter, err := strconv.Atoi(limit)
+	if err != nil || limitFilter < 1 {
+		limitFilter = 10
+	}
+
+	pageFilter, err := strconv.Atoi(page)
+	if err != nil || pageFilter < 1 {
+		pageFilter = 1
+	}
+
+	return &pagination.Pagination{
+		Limit: limitFilter,
+		Page:  pageFilter,
+	}
+}
+
+func (h *productHandlers) RollbackProduct(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	productID, err := uuid.Parse(c.Param("product_id"))
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	version, err := strconv.Atoi(c.Param("version"))
+	if err != nil || version < 1 {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	err = h.productUC.RollbackProduct(c, userID.(string), productID.String(), version)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
+
+func (h *productHandlers) GetPriceHistory(c *gin.Context) {
+	productID, err := uuid.Parse(c.Param("product_id"))
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	history, err := h.productUC.GetPriceHistory(c, productID.String())
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, history, http.StatusOK)
+}
+
+func (h *productHandlers) CreateProductAlert(c *gin.Context) {
+	var requestBody body.CreateProductAlertRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	invalidFields, err := requestBody.Validate()
+	if err != nil {
+		response.ErrorResponseData(c.Writer, invalidFields, response.UnprocessableEntityMessage, http.StatusUnprocessableEntity)
+		return
+	}
+
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	productAlert, err := h.productUC.CreateProductAlert(c, userID.(string), requestBody)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, productAlert, http.StatusCreated)
+}
+
+func (h *productHandlers) GetProductAlerts(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	pgn := h.ValidateQueryPagination(c)
+	alerts, err := h.productUC.GetProductAlerts(c, userID.(string), pgn)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, alerts, http.StatusOK)
+}
+
+func (h *productHandlers) DeleteProductAlert(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	alertID, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	err = h.productUC.DeleteProductAlert(c, userID.(string), alertID.String())
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
+
+func (h *productHandlers) UnsubscribeProductAlert(c *gin.Context) {
+	var requestBody body.UnsubscribeProductAlertRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	invalidFields, err := requestBody.Validate()
+	if err != nil {
+		response.ErrorResponseData(c.Writer, invalidFields, response.UnprocessableEntityMessage, http.StatusUnprocessableEntity)
+		return
+	}
+
+	err = h.productUC.UnsubscribeProductAlert(c, requestBody.Token)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerProduct, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
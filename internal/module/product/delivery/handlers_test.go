@@ -1343,97 +1343,9 @@ func TestProductHandlers_GetAllProductImage(t *testing.T) {
 	}
 }
 
-func TestCartHandlers_UpdateProductMetadata(t *testing.T) {
-	testCase := []struct {
-		name       string
-		body       interface{}
-		mock       func(s *mocks.UseCase)
-		expected   int
-		authorized bool
-	}{
-		{
-			name: "success update product meta data",
-			body: body.GetProductRequest{
-				ProductID: "123456",
-			},
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateProductMetadata", mock.Anything).Return(nil)
-			},
-			expected:   http.StatusOK,
-			authorized: true,
-		},
-		{
-			name: "update product error custom",
-			body: body.GetProductRequest{
-				ProductID: "123456",
-			},
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateProductMetadata", mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
-			},
-			expected:   http.StatusBadRequest,
-			authorized: true,
-		},
-		{
-			name: "update product meta data error internal",
-			body: body.GetProductRequest{
-				ProductID: "123456",
-			},
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateProductMetadata", mock.Anything).Return(errors.New("test"))
-			},
-			expected:   http.StatusInternalServerError,
-			authorized: true,
-		},
-	}
-
-	for _, tc := range testCase {
-		t.Run(tc.name, func(t *testing.T) {
-			jsonValue, err := json.Marshal(tc.body)
-			if err != nil {
-				t.Error(err)
-			}
-
-			rr := httptest.NewRecorder()
-			c, _ := gin.CreateTestContext(rr)
-
-			r := httptest.NewRequest(http.MethodDelete, "/api/v1/product/favorite", bytes.NewBuffer(jsonValue))
-			r.Header = make(http.Header)
-
-			c.Request = r
-			if tc.authorized {
-				c.Set("userID", "123456")
-			}
-
-			c.Request.Header.Set("Content-Type", "application/json")
-			MockJsonPost(c, tc.body)
-
-			s := mocks.NewUseCase(t)
-
-			cfg := &config.Config{
-				Logger: config.LoggerConfig{
-					Development:       true,
-					DisableCaller:     false,
-					DisableStacktrace: false,
-					Encoding:          "json",
-					Level:             "info",
-				},
-			}
-
-			appLogger := logger.NewAPILogger(cfg)
-			appLogger.InitLogger()
-
-			h := NewProductHandlers(cfg, s, appLogger)
-
-			tc.mock(s)
-			h.UpdateProductMetadata(c)
-
-			assert.Equal(t, rr.Code, tc.expected)
-		})
-	}
-}
-
 func TestCartHandlers_CreateProduct(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	testCase := []struct {
 		name       string
 		body       interface{}
@@ -1452,7 +1364,7 @@ func TestCartHandlers_CreateProduct(t *testing.T) {
 					ListedStatus: true,
 				},
 				ProductDetail: []body.CreateProductDetailRequest{{
-					Price:     temp,
+					Price:     tempMoney,
 					Stock:     temp,
 					Weight:    temp,
 					Size:      temp,
@@ -1483,7 +1395,7 @@ func TestCartHandlers_CreateProduct(t *testing.T) {
 					ListedStatus: true,
 				},
 				ProductDetail: []body.CreateProductDetailRequest{{
-					Price:     temp,
+					Price:     tempMoney,
 					Stock:     temp,
 					Weight:    temp,
 					Size:      temp,
@@ -1530,7 +1442,7 @@ func TestCartHandlers_CreateProduct(t *testing.T) {
 					ListedStatus: true,
 				},
 				ProductDetail: []body.CreateProductDetailRequest{{
-					Price:     temp,
+					Price:     tempMoney,
 					Stock:     temp,
 					Weight:    temp,
 					Size:      temp,
@@ -1789,6 +1701,7 @@ func TestCartHandlers_UpdateListedStatusBulk(t *testing.T) {
 
 func TestCartHandlers_UpdateProduct(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	testCase := []struct {
 		name       string
 		body       interface{}
@@ -1807,7 +1720,7 @@ func TestCartHandlers_UpdateProduct(t *testing.T) {
 				},
 				ProductDetail: []body.UpdateProductDetailRequest{{
 					ProductDetailID: "989d94b7-58fc-4a76-ae01-1c1b47a0755c",
-					Price:           temp,
+					Price:           tempMoney,
 					Stock:           temp,
 					Weight:          temp,
 					Size:            temp,
@@ -1840,7 +1753,7 @@ func TestCartHandlers_UpdateProduct(t *testing.T) {
 				},
 				ProductDetail: []body.UpdateProductDetailRequest{{
 					ProductDetailID: "989d94b7-58fc-4a76-ae01-1c1b47a0755c",
-					Price:           temp,
+					Price:           tempMoney,
 					Stock:           temp,
 					Weight:          temp,
 					Size:            temp,
@@ -1891,7 +1804,7 @@ func TestCartHandlers_UpdateProduct(t *testing.T) {
 				},
 				ProductDetail: []body.UpdateProductDetailRequest{{
 					ProductDetailID: "989d94b7-58fc-4a76-ae01-1c1b47a0755c",
-					Price:           temp,
+					Price:           tempMoney,
 					Stock:           temp,
 					Weight:          temp,
 					Size:            temp,
@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"murakali/internal/constant"
 	"murakali/internal/middleware"
 	"murakali/internal/module/product"
 
@@ -18,9 +19,10 @@ func MapProductRoutes(productGroup *gin.RouterGroup, h product.Handlers, mw *mid
 	productGroup.GET("/:product_id/picture", h.GetAllProductImage)
 	productGroup.GET("/:product_id/review", h.GetProductReviews)
 	productGroup.GET("/:product_id/review/rating", h.GetTotalReviewRatingByProductID)
+	productGroup.GET("/:product_id/price-history", h.GetPriceHistory)
 	productGroup.GET("/", h.GetProducts)
 	productGroup.POST("/favorite/count", h.CountSpecificFavoriteProduct)
-	productGroup.POST("/metadata", h.UpdateProductMetadata)
+	productGroup.POST("/alert/unsubscribe", h.UnsubscribeProductAlert)
 
 	productGroup.Use(mw.AuthJWTMiddleware())
 	productGroup.GET("/favorite", h.GetFavoriteProducts)
@@ -28,10 +30,19 @@ func MapProductRoutes(productGroup *gin.RouterGroup, h product.Handlers, mw *mid
 	productGroup.POST("/picture", h.UploadProductPicture)
 	productGroup.POST("/favorite", h.CreateFavoriteProduct)
 	productGroup.DELETE("/favorite", h.DeleteFavoriteProduct)
+	productGroup.GET("/alert", h.GetProductAlerts)
+	productGroup.POST("/alert", h.CreateProductAlert)
+	productGroup.DELETE("/alert/:id", h.DeleteProductAlert)
 	productGroup.DELETE("/review/:review_id", h.DeleteProductReview)
 	productGroup.POST("/:product_id/review", h.CreateProductReview)
-	productGroup.Use(mw.SellerJWTMiddleware())
+	productGroup.Use(mw.RequireShopScope(constant.ShopScopeProducts))
 	productGroup.POST("/", h.CreateProduct)
+	productGroup.POST("/variant-grid", h.GenerateVariantGrid)
+	productGroup.POST("/import", h.ImportProducts)
+	productGroup.GET("/import/:id", h.GetProductImportJob)
+	productGroup.GET("/export", h.ExportProducts)
+	productGroup.GET("/:product_id/history", h.GetProductVersions)
+	productGroup.POST("/:product_id/history/:version/rollback", h.RollbackProduct)
 	productGroup.PUT("/status/:id", h.UpdateListedStatus)
 	productGroup.PATCH("/bulk-status", h.UpdateListedStatusBulk)
 	productGroup.PUT("/:id", h.UpdateProduct)
@@ -4,15 +4,14 @@ package mocks
 
 import (
 	context "context"
+	model "murakali/internal/model"
 	body "murakali/internal/module/product/delivery/body"
+	pagination "murakali/pkg/pagination"
+	postgre "murakali/pkg/postgre"
 
 	mock "github.com/stretchr/testify/mock"
 
-	model "murakali/internal/model"
-
-	pagination "murakali/pkg/pagination"
-
-	postgre "murakali/pkg/postgre"
+	time "time"
 
 	uuid "github.com/google/uuid"
 )
@@ -92,6 +91,34 @@ func (_m *Repository) CreatePhoto(ctx context.Context, tx postgre.Transaction, p
 	return r0
 }
 
+// CreatePriceHistory provides a mock function with given fields: ctx, tx, productDetailID, price
+func (_m *Repository) CreatePriceHistory(ctx context.Context, tx postgre.Transaction, productDetailID string, price model.Money) error {
+	ret := _m.Called(ctx, tx, productDetailID, price)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, model.Money) error); ok {
+		r0 = rf(ctx, tx, productDetailID, price)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePriceTier provides a mock function with given fields: ctx, tx, productDetailID, tier
+func (_m *Repository) CreatePriceTier(ctx context.Context, tx postgre.Transaction, productDetailID string, tier body.PriceTierRequest) error {
+	ret := _m.Called(ctx, tx, productDetailID, tier)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, body.PriceTierRequest) error); ok {
+		r0 = rf(ctx, tx, productDetailID, tier)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateProduct provides a mock function with given fields: ctx, tx, requestBody
 func (_m *Repository) CreateProduct(ctx context.Context, tx postgre.Transaction, requestBody body.CreateProductInfoForQuery) (string, error) {
 	ret := _m.Called(ctx, tx, requestBody)
@@ -113,6 +140,20 @@ func (_m *Repository) CreateProduct(ctx context.Context, tx postgre.Transaction,
 	return r0, r1
 }
 
+// CreateProductAlert provides a mock function with given fields: ctx, productAlert
+func (_m *Repository) CreateProductAlert(ctx context.Context, productAlert *model.ProductAlert) error {
+	ret := _m.Called(ctx, productAlert)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductAlert) error); ok {
+		r0 = rf(ctx, productAlert)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateProductDetail provides a mock function with given fields: ctx, tx, requestBody, ProductID
 func (_m *Repository) CreateProductDetail(ctx context.Context, tx postgre.Transaction, requestBody body.CreateProductDetailRequest, ProductID string) (string, error) {
 	ret := _m.Called(ctx, tx, requestBody, ProductID)
@@ -134,6 +175,20 @@ func (_m *Repository) CreateProductDetail(ctx context.Context, tx postgre.Transa
 	return r0, r1
 }
 
+// CreateProductImportJob provides a mock function with given fields: ctx, job
+func (_m *Repository) CreateProductImportJob(ctx context.Context, job *model.ProductImportJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductImportJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateProductReview provides a mock function with given fields: ctx, tx, userID, reqBody
 func (_m *Repository) CreateProductReview(ctx context.Context, tx postgre.Transaction, userID string, reqBody body.ReviewProductRequest) error {
 	ret := _m.Called(ctx, tx, userID, reqBody)
@@ -148,6 +203,20 @@ func (_m *Repository) CreateProductReview(ctx context.Context, tx postgre.Transa
 	return r0
 }
 
+// CreateProductVersion provides a mock function with given fields: ctx, tx, version
+func (_m *Repository) CreateProductVersion(ctx context.Context, tx postgre.Transaction, version *model.ProductVersion) error {
+	ret := _m.Called(ctx, tx, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.ProductVersion) error); ok {
+		r0 = rf(ctx, tx, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateVariant provides a mock function with given fields: ctx, tx, productDetailID, variantDetailID
 func (_m *Repository) CreateVariant(ctx context.Context, tx postgre.Transaction, productDetailID string, variantDetailID string) error {
 	ret := _m.Called(ctx, tx, productDetailID, variantDetailID)
@@ -162,6 +231,27 @@ func (_m *Repository) CreateVariant(ctx context.Context, tx postgre.Transaction,
 	return r0
 }
 
+// CreateVariantAxis provides a mock function with given fields: ctx, tx, productID, name, position
+func (_m *Repository) CreateVariantAxis(ctx context.Context, tx postgre.Transaction, productID string, name string, position int) (string, error) {
+	ret := _m.Called(ctx, tx, productID, name, position)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, string, int) string); ok {
+		r0 = rf(ctx, tx, productID, name, position)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string, string, int) error); ok {
+		r1 = rf(ctx, tx, productID, name, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateVariantDetail provides a mock function with given fields: ctx, tx, requestBody
 func (_m *Repository) CreateVariantDetail(ctx context.Context, tx postgre.Transaction, requestBody body.VariantDetailRequest) (string, error) {
 	ret := _m.Called(ctx, tx, requestBody)
@@ -183,6 +273,20 @@ func (_m *Repository) CreateVariantDetail(ctx context.Context, tx postgre.Transa
 	return r0, r1
 }
 
+// CreateVariantOption provides a mock function with given fields: ctx, tx, axisID, name, position
+func (_m *Repository) CreateVariantOption(ctx context.Context, tx postgre.Transaction, axisID string, name string, position int) error {
+	ret := _m.Called(ctx, tx, axisID, name, position)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, string, int) error); ok {
+		r0 = rf(ctx, tx, axisID, name, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteFavoriteProduct provides a mock function with given fields: ctx, tx, userID, productID
 func (_m *Repository) DeleteFavoriteProduct(ctx context.Context, tx postgre.Transaction, userID string, productID string) error {
 	ret := _m.Called(ctx, tx, userID, productID)
@@ -211,6 +315,62 @@ func (_m *Repository) DeletePhoto(ctx context.Context, tx postgre.Transaction, p
 	return r0
 }
 
+// DeletePriceTiers provides a mock function with given fields: ctx, tx, productDetailID
+func (_m *Repository) DeletePriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
+	ret := _m.Called(ctx, tx, productDetailID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, productDetailID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteProductAlert provides a mock function with given fields: ctx, userID, alertID
+func (_m *Repository) DeleteProductAlert(ctx context.Context, userID string, alertID string) (int64, error) {
+	ret := _m.Called(ctx, userID, alertID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, userID, alertID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, alertID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProductAlertByToken provides a mock function with given fields: ctx, token
+func (_m *Repository) DeleteProductAlertByToken(ctx context.Context, token string) (int64, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteProductDetail provides a mock function with given fields: ctx, tx, productDetailID
 func (_m *Repository) DeleteProductDetail(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
 	ret := _m.Called(ctx, tx, productDetailID)
@@ -253,6 +413,41 @@ func (_m *Repository) DeleteVariant(ctx context.Context, tx postgre.Transaction,
 	return r0
 }
 
+// DeleteVariantsByProductDetailID provides a mock function with given fields: ctx, tx, productDetailID
+func (_m *Repository) DeleteVariantsByProductDetailID(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
+	ret := _m.Called(ctx, tx, productDetailID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, productDetailID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// FailStaleProductImportJobs provides a mock function with given fields: ctx, createdBefore
+func (_m *Repository) FailStaleProductImportJobs(ctx context.Context, createdBefore time.Time) (int64, error) {
+	ret := _m.Called(ctx, createdBefore)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, createdBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindFavoriteProduct provides a mock function with given fields: ctx, userID, productID
 func (_m *Repository) FindFavoriteProduct(ctx context.Context, userID string, productID string) (bool, error) {
 	ret := _m.Called(ctx, userID, productID)
@@ -297,6 +492,29 @@ func (_m *Repository) FindReview(ctx context.Context, reviewID string) (*body.Re
 	return r0, r1
 }
 
+// GetAlertedProductIDs provides a mock function with given fields: ctx
+func (_m *Repository) GetAlertedProductIDs(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllFavoriteTotalProduct provides a mock function with given fields: ctx, query, userID
 func (_m *Repository) GetAllFavoriteTotalProduct(ctx context.Context, query *body.GetProductQueryRequest, userID string) (int64, error) {
 	ret := _m.Called(ctx, query, userID)
@@ -562,6 +780,121 @@ func (_m *Repository) GetMaxMinPriceByID(ctx context.Context, productID string)
 	return r0, r1
 }
 
+// GetPriceHistory provides a mock function with given fields: ctx, productID, since
+func (_m *Repository) GetPriceHistory(ctx context.Context, productID string, since time.Time) ([]*model.PriceHistory, error) {
+	ret := _m.Called(ctx, productID, since)
+
+	var r0 []*model.PriceHistory
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Time) []*model.PriceHistory); ok {
+		r0 = rf(ctx, productID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Time) error); ok {
+		r1 = rf(ctx, productID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceTiers provides a mock function with given fields: ctx, productDetailID
+func (_m *Repository) GetPriceTiers(ctx context.Context, productDetailID string) ([]*model.PriceTier, error) {
+	ret := _m.Called(ctx, productDetailID)
+
+	var r0 []*model.PriceTier
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.PriceTier); ok {
+		r0 = rf(ctx, productDetailID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceTier)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productDetailID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAlertState provides a mock function with given fields: ctx, productDetailID
+func (_m *Repository) GetProductAlertState(ctx context.Context, productDetailID string) (*model.ProductAlert, error) {
+	ret := _m.Called(ctx, productDetailID)
+
+	var r0 *model.ProductAlert
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ProductAlert); ok {
+		r0 = rf(ctx, productDetailID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductAlert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productDetailID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAlerts provides a mock function with given fields: ctx, userID, pgn
+func (_m *Repository) GetProductAlerts(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*model.ProductAlert, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 []*model.ProductAlert
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.ProductAlert); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ProductAlert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAlertsByProductIDs provides a mock function with given fields: ctx, productIDs
+func (_m *Repository) GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error) {
+	ret := _m.Called(ctx, productIDs)
+
+	var r0 []*model.ProductAlert
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.ProductAlert); ok {
+		r0 = rf(ctx, productIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ProductAlert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, productIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductDetail provides a mock function with given fields: ctx, productID, promo
 func (_m *Repository) GetProductDetail(ctx context.Context, productID string, promo *body.PromotionInfo) ([]*body.ProductDetail, error) {
 	ret := _m.Called(ctx, productID, promo)
@@ -585,6 +918,52 @@ func (_m *Repository) GetProductDetail(ctx context.Context, productID string, pr
 	return r0, r1
 }
 
+// GetProductFacets provides a mock function with given fields: ctx, query
+func (_m *Repository) GetProductFacets(ctx context.Context, query *body.GetProductQueryRequest) (*body.ProductFacets, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 *body.ProductFacets
+	if rf, ok := ret.Get(0).(func(context.Context, *body.GetProductQueryRequest) *body.ProductFacets); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.ProductFacets)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *body.GetProductQueryRequest) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductImportJob provides a mock function with given fields: ctx, shopID, jobID
+func (_m *Repository) GetProductImportJob(ctx context.Context, shopID string, jobID string) (*model.ProductImportJob, error) {
+	ret := _m.Called(ctx, shopID, jobID)
+
+	var r0 *model.ProductImportJob
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ProductImportJob); ok {
+		r0 = rf(ctx, shopID, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductImportJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductInfo provides a mock function with given fields: ctx, productID
 func (_m *Repository) GetProductInfo(ctx context.Context, productID string) (*body.ProductInfo, error) {
 	ret := _m.Called(ctx, productID)
@@ -631,6 +1010,163 @@ func (_m *Repository) GetProductReviews(ctx context.Context, pgn *pagination.Pag
 	return r0, r1
 }
 
+// GetProductShopID provides a mock function with given fields: ctx, productID
+func (_m *Repository) GetProductShopID(ctx context.Context, productID string) (string, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductSnapshot provides a mock function with given fields: ctx, tx, productID
+func (_m *Repository) GetProductSnapshot(ctx context.Context, tx postgre.Transaction, productID string) (*model.ProductSnapshot, error) {
+	ret := _m.Called(ctx, tx, productID)
+
+	var r0 *model.ProductSnapshot
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) *model.ProductSnapshot); ok {
+		r0 = rf(ctx, tx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductSnapshot)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductVariantAxes provides a mock function with given fields: ctx, productID
+func (_m *Repository) GetProductVariantAxes(ctx context.Context, productID string) ([]*body.VariantAxis, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []*body.VariantAxis
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*body.VariantAxis); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.VariantAxis)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductVariantCombinations provides a mock function with given fields: ctx, tx, productID
+func (_m *Repository) GetProductVariantCombinations(ctx context.Context, tx postgre.Transaction, productID string) ([][]body.VariantDetailRequest, error) {
+	ret := _m.Called(ctx, tx, productID)
+
+	var r0 [][]body.VariantDetailRequest
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) [][]body.VariantDetailRequest); ok {
+		r0 = rf(ctx, tx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]body.VariantDetailRequest)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductVersion provides a mock function with given fields: ctx, productID, version
+func (_m *Repository) GetProductVersion(ctx context.Context, productID string, version int) (*model.ProductVersion, error) {
+	ret := _m.Called(ctx, productID, version)
+
+	var r0 *model.ProductVersion
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *model.ProductVersion); ok {
+		r0 = rf(ctx, productID, version)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, productID, version)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductVersionNumber provides a mock function with given fields: ctx, tx, productID
+func (_m *Repository) GetProductVersionNumber(ctx context.Context, tx postgre.Transaction, productID string) (int, error) {
+	ret := _m.Called(ctx, tx, productID)
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) int); ok {
+		r0 = rf(ctx, tx, productID)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductVersions provides a mock function with given fields: ctx, productID, pgn
+func (_m *Repository) GetProductVersions(ctx context.Context, productID string, pgn *pagination.Pagination) ([]*model.ProductVersion, error) {
+	ret := _m.Called(ctx, productID, pgn)
+
+	var r0 []*model.ProductVersion
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.ProductVersion); ok {
+		r0 = rf(ctx, productID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ProductVersion)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, productID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProducts provides a mock function with given fields: ctx, pgn, query
 func (_m *Repository) GetProducts(ctx context.Context, pgn *pagination.Pagination, query *body.GetProductQueryRequest) ([]*body.Products, []*model.Promotion, []*model.Voucher, error) {
 	ret := _m.Called(ctx, pgn, query)
@@ -803,6 +1339,29 @@ func (_m *Repository) GetShopProductRating(ctx context.Context, shopID string) (
 	return r0, r1
 }
 
+// GetShopProductsForExport provides a mock function with given fields: ctx, shopID
+func (_m *Repository) GetShopProductsForExport(ctx context.Context, shopID string) ([]*body.ProductCSVRow, error) {
+	ret := _m.Called(ctx, shopID)
+
+	var r0 []*body.ProductCSVRow
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*body.ProductCSVRow); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.ProductCSVRow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalAllReviewProduct provides a mock function with given fields: ctx, productID, query
 func (_m *Repository) GetTotalAllReviewProduct(ctx context.Context, productID string, query *body.GetReviewQueryRequest) (int64, error) {
 	ret := _m.Called(ctx, productID, query)
@@ -845,6 +1404,48 @@ func (_m *Repository) GetTotalProduct(ctx context.Context) (int64, error) {
 	return r0, r1
 }
 
+// GetTotalProductAlerts provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetTotalProductAlerts(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTotalProductVersions provides a mock function with given fields: ctx, productID
+func (_m *Repository) GetTotalProductVersions(ctx context.Context, productID string) (int64, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalReviewRatingByProductID provides a mock function with given fields: ctx, productID
 func (_m *Repository) GetTotalReviewRatingByProductID(ctx context.Context, productID string) ([]*body.RatingProduct, error) {
 	ret := _m.Called(ctx, productID)
@@ -868,6 +1469,20 @@ func (_m *Repository) GetTotalReviewRatingByProductID(ctx context.Context, produ
 	return r0, r1
 }
 
+// RestoreProductDetail provides a mock function with given fields: ctx, tx, productID, productDetailID
+func (_m *Repository) RestoreProductDetail(ctx context.Context, tx postgre.Transaction, productID string, productDetailID string) error {
+	ret := _m.Called(ctx, tx, productID, productDetailID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, string) error); ok {
+		r0 = rf(ctx, tx, productID, productDetailID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateListedStatus provides a mock function with given fields: ctx, tx, listedStatus, productID
 func (_m *Repository) UpdateListedStatus(ctx context.Context, tx postgre.Transaction, listedStatus bool, productID string) error {
 	ret := _m.Called(ctx, tx, listedStatus, productID)
@@ -896,6 +1511,27 @@ func (_m *Repository) UpdateProduct(ctx context.Context, tx postgre.Transaction,
 	return r0
 }
 
+// UpdateProductAlertTriggered provides a mock function with given fields: ctx, alertID, triggered
+func (_m *Repository) UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error) {
+	ret := _m.Called(ctx, alertID, triggered)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) bool); ok {
+		r0 = rf(ctx, alertID, triggered)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, alertID, triggered)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateProductDetail provides a mock function with given fields: ctx, tx, requestBody, productID
 func (_m *Repository) UpdateProductDetail(ctx context.Context, tx postgre.Transaction, requestBody body.UpdateProductDetailRequest, productID string) error {
 	ret := _m.Called(ctx, tx, requestBody, productID)
@@ -924,6 +1560,20 @@ func (_m *Repository) UpdateProductFavorite(ctx context.Context, productID strin
 	return r0
 }
 
+// UpdateProductImportJob provides a mock function with given fields: ctx, job
+func (_m *Repository) UpdateProductImportJob(ctx context.Context, job *model.ProductImportJob) error {
+	ret := _m.Called(ctx, job)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ProductImportJob) error); ok {
+		r0 = rf(ctx, job)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateProductRating provides a mock function with given fields: ctx, productID, ratingAvg
 func (_m *Repository) UpdateProductRating(ctx context.Context, productID string, ratingAvg float64) error {
 	ret := _m.Called(ctx, productID, ratingAvg)
@@ -81,6 +81,29 @@ func (_m *UseCase) CreateProduct(ctx context.Context, requestBody body.CreatePro
 	return r0
 }
 
+// CreateProductAlert provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) CreateProductAlert(ctx context.Context, userID string, requestBody body.CreateProductAlertRequest) (*model.ProductAlert, error) {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 *model.ProductAlert
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.CreateProductAlertRequest) *model.ProductAlert); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductAlert)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, body.CreateProductAlertRequest) error); ok {
+		r1 = rf(ctx, userID, requestBody)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateProductReview provides a mock function with given fields: ctx, reqBody, userID
 func (_m *UseCase) CreateProductReview(ctx context.Context, reqBody body.ReviewProductRequest, userID string) error {
 	ret := _m.Called(ctx, reqBody, userID)
@@ -109,6 +132,20 @@ func (_m *UseCase) DeleteFavoriteProduct(ctx context.Context, productID string,
 	return r0
 }
 
+// DeleteProductAlert provides a mock function with given fields: ctx, userID, alertID
+func (_m *UseCase) DeleteProductAlert(ctx context.Context, userID string, alertID string) error {
+	ret := _m.Called(ctx, userID, alertID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, alertID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteProductReview provides a mock function with given fields: ctx, reviewID, userID
 func (_m *UseCase) DeleteProductReview(ctx context.Context, reviewID string, userID string) error {
 	ret := _m.Called(ctx, reviewID, userID)
@@ -123,6 +160,71 @@ func (_m *UseCase) DeleteProductReview(ctx context.Context, reviewID string, use
 	return r0
 }
 
+// EvaluateProductAlerts provides a mock function with given fields: ctx
+func (_m *UseCase) EvaluateProductAlerts(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExportProducts provides a mock function with given fields: ctx, userID
+func (_m *UseCase) ExportProducts(ctx context.Context, userID string) ([]*body.ProductCSVRow, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*body.ProductCSVRow
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*body.ProductCSVRow); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.ProductCSVRow)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FailStaleProductImportJobs provides a mock function with given fields: ctx
+func (_m *UseCase) FailStaleProductImportJobs(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllProductImage provides a mock function with given fields: ctx, productID
 func (_m *UseCase) GetAllProductImage(ctx context.Context, productID string) ([]*body.GetImageResponse, error) {
 	ret := _m.Called(ctx, productID)
@@ -238,6 +340,52 @@ func (_m *UseCase) GetFavoriteProducts(ctx context.Context, pgn *pagination.Pagi
 	return r0, r1
 }
 
+// GetPriceHistory provides a mock function with given fields: ctx, productID
+func (_m *UseCase) GetPriceHistory(ctx context.Context, productID string) ([]*body.ProductPriceHistoryResponse, error) {
+	ret := _m.Called(ctx, productID)
+
+	var r0 []*body.ProductPriceHistoryResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*body.ProductPriceHistoryResponse); ok {
+		r0 = rf(ctx, productID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*body.ProductPriceHistoryResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, productID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAlerts provides a mock function with given fields: ctx, userID, pgn
+func (_m *UseCase) GetProductAlerts(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductDetail provides a mock function with given fields: ctx, productID
 func (_m *UseCase) GetProductDetail(ctx context.Context, productID string) (*body.ProductDetailResponse, error) {
 	ret := _m.Called(ctx, productID)
@@ -261,6 +409,29 @@ func (_m *UseCase) GetProductDetail(ctx context.Context, productID string) (*bod
 	return r0, r1
 }
 
+// GetProductImportJob provides a mock function with given fields: ctx, userID, jobID
+func (_m *UseCase) GetProductImportJob(ctx context.Context, userID string, jobID string) (*model.ProductImportJob, error) {
+	ret := _m.Called(ctx, userID, jobID)
+
+	var r0 *model.ProductImportJob
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ProductImportJob); ok {
+		r0 = rf(ctx, userID, jobID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductImportJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, jobID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductReviews provides a mock function with given fields: ctx, pgn, productID, query
 func (_m *UseCase) GetProductReviews(ctx context.Context, pgn *pagination.Pagination, productID string, query *body.GetReviewQueryRequest) (*pagination.Pagination, error) {
 	ret := _m.Called(ctx, pgn, productID, query)
@@ -284,6 +455,29 @@ func (_m *UseCase) GetProductReviews(ctx context.Context, pgn *pagination.Pagina
 	return r0, r1
 }
 
+// GetProductVersions provides a mock function with given fields: ctx, userID, productID, pgn
+func (_m *UseCase) GetProductVersions(ctx context.Context, userID string, productID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, productID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, productID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, productID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProducts provides a mock function with given fields: ctx, pgn, query
 func (_m *UseCase) GetProducts(ctx context.Context, pgn *pagination.Pagination, query *body.GetProductQueryRequest) (*pagination.Pagination, error) {
 	ret := _m.Called(ctx, pgn, query)
@@ -353,6 +547,57 @@ func (_m *UseCase) GetTotalReviewRatingByProductID(ctx context.Context, productI
 	return r0, r1
 }
 
+// ImportProducts provides a mock function with given fields: ctx, userID, rows
+func (_m *UseCase) ImportProducts(ctx context.Context, userID string, rows []*body.ProductCSVRow) (*model.ProductImportJob, error) {
+	ret := _m.Called(ctx, userID, rows)
+
+	var r0 *model.ProductImportJob
+	if rf, ok := ret.Get(0).(func(context.Context, string, []*body.ProductCSVRow) *model.ProductImportJob); ok {
+		r0 = rf(ctx, userID, rows)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductImportJob)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, []*body.ProductCSVRow) error); ok {
+		r1 = rf(ctx, userID, rows)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RollbackProduct provides a mock function with given fields: ctx, userID, productID, version
+func (_m *UseCase) RollbackProduct(ctx context.Context, userID string, productID string, version int) error {
+	ret := _m.Called(ctx, userID, productID, version)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int) error); ok {
+		r0 = rf(ctx, userID, productID, version)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UnsubscribeProductAlert provides a mock function with given fields: ctx, token
+func (_m *UseCase) UnsubscribeProductAlert(ctx context.Context, token string) error {
+	ret := _m.Called(ctx, token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateListedStatus provides a mock function with given fields: ctx, productID
 func (_m *UseCase) UpdateListedStatus(ctx context.Context, productID string) error {
 	ret := _m.Called(ctx, productID)
@@ -396,17 +641,24 @@ func (_m *UseCase) UpdateProductListedStatusBulk(ctx context.Context, _a1 body.U
 }
 
 // UpdateProductMetadata provides a mock function with given fields: ctx
-func (_m *UseCase) UpdateProductMetadata(ctx context.Context) error {
+func (_m *UseCase) UpdateProductMetadata(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 type mockConstructorTestingTNewUseCase interface {
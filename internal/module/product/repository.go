@@ -6,6 +6,7 @@ import (
 	"murakali/internal/module/product/delivery/body"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -24,6 +25,7 @@ type Repository interface {
 	GetProducts(ctx context.Context, pgn *pagination.Pagination, query *body.GetProductQueryRequest) ([]*body.Products,
 		[]*model.Promotion, []*model.Voucher, error)
 	GetAllTotalProduct(ctx context.Context, query *body.GetProductQueryRequest) (int64, error)
+	GetProductFacets(ctx context.Context, query *body.GetProductQueryRequest) (*body.ProductFacets, error)
 	GetFavoriteProducts(ctx context.Context, pgn *pagination.Pagination, query *body.GetProductQueryRequest, userID string) ([]*body.Products,
 		[]*model.Promotion, []*model.Voucher, error)
 	GetAllFavoriteTotalProduct(ctx context.Context, query *body.GetProductQueryRequest, userID string) (int64, error)
@@ -46,6 +48,7 @@ type Repository interface {
 	CreatePhoto(ctx context.Context, tx postgre.Transaction, productDetailID, url string) error
 	CreateVariant(ctx context.Context, tx postgre.Transaction, productDetailID string, variantDetailID string) error
 	CreateVariantDetail(ctx context.Context, tx postgre.Transaction, requestBody body.VariantDetailRequest) (string, error)
+	DeleteVariantsByProductDetailID(ctx context.Context, tx postgre.Transaction, productDetailID string) error
 	GetListedStatus(ctx context.Context, productID string) (bool, error)
 	UpdateListedStatus(ctx context.Context, tx postgre.Transaction, listedStatus bool, productID string) error
 	UpdateProduct(ctx context.Context, tx postgre.Transaction, requestBody body.UpdateProductInfoForQuery, productID string) error
@@ -61,4 +64,35 @@ type Repository interface {
 	UpdateProductRating(ctx context.Context, productID string, ratingAvg float64) error
 	UpdateShopProductRating(ctx context.Context, shop *model.ShopProductRating) error
 	GetShopProductRating(ctx context.Context, shopID string) (*model.ShopProductRating, error)
+	CreateProductImportJob(ctx context.Context, job *model.ProductImportJob) error
+	UpdateProductImportJob(ctx context.Context, job *model.ProductImportJob) error
+	GetProductImportJob(ctx context.Context, shopID, jobID string) (*model.ProductImportJob, error)
+	FailStaleProductImportJobs(ctx context.Context, createdBefore time.Time) (int64, error)
+	GetShopProductsForExport(ctx context.Context, shopID string) ([]*body.ProductCSVRow, error)
+	CreateVariantAxis(ctx context.Context, tx postgre.Transaction, productID, name string, position int) (string, error)
+	CreateVariantOption(ctx context.Context, tx postgre.Transaction, axisID, name string, position int) error
+	GetProductVariantAxes(ctx context.Context, productID string) ([]*body.VariantAxis, error)
+	GetProductVariantCombinations(ctx context.Context, tx postgre.Transaction, productID string) ([][]body.VariantDetailRequest, error)
+	GetPriceTiers(ctx context.Context, productDetailID string) ([]*model.PriceTier, error)
+	CreatePriceTier(ctx context.Context, tx postgre.Transaction, productDetailID string, tier body.PriceTierRequest) error
+	DeletePriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) error
+	GetProductSnapshot(ctx context.Context, tx postgre.Transaction, productID string) (*model.ProductSnapshot, error)
+	GetProductVersionNumber(ctx context.Context, tx postgre.Transaction, productID string) (int, error)
+	CreateProductVersion(ctx context.Context, tx postgre.Transaction, version *model.ProductVersion) error
+	GetTotalProductVersions(ctx context.Context, productID string) (int64, error)
+	GetProductVersions(ctx context.Context, productID string, pgn *pagination.Pagination) ([]*model.ProductVersion, error)
+	GetProductVersion(ctx context.Context, productID string, version int) (*model.ProductVersion, error)
+	CreatePriceHistory(ctx context.Context, tx postgre.Transaction, productDetailID string, price model.Money) error
+	GetPriceHistory(ctx context.Context, productID string, since time.Time) ([]*model.PriceHistory, error)
+	GetProductShopID(ctx context.Context, productID string) (string, error)
+	RestoreProductDetail(ctx context.Context, tx postgre.Transaction, productID, productDetailID string) error
+	GetProductAlertState(ctx context.Context, productDetailID string) (*model.ProductAlert, error)
+	GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error)
+	GetProductAlerts(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*model.ProductAlert, error)
+	GetTotalProductAlerts(ctx context.Context, userID string) (int64, error)
+	GetAlertedProductIDs(ctx context.Context) ([]string, error)
+	CreateProductAlert(ctx context.Context, productAlert *model.ProductAlert) error
+	UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error)
+	DeleteProductAlert(ctx context.Context, userID, alertID string) (int64, error)
+	DeleteProductAlertByToken(ctx context.Context, token string) (int64, error)
 }
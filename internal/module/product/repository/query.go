@@ -1,5 +1,7 @@
 package repository
 
+import "murakali/internal/rbac"
+
 const (
 	GetCategoriesQuery           = `SELECT "id", "parent_id", "name", "photo_url" FROM "category" WHERE "parent_id" IS NULL AND "deleted_at" IS NULL`
 	GetCategoriesByNameQuery     = `SELECT "id", "parent_id", "name", "photo_url" FROM "category" WHERE "name" = $1 AND "deleted_at" IS NULL`
@@ -38,7 +40,10 @@ const (
 	where pr.id = $1 and b.deleted_at is null`
 
 	GetProductDetailQuery = `select
-	pd.id,pd.price,pd.stock,pd.weight,pd.size,pd.hazardous,pd.condition,pd.bulk_price
+	pd.id,pd.price,pd.stock,
+	pd.stock - coalesce((select sum(sr.quantity) from stock_reservation sr
+		where sr.product_detail_id = pd.id and sr.status = $2 and sr.expired_at > now()), 0),
+	pd.weight,pd.size,pd.hazardous,pd.condition,pd.bulk_price
 	from 
 	product_detail pd
 	where pd.product_id = $1 and pd.deleted_at is null`
@@ -71,7 +76,9 @@ const (
 		"p".listed_status,
 		"p"."created_at",
 		"p"."updated_at",
-		"p"."sku"
+		"p"."sku",
+		` + ProductSearchRelevance + ` as "relevance",
+		` + ProductSearchHighlight + ` as "highlight"
 	FROM "product" as "p"
 	LEFT JOIN (
 		SELECT * FROM "promotion"
@@ -81,7 +88,7 @@ const (
 	INNER JOIN "category" as "c" ON "c"."id" = "p"."category_id"
 	INNER JOIN "user" as "u" ON "u"."id" = "s"."user_id"
 	INNER JOIN "address" as "a" ON "u"."id" = "a"."user_id"
-	WHERE "p".title ILIKE $1 
+	WHERE ` + ProductSearchCondition + `
 	AND "a"."is_shop_default" = true
 	AND  "c".name ILIKE $2
 	AND ("p".rating_avg BETWEEN $3 AND $4)
@@ -100,7 +107,9 @@ const (
 		"p".listed_status,
 		"p"."created_at",
 		"p"."updated_at",
-		"p"."sku"
+		"p"."sku",
+		` + ProductSearchRelevance + ` as "relevance",
+		` + ProductSearchHighlight + ` as "highlight"
 	FROM "product" as "p"
 	LEFT JOIN (
 		SELECT * FROM "promotion"
@@ -110,7 +119,7 @@ const (
 	INNER JOIN "category" as "c" ON "c"."id" = "p"."category_id"
 	INNER JOIN "user" as "u" ON "u"."id" = "s"."user_id"
 	INNER JOIN "address" as "a" ON "u"."id" = "a"."user_id"
-	WHERE "p".title ILIKE $1 
+	WHERE ` + ProductSearchCondition + `
 	AND "a"."is_shop_default" = true
 	AND  "c".name ILIKE $2
 	AND ("p".rating_avg BETWEEN $3 AND $4)
@@ -138,7 +147,7 @@ const (
 	INNER JOIN "shop" as "s" ON "s"."id" = "p"."shop_id"
 	INNER JOIN "user" as "u" ON "u"."id" = "s"."user_id"
 	INNER JOIN "address" as "a" ON "u"."id" = "a"."user_id"
-	WHERE "p".title ILIKE $1 
+	WHERE ` + ProductSearchCondition + `
 	AND "a"."is_shop_default" = true
 	AND  "c".name ILIKE $2
 	AND ("p".rating_avg BETWEEN $3 AND $4)
@@ -151,7 +160,7 @@ const (
 	INNER JOIN "shop" as "s" ON "s"."id" = "p"."shop_id"
 	INNER JOIN "user" as "u" ON "u"."id" = "s"."user_id"
 	INNER JOIN "address" as "a" ON "u"."id" = "a"."user_id"
-	WHERE "p".title ILIKE $1 
+	WHERE ` + ProductSearchCondition + `
 	AND "a"."is_shop_default" = true
 	AND  "c".name ILIKE $2
 	AND ("p".rating_avg BETWEEN $3 AND $4)
@@ -269,7 +278,38 @@ const (
 
 	DeleteReviewByIDQuery = `UPDATE "review" set deleted_at = now() WHERE id = $1;`
 
-	GetShopIDByUserIDQuery = `SELECT id from "shop" WHERE user_id = $1 AND deleted_at IS NULL `
+	GetShopIDByUserIDQuery = `SELECT "shop_id" FROM (` + rbac.GetShopAccessQuery + `) AS "shop_access"`
+
+	CreateProductImportJobQuery = `INSERT INTO "product_import_job"
+	(shop_id, user_id, status, total_rows, total_products)
+	VALUES ($1, $2, $3, $4, $5) RETURNING "id", "created_at";`
+
+	UpdateProductImportJobQuery = `UPDATE "product_import_job"
+	SET "status" = $2, "imported_products" = $3, "failed_rows" = $4, "errors" = $5, "finished_at" = $6
+	WHERE "id" = $1;`
+
+	FailStaleProductImportJobsQuery = `UPDATE "product_import_job"
+	SET "status" = $1, "finished_at" = now()
+	WHERE "status" = $2 AND "created_at" < $3;`
+
+	GetProductImportJobQuery = `SELECT "id", "shop_id", "user_id", "status", "total_rows", "total_products",
+	"imported_products", "failed_rows", "errors", "created_at", "finished_at"
+	FROM "product_import_job" WHERE "id" = $1 AND "shop_id" = $2;`
+
+	GetShopProductsForExportQuery = `SELECT "p"."id"::text, coalesce("p"."title", ''), coalesce("p"."description", ''),
+	coalesce("p"."thumbnail_url", ''), coalesce("p"."category_id"::text, ''), coalesce("p"."listed_status"::text, ''),
+	coalesce("pd"."price"::text, ''), coalesce("pd"."stock"::text, ''), coalesce("pd"."weight"::text, ''),
+	coalesce("pd"."size"::text, ''), coalesce("pd"."hazardous"::text, ''), coalesce("pd"."condition", ''),
+	coalesce("pd"."bulk_price"::text, ''),
+	coalesce((SELECT string_agg("ph"."url", '|' ORDER BY "ph"."url") FROM "photo" AS "ph"
+		WHERE "ph"."product_detail_id" = "pd"."id"), ''),
+	coalesce((SELECT string_agg("vd"."type" || ':' || "vd"."name", '|' ORDER BY "vd"."type")
+		FROM "variant" AS "v" INNER JOIN "variant_detail" AS "vd" ON "vd"."id" = "v"."variant_detail_id"
+		WHERE "v"."product_detail_id" = "pd"."id" AND "v"."deleted_at" IS NULL), '')
+	FROM "product" AS "p"
+	INNER JOIN "product_detail" AS "pd" ON "pd"."product_id" = "p"."id" AND "pd"."deleted_at" IS NULL
+	WHERE "p"."shop_id" = $1 AND "p"."deleted_at" IS NULL
+	ORDER BY "p"."created_at" ASC, "p"."id" ASC, "pd"."created_at" ASC;`
 
 	CreateProductQuery = `INSERT INTO "product" 
 	(category_id, shop_id, sku, title,
@@ -357,6 +397,8 @@ const (
 
 	DeleteVariantByIDQuery = `UPDATE "variant" set deleted_at = now() WHERE id = $1`
 
+	DeleteVariantsByProductDetailIDQuery = `UPDATE "variant" SET "deleted_at" = now() WHERE "product_detail_id" = $1 AND "deleted_at" IS NULL`
+
 	DeletePhotoByIDQuery = `
 	DELETE FROM "photo" WHERE "product_detail_id" = $1`
 
@@ -369,4 +411,220 @@ const (
 	UpdateVariantQuery = `UPDATE 
 	"variant" SET  "variant_detail_id" = $1, "updated_at" = now()
 	WHERE "id" = $2`
+
+	CreateVariantAxisQuery = `INSERT INTO "product_variant_axis"
+	(product_id, name, position)
+	VALUES ($1, $2, $3) RETURNING "id";`
+
+	CreateVariantOptionQuery = `INSERT INTO "product_variant_option"
+	(axis_id, name, position)
+	VALUES ($1, $2, $3);`
+
+	GetProductVariantAxesQuery = `SELECT "a"."name", "o"."name"
+	FROM "product_variant_axis" AS "a"
+	INNER JOIN "product_variant_option" AS "o" ON "o"."axis_id" = "a"."id"
+	WHERE "a"."product_id" = $1
+	ORDER BY "a"."position" ASC, "o"."position" ASC`
+
+	GetProductVariantCombinationsQuery = `SELECT "pd"."id", "vd"."type", "vd"."name"
+	FROM "product_detail" AS "pd"
+	LEFT JOIN "variant" AS "v" ON "v"."product_detail_id" = "pd"."id" AND "v"."deleted_at" IS NULL
+	LEFT JOIN "variant_detail" AS "vd" ON "vd"."id" = "v"."variant_detail_id"
+	WHERE "pd"."product_id" = $1 AND "pd"."deleted_at" IS NULL
+	ORDER BY "pd"."created_at" ASC, "pd"."id" ASC`
+
+	GetPriceTiersQuery = `SELECT "min_quantity", "max_quantity", "price" FROM "product_price_tier"
+	WHERE "product_detail_id" = $1 ORDER BY "min_quantity" ASC`
+
+	CreatePriceTierQuery = `INSERT INTO "product_price_tier"
+	(product_detail_id, min_quantity, max_quantity, price)
+	VALUES ($1, $2, $3, $4);`
+
+	DeletePriceTiersQuery = `DELETE FROM "product_price_tier" WHERE "product_detail_id" = $1;`
+
+	GetProductSnapshotQuery = `SELECT coalesce("title", ''), coalesce("description", ''), coalesce("thumbnail_url", ''),
+	coalesce("listed_status", false)
+	FROM "product" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE`
+
+	GetProductDetailSnapshotQuery = `SELECT "pd"."id"::text, coalesce("pd"."price", 0), coalesce("pd"."stock", 0),
+	coalesce("pd"."weight", 0), coalesce("pd"."size", 0), coalesce("pd"."hazardous", false), coalesce("pd"."condition", ''),
+	coalesce("pd"."bulk_price", false),
+	coalesce(array_agg("ph"."url" ORDER BY "ph"."url") FILTER (WHERE "ph"."url" IS NOT NULL), '{}')
+	FROM "product_detail" AS "pd"
+	LEFT JOIN "photo" AS "ph" ON "ph"."product_detail_id" = "pd"."id"
+	WHERE "pd"."product_id" = $1 AND "pd"."deleted_at" IS NULL
+	GROUP BY "pd"."id"
+	ORDER BY "pd"."created_at" ASC, "pd"."id" ASC`
+
+	GetVariantSnapshotQuery = `SELECT "vd"."type", "vd"."name" FROM "variant" AS "v"
+	INNER JOIN "variant_detail" AS "vd" ON "vd"."id" = "v"."variant_detail_id"
+	WHERE "v"."product_detail_id" = $1 AND "v"."deleted_at" IS NULL
+	ORDER BY "vd"."type" ASC, "vd"."name" ASC`
+
+	GetProductVersionNumberQuery = `SELECT coalesce(max("version"), 0) FROM "product_version" WHERE "product_id" = $1`
+
+	CreateProductVersionQuery = `INSERT INTO "product_version"
+	(product_id, version, user_id, note, snapshot, changes)
+	VALUES ($1, $2, $3, $4, $5, $6) RETURNING "id", "created_at";`
+
+	GetTotalProductVersionsQuery = `SELECT count("id") FROM "product_version" WHERE "product_id" = $1`
+
+	GetProductVersionsQuery = `SELECT "id", "product_id", "version", "user_id", "note", "snapshot", "changes", "created_at"
+	FROM "product_version" WHERE "product_id" = $1
+	ORDER BY "version" DESC LIMIT $2 OFFSET $3`
+
+	GetProductVersionQuery = `SELECT "id", "product_id", "version", "user_id", "note", "snapshot", "changes", "created_at"
+	FROM "product_version" WHERE "product_id" = $1 AND "version" = $2`
+
+	CreatePriceHistoryQuery = `INSERT INTO "product_price_history" (product_detail_id, price) VALUES ($1, $2);`
+
+	GetPriceHistoryQuery = `SELECT "ph"."product_detail_id"::text, "ph"."price", greatest("ph"."created_at", $2)
+	FROM "product_price_history" AS "ph"
+	INNER JOIN "product_detail" AS "pd" ON "pd"."id" = "ph"."product_detail_id" AND "pd"."deleted_at" IS NULL
+	WHERE "pd"."product_id" = $1 AND ("ph"."created_at" >= $2 OR "ph"."created_at" = (
+		SELECT max("prev"."created_at") FROM "product_price_history" AS "prev"
+		WHERE "prev"."product_detail_id" = "ph"."product_detail_id" AND "prev"."created_at" < $2))
+	ORDER BY "pd"."created_at" ASC, "pd"."id" ASC, "ph"."created_at" ASC`
+
+	GetProductShopIDQuery = `SELECT "shop_id" FROM "product" WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	RestoreProductDetailQuery = `UPDATE "product_detail" SET "deleted_at" = NULL, "updated_at" = now()
+	WHERE "id" = $1 AND "product_id" = $2`
+
+	ProductAlertStateColumns = `"p"."id", coalesce("p"."title", ''), coalesce("p"."thumbnail_url", ''), coalesce("pd"."price", 0),
+	coalesce("pd"."stock", 0) - coalesce((
+		SELECT sum("sr"."quantity") FROM "stock_reservation" AS "sr"
+		WHERE "sr"."product_detail_id" = "pd"."id" AND "sr"."status" = $1 AND "sr"."expired_at" > now()
+	), 0),
+	"promo"."discount_percentage", "promo"."discount_fix_price", "promo"."min_product_price", "promo"."max_discount_price"`
+
+	ProductAlertPromotionJoin = `LEFT JOIN LATERAL (
+		SELECT "discount_percentage", "discount_fix_price", "min_product_price", "max_discount_price"
+		FROM "promotion"
+		WHERE "product_id" = "p"."id" AND "deleted_at" IS NULL AND "quota" > 0
+		AND "actived_date" < now() AND "expired_date" >= now()
+		ORDER BY "actived_date" DESC LIMIT 1
+	) AS "promo" ON TRUE`
+
+	ProductAlertSelect = `SELECT "pa"."id", "pa"."user_id", "pa"."product_detail_id", "pa"."alert_type", "pa"."target_price",
+	"pa"."is_triggered", "pa"."unsubscribe_token", "pa"."notified_at", "pa"."created_at", "u"."email",
+	` + ProductAlertStateColumns + `
+	FROM "product_alert" AS "pa"
+	INNER JOIN "user" AS "u" ON "u"."id" = "pa"."user_id"
+	INNER JOIN "product_detail" AS "pd" ON "pd"."id" = "pa"."product_detail_id"
+	INNER JOIN "product" AS "p" ON "p"."id" = "pd"."product_id"
+	` + ProductAlertPromotionJoin
+
+	GetProductAlertStateQuery = `SELECT ` + ProductAlertStateColumns + `
+	FROM "product_detail" AS "pd"
+	INNER JOIN "product" AS "p" ON "p"."id" = "pd"."product_id"
+	` + ProductAlertPromotionJoin + `
+	WHERE "pd"."id" = $2 AND "pd"."deleted_at" IS NULL AND "p"."deleted_at" IS NULL`
+
+	GetProductAlertsByProductIDsQuery = ProductAlertSelect + `
+	WHERE "pd"."product_id"::text = any($2) AND "pa"."deleted_at" IS NULL AND "pd"."deleted_at" IS NULL
+	AND "p"."deleted_at" IS NULL AND "p"."listed_status" = TRUE`
+
+	GetProductAlertsQuery = ProductAlertSelect + `
+	WHERE "pa"."user_id" = $2 AND "pa"."deleted_at" IS NULL
+	ORDER BY "pa"."created_at" DESC LIMIT $3 OFFSET $4`
+
+	GetTotalProductAlertsQuery = `SELECT count("id") FROM "product_alert" WHERE "user_id" = $1 AND "deleted_at" IS NULL`
+
+	GetAlertedProductIDsQuery = `SELECT DISTINCT "pd"."product_id"::text
+	FROM "product_alert" AS "pa"
+	INNER JOIN "product_detail" AS "pd" ON "pd"."id" = "pa"."product_detail_id"
+	WHERE "pa"."deleted_at" IS NULL`
+
+	CreateProductAlertQuery = `INSERT INTO "product_alert" (user_id, product_detail_id, alert_type, target_price, is_triggered)
+	VALUES ($1, $2, $3, $4, $5)
+	ON CONFLICT ("user_id", "product_detail_id", "alert_type") WHERE "deleted_at" IS NULL
+	DO UPDATE SET "target_price" = EXCLUDED."target_price", "is_triggered" = EXCLUDED."is_triggered", "updated_at" = now()
+	RETURNING "id", "unsubscribe_token", "notified_at", "created_at"`
+
+	UpdateProductAlertTriggeredQuery = `UPDATE "product_alert" SET "is_triggered" = $2::boolean, "updated_at" = now(),
+	"notified_at" = CASE WHEN $2::boolean THEN now() ELSE "notified_at" END
+	WHERE "id" = $1 AND "is_triggered" <> $2::boolean AND "deleted_at" IS NULL`
+
+	DeleteProductAlertQuery = `UPDATE "product_alert" SET "deleted_at" = now()
+	WHERE "id" = $1 AND "user_id" = $2 AND "deleted_at" IS NULL`
+
+	DeleteProductAlertByTokenQuery = `UPDATE "product_alert" SET "deleted_at" = now()
+	WHERE "unsubscribe_token" = $1 AND "deleted_at" IS NULL`
+
+	ProductSearchCondition = `($1 = ''
+		OR "p"."search_vector" @@ websearch_to_tsquery('simple', $1)
+		OR "p"."title" % $1
+		OR $1 <% "p"."title"
+		OR "p"."title" ILIKE '%' || $1 || '%')`
+
+	ProductSearchRelevance = `CASE WHEN $1 = '' THEN 0 ELSE
+		ts_rank_cd("p"."search_vector", websearch_to_tsquery('simple', $1)) + word_similarity($1, "p"."title")
+	END`
+
+	ProductSearchHighlight = `CASE WHEN $1 = '' THEN NULL ELSE
+		ts_headline('simple', replace(replace(replace(replace(
+			coalesce("p"."title", '') || ' ' || coalesce("p"."description", ''),
+			'&', '&amp;'), '<', '&lt;'), '>', '&gt;'), '"', '&quot;'),
+			websearch_to_tsquery('simple', $1), 'StartSel=<mark>, StopSel=</mark>, MaxWords=30, MinWords=10, MaxFragments=2')
+	END`
+
+	ProductFacetFilterQuery = `
+	WITH "filtered" AS (
+		SELECT "p"."id", "p"."min_price", "p"."rating_avg", "c"."name" as "category_name",
+			"a"."province_id", "a"."province"
+		FROM "product" as "p"
+		INNER JOIN "shop" as "s" ON "s"."id" = "p"."shop_id"
+		INNER JOIN "category" as "c" ON "c"."id" = "p"."category_id"
+		INNER JOIN "user" as "u" ON "u"."id" = "s"."user_id"
+		INNER JOIN "address" as "a" ON "u"."id" = "a"."user_id"
+		WHERE ` + ProductSearchCondition + `
+		AND "a"."is_shop_default" = true
+	`
+
+	WhereShopIDFacet = `
+		AND "s"."id"::text = $%d
+	`
+
+	WhereCategoryFacet = `
+		AND "c"."name" ILIKE $%d
+	`
+
+	WhereRatingFacet = `
+		AND ("p"."rating_avg" BETWEEN $%d AND $%d)
+	`
+
+	WherePriceFacet = `
+		AND ("p"."min_price" BETWEEN $%d AND $%d)
+	`
+
+	WhereProvinceIDsFacet = `
+		AND ("a"."province_id"::text = any($%d))
+	`
+
+	CloseProductFacetFilter = `
+	)`
+
+	GetCategoryFacetQuery = `
+	SELECT "category_name", count("id") FROM "filtered"
+	GROUP BY "category_name" ORDER BY count("id") DESC, "category_name" ASC`
+
+	GetProvinceFacetQuery = `
+	SELECT "province_id"::text, "province", count("id") FROM "filtered"
+	GROUP BY "province_id", "province" ORDER BY count("id") DESC, "province" ASC`
+
+	GetRatingFacetQuery = `
+	SELECT floor(coalesce("rating_avg", 0))::int as "rating", count("id") FROM "filtered"
+	GROUP BY "rating" ORDER BY "rating" DESC`
+
+	GetPriceFacetQuery = `,
+	"bounds" AS (SELECT min("min_price") as "lo", max("min_price") as "hi" FROM "filtered")
+	SELECT round("h"."lo" + "h"."width" * ("h"."bucket" - 1))::bigint, round("h"."lo" + "h"."width" * "h"."bucket")::bigint,
+		count("h"."id")
+	FROM (
+		SELECT width_bucket("f"."min_price", "b"."lo", "b"."hi" + 1, 10) as "bucket", "b"."lo",
+			("b"."hi" + 1 - "b"."lo")::numeric / 10 as "width", "f"."id"
+		FROM "filtered" as "f" CROSS JOIN "bounds" as "b"
+	) as "h"
+	GROUP BY "h"."bucket", "h"."lo", "h"."width" ORDER BY "h"."bucket" ASC`
 )
@@ -3,7 +3,9 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"murakali/internal/constant"
 	"murakali/internal/model"
 	"murakali/internal/module/product"
 	"murakali/internal/module/product/delivery/body"
@@ -12,9 +14,12 @@ import (
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/go-redis/redis/v8"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type productRepo struct {
@@ -300,7 +305,7 @@ func (r *productRepo) GetProductDetail(ctx context.Context, productID string, pr
 	productDetail := make([]*body.ProductDetail, 0)
 
 	res, err := r.PSQL.QueryContext(
-		ctx, GetProductDetailQuery, productID)
+		ctx, GetProductDetailQuery, productID, constant.StockReservationActive)
 
 	if err != nil {
 		return nil, err
@@ -313,6 +318,7 @@ func (r *productRepo) GetProductDetail(ctx context.Context, productID string, pr
 			&detail.ProductDetailID,
 			&detail.NormalPrice,
 			&detail.Stock,
+			&detail.AvailableStock,
 			&detail.Weight,
 			&detail.Size,
 			&detail.Hazardous,
@@ -403,6 +409,14 @@ func (r *productRepo) GetProductDetail(ctx context.Context, productID string, pr
 		}
 		detail.VariantInfos = variantInfos
 
+		if detail.BulkPrice {
+			priceTiers, errTier := r.GetPriceTiers(ctx, detail.ProductDetailID)
+			if errTier != nil {
+				return nil, errTier
+			}
+			detail.PriceTiers = priceTiers
+		}
+
 		productDetail = append(productDetail, &detail)
 	}
 	if res.Err() != nil {
@@ -473,7 +487,7 @@ func (r *productRepo) GetProducts(ctx context.Context, pgn *pagination.Paginatio
 	if len(query.Province) > 0 {
 		res, err = r.PSQL.QueryContext(
 			ctx, GetProductsWithProvinceQuery+queryWhereShopIds+queryWhereProvinceIds+queryListedStatus+queryOrderBySomething,
-			query.Search,
+			query.Keyword,
 			query.Category,
 			query.MinRating,
 			query.MaxRating,
@@ -484,7 +498,7 @@ func (r *productRepo) GetProducts(ctx context.Context, pgn *pagination.Paginatio
 	} else {
 		res, err = r.PSQL.QueryContext(
 			ctx, GetProductsQuery+queryWhereShopIds+queryWhereProvinceIds+queryListedStatus+queryOrderBySomething,
-			query.Search,
+			query.Keyword,
 			query.Category,
 			query.MinRating,
 			query.MaxRating,
@@ -526,8 +540,10 @@ func (r *productRepo) GetProducts(ctx context.Context, pgn *pagination.Paginatio
 			&productData.CreatedAt,
 			&productData.UpdatedAt,
 			&productData.SKU,
+			&productData.Relevance,
+			&productData.Highlight,
 		); errScan != nil {
-			return nil, nil, nil, err
+			return nil, nil, nil, errScan
 		}
 
 		products = append(products, &productData)
@@ -564,7 +580,7 @@ func (r *productRepo) GetAllTotalProduct(ctx context.Context, query *body.GetPro
 	if len(query.Province) > 0 {
 		if err := r.PSQL.QueryRowContext(ctx,
 			GetAllTotalProductWithProvinceQuery+queryWhereShopIds+queryWhereProvinceIds+queryListedStatus,
-			query.Search,
+			query.Keyword,
 			query.Category,
 			query.MinRating,
 			query.MaxRating,
@@ -577,7 +593,7 @@ func (r *productRepo) GetAllTotalProduct(ctx context.Context, query *body.GetPro
 	} else {
 		if err := r.PSQL.QueryRowContext(ctx,
 			GetAllTotalProductQuery+queryWhereShopIds+queryWhereProvinceIds+queryListedStatus,
-			query.Search,
+			query.Keyword,
 			query.Category,
 			query.MinRating,
 			query.MaxRating,
@@ -591,6 +607,140 @@ func (r *productRepo) GetAllTotalProduct(ctx context.Context, query *body.GetPro
 	return total, nil
 }
 
+func (r *productRepo) GetProductFacets(ctx context.Context, query *body.GetProductQueryRequest) (*body.ProductFacets, error) {
+	facets := &body.ProductFacets{
+		Categories: make([]*body.FacetCount, 0),
+		Provinces:  make([]*body.FacetCount, 0),
+		Ratings:    make([]*body.FacetCount, 0),
+		Prices:     make([]*body.PriceBucket, 0),
+	}
+
+	filterQuery, args := productFacetFilter(query, constant.ProductFacetCategory)
+	categories, err := r.PSQL.QueryContext(ctx, filterQuery+GetCategoryFacetQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer categories.Close()
+
+	for categories.Next() {
+		var facet body.FacetCount
+		if errScan := categories.Scan(&facet.Label, &facet.Count); errScan != nil {
+			return nil, errScan
+		}
+
+		facet.Key = facet.Label
+		facets.Categories = append(facets.Categories, &facet)
+	}
+
+	if categories.Err() != nil {
+		return nil, categories.Err()
+	}
+
+	filterQuery, args = productFacetFilter(query, constant.ProductFacetProvince)
+	provinces, err := r.PSQL.QueryContext(ctx, filterQuery+GetProvinceFacetQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer provinces.Close()
+
+	for provinces.Next() {
+		var facet body.FacetCount
+		if errScan := provinces.Scan(&facet.Key, &facet.Label, &facet.Count); errScan != nil {
+			return nil, errScan
+		}
+
+		facets.Provinces = append(facets.Provinces, &facet)
+	}
+
+	if provinces.Err() != nil {
+		return nil, provinces.Err()
+	}
+
+	filterQuery, args = productFacetFilter(query, constant.ProductFacetRating)
+	ratings, err := r.PSQL.QueryContext(ctx, filterQuery+GetRatingFacetQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer ratings.Close()
+
+	for ratings.Next() {
+		var rating int
+		var facet body.FacetCount
+		if errScan := ratings.Scan(&rating, &facet.Count); errScan != nil {
+			return nil, errScan
+		}
+
+		facet.Key = strconv.Itoa(rating)
+		facet.Label = strconv.Itoa(rating)
+		facets.Ratings = append(facets.Ratings, &facet)
+	}
+
+	if ratings.Err() != nil {
+		return nil, ratings.Err()
+	}
+
+	filterQuery, args = productFacetFilter(query, constant.ProductFacetPrice)
+	prices, err := r.PSQL.QueryContext(ctx, filterQuery+GetPriceFacetQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer prices.Close()
+
+	for prices.Next() {
+		var facet body.PriceBucket
+		if errScan := prices.Scan(&facet.From, &facet.To, &facet.Count); errScan != nil {
+			return nil, errScan
+		}
+
+		facets.Prices = append(facets.Prices, &facet)
+	}
+
+	if prices.Err() != nil {
+		return nil, prices.Err()
+	}
+
+	return facets, nil
+}
+
+func productFacetFilter(query *body.GetProductQueryRequest, exclude string) (string, []interface{}) {
+	filterQuery := ProductFacetFilterQuery
+	args := []interface{}{query.Keyword}
+
+	if query.Shop != "" {
+		args = append(args, query.Shop)
+		filterQuery += fmt.Sprintf(WhereShopIDFacet, len(args))
+	}
+
+	switch query.ListedStatus {
+	case 1:
+		filterQuery += WhereListedStatusTrue
+	case 2:
+		filterQuery += WhereListedStatusFalse
+	}
+
+	if exclude != constant.ProductFacetCategory {
+		args = append(args, query.Category)
+		filterQuery += fmt.Sprintf(WhereCategoryFacet, len(args))
+	}
+
+	if exclude != constant.ProductFacetRating {
+		args = append(args, query.MinRating, query.MaxRating)
+		filterQuery += fmt.Sprintf(WhereRatingFacet, len(args)-1, len(args))
+	}
+
+	if exclude != constant.ProductFacetPrice {
+		args = append(args, query.MinPrice, query.MaxPrice)
+		filterQuery += fmt.Sprintf(WherePriceFacet, len(args)-1, len(args))
+	}
+
+	if exclude != constant.ProductFacetProvince && len(query.Province) > 0 {
+		args = append(args, query.Province)
+		filterQuery += fmt.Sprintf(WhereProvinceIDsFacet, len(args))
+	}
+
+	return filterQuery + CloseProductFacetFilter, args
+}
+
 func (r *productRepo) GetFavoriteProducts(
 	ctx context.Context, pgn *pagination.Pagination, query *body.GetProductQueryRequest, userID string) ([]*body.Products,
 	[]*model.Promotion, []*model.Voucher, error) {
@@ -1032,7 +1182,7 @@ func (r *productRepo) UpdateProductDetail(ctx context.Context,
 }
 
 func (r *productRepo) DeletePhoto(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
-	_, err := r.PSQL.ExecContext(ctx, DeletePhotoByIDQuery, productDetailID)
+	_, err := tx.ExecContext(ctx, DeletePhotoByIDQuery, productDetailID)
 	if err != nil {
 		return err
 	}
@@ -1040,7 +1190,7 @@ func (r *productRepo) DeletePhoto(ctx context.Context, tx postgre.Transaction, p
 }
 
 func (r *productRepo) DeleteProductDetail(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
-	_, err := r.PSQL.ExecContext(ctx, DeleteProductDetailByIDQuery, productDetailID)
+	_, err := tx.ExecContext(ctx, DeleteProductDetailByIDQuery, productDetailID)
 	if err != nil {
 		return err
 	}
@@ -1048,13 +1198,18 @@ func (r *productRepo) DeleteProductDetail(ctx context.Context, tx postgre.Transa
 }
 
 func (r *productRepo) DeleteVariant(ctx context.Context, tx postgre.Transaction, productID string) error {
-	_, err := r.PSQL.ExecContext(ctx, DeleteVariantByIDQuery, productID)
+	_, err := tx.ExecContext(ctx, DeleteVariantByIDQuery, productID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+func (r *productRepo) DeleteVariantsByProductDetailID(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
+	_, err := tx.ExecContext(ctx, DeleteVariantsByProductDetailIDQuery, productDetailID)
+	return err
+}
+
 func (r *productRepo) GetMaxMinPriceByID(ctx context.Context, productID string) (*body.RangePrice, error) {
 	var rangePrice body.RangePrice
 	if err := r.PSQL.QueryRowContext(ctx, GetMaxMinPriceQuery, productID).Scan(&rangePrice.MaxPrice, &rangePrice.MinPrice); err != nil {
@@ -1090,3 +1245,584 @@ func (r *productRepo) UpdateShopProductRating(ctx context.Context, shop *model.S
 	}
 	return nil
 }
+
+func (r *productRepo) CreateProductImportJob(ctx context.Context, job *model.ProductImportJob) error {
+	return r.PSQL.QueryRowContext(ctx, CreateProductImportJobQuery, job.ShopID, job.UserID, job.Status,
+		job.TotalRows, job.TotalProducts).Scan(&job.ID, &job.CreatedAt)
+}
+
+func (r *productRepo) UpdateProductImportJob(ctx context.Context, job *model.ProductImportJob) error {
+	importErrors, err := json.Marshal(job.Errors)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.PSQL.ExecContext(ctx, UpdateProductImportJobQuery, job.ID, job.Status, job.ImportedProducts,
+		job.FailedRows, importErrors, job.FinishedAt)
+	return err
+}
+
+func (r *productRepo) FailStaleProductImportJobs(ctx context.Context, createdBefore time.Time) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, FailStaleProductImportJobsQuery, constant.ProductImportStatusFailed,
+		constant.ProductImportStatusRunning, createdBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *productRepo) GetProductImportJob(ctx context.Context, shopID, jobID string) (*model.ProductImportJob, error) {
+	var job model.ProductImportJob
+	var importErrors []byte
+	if err := r.PSQL.QueryRowContext(ctx, GetProductImportJobQuery, jobID, shopID).Scan(
+		&job.ID,
+		&job.ShopID,
+		&job.UserID,
+		&job.Status,
+		&job.TotalRows,
+		&job.TotalProducts,
+		&job.ImportedProducts,
+		&job.FailedRows,
+		&importErrors,
+		&job.CreatedAt,
+		&job.FinishedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(importErrors, &job.Errors); err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+func (r *productRepo) GetShopProductsForExport(ctx context.Context, shopID string) ([]*body.ProductCSVRow, error) {
+	rows := make([]*body.ProductCSVRow, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetShopProductsForExportQuery, shopID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var row body.ProductCSVRow
+		if errScan := res.Scan(
+			&row.ProductRef,
+			&row.Title,
+			&row.Description,
+			&row.Thumbnail,
+			&row.CategoryID,
+			&row.ListedStatus,
+			&row.Price,
+			&row.Stock,
+			&row.Weight,
+			&row.Size,
+			&row.Hazardous,
+			&row.Condition,
+			&row.BulkPrice,
+			&row.Photos,
+			&row.Variants,
+		); errScan != nil {
+			return nil, errScan
+		}
+
+		rows = append(rows, &row)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return rows, nil
+}
+
+func (r *productRepo) CreateVariantAxis(ctx context.Context, tx postgre.Transaction, productID, name string, position int) (string, error) {
+	var axisID string
+	if err := tx.QueryRowContext(ctx, CreateVariantAxisQuery, productID, name, position).Scan(&axisID); err != nil {
+		return "", err
+	}
+
+	return axisID, nil
+}
+
+func (r *productRepo) CreateVariantOption(ctx context.Context, tx postgre.Transaction, axisID, name string, position int) error {
+	_, err := tx.ExecContext(ctx, CreateVariantOptionQuery, axisID, name, position)
+	return err
+}
+
+func (r *productRepo) GetProductVariantAxes(ctx context.Context, productID string) ([]*body.VariantAxis, error) {
+	axes := make([]*body.VariantAxis, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetProductVariantAxesQuery, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var axisName, optionName string
+		if errScan := res.Scan(&axisName, &optionName); errScan != nil {
+			return nil, errScan
+		}
+
+		if len(axes) == 0 || axes[len(axes)-1].Name != axisName {
+			axes = append(axes, &body.VariantAxis{Name: axisName, Options: make([]*body.VariantOption, 0)})
+		}
+		axis := axes[len(axes)-1]
+		axis.Options = append(axis.Options, &body.VariantOption{Name: optionName})
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return axes, nil
+}
+
+func (r *productRepo) GetProductVariantCombinations(ctx context.Context, tx postgre.Transaction,
+	productID string) ([][]body.VariantDetailRequest, error) {
+	combinations := make([][]body.VariantDetailRequest, 0)
+	res, err := tx.QueryContext(ctx, GetProductVariantCombinationsQuery, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	lastDetailID := ""
+	for res.Next() {
+		var detailID string
+		var variantType, variantName sql.NullString
+		if errScan := res.Scan(&detailID, &variantType, &variantName); errScan != nil {
+			return nil, errScan
+		}
+
+		if detailID != lastDetailID {
+			lastDetailID = detailID
+			combinations = append(combinations, make([]body.VariantDetailRequest, 0))
+		}
+
+		if variantType.Valid && variantName.Valid {
+			i := len(combinations) - 1
+			combinations[i] = append(combinations[i], body.VariantDetailRequest{Type: variantType.String, Name: variantName.String})
+		}
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return combinations, nil
+}
+
+func (r *productRepo) GetPriceTiers(ctx context.Context, productDetailID string) ([]*model.PriceTier, error) {
+	priceTiers := make([]*model.PriceTier, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPriceTiersQuery, productDetailID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var tier model.PriceTier
+		if errScan := res.Scan(&tier.MinQuantity, &tier.MaxQuantity, &tier.Price); errScan != nil {
+			return nil, errScan
+		}
+		priceTiers = append(priceTiers, &tier)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return priceTiers, nil
+}
+
+func (r *productRepo) CreatePriceTier(ctx context.Context, tx postgre.Transaction, productDetailID string, tier body.PriceTierRequest) error {
+	_, err := tx.ExecContext(ctx, CreatePriceTierQuery, productDetailID, tier.MinQuantity, tier.MaxQuantity, tier.Price)
+	return err
+}
+
+func (r *productRepo) DeletePriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) error {
+	_, err := tx.ExecContext(ctx, DeletePriceTiersQuery, productDetailID)
+	return err
+}
+
+func (r *productRepo) GetProductSnapshot(ctx context.Context, tx postgre.Transaction, productID string) (*model.ProductSnapshot, error) {
+	var snapshot model.ProductSnapshot
+	if err := tx.QueryRowContext(ctx, GetProductSnapshotQuery, productID).Scan(
+		&snapshot.Title,
+		&snapshot.Description,
+		&snapshot.Thumbnail,
+		&snapshot.ListedStatus,
+	); err != nil {
+		return nil, err
+	}
+
+	res, err := tx.QueryContext(ctx, GetProductDetailSnapshotQuery, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	snapshot.ProductDetail = make([]*model.ProductDetailSnapshot, 0)
+	for res.Next() {
+		detail := model.ProductDetailSnapshot{Photo: make([]string, 0)}
+		if errScan := res.Scan(
+			&detail.ID,
+			&detail.Price,
+			&detail.Stock,
+			&detail.Weight,
+			&detail.Size,
+			&detail.Hazardous,
+			&detail.Condition,
+			&detail.BulkPrice,
+			(*pq.StringArray)(&detail.Photo),
+		); errScan != nil {
+			return nil, errScan
+		}
+		snapshot.ProductDetail = append(snapshot.ProductDetail, &detail)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+	res.Close()
+
+	for _, detail := range snapshot.ProductDetail {
+		detail.PriceTiers, err = r.getPriceTiers(ctx, tx, detail.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		detail.Variant, err = r.getVariantSnapshot(ctx, tx, detail.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &snapshot, nil
+}
+
+func (r *productRepo) getVariantSnapshot(ctx context.Context, tx postgre.Transaction, productDetailID string) ([]*model.VariantSnapshot, error) {
+	variants := make([]*model.VariantSnapshot, 0)
+	res, err := tx.QueryContext(ctx, GetVariantSnapshotQuery, productDetailID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var variant model.VariantSnapshot
+		if errScan := res.Scan(&variant.Type, &variant.Name); errScan != nil {
+			return nil, errScan
+		}
+		variants = append(variants, &variant)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return variants, nil
+}
+
+func (r *productRepo) getPriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) ([]*model.PriceTier, error) {
+	priceTiers := make([]*model.PriceTier, 0)
+	res, err := tx.QueryContext(ctx, GetPriceTiersQuery, productDetailID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var tier model.PriceTier
+		if errScan := res.Scan(&tier.MinQuantity, &tier.MaxQuantity, &tier.Price); errScan != nil {
+			return nil, errScan
+		}
+		priceTiers = append(priceTiers, &tier)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return priceTiers, nil
+}
+
+func (r *productRepo) GetProductVersionNumber(ctx context.Context, tx postgre.Transaction, productID string) (int, error) {
+	var version int
+	if err := tx.QueryRowContext(ctx, GetProductVersionNumberQuery, productID).Scan(&version); err != nil {
+		return 0, err
+	}
+
+	return version, nil
+}
+
+func (r *productRepo) CreateProductVersion(ctx context.Context, tx postgre.Transaction, version *model.ProductVersion) error {
+	snapshot, err := json.Marshal(version.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	changes, err := json.Marshal(version.Changes)
+	if err != nil {
+		return err
+	}
+
+	return tx.QueryRowContext(ctx, CreateProductVersionQuery, version.ProductID, version.Version, version.UserID,
+		version.Note, snapshot, changes).Scan(&version.ID, &version.CreatedAt)
+}
+
+func (r *productRepo) GetTotalProductVersions(ctx context.Context, productID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalProductVersionsQuery, productID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *productRepo) GetProductVersions(ctx context.Context, productID string, pgn *pagination.Pagination) ([]*model.ProductVersion, error) {
+	versions := make([]*model.ProductVersion, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetProductVersionsQuery, productID, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		version, errScan := scanProductVersion(res.Scan)
+		if errScan != nil {
+			return nil, errScan
+		}
+		versions = append(versions, version)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return versions, nil
+}
+
+func (r *productRepo) GetProductVersion(ctx context.Context, productID string, version int) (*model.ProductVersion, error) {
+	return scanProductVersion(r.PSQL.QueryRowContext(ctx, GetProductVersionQuery, productID, version).Scan)
+}
+
+func scanProductVersion(scan func(dest ...interface{}) error) (*model.ProductVersion, error) {
+	var version model.ProductVersion
+	var snapshot, changes []byte
+	if err := scan(
+		&version.ID,
+		&version.ProductID,
+		&version.Version,
+		&version.UserID,
+		&version.Note,
+		&snapshot,
+		&changes,
+		&version.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(snapshot, &version.Snapshot); err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(changes, &version.Changes); err != nil {
+		return nil, err
+	}
+
+	return &version, nil
+}
+
+func (r *productRepo) CreatePriceHistory(ctx context.Context, tx postgre.Transaction, productDetailID string, price model.Money) error {
+	_, err := tx.ExecContext(ctx, CreatePriceHistoryQuery, productDetailID, price)
+	return err
+}
+
+func (r *productRepo) GetPriceHistory(ctx context.Context, productID string, since time.Time) ([]*model.PriceHistory, error) {
+	history := make([]*model.PriceHistory, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPriceHistoryQuery, productID, since)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var point model.PriceHistory
+		if errScan := res.Scan(&point.ProductDetailID, &point.Price, &point.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+		history = append(history, &point)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return history, nil
+}
+
+func (r *productRepo) GetProductShopID(ctx context.Context, productID string) (string, error) {
+	var shopID string
+	if err := r.PSQL.QueryRowContext(ctx, GetProductShopIDQuery, productID).Scan(&shopID); err != nil {
+		return "", err
+	}
+
+	return shopID, nil
+}
+
+func (r *productRepo) RestoreProductDetail(ctx context.Context, tx postgre.Transaction, productID, productDetailID string) error {
+	_, err := tx.ExecContext(ctx, RestoreProductDetailQuery, productDetailID, productID)
+	return err
+}
+
+func (r *productRepo) GetProductAlertState(ctx context.Context, productDetailID string) (*model.ProductAlert, error) {
+	var productAlert model.ProductAlert
+	var discount model.Discount
+	if err := r.PSQL.QueryRowContext(ctx, GetProductAlertStateQuery, constant.StockReservationActive, productDetailID).
+		Scan(productAlertStateDest(&productAlert, &discount)...); err != nil {
+		return nil, err
+	}
+	productAlert.Discount = &discount
+
+	return &productAlert, nil
+}
+
+func (r *productRepo) GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error) {
+	return r.getProductAlerts(ctx, GetProductAlertsByProductIDsQuery, constant.StockReservationActive, pq.Array(productIDs))
+}
+
+func (r *productRepo) GetProductAlerts(ctx context.Context, userID string, pgn *pagination.Pagination) ([]*model.ProductAlert, error) {
+	return r.getProductAlerts(ctx, GetProductAlertsQuery, constant.StockReservationActive, userID, pgn.GetLimit(), pgn.GetOffset())
+}
+
+func (r *productRepo) getProductAlerts(ctx context.Context, query string, args ...interface{}) ([]*model.ProductAlert, error) {
+	alerts := make([]*model.ProductAlert, 0)
+	res, err := r.PSQL.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var productAlert model.ProductAlert
+		var discount model.Discount
+		dest := []interface{}{
+			&productAlert.ID,
+			&productAlert.UserID,
+			&productAlert.ProductDetailID,
+			&productAlert.AlertType,
+			&productAlert.TargetPrice,
+			&productAlert.IsTriggered,
+			&productAlert.UnsubscribeToken,
+			&productAlert.NotifiedAt,
+			&productAlert.CreatedAt,
+			&productAlert.Email,
+		}
+		if errScan := res.Scan(append(dest, productAlertStateDest(&productAlert, &discount)...)...); errScan != nil {
+			return nil, errScan
+		}
+		productAlert.Discount = &discount
+		alerts = append(alerts, &productAlert)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return alerts, nil
+}
+
+func productAlertStateDest(productAlert *model.ProductAlert, discount *model.Discount) []interface{} {
+	return []interface{}{
+		&productAlert.ProductID,
+		&productAlert.Title,
+		&productAlert.ThumbnailURL,
+		&productAlert.Price,
+		&productAlert.AvailableStock,
+		&discount.DiscountPercentage,
+		&discount.DiscountFixPrice,
+		&discount.MinProductPrice,
+		&discount.MaxDiscountPrice,
+	}
+}
+
+func (r *productRepo) GetTotalProductAlerts(ctx context.Context, userID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalProductAlertsQuery, userID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *productRepo) GetAlertedProductIDs(ctx context.Context) ([]string, error) {
+	productIDs := make([]string, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetAlertedProductIDsQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var productID string
+		if errScan := res.Scan(&productID); errScan != nil {
+			return nil, errScan
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return productIDs, nil
+}
+
+func (r *productRepo) CreateProductAlert(ctx context.Context, productAlert *model.ProductAlert) error {
+	return r.PSQL.QueryRowContext(ctx, CreateProductAlertQuery,
+		productAlert.UserID,
+		productAlert.ProductDetailID,
+		productAlert.AlertType,
+		productAlert.TargetPrice,
+		productAlert.IsTriggered).Scan(
+		&productAlert.ID,
+		&productAlert.UnsubscribeToken,
+		&productAlert.NotifiedAt,
+		&productAlert.CreatedAt)
+}
+
+func (r *productRepo) UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error) {
+	res, err := r.PSQL.ExecContext(ctx, UpdateProductAlertTriggeredQuery, alertID, triggered)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *productRepo) DeleteProductAlert(ctx context.Context, userID, alertID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, DeleteProductAlertQuery, alertID, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *productRepo) DeleteProductAlertByToken(ctx context.Context, token string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, DeleteProductAlertByTokenQuery, token)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -31,5 +31,17 @@ type UseCase interface {
 	UpdateListedStatus(ctx context.Context, productID string) error
 	UpdateProductListedStatusBulk(ctx context.Context, product body.UpdateProductListedStatusBulkRequest) error
 	UpdateProduct(ctx context.Context, requestBody body.UpdateProductRequest, userID, productID string) error
-	UpdateProductMetadata(ctx context.Context) error
+	UpdateProductMetadata(ctx context.Context) (int64, error)
+	ImportProducts(ctx context.Context, userID string, rows []*body.ProductCSVRow) (*model.ProductImportJob, error)
+	GetProductImportJob(ctx context.Context, userID, jobID string) (*model.ProductImportJob, error)
+	FailStaleProductImportJobs(ctx context.Context) (int64, error)
+	ExportProducts(ctx context.Context, userID string) ([]*body.ProductCSVRow, error)
+	GetProductVersions(ctx context.Context, userID, productID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	RollbackProduct(ctx context.Context, userID, productID string, version int) error
+	GetPriceHistory(ctx context.Context, productID string) ([]*body.ProductPriceHistoryResponse, error)
+	CreateProductAlert(ctx context.Context, userID string, requestBody body.CreateProductAlertRequest) (*model.ProductAlert, error)
+	GetProductAlerts(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	DeleteProductAlert(ctx context.Context, userID, alertID string) error
+	UnsubscribeProductAlert(ctx context.Context, token string) error
+	EvaluateProductAlerts(ctx context.Context) (int64, error)
 }
@@ -3,18 +3,25 @@ package usecase
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 
 	"math"
 	"murakali/config"
+	"murakali/internal/alert"
+	"murakali/internal/constant"
 	"murakali/internal/model"
 	"murakali/internal/module/product"
 	"murakali/internal/module/product/delivery/body"
 	"murakali/internal/util"
 	"murakali/pkg/httperror"
+	"murakali/pkg/logger"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
 	"net/http"
+	"reflect"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -23,28 +30,32 @@ type productUC struct {
 	cfg         *config.Config
 	txRepo      *postgre.TxRepo
 	productRepo product.Repository
+	log         logger.Logger
 }
 
-func NewProductUseCase(cfg *config.Config, txRepo *postgre.TxRepo, productRepo product.Repository) product.UseCase {
-	return &productUC{cfg: cfg, txRepo: txRepo, productRepo: productRepo}
+func NewProductUseCase(cfg *config.Config, txRepo *postgre.TxRepo, productRepo product.Repository, log logger.Logger) product.UseCase {
+	return &productUC{cfg: cfg, txRepo: txRepo, productRepo: productRepo, log: log}
 }
 
-func (u *productUC) UpdateProductMetadata(ctx context.Context) error {
+func (u *productUC) UpdateProductMetadata(ctx context.Context) (int64, error) {
 	productFav, err := u.productRepo.GetFavoriteProduct(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
 	productRating, err := u.productRepo.GetRatingProduct(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
+	var rowsAffected int64
 	var errFav error
 	for _, favorite := range productFav {
 		if err := u.productRepo.UpdateProductFavorite(ctx, favorite.Product.ID.String(), *favorite.Count); err != nil {
 			errFav = err
+			continue
 		}
+		rowsAffected++
 	}
 
 	var errRating error
@@ -53,7 +64,9 @@ func (u *productUC) UpdateProductMetadata(ctx context.Context) error {
 		shopID[rating.Product.ShopID.String()] = rating.Product.ShopID.String()
 		if err := u.productRepo.UpdateProductRating(ctx, rating.Product.ID.String(), *rating.Avg); err != nil {
 			errRating = err
+			continue
 		}
+		rowsAffected++
 	}
 
 	var errShop error
@@ -64,18 +77,18 @@ func (u *productUC) UpdateProductMetadata(ctx context.Context) error {
 		}
 	}
 	if errShop != nil {
-		return errShop
+		return rowsAffected, errShop
 	}
 
 	if errFav != nil {
-		return errFav
+		return rowsAffected, errFav
 	}
 
 	if errRating != nil {
-		return errRating
+		return rowsAffected, errRating
 	}
 
-	return nil
+	return rowsAffected, nil
 }
 
 func (u *productUC) GetCategories(ctx context.Context) ([]*body.CategoryResponse, error) {
@@ -221,35 +234,15 @@ func (u *productUC) CalculateDiscountProduct(p *body.Products) *body.Products {
 	if p.PromoMaxDiscountPrice == nil {
 		return p
 	}
-	var maxDiscountPrice float64
-	var minProductPrice float64
-	var discountPercentage float64
-	var discountFixPrice float64
-	var resultDiscount float64
-	if p.PromoMinProductPrice != nil {
-		minProductPrice = *p.PromoMinProductPrice
-	}
-
-	maxDiscountPrice = *p.PromoMaxDiscountPrice
-	if p.PromoDiscountPercentage != nil {
-		discountPercentage = *p.PromoDiscountPercentage
-		if p.MinPrice >= minProductPrice && *p.PromoDiscountPercentage > 0 {
-			resultDiscount = math.Min(maxDiscountPrice,
-				p.MinPrice*(discountPercentage/100.00))
-		}
-	}
-
-	if p.PromoDiscountFixPrice != nil {
-		discountFixPrice = *p.PromoDiscountFixPrice
-		if p.MinPrice >= minProductPrice && discountFixPrice > 0 {
-			resultDiscount = math.Max(resultDiscount, discountFixPrice)
-			resultDiscount = math.Min(resultDiscount, maxDiscountPrice)
-		}
-	}
-
+	resultDiscount, subPrice := util.CalculateDiscount(p.MinPrice, &model.Discount{
+		DiscountPercentage: p.PromoDiscountPercentage,
+		DiscountFixPrice:   p.PromoDiscountFixPrice,
+		MinProductPrice:    p.PromoMinProductPrice,
+		MaxDiscountPrice:   p.PromoMaxDiscountPrice,
+	})
 	if resultDiscount > 0 {
 		p.ResultDiscount = &resultDiscount
-		p.SubPrice = p.MinPrice - resultDiscount
+		p.SubPrice = subPrice
 	}
 
 	return p
@@ -277,10 +270,18 @@ func (u *productUC) GetProductDetail(ctx context.Context, productID string) (*bo
 		}
 	}
 
+	axes, err := u.productRepo.GetProductVariantAxes(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+	axes, combinations := body.BuildVariantPicker(axes, details)
+
 	result := body.ProductDetailResponse{
-		ProductInfo:   productInfo,
-		PromotionInfo: promotionInfo,
-		ProductDetail: details,
+		ProductInfo:         productInfo,
+		PromotionInfo:       promotionInfo,
+		ProductDetail:       details,
+		VariantAxes:         axes,
+		VariantCombinations: combinations,
 	}
 	return &result, nil
 }
@@ -326,12 +327,20 @@ func (u *productUC) GetProducts(ctx context.Context, pgn *pagination.Pagination,
 			CreatedAt:                 products[i].CreatedAt,
 			UpdatedAt:                 products[i].UpdatedAt,
 			SKU:                       products[i].SKU,
+			Relevance:                 products[i].Relevance,
+			Highlight:                 products[i].Highlight,
 		}
 		p = u.CalculateDiscountProduct(p)
 		resultProduct = append(resultProduct, p)
 	}
 	pgn.Rows = resultProduct
 
+	facets, err := u.productRepo.GetProductFacets(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	pgn.Facets = facets
+
 	return pgn, nil
 }
 
@@ -547,6 +556,11 @@ func (u *productUC) CreateProduct(ctx context.Context, requestBody body.CreatePr
 		return errGet
 	}
 
+	return u.createShopProduct(ctx, shopID, userID, requestBody)
+}
+
+func (u *productUC) createShopProduct(ctx context.Context, shopID, userID string, requestBody body.CreateProductRequest) error {
+	requestBody.ResolveVariantAxes()
 	err := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
 		totalData := len(requestBody.ProductDetail)
 		minPriceTemp, maxPriceTemp := requestBody.ProductDetail[0].Price, requestBody.ProductDetail[0].Price
@@ -576,12 +590,31 @@ func (u *productUC) CreateProduct(ctx context.Context, requestBody body.CreatePr
 			return err
 		}
 
+		for i, axis := range requestBody.VariantAxes {
+			axisID, err := u.productRepo.CreateVariantAxis(ctx, tx, productID, axis.Name, i)
+			if err != nil {
+				return err
+			}
+
+			for j, option := range axis.Options {
+				if err := u.productRepo.CreateVariantOption(ctx, tx, axisID, option, j); err != nil {
+					return err
+				}
+			}
+		}
+
 		for i := 0; i < totalData; i++ {
 			productDetilID, err := u.productRepo.CreateProductDetail(ctx, tx, requestBody.ProductDetail[i], productID)
 			if err != nil {
 				return err
 			}
 
+			for _, tier := range requestBody.ProductDetail[i].PriceTiers {
+				if err := u.productRepo.CreatePriceTier(ctx, tx, productDetilID, tier); err != nil {
+					return err
+				}
+			}
+
 			totalDataPhoto := len(requestBody.ProductDetail[i].Photo)
 			if totalDataPhoto > 0 {
 				for k := 0; k < totalDataPhoto; k++ {
@@ -607,7 +640,7 @@ func (u *productUC) CreateProduct(ctx context.Context, requestBody body.CreatePr
 			}
 		}
 
-		return nil
+		return u.recordProductVersion(ctx, tx, productID, userID, "", nil)
 	})
 
 	if err != nil {
@@ -668,6 +701,14 @@ func (u *productUC) UpdateProductListedStatusBulk(ctx context.Context, productRe
 
 func (u *productUC) UpdateProduct(ctx context.Context, requestBody body.UpdateProductRequest, userID, productID string) error {
 	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		before, err := u.productRepo.GetProductSnapshot(ctx, tx, productID)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return httperror.New(http.StatusNotFound, body.ProductNotFound)
+			}
+			return err
+		}
+
 		totalData := len(requestBody.ProductDetail)
 
 		totalDataRemove := len(requestBody.ProductDetailRemove)
@@ -681,11 +722,30 @@ func (u *productUC) UpdateProduct(ctx context.Context, requestBody body.UpdatePr
 		}
 
 		for i := 0; i < totalData; i++ {
+			if requestBody.ProductDetail[i].PriceTiers == nil {
+				if err := keepStoredPriceTiers(&requestBody.ProductDetail[i], before); err != nil {
+					return err
+				}
+			}
+
 			err := u.productRepo.UpdateProductDetail(ctx, tx, requestBody.ProductDetail[i], productID)
 			if err != nil {
 				return err
 			}
 
+			if requestBody.ProductDetail[i].PriceTiers != nil {
+				err = u.productRepo.DeletePriceTiers(ctx, tx, requestBody.ProductDetail[i].ProductDetailID)
+				if err != nil {
+					return err
+				}
+				for _, tier := range requestBody.ProductDetail[i].PriceTiers {
+					err = u.productRepo.CreatePriceTier(ctx, tx, requestBody.ProductDetail[i].ProductDetailID, tier)
+					if err != nil {
+						return err
+					}
+				}
+			}
+
 			totalDataPhoto := len(requestBody.ProductDetail[i].Photo)
 			if totalDataPhoto > 0 {
 				err = u.productRepo.DeletePhoto(ctx, tx, requestBody.ProductDetail[i].ProductDetailID)
@@ -735,17 +795,79 @@ func (u *productUC) UpdateProduct(ctx context.Context, requestBody body.UpdatePr
 			MinPrice:     rangePrice.MinPrice,
 			MaxPrice:     rangePrice.MaxPrice,
 		}
-		err := u.productRepo.UpdateProduct(ctx, tx, tempBodyProduct, productID)
+		err = u.productRepo.UpdateProduct(ctx, tx, tempBodyProduct, productID)
 		if err != nil {
 			return err
 		}
 
-		return nil
+		if err := u.validateVariantMatrix(ctx, tx, productID); err != nil {
+			return err
+		}
+
+		return u.recordProductVersion(ctx, tx, productID, userID, "", before)
 	})
 
 	if errTx != nil {
 		return errTx
 	}
+
+	_, _ = alert.Evaluate(ctx, u.cfg, u.productRepo, productID)
+	return nil
+}
+
+func keepStoredPriceTiers(detail *body.UpdateProductDetailRequest, before *model.ProductSnapshot) error {
+	detail.BulkPrice = false
+	for _, stored := range before.ProductDetail {
+		if stored.ID != detail.ProductDetailID {
+			continue
+		}
+
+		tiers := make([]body.PriceTierRequest, 0, len(stored.PriceTiers))
+		for _, tier := range stored.PriceTiers {
+			tiers = append(tiers, body.PriceTierRequest{
+				MinQuantity: tier.MinQuantity,
+				MaxQuantity: tier.MaxQuantity,
+				Price:       tier.Price,
+			})
+		}
+
+		if message := body.ValidatePriceTiers(detail.Price, tiers); message != "" {
+			return httperror.New(http.StatusBadRequest, message)
+		}
+		detail.BulkPrice = stored.BulkPrice
+	}
+
+	return nil
+}
+
+func (u *productUC) validateVariantMatrix(ctx context.Context, tx postgre.Transaction, productID string) error {
+	axes, err := u.productRepo.GetProductVariantAxes(ctx, productID)
+	if err != nil {
+		return err
+	}
+
+	if len(axes) == 0 {
+		return nil
+	}
+
+	variantAxes := make([]body.VariantAxisRequest, 0, len(axes))
+	for _, axis := range axes {
+		options := make([]string, 0, len(axis.Options))
+		for _, option := range axis.Options {
+			options = append(options, option.Name)
+		}
+		variantAxes = append(variantAxes, body.VariantAxisRequest{Name: axis.Name, Options: options})
+	}
+
+	combinations, err := u.productRepo.GetProductVariantCombinations(ctx, tx, productID)
+	if err != nil {
+		return err
+	}
+
+	if message := body.ValidateVariantCombinations(variantAxes, combinations); message != "" {
+		return httperror.New(http.StatusUnprocessableEntity, message)
+	}
+
 	return nil
 }
 
@@ -792,3 +914,519 @@ func (u *productUC) DeleteProductReview(ctx context.Context, reviewID, userID st
 
 	return nil
 }
+
+func (u *productUC) ImportProducts(ctx context.Context, userID string, rows []*body.ProductCSVRow) (*model.ProductImportJob, error) {
+	shopID, err := u.productRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return nil, err
+	}
+
+	groups, rowErrors := body.GroupProductCSVRows(rows)
+	job := &model.ProductImportJob{
+		ShopID:        uuid.MustParse(shopID),
+		UserID:        uuid.MustParse(userID),
+		Status:        constant.ProductImportStatusRunning,
+		TotalRows:     len(rows),
+		TotalProducts: len(groups),
+		Errors:        make([]*model.ProductImportError, 0),
+	}
+	if err := u.productRepo.CreateProductImportJob(ctx, job); err != nil {
+		return nil, err
+	}
+
+	go u.runProductImport(context.Background(), job, groups, rowErrors)
+
+	return job, nil
+}
+
+func (u *productUC) runProductImport(ctx context.Context, job *model.ProductImportJob, groups []*body.ProductImportGroup,
+	rowErrors []*model.ProductImportError) {
+	importedProducts := 0
+	defer func() {
+		if r := recover(); r != nil {
+			u.log.Errorf("ProductImport, Job: %s, Panic: %v", job.ID, r)
+			u.finishProductImport(ctx, job, constant.ProductImportStatusFailed, importedProducts, rowErrors)
+		}
+	}()
+
+	for _, group := range groups {
+		if err := u.createShopProduct(ctx, job.ShopID.String(), job.UserID.String(), group.Request); err != nil {
+			message := body.ProductImportFailedMessage
+			var e *httperror.Error
+			if errors.As(err, &e) {
+				message = e.Error()
+			}
+			for _, rowNumber := range group.Rows {
+				rowErrors = append(rowErrors, &model.ProductImportError{Row: rowNumber, Field: "product_ref", Message: message})
+			}
+			continue
+		}
+
+		importedProducts++
+	}
+
+	status := constant.ProductImportStatusCompleted
+	if importedProducts == 0 && len(groups) > 0 {
+		status = constant.ProductImportStatusFailed
+	}
+	u.finishProductImport(ctx, job, status, importedProducts, rowErrors)
+}
+
+func (u *productUC) finishProductImport(ctx context.Context, job *model.ProductImportJob, status string, importedProducts int,
+	rowErrors []*model.ProductImportError) {
+	failedRows := make(map[int]bool)
+	for _, rowError := range rowErrors {
+		failedRows[rowError.Row] = true
+	}
+
+	body.SortProductImportErrors(rowErrors)
+	job.Status = status
+	job.ImportedProducts = importedProducts
+	job.FailedRows = len(failedRows)
+	job.Errors = rowErrors
+	job.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+
+	if err := u.productRepo.UpdateProductImportJob(ctx, job); err != nil {
+		u.log.Errorf("ProductImport, Job: %s, Error: %s", job.ID, err)
+	}
+}
+
+func (u *productUC) FailStaleProductImportJobs(ctx context.Context) (int64, error) {
+	staleAfter, err := time.ParseDuration(constant.ProductImportStaleAfter)
+	if err != nil {
+		return 0, err
+	}
+
+	return u.productRepo.FailStaleProductImportJobs(ctx, time.Now().Add(-staleAfter))
+}
+
+func (u *productUC) GetProductImportJob(ctx context.Context, userID, jobID string) (*model.ProductImportJob, error) {
+	shopID, err := u.productRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return nil, err
+	}
+
+	job, err := u.productRepo.GetProductImportJob(ctx, shopID, jobID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, body.ProductImportJobNotFound)
+		}
+		return nil, err
+	}
+
+	return job, nil
+}
+
+func (u *productUC) ExportProducts(ctx context.Context, userID string) ([]*body.ProductCSVRow, error) {
+	shopID, err := u.productRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return nil, err
+	}
+
+	return u.productRepo.GetShopProductsForExport(ctx, shopID)
+}
+
+func (u *productUC) recordProductVersion(ctx context.Context, tx postgre.Transaction, productID, userID, note string,
+	before *model.ProductSnapshot) error {
+	after, err := u.productRepo.GetProductSnapshot(ctx, tx, productID)
+	if err != nil {
+		return err
+	}
+
+	lastVersion, err := u.productRepo.GetProductVersionNumber(ctx, tx, productID)
+	if err != nil {
+		return err
+	}
+
+	id, err := uuid.Parse(productID)
+	if err != nil {
+		return err
+	}
+
+	actor, err := uuid.Parse(userID)
+	if err != nil {
+		return err
+	}
+
+	changes := make([]*model.ProductChange, 0)
+	beforePrices := make(map[string]model.Money)
+	if before != nil {
+		changes = body.DiffProductSnapshots(before, after)
+		if len(changes) == 0 {
+			return nil
+		}
+
+		for _, detail := range before.ProductDetail {
+			beforePrices[detail.ID] = detail.Price
+		}
+
+		if lastVersion == 0 {
+			lastVersion++
+			baseline := &model.ProductVersion{
+				ProductID: id,
+				Version:   lastVersion,
+				Snapshot:  before,
+				Changes:   make([]*model.ProductChange, 0),
+			}
+			if err := u.productRepo.CreateProductVersion(ctx, tx, baseline); err != nil {
+				return err
+			}
+		}
+	}
+
+	version := &model.ProductVersion{
+		ProductID: id,
+		Version:   lastVersion + 1,
+		UserID:    &actor,
+		Note:      note,
+		Snapshot:  after,
+		Changes:   changes,
+	}
+	if err := u.productRepo.CreateProductVersion(ctx, tx, version); err != nil {
+		return err
+	}
+
+	for _, detail := range after.ProductDetail {
+		if price, ok := beforePrices[detail.ID]; ok && price == detail.Price {
+			continue
+		}
+
+		if err := u.productRepo.CreatePriceHistory(ctx, tx, detail.ID, detail.Price); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (u *productUC) checkProductOwner(ctx context.Context, userID, productID string) error {
+	shopID, err := u.productRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return err
+	}
+
+	productShopID, err := u.productRepo.GetProductShopID(ctx, productID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, body.ProductNotFound)
+		}
+		return err
+	}
+
+	if productShopID != shopID {
+		return httperror.New(http.StatusNotFound, body.ProductNotFound)
+	}
+
+	return nil
+}
+
+func (u *productUC) GetProductVersions(ctx context.Context, userID, productID string,
+	pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	if err := u.checkProductOwner(ctx, userID, productID); err != nil {
+		return nil, err
+	}
+
+	totalRows, err := u.productRepo.GetTotalProductVersions(ctx, productID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	versions, err := u.productRepo.GetProductVersions(ctx, productID, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = versions
+	return pgn, nil
+}
+
+func (u *productUC) RollbackProduct(ctx context.Context, userID, productID string, version int) error {
+	if err := u.checkProductOwner(ctx, userID, productID); err != nil {
+		return err
+	}
+
+	target, err := u.productRepo.GetProductVersion(ctx, productID, version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, body.ProductVersionNotFound)
+		}
+		return err
+	}
+
+	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		before, err := u.productRepo.GetProductSnapshot(ctx, tx, productID)
+		if err != nil {
+			return err
+		}
+
+		currentStock := make(map[string]float64)
+		currentVariant := make(map[string][]*model.VariantSnapshot)
+		for _, detail := range before.ProductDetail {
+			currentStock[detail.ID] = detail.Stock
+			currentVariant[detail.ID] = detail.Variant
+		}
+
+		restored := make(map[string]bool)
+		for _, detail := range target.Snapshot.ProductDetail {
+			stock, ok := currentStock[detail.ID]
+			if !ok {
+				restored[detail.ID] = true
+				continue
+			}
+			detail.Stock = stock
+			if detail.Variant == nil {
+				detail.Variant = currentVariant[detail.ID]
+			}
+		}
+
+		if len(body.DiffProductSnapshots(before, target.Snapshot)) == 0 {
+			return httperror.New(http.StatusBadRequest, body.ProductVersionIsCurrent)
+		}
+
+		for id := range restored {
+			if err := u.productRepo.RestoreProductDetail(ctx, tx, productID, id); err != nil {
+				return err
+			}
+		}
+
+		if len(restored) > 0 {
+			current, err := u.productRepo.GetProductSnapshot(ctx, tx, productID)
+			if err != nil {
+				return err
+			}
+			for _, detail := range current.ProductDetail {
+				currentStock[detail.ID] = detail.Stock
+				currentVariant[detail.ID] = detail.Variant
+			}
+		}
+
+		keep := make(map[string]bool)
+		var minPrice, maxPrice model.Money
+		for i, detail := range target.Snapshot.ProductDetail {
+			keep[detail.ID] = true
+			if i == 0 || detail.Price < minPrice {
+				minPrice = detail.Price
+			}
+			if i == 0 || detail.Price > maxPrice {
+				maxPrice = detail.Price
+			}
+
+			err := u.productRepo.UpdateProductDetail(ctx, tx, body.UpdateProductDetailRequest{
+				ProductDetailID: detail.ID,
+				Price:           detail.Price,
+				Stock:           currentStock[detail.ID],
+				Weight:          detail.Weight,
+				Size:            detail.Size,
+				Hazardous:       detail.Hazardous,
+				Codition:        detail.Condition,
+				BulkPrice:       detail.BulkPrice,
+			}, productID)
+			if err != nil {
+				return err
+			}
+
+			if err := u.productRepo.DeletePhoto(ctx, tx, detail.ID); err != nil {
+				return err
+			}
+			for _, photo := range detail.Photo {
+				if err := u.productRepo.CreatePhoto(ctx, tx, detail.ID, photo); err != nil {
+					return err
+				}
+			}
+
+			if err := u.productRepo.DeletePriceTiers(ctx, tx, detail.ID); err != nil {
+				return err
+			}
+			for _, tier := range detail.PriceTiers {
+				err := u.productRepo.CreatePriceTier(ctx, tx, detail.ID, body.PriceTierRequest{
+					MinQuantity: tier.MinQuantity,
+					MaxQuantity: tier.MaxQuantity,
+					Price:       tier.Price,
+				})
+				if err != nil {
+					return err
+				}
+			}
+
+			if detail.Variant == nil || reflect.DeepEqual(detail.Variant, currentVariant[detail.ID]) {
+				continue
+			}
+
+			if err := u.productRepo.DeleteVariantsByProductDetailID(ctx, tx, detail.ID); err != nil {
+				return err
+			}
+			for _, variant := range detail.Variant {
+				variantDetailID, err := u.productRepo.CreateVariantDetail(ctx, tx, body.VariantDetailRequest{
+					Type: variant.Type,
+					Name: variant.Name,
+				})
+				if err != nil {
+					return err
+				}
+
+				if err := u.productRepo.CreateVariant(ctx, tx, detail.ID, variantDetailID); err != nil {
+					return err
+				}
+			}
+		}
+
+		for _, detail := range before.ProductDetail {
+			if keep[detail.ID] {
+				continue
+			}
+			if err := u.productRepo.DeleteProductDetail(ctx, tx, detail.ID); err != nil {
+				return err
+			}
+		}
+
+		err = u.productRepo.UpdateProduct(ctx, tx, body.UpdateProductInfoForQuery{
+			Title:        target.Snapshot.Title,
+			Description:  target.Snapshot.Description,
+			Thumbnail:    target.Snapshot.Thumbnail,
+			ListedStatus: target.Snapshot.ListedStatus,
+			MinPrice:     minPrice,
+			MaxPrice:     maxPrice,
+		}, productID)
+		if err != nil {
+			return err
+		}
+
+		if err := u.validateVariantMatrix(ctx, tx, productID); err != nil {
+			return err
+		}
+
+		return u.recordProductVersion(ctx, tx, productID, userID, fmt.Sprintf(body.ProductRollbackNote, version), before)
+	})
+	if errTx != nil {
+		return errTx
+	}
+
+	_, _ = alert.Evaluate(ctx, u.cfg, u.productRepo, productID)
+	return nil
+}
+
+func (u *productUC) GetPriceHistory(ctx context.Context, productID string) ([]*body.ProductPriceHistoryResponse, error) {
+	if _, err := u.productRepo.GetProductShopID(ctx, productID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, body.ProductNotFound)
+		}
+		return nil, err
+	}
+
+	since := time.Now().AddDate(0, 0, -constant.ProductPriceHistoryDays)
+	history, err := u.productRepo.GetPriceHistory(ctx, productID, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return body.GroupPriceHistory(history), nil
+}
+
+func (u *productUC) CreateProductAlert(ctx context.Context, userID string,
+	requestBody body.CreateProductAlertRequest) (*model.ProductAlert, error) {
+	productAlert, err := u.productRepo.GetProductAlertState(ctx, requestBody.ProductDetailID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusNotFound, body.ProductDetailNotFound)
+		}
+		return nil, err
+	}
+
+	productAlert.UserID, err = uuid.Parse(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	productAlert.ProductDetailID, err = uuid.Parse(requestBody.ProductDetailID)
+	if err != nil {
+		return nil, err
+	}
+
+	productAlert.AlertType = requestBody.AlertType
+	productAlert.TargetPrice = requestBody.TargetPrice
+	productAlert.CurrentPrice = alert.CurrentPrice(productAlert)
+	if productAlert.AlertType == constant.ProductAlertPriceDrop && productAlert.CurrentPrice <= *productAlert.TargetPrice {
+		return nil, httperror.New(http.StatusBadRequest, body.TargetPriceNotBelowMessage)
+	}
+
+	productAlert.IsTriggered = alert.IsMet(productAlert)
+	if err := u.productRepo.CreateProductAlert(ctx, productAlert); err != nil {
+		return nil, err
+	}
+
+	return productAlert, nil
+}
+
+func (u *productUC) GetProductAlerts(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	totalRows, err := u.productRepo.GetTotalProductAlerts(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	alerts, err := u.productRepo.GetProductAlerts(ctx, userID, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, productAlert := range alerts {
+		productAlert.CurrentPrice = alert.CurrentPrice(productAlert)
+	}
+
+	pgn.Rows = alerts
+	return pgn, nil
+}
+
+func (u *productUC) DeleteProductAlert(ctx context.Context, userID, alertID string) error {
+	rowsAffected, err := u.productRepo.DeleteProductAlert(ctx, userID, alertID)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return httperror.New(http.StatusNotFound, body.ProductAlertNotFound)
+	}
+
+	return nil
+}
+
+func (u *productUC) UnsubscribeProductAlert(ctx context.Context, token string) error {
+	rowsAffected, err := u.productRepo.DeleteProductAlertByToken(ctx, token)
+	if err != nil {
+		return err
+	}
+
+	if rowsAffected == 0 {
+		return httperror.New(http.StatusNotFound, body.ProductAlertNotFound)
+	}
+
+	return nil
+}
+
+func (u *productUC) EvaluateProductAlerts(ctx context.Context) (int64, error) {
+	productIDs, err := u.productRepo.GetAlertedProductIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return alert.Evaluate(ctx, u.cfg, u.productRepo, productIDs...)
+}
@@ -5,10 +5,12 @@ import (
 	"database/sql"
 	"fmt"
 	"murakali/config"
+	"murakali/internal/constant"
 	"murakali/internal/model"
 	"murakali/internal/module/product/delivery/body"
 	"murakali/internal/module/product/mocks"
 	"murakali/pkg/httperror"
+	"murakali/pkg/logger"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
@@ -55,10 +57,10 @@ func TestCartUseCase_UpdateProductMetadata(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
-			err := u.UpdateProductMetadata(context.Background())
+			_, err := u.UpdateProductMetadata(context.Background())
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
 			}
@@ -155,7 +157,7 @@ func TestProductUseCase_GetCategories(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetCategories(context.Background())
@@ -199,7 +201,7 @@ func TestProductUseCase_GetBanner(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetBanners(context.Background())
@@ -262,7 +264,7 @@ func TestProductUseCase_GetCategoriesByName(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetCategoriesByName(context.Background(), "test")
@@ -275,6 +277,7 @@ func TestProductUseCase_GetCategoriesByName(t *testing.T) {
 
 func TestProductUseCase_GetRecommendedProducts(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	dateString := "2021-11-23"
 	date, _ := time.Parse("2006-01-02", dateString)
 	id, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -299,25 +302,25 @@ func TestProductUseCase_GetRecommendedProducts(t *testing.T) {
 							UnitSold:                  10,
 							RatingAVG:                 temp,
 							ThumbnailURL:              "test",
-							MinPrice:                  temp,
-							MaxPrice:                  temp,
+							MinPrice:                  tempMoney,
+							MaxPrice:                  tempMoney,
 							ViewCount:                 10,
-							SubPrice:                  temp,
+							SubPrice:                  tempMoney,
 							PromoDiscountPercentage:   &temp,
-							PromoDiscountFixPrice:     &temp,
-							PromoMaxDiscountPrice:     &temp,
-							ResultDiscount:            &temp,
+							PromoDiscountFixPrice:     &tempMoney,
+							PromoMaxDiscountPrice:     &tempMoney,
+							ResultDiscount:            &tempMoney,
 							VoucherDiscountPercentage: &temp,
-							VoucherDiscountFixPrice:   &temp,
+							VoucherDiscountFixPrice:   &tempMoney,
 						}},
 						[]*model.Promotion{{
 							ID:                 id,
 							Name:               "test",
 							ProductID:          id,
 							DiscountPercentage: &temp,
-							DiscountFixPrice:   &temp,
-							MinProductPrice:    &temp,
-							MaxDiscountPrice:   &temp,
+							DiscountFixPrice:   &tempMoney,
+							MinProductPrice:    &tempMoney,
+							MaxDiscountPrice:   &tempMoney,
 						}}, []*model.Voucher{{ID: id, ShopID: id, Code: "test",
 							Quota: 10, ActivedDate: date, ExpiredDate: date}}, nil)
 
@@ -350,7 +353,7 @@ func TestProductUseCase_GetRecommendedProducts(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetRecommendedProducts(context.Background(), &pagination.Pagination{})
@@ -381,6 +384,8 @@ func TestProductUseCase_GetProductDetail(t *testing.T) {
 				r.On("GetProductDetail", mock.Anything, mock.Anything, mock.Anything).
 					Return([]*body.ProductDetail{{
 						ProductDetailID: "989d94b7-58fc-4a76-ae01-1c1b47a0755c"}}, nil)
+				r.On("GetProductVariantAxes", mock.Anything, mock.Anything).
+					Return([]*body.VariantAxis{}, nil)
 			},
 			expectedErr: nil,
 		},
@@ -422,7 +427,7 @@ func TestProductUseCase_GetProductDetail(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetProductDetail(context.Background(), "989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -435,6 +440,7 @@ func TestProductUseCase_GetProductDetail(t *testing.T) {
 
 func TestProductUseCase_GetProducts(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	dateString := "2021-11-23"
 	date, _ := time.Parse("2006-01-02", dateString)
 	id, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -459,31 +465,47 @@ func TestProductUseCase_GetProducts(t *testing.T) {
 							UnitSold:                  10,
 							RatingAVG:                 temp,
 							ThumbnailURL:              "test",
-							MinPrice:                  temp,
-							MaxPrice:                  temp,
+							MinPrice:                  tempMoney,
+							MaxPrice:                  tempMoney,
 							ViewCount:                 10,
-							SubPrice:                  temp,
+							SubPrice:                  tempMoney,
 							PromoDiscountPercentage:   &temp,
-							PromoDiscountFixPrice:     &temp,
-							PromoMaxDiscountPrice:     &temp,
-							ResultDiscount:            &temp,
+							PromoDiscountFixPrice:     &tempMoney,
+							PromoMaxDiscountPrice:     &tempMoney,
+							ResultDiscount:            &tempMoney,
 							VoucherDiscountPercentage: &temp,
-							VoucherDiscountFixPrice:   &temp,
+							VoucherDiscountFixPrice:   &tempMoney,
 						}},
 						[]*model.Promotion{{
 							ID:                 id,
 							Name:               "test",
 							ProductID:          id,
 							DiscountPercentage: &temp,
-							DiscountFixPrice:   &temp,
-							MinProductPrice:    &temp,
-							MaxDiscountPrice:   &temp,
+							DiscountFixPrice:   &tempMoney,
+							MinProductPrice:    &tempMoney,
+							MaxDiscountPrice:   &tempMoney,
 						}}, []*model.Voucher{{ID: id, ShopID: id, Code: "test",
 							Quota: 10, ActivedDate: date, ExpiredDate: date}}, nil)
-
+				r.On("GetProductFacets", mock.Anything, mock.Anything).
+					Return(&body.ProductFacets{
+						Categories: []*body.FacetCount{{Key: "test", Label: "test", Count: 1}},
+					}, nil)
 			},
 			expectedErr: nil,
 		},
+		{
+			name: "get  get  product facets error",
+			body: nil,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetAllTotalProduct", mock.Anything, mock.Anything).
+					Return(int64(0), nil)
+				r.On("GetProducts", mock.Anything, mock.Anything, mock.Anything).
+					Return([]*body.Products{}, []*model.Promotion{}, []*model.Voucher{}, nil)
+				r.On("GetProductFacets", mock.Anything, mock.Anything).
+					Return(nil, fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
 		{
 			name: "get  get  product error",
 			body: nil,
@@ -510,7 +532,7 @@ func TestProductUseCase_GetProducts(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetProducts(context.Background(), &pagination.Pagination{}, &body.GetProductQueryRequest{})
@@ -595,7 +617,7 @@ func TestProductUseCase_GetAllProductImage(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAllProductImage(context.Background(), "989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -608,6 +630,7 @@ func TestProductUseCase_GetAllProductImage(t *testing.T) {
 
 func TestProductUseCase_GetFavoriteProducts(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	dateString := "2021-11-23"
 	date, _ := time.Parse("2006-01-02", dateString)
 	id, _ := uuid.Parse("989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -632,25 +655,25 @@ func TestProductUseCase_GetFavoriteProducts(t *testing.T) {
 							UnitSold:                  10,
 							RatingAVG:                 temp,
 							ThumbnailURL:              "test",
-							MinPrice:                  temp,
-							MaxPrice:                  temp,
+							MinPrice:                  tempMoney,
+							MaxPrice:                  tempMoney,
 							ViewCount:                 10,
-							SubPrice:                  temp,
+							SubPrice:                  tempMoney,
 							PromoDiscountPercentage:   &temp,
-							PromoDiscountFixPrice:     &temp,
-							PromoMaxDiscountPrice:     &temp,
-							ResultDiscount:            &temp,
+							PromoDiscountFixPrice:     &tempMoney,
+							PromoMaxDiscountPrice:     &tempMoney,
+							ResultDiscount:            &tempMoney,
 							VoucherDiscountPercentage: &temp,
-							VoucherDiscountFixPrice:   &temp,
+							VoucherDiscountFixPrice:   &tempMoney,
 						}},
 						[]*model.Promotion{{
 							ID:                 id,
 							Name:               "test",
 							ProductID:          id,
 							DiscountPercentage: &temp,
-							DiscountFixPrice:   &temp,
-							MinProductPrice:    &temp,
-							MaxDiscountPrice:   &temp,
+							DiscountFixPrice:   &tempMoney,
+							MinProductPrice:    &tempMoney,
+							MaxDiscountPrice:   &tempMoney,
 						}}, []*model.Voucher{{ID: id, ShopID: id, Code: "test",
 							Quota: 10, ActivedDate: date, ExpiredDate: date}}, nil)
 
@@ -683,7 +706,7 @@ func TestProductUseCase_GetFavoriteProducts(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetFavoriteProducts(context.Background(), &pagination.Pagination{}, &body.GetProductQueryRequest{}, "989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -728,7 +751,7 @@ func TestProductUseCase_CountSpecificFavoriteProduct(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.CountSpecificFavoriteProduct(context.Background(), "989d94b7-58fc-4a76-ae01-1c1b47a0755c")
@@ -794,7 +817,7 @@ func TestCartUseCase_CreateFavoriteProduct(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.CreateFavoriteProduct(context.Background(), "123456", "123456")
@@ -857,7 +880,7 @@ func TestCartUseCase_DeleteFavoriteProduct(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteFavoriteProduct(context.Background(), "123456", "123456")
@@ -908,7 +931,7 @@ func TestProductUseCase_GetProductReviews(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetProductReviews(context.Background(), &pagination.Pagination{}, "123456", &body.GetReviewQueryRequest{})
@@ -965,7 +988,7 @@ func TestProductUseCase_GetTotalReviewRatingByProductID(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetTotalReviewRatingByProductID(context.Background(), "123456")
@@ -978,6 +1001,9 @@ func TestProductUseCase_GetTotalReviewRatingByProductID(t *testing.T) {
 
 func TestCartUseCase_CreateProduct(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
+	productID := "8f0cf4ba-2a1e-4b4e-9f5e-7d1b1f2c7a10"
+	snapshot := &model.ProductSnapshot{ProductDetail: []*model.ProductDetailSnapshot{{ID: "123456", Price: tempMoney}}}
 	testCase := []struct {
 		name        string
 		body        body.CreateProductRequest
@@ -995,7 +1021,7 @@ func TestCartUseCase_CreateProduct(t *testing.T) {
 					ListedStatus: true,
 				},
 				ProductDetail: []body.CreateProductDetailRequest{{
-					Price:     temp,
+					Price:     tempMoney,
 					Stock:     temp,
 					Weight:    temp,
 					Size:      temp,
@@ -1011,12 +1037,59 @@ func TestCartUseCase_CreateProduct(t *testing.T) {
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("123456", nil)
-				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(productID, nil)
+				r.On("CreateVariantAxis", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateVariantOption", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("CreateProductDetail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
 				r.On("CreatePhoto", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("CreateVariantDetail", mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
 				r.On("CreateVariant", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
-
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(snapshot, nil)
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(0, nil)
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "123456", tempMoney).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success create product with price tiers",
+			body: body.CreateProductRequest{
+				ProductInfo: body.CreateProductInfo{
+					Title:        "test",
+					Description:  "description",
+					Thumbnail:    "test",
+					CategoryID:   "989d94b7-58fc-4a76-ae01-1c1b47a0755c",
+					ListedStatus: true,
+				},
+				ProductDetail: []body.CreateProductDetailRequest{{
+					Price:     tempMoney,
+					Stock:     temp,
+					Weight:    temp,
+					Size:      temp,
+					Codition:  "test",
+					BulkPrice: true,
+					Photo:     []string{"test"},
+					VariantDetail: []body.VariantDetailRequest{{
+						Type: "color",
+						Name: "big",
+					}},
+					PriceTiers: []body.PriceTierRequest{{MinQuantity: 10, Price: 8}},
+				}},
+			},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(productID, nil)
+				r.On("CreateVariantAxis", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateVariantOption", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateProductDetail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreatePriceTier", mock.Anything, mock.Anything, "123456", body.PriceTierRequest{MinQuantity: 10, Price: 8}).Return(nil)
+				r.On("CreatePhoto", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateVariantDetail", mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateVariant", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(snapshot, nil)
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(0, nil)
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "123456", tempMoney).Return(nil)
 			},
 			expectedErr: nil,
 		},
@@ -1031,7 +1104,7 @@ func TestCartUseCase_CreateProduct(t *testing.T) {
 					ListedStatus: true,
 				},
 				ProductDetail: []body.CreateProductDetailRequest{{
-					Price:     temp,
+					Price:     tempMoney,
 					Stock:     temp,
 					Weight:    temp,
 					Size:      temp,
@@ -1062,7 +1135,7 @@ func TestCartUseCase_CreateProduct(t *testing.T) {
 					ListedStatus: true,
 				},
 				ProductDetail: []body.CreateProductDetailRequest{{
-					Price:     temp,
+					Price:     tempMoney,
 					Stock:     temp,
 					Weight:    temp,
 					Size:      temp,
@@ -1091,10 +1164,10 @@ func TestCartUseCase_CreateProduct(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
-			err := u.CreateProduct(context.Background(), tc.body, "123456")
+			err := u.CreateProduct(context.Background(), tc.body, "2c4f7a52-0b0e-4d8a-9a6f-3f1d2e5b6c7d")
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
 			}
@@ -1104,6 +1177,7 @@ func TestCartUseCase_CreateProduct(t *testing.T) {
 
 func TestAdminUC_EditBanner(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -1121,9 +1195,9 @@ func TestAdminUC_EditBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("FindReview", mock.Anything, mock.Anything).Return(&body.ReviewProduct{}, nil)
@@ -1139,9 +1213,9 @@ func TestAdminUC_EditBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("FindReview", mock.Anything, mock.Anything).Return(nil, fmt.Errorf("test"))
@@ -1156,9 +1230,9 @@ func TestAdminUC_EditBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("FindReview", mock.Anything, mock.Anything).Return(nil, nil)
@@ -1173,9 +1247,9 @@ func TestAdminUC_EditBanner(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("FindReview", mock.Anything, mock.Anything).Return(&body.ReviewProduct{}, nil)
@@ -1190,7 +1264,7 @@ func TestAdminUC_EditBanner(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteProductReview(context.Background(), "123", "123")
@@ -1203,6 +1277,7 @@ func TestAdminUC_EditBanner(t *testing.T) {
 
 func TestAdminUC_CreateProductReview(t *testing.T) {
 	var temp float64 = 10
+	var tempMoney model.Money = 10
 	datestring := "02-01-2006 15:04:05"
 	date, _ := time.Parse("02-01-2006 15:04:05", datestring)
 	testCase := []struct {
@@ -1220,9 +1295,9 @@ func TestAdminUC_CreateProductReview(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetProductReviews", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*body.ReviewProduct{}, nil)
@@ -1238,9 +1313,9 @@ func TestAdminUC_CreateProductReview(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				r.On("GetProductReviews", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*body.ReviewProduct{{}, {}}, nil)
@@ -1255,9 +1330,9 @@ func TestAdminUC_CreateProductReview(t *testing.T) {
 				ActivedDate:        date,
 				ExpiredDate:        date,
 				DiscountPercentage: &temp,
-				DiscountFixPrice:   &temp,
-				MinProductPrice:    &temp,
-				MaxDiscountPrice:   &temp,
+				DiscountFixPrice:   &tempMoney,
+				MinProductPrice:    &tempMoney,
+				MaxDiscountPrice:   &tempMoney,
 			},
 
 			mock: func(t *testing.T, r *mocks.Repository) {
@@ -1273,7 +1348,7 @@ func TestAdminUC_CreateProductReview(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.CreateProductReview(context.Background(), body.ReviewProductRequest{}, "123")
@@ -1296,6 +1371,7 @@ func TestAdminUC_UpdateProduct(t *testing.T) {
 			name:    "Delete Product successfully",
 			reqBody: body.UpdateProductRequest{ProductDetailRemove: []string{"123", "123"}},
 			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, mock.Anything).Return(&model.ProductSnapshot{}, nil)
 				r.On("DeleteProductDetail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 			},
 			expectedErr: fmt.Errorf("test"),
@@ -1304,12 +1380,50 @@ func TestAdminUC_UpdateProduct(t *testing.T) {
 			name:    "Delete Product successfully",
 			reqBody: body.UpdateProductRequest{ProductDetailRemove: []string{"123", "123"}, ProductDetail: []body.UpdateProductDetailRequest{{}, {}}},
 			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, mock.Anything).Return(&model.ProductSnapshot{}, nil)
 				r.On("DeleteProductDetail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateProductDetail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
 
 			},
 			expectedErr: fmt.Errorf("test"),
 		},
+		{
+			name: "keep stored price tiers when not sent",
+			reqBody: body.UpdateProductRequest{ProductDetail: []body.UpdateProductDetailRequest{
+				{ProductDetailID: "detail-1", Price: 10000},
+			}},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, mock.Anything).Return(&model.ProductSnapshot{
+					ProductDetail: []*model.ProductDetailSnapshot{{
+						ID:         "detail-1",
+						Price:      12000,
+						BulkPrice:  true,
+						PriceTiers: []*model.PriceTier{{MinQuantity: 10, Price: 9000}},
+					}},
+				}, nil)
+				r.On("UpdateProductDetail", mock.Anything, mock.Anything, mock.MatchedBy(func(detail body.UpdateProductDetailRequest) bool {
+					return detail.BulkPrice
+				}), mock.Anything).Return(fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+		{
+			name: "error stored price tiers above new price",
+			reqBody: body.UpdateProductRequest{ProductDetail: []body.UpdateProductDetailRequest{
+				{ProductDetailID: "detail-1", Price: 8000},
+			}},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, mock.Anything).Return(&model.ProductSnapshot{
+					ProductDetail: []*model.ProductDetailSnapshot{{
+						ID:         "detail-1",
+						Price:      12000,
+						BulkPrice:  true,
+						PriceTiers: []*model.PriceTier{{MinQuantity: 10, Price: 9000}},
+					}},
+				}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, body.InvalidPriceTiersMessage),
+		},
 	}
 
 	for _, tc := range testCase {
@@ -1317,7 +1431,7 @@ func TestAdminUC_UpdateProduct(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateProduct(context.Background(), tc.reqBody, "123", "123")
@@ -1368,7 +1482,7 @@ func TestAdminUC_UpdateProductListedStatusBulk(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateProductListedStatusBulk(context.Background(), tc.reqBody)
@@ -1448,7 +1562,7 @@ func TestAdminUC_UpdateListedStatus(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateListedStatus(context.Background(), "123")
@@ -1458,3 +1572,729 @@ func TestAdminUC_UpdateListedStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestProductUseCase_RunProductImport(t *testing.T) {
+	shopID := uuid.New()
+	productID := uuid.New().String()
+	rows := []*body.ProductCSVRow{
+		{ProductRef: "shirt", Title: "Shirt", Description: "Cotton shirt", Thumbnail: "thumbnail",
+			CategoryID: "989d94b7-58fc-4a76-ae01-1c1b47a0755c", ListedStatus: "true", Price: "10000", Stock: "5",
+			Weight: "100", Size: "10", Condition: "new", Photos: "photo", Variants: "color:red"},
+		{ProductRef: "shirt", Price: "12000", Stock: "5", Weight: "100", Size: "10", Condition: "new",
+			Photos: "photo", Variants: "color:blue"},
+		{ProductRef: "pants", Title: "Pants", Description: "Jeans", Thumbnail: "thumbnail",
+			CategoryID: "989d94b7-58fc-4a76-ae01-1c1b47a0755c", Price: "abc", Stock: "5", Weight: "100",
+			Size: "10", Condition: "new", Photos: "photo", Variants: "size:m"},
+		{ProductRef: "pants", Price: "10000", Stock: "5", Weight: "100", Size: "10", Condition: "new",
+			Photos: "photo", Variants: "size:l"},
+	}
+
+	testCase := []struct {
+		name             string
+		mock             func(t *testing.T, r *mocks.Repository)
+		expectedStatus   string
+		expectedImported int
+		expectedErrors   []*model.ProductImportError
+	}{
+		{
+			name: "success import valid product and report invalid rows",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return(productID, nil)
+				r.On("CreateVariantAxis", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateVariantOption", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateProductDetail", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreatePhoto", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateVariantDetail", mock.Anything, mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("CreateVariant", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(&model.ProductSnapshot{
+					ProductDetail: []*model.ProductDetailSnapshot{{ID: "123456", Price: 10000}, {ID: "654321", Price: 12000}},
+				}, nil)
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(0, nil)
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "123456", model.Money(10000)).Return(nil)
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "654321", model.Money(12000)).Return(nil)
+				r.On("UpdateProductImportJob", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedStatus:   constant.ProductImportStatusCompleted,
+			expectedImported: 1,
+			expectedErrors: []*model.ProductImportError{
+				{Row: 4, Field: "price", Message: body.InvalidNumberMessage},
+				{Row: 5, Field: "product_ref", Message: body.ProductImportSkippedMessage},
+			},
+		},
+		{
+			name: "failed create product",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Return("", fmt.Errorf("test"))
+				r.On("UpdateProductImportJob", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedStatus:   constant.ProductImportStatusFailed,
+			expectedImported: 0,
+			expectedErrors: []*model.ProductImportError{
+				{Row: 2, Field: "product_ref", Message: body.ProductImportFailedMessage},
+				{Row: 3, Field: "product_ref", Message: body.ProductImportFailedMessage},
+				{Row: 4, Field: "price", Message: body.InvalidNumberMessage},
+				{Row: 5, Field: "product_ref", Message: body.ProductImportSkippedMessage},
+			},
+		},
+		{
+			name: "failed create product report error message",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).
+					Return("", httperror.New(http.StatusBadRequest, response.UnknownShop))
+				r.On("UpdateProductImportJob", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
+			},
+			expectedStatus:   constant.ProductImportStatusFailed,
+			expectedImported: 0,
+			expectedErrors: []*model.ProductImportError{
+				{Row: 2, Field: "product_ref", Message: response.UnknownShop},
+				{Row: 3, Field: "product_ref", Message: response.UnknownShop},
+				{Row: 4, Field: "price", Message: body.InvalidNumberMessage},
+				{Row: 5, Field: "product_ref", Message: body.ProductImportSkippedMessage},
+			},
+		},
+		{
+			name: "failed panic while importing",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("CreateProduct", mock.Anything, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					panic("test")
+				}).Return("", nil)
+				r.On("UpdateProductImportJob", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedStatus:   constant.ProductImportStatusFailed,
+			expectedImported: 0,
+			expectedErrors: []*model.ProductImportError{
+				{Row: 4, Field: "price", Message: body.InvalidNumberMessage},
+				{Row: 5, Field: "product_ref", Message: body.ProductImportSkippedMessage},
+			},
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, sqlMock, _ := sqlmock.New()
+			sqlMock.ExpectBegin()
+			sqlMock.ExpectCommit()
+			r := mocks.NewRepository(t)
+			log := logger.NewAPILogger(&config.Config{})
+			log.InitLogger()
+			u := &productUC{cfg: &config.Config{}, txRepo: &postgre.TxRepo{PSQL: sql}, productRepo: r, log: log}
+
+			tc.mock(t, r)
+			groups, rowErrors := body.GroupProductCSVRows(rows)
+			job := &model.ProductImportJob{ShopID: shopID, UserID: uuid.New(), TotalRows: len(rows), TotalProducts: len(groups)}
+			u.runProductImport(context.Background(), job, groups, rowErrors)
+
+			assert.Equal(t, tc.expectedStatus, job.Status)
+			assert.Equal(t, tc.expectedImported, job.ImportedProducts)
+			assert.Equal(t, tc.expectedErrors, job.Errors)
+			assert.Equal(t, len(tc.expectedErrors), job.FailedRows)
+			assert.True(t, job.FinishedAt.Valid)
+		})
+	}
+}
+
+func TestProductUseCase_GetProductImportJob(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success get import job",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("GetProductImportJob", mock.Anything, "123456", mock.Anything).Return(&model.ProductImportJob{}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "import job not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("123456", nil)
+				r.On("GetProductImportJob", mock.Anything, "123456", mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, body.ProductImportJobNotFound),
+		},
+		{
+			name: "user has no shop",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("", sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.UserNotExistMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
+
+			tc.mock(t, r)
+			_, err := u.GetProductImportJob(context.Background(), "123456", uuid.NewString())
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestProductUseCase_FailStaleProductImportJobs(t *testing.T) {
+	testCase := []struct {
+		name         string
+		mock         func(t *testing.T, r *mocks.Repository)
+		expectedRows int64
+		expectedErr  error
+	}{
+		{
+			name: "success fail stale import jobs",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("FailStaleProductImportJobs", mock.Anything, mock.MatchedBy(func(createdBefore time.Time) bool {
+					return time.Since(createdBefore) >= time.Hour
+				})).Return(int64(2), nil)
+			},
+			expectedRows: 2,
+			expectedErr:  nil,
+		},
+		{
+			name: "failed fail stale import jobs",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("FailStaleProductImportJobs", mock.Anything, mock.Anything).Return(int64(0), fmt.Errorf("test"))
+			},
+			expectedRows: 0,
+			expectedErr:  fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
+
+			tc.mock(t, r)
+			rows, err := u.FailStaleProductImportJobs(context.Background())
+
+			assert.Equal(t, tc.expectedRows, rows)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestProductUseCase_ValidateVariantMatrix(t *testing.T) {
+	axes := []*body.VariantAxis{
+		{Name: "color", Options: []*body.VariantOption{{Name: "red"}, {Name: "blue"}}},
+		{Name: "size", Options: []*body.VariantOption{{Name: "m"}, {Name: "l"}}},
+	}
+
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "product without variant axes",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductVariantAxes", mock.Anything, mock.Anything).Return([]*body.VariantAxis{}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "every detail maps to one combination",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductVariantAxes", mock.Anything, mock.Anything).Return(axes, nil)
+				r.On("GetProductVariantCombinations", mock.Anything, mock.Anything, mock.Anything).Return([][]body.VariantDetailRequest{
+					{{Type: "size", Name: "m"}, {Type: "color", Name: "red"}},
+					{{Type: "color", Name: "blue"}, {Type: "size", Name: "m"}},
+				}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "duplicate combination",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductVariantAxes", mock.Anything, mock.Anything).Return(axes, nil)
+				r.On("GetProductVariantCombinations", mock.Anything, mock.Anything, mock.Anything).Return([][]body.VariantDetailRequest{
+					{{Type: "color", Name: "red"}, {Type: "size", Name: "m"}},
+					{{Type: "size", Name: "m"}, {Type: "color", Name: "red"}},
+				}, nil)
+			},
+			expectedErr: httperror.New(http.StatusUnprocessableEntity, body.DuplicateVariantCombinationMessage),
+		},
+		{
+			name: "detail missing an axis",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductVariantAxes", mock.Anything, mock.Anything).Return(axes, nil)
+				r.On("GetProductVariantCombinations", mock.Anything, mock.Anything, mock.Anything).Return([][]body.VariantDetailRequest{
+					{{Type: "color", Name: "red"}},
+				}, nil)
+			},
+			expectedErr: httperror.New(http.StatusUnprocessableEntity, body.VariantCombinationMismatchMessage),
+		},
+		{
+			name: "option outside the axis",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductVariantAxes", mock.Anything, mock.Anything).Return(axes, nil)
+				r.On("GetProductVariantCombinations", mock.Anything, mock.Anything, mock.Anything).Return([][]body.VariantDetailRequest{
+					{{Type: "color", Name: "green"}, {Type: "size", Name: "m"}},
+				}, nil)
+			},
+			expectedErr: httperror.New(http.StatusUnprocessableEntity, body.VariantCombinationMismatchMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := &productUC{cfg: &config.Config{}, txRepo: &postgre.TxRepo{}, productRepo: r}
+
+			tc.mock(t, r)
+			err := u.validateVariantMatrix(context.Background(), nil, "123")
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestProductUseCase_GetProductDetailVariantPicker(t *testing.T) {
+	inStock, outOfStock := float64(5), float64(0)
+	r := mocks.NewRepository(t)
+	u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
+
+	r.On("GetProductInfo", mock.Anything, mock.Anything).Return(&body.ProductInfo{}, nil)
+	r.On("GetPromotionInfo", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+	r.On("GetProductDetail", mock.Anything, mock.Anything, mock.Anything).Return([]*body.ProductDetail{
+		{ProductDetailID: "1", AvailableStock: &inStock, Variant: map[string]string{"red": "color", "m": "size"}},
+		{ProductDetailID: "2", AvailableStock: &outOfStock, Variant: map[string]string{"blue": "color", "l": "size"}},
+	}, nil)
+	r.On("GetProductVariantAxes", mock.Anything, mock.Anything).Return([]*body.VariantAxis{
+		{Name: "size", Options: []*body.VariantOption{{Name: "m"}, {Name: "l"}}},
+		{Name: "color", Options: []*body.VariantOption{{Name: "red"}, {Name: "blue"}}},
+	}, nil)
+
+	res, err := u.GetProductDetail(context.Background(), "123")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*body.VariantAxis{
+		{Name: "size", Options: []*body.VariantOption{{Name: "m", Available: true}, {Name: "l"}}},
+		{Name: "color", Options: []*body.VariantOption{{Name: "red", Available: true}, {Name: "blue"}}},
+	}, res.VariantAxes)
+	assert.Equal(t, []*body.VariantCombination{
+		{ProductDetailID: "1", Options: []string{"m", "red"}, AvailableStock: &inStock},
+		{ProductDetailID: "2", Options: []string{"l", "blue"}, AvailableStock: &outOfStock},
+	}, res.VariantCombinations)
+}
+
+func TestProductUseCase_RecordProductVersion(t *testing.T) {
+	productID := uuid.New().String()
+	userID := uuid.New().String()
+	before := &model.ProductSnapshot{
+		Title: "shirt",
+		ProductDetail: []*model.ProductDetailSnapshot{
+			{ID: "detail-1", Price: 10000, Stock: 5, Photo: []string{}, PriceTiers: []*model.PriceTier{}},
+			{ID: "detail-2", Price: 12000, Stock: 5, Photo: []string{}, PriceTiers: []*model.PriceTier{}},
+		},
+	}
+
+	testCase := []struct {
+		name  string
+		after *model.ProductSnapshot
+		mock  func(t *testing.T, r *mocks.Repository, after *model.ProductSnapshot)
+	}{
+		{
+			name: "record baseline and changed version",
+			after: &model.ProductSnapshot{
+				Title: "cotton shirt",
+				ProductDetail: []*model.ProductDetailSnapshot{
+					{ID: "detail-1", Price: 9000, Stock: 5, Photo: []string{}, PriceTiers: []*model.PriceTier{}},
+					{ID: "detail-2", Price: 12000, Stock: 5, Photo: []string{}, PriceTiers: []*model.PriceTier{}},
+				},
+			},
+			mock: func(t *testing.T, r *mocks.Repository, after *model.ProductSnapshot) {
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(after, nil)
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(0, nil)
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.MatchedBy(func(v *model.ProductVersion) bool {
+					return v.Version == 1 && v.UserID == nil && v.Snapshot == before && len(v.Changes) == 0
+				})).Return(nil).Once()
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.MatchedBy(func(v *model.ProductVersion) bool {
+					return v.Version == 2 && v.UserID.String() == userID && v.Snapshot == after && len(v.Changes) == 2 &&
+						v.Changes[0].Field == "title" && v.Changes[1].Field == "products_detail.detail-1.price"
+				})).Return(nil).Once()
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "detail-1", model.Money(9000)).Return(nil)
+			},
+		},
+		{
+			name: "skip version without changes",
+			after: &model.ProductSnapshot{
+				Title: "shirt",
+				ProductDetail: []*model.ProductDetailSnapshot{
+					{ID: "detail-1", Price: 10000, Stock: 5, Photo: []string{}, PriceTiers: []*model.PriceTier{}},
+					{ID: "detail-2", Price: 12000, Stock: 5, Photo: []string{}, PriceTiers: []*model.PriceTier{}},
+				},
+			},
+			mock: func(t *testing.T, r *mocks.Repository, after *model.ProductSnapshot) {
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(after, nil)
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(3, nil)
+			},
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := &productUC{cfg: &config.Config{}, productRepo: r}
+
+			tc.mock(t, r, tc.after)
+			err := u.recordProductVersion(context.Background(), nil, productID, userID, "", before)
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestProductUseCase_RollbackProduct(t *testing.T) {
+	productID := uuid.New().String()
+	userID := uuid.New().String()
+	newSnapshot := func() *model.ProductSnapshot {
+		return &model.ProductSnapshot{
+			Title: "shirt",
+			ProductDetail: []*model.ProductDetailSnapshot{
+				{ID: "detail-1", Price: 10000, Stock: 5, Photo: []string{"photo"}, PriceTiers: []*model.PriceTier{}},
+			},
+		}
+	}
+
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository, sqlMock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name: "product of another shop",
+			mock: func(t *testing.T, r *mocks.Repository, sqlMock sqlmock.Sqlmock) {
+				r.On("GetShopIDByUserID", mock.Anything, userID).Return("shop-1", nil)
+				r.On("GetProductShopID", mock.Anything, productID).Return("shop-2", nil)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, body.ProductNotFound),
+		},
+		{
+			name: "version not found",
+			mock: func(t *testing.T, r *mocks.Repository, sqlMock sqlmock.Sqlmock) {
+				r.On("GetShopIDByUserID", mock.Anything, userID).Return("shop-1", nil)
+				r.On("GetProductShopID", mock.Anything, productID).Return("shop-1", nil)
+				r.On("GetProductVersion", mock.Anything, productID, 2).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, body.ProductVersionNotFound),
+		},
+		{
+			name: "version is current apart from stock",
+			mock: func(t *testing.T, r *mocks.Repository, sqlMock sqlmock.Sqlmock) {
+				target := newSnapshot()
+				target.ProductDetail[0].Stock = 20
+				r.On("GetShopIDByUserID", mock.Anything, userID).Return("shop-1", nil)
+				r.On("GetProductShopID", mock.Anything, productID).Return("shop-1", nil)
+				r.On("GetProductVersion", mock.Anything, productID, 2).Return(&model.ProductVersion{Version: 2, Snapshot: target}, nil)
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectRollback()
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(newSnapshot(), nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, body.ProductVersionIsCurrent),
+		},
+		{
+			name: "success rollback keeps current stock",
+			mock: func(t *testing.T, r *mocks.Repository, sqlMock sqlmock.Sqlmock) {
+				target := newSnapshot()
+				target.ProductDetail[0].Stock = 20
+				current := newSnapshot()
+				current.Title = "cotton shirt"
+				current.ProductDetail[0].Price = 9000
+				current.ProductDetail = append(current.ProductDetail, &model.ProductDetailSnapshot{ID: "detail-2", Price: 9500})
+				after := newSnapshot()
+
+				r.On("GetShopIDByUserID", mock.Anything, userID).Return("shop-1", nil)
+				r.On("GetProductShopID", mock.Anything, productID).Return("shop-1", nil)
+				r.On("GetProductVersion", mock.Anything, productID, 2).Return(&model.ProductVersion{Version: 2, Snapshot: target}, nil)
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectCommit()
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(current, nil).Once()
+				r.On("UpdateProductDetail", mock.Anything, mock.Anything, body.UpdateProductDetailRequest{
+					ProductDetailID: "detail-1",
+					Price:           10000,
+					Stock:           5,
+				}, productID).Return(nil)
+				r.On("DeletePhoto", mock.Anything, mock.Anything, "detail-1").Return(nil)
+				r.On("CreatePhoto", mock.Anything, mock.Anything, "detail-1", "photo").Return(nil)
+				r.On("DeletePriceTiers", mock.Anything, mock.Anything, "detail-1").Return(nil)
+				r.On("DeleteProductDetail", mock.Anything, mock.Anything, "detail-2").Return(nil)
+				r.On("UpdateProduct", mock.Anything, mock.Anything, body.UpdateProductInfoForQuery{
+					Title:    "shirt",
+					MinPrice: 10000,
+					MaxPrice: 10000,
+				}, productID).Return(nil)
+				r.On("GetProductVariantAxes", mock.Anything, productID).Return([]*body.VariantAxis{}, nil)
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(after, nil).Once()
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(3, nil)
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.MatchedBy(func(v *model.ProductVersion) bool {
+					return v.Version == 4 && v.Note == "Rollback to version 2"
+				})).Return(nil)
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "detail-1", model.Money(10000)).Return(nil)
+				r.On("GetProductAlertsByProductIDs", mock.Anything, []string{productID}).Return([]*model.ProductAlert{}, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success rollback restores deleted detail with its variants",
+			mock: func(t *testing.T, r *mocks.Repository, sqlMock sqlmock.Sqlmock) {
+				red := []*model.VariantSnapshot{{Type: "color", Name: "red"}}
+				blue := []*model.VariantSnapshot{{Type: "color", Name: "blue"}}
+				target := newSnapshot()
+				target.ProductDetail[0].Variant = red
+				target.ProductDetail = append(target.ProductDetail, &model.ProductDetailSnapshot{
+					ID: "detail-2", Price: 12000, Photo: []string{}, PriceTiers: []*model.PriceTier{}, Variant: blue,
+				})
+				current := newSnapshot()
+				current.ProductDetail[0].Variant = red
+				restored := newSnapshot()
+				restored.ProductDetail[0].Variant = red
+				restored.ProductDetail = append(restored.ProductDetail, &model.ProductDetailSnapshot{
+					ID: "detail-2", Price: 12000, Stock: 3, Photo: []string{}, PriceTiers: []*model.PriceTier{},
+					Variant: []*model.VariantSnapshot{},
+				})
+				after := newSnapshot()
+				after.ProductDetail[0].Variant = red
+				after.ProductDetail = append(after.ProductDetail, &model.ProductDetailSnapshot{
+					ID: "detail-2", Price: 12000, Stock: 3, Photo: []string{}, PriceTiers: []*model.PriceTier{}, Variant: blue,
+				})
+
+				r.On("GetShopIDByUserID", mock.Anything, userID).Return("shop-1", nil)
+				r.On("GetProductShopID", mock.Anything, productID).Return("shop-1", nil)
+				r.On("GetProductVersion", mock.Anything, productID, 2).Return(&model.ProductVersion{Version: 2, Snapshot: target}, nil)
+				sqlMock.ExpectBegin()
+				sqlMock.ExpectCommit()
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(current, nil).Once()
+				r.On("RestoreProductDetail", mock.Anything, mock.Anything, productID, "detail-2").Return(nil)
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(restored, nil).Once()
+				r.On("UpdateProductDetail", mock.Anything, mock.Anything, body.UpdateProductDetailRequest{
+					ProductDetailID: "detail-1",
+					Price:           10000,
+					Stock:           5,
+				}, productID).Return(nil)
+				r.On("UpdateProductDetail", mock.Anything, mock.Anything, body.UpdateProductDetailRequest{
+					ProductDetailID: "detail-2",
+					Price:           12000,
+					Stock:           3,
+				}, productID).Return(nil)
+				r.On("DeletePhoto", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreatePhoto", mock.Anything, mock.Anything, "detail-1", "photo").Return(nil)
+				r.On("DeletePriceTiers", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("DeleteVariantsByProductDetailID", mock.Anything, mock.Anything, "detail-2").Return(nil)
+				r.On("CreateVariantDetail", mock.Anything, mock.Anything, body.VariantDetailRequest{Type: "color", Name: "blue"}).
+					Return("variant-detail-2", nil)
+				r.On("CreateVariant", mock.Anything, mock.Anything, "detail-2", "variant-detail-2").Return(nil)
+				r.On("UpdateProduct", mock.Anything, mock.Anything, body.UpdateProductInfoForQuery{
+					Title:    "shirt",
+					MinPrice: 10000,
+					MaxPrice: 12000,
+				}, productID).Return(nil)
+				r.On("GetProductVariantAxes", mock.Anything, productID).Return([]*body.VariantAxis{
+					{Name: "color", Options: []*body.VariantOption{{Name: "red"}, {Name: "blue"}}},
+				}, nil)
+				r.On("GetProductVariantCombinations", mock.Anything, mock.Anything, productID).Return([][]body.VariantDetailRequest{
+					{{Type: "color", Name: "red"}},
+					{{Type: "color", Name: "blue"}},
+				}, nil)
+				r.On("GetProductSnapshot", mock.Anything, mock.Anything, productID).Return(after, nil).Once()
+				r.On("GetProductVersionNumber", mock.Anything, mock.Anything, productID).Return(3, nil)
+				r.On("CreateProductVersion", mock.Anything, mock.Anything, mock.MatchedBy(func(v *model.ProductVersion) bool {
+					return v.Version == 4 && v.Note == "Rollback to version 2"
+				})).Return(nil)
+				r.On("CreatePriceHistory", mock.Anything, mock.Anything, "detail-2", model.Money(12000)).Return(nil)
+				r.On("GetProductAlertsByProductIDs", mock.Anything, []string{productID}).Return([]*model.ProductAlert{}, nil)
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, sqlMock, _ := sqlmock.New()
+			r := mocks.NewRepository(t)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
+
+			tc.mock(t, r, sqlMock)
+			err := u.RollbackProduct(context.Background(), userID, productID, 2)
+			if tc.expectedErr == nil {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, tc.expectedErr.Error(), err.Error())
+		})
+	}
+}
+
+func TestProductUseCase_GetPriceHistory(t *testing.T) {
+	now := time.Now()
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expected    []*body.ProductPriceHistoryResponse
+		expectedErr error
+	}{
+		{
+			name: "product not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductShopID", mock.Anything, "123").Return("", sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, body.ProductNotFound),
+		},
+		{
+			name: "success group price history per product detail",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductShopID", mock.Anything, "123").Return("shop-1", nil)
+				r.On("GetPriceHistory", mock.Anything, "123", mock.MatchedBy(func(since time.Time) bool {
+					days := now.Sub(since).Hours() / 24
+					return days >= constant.ProductPriceHistoryDays-1 && days <= constant.ProductPriceHistoryDays+1
+				})).Return([]*model.PriceHistory{
+					{ProductDetailID: "detail-1", Price: 10000, CreatedAt: now.AddDate(0, 0, -90)},
+					{ProductDetailID: "detail-1", Price: 9000, CreatedAt: now},
+					{ProductDetailID: "detail-2", Price: 12000, CreatedAt: now},
+				}, nil)
+			},
+			expected: []*body.ProductPriceHistoryResponse{
+				{ProductDetailID: "detail-1", Prices: []*body.PricePoint{
+					{Price: 10000, CreatedAt: now.AddDate(0, 0, -90)},
+					{Price: 9000, CreatedAt: now},
+				}},
+				{ProductDetailID: "detail-2", Prices: []*body.PricePoint{{Price: 12000, CreatedAt: now}}},
+			},
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil)
+
+			tc.mock(t, r)
+			history, err := u.GetPriceHistory(context.Background(), "123")
+			if tc.expectedErr != nil {
+				assert.Equal(t, tc.expectedErr.Error(), err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, history)
+		})
+	}
+}
+
+func TestProductUseCase_CreateProductAlert(t *testing.T) {
+	userID := uuid.New().String()
+	productDetailID := uuid.New().String()
+	target := model.Money(9000)
+	highTarget := model.Money(10000)
+	testCase := []struct {
+		name        string
+		body        body.CreateProductAlertRequest
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success create price drop alert",
+			body: body.CreateProductAlertRequest{ProductDetailID: productDetailID, AlertType: constant.ProductAlertPriceDrop, TargetPrice: &target},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductAlertState", mock.Anything, productDetailID).Return(&model.ProductAlert{Price: 10000}, nil)
+				r.On("CreateProductAlert", mock.Anything, mock.MatchedBy(func(a *model.ProductAlert) bool {
+					return !a.IsTriggered && a.CurrentPrice == 10000
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success create back in stock alert while in stock",
+			body: body.CreateProductAlertRequest{ProductDetailID: productDetailID, AlertType: constant.ProductAlertBackInStock},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductAlertState", mock.Anything, productDetailID).Return(&model.ProductAlert{Price: 10000, AvailableStock: 5}, nil)
+				r.On("CreateProductAlert", mock.Anything, mock.MatchedBy(func(a *model.ProductAlert) bool {
+					return a.IsTriggered
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error product detail not found",
+			body: body.CreateProductAlertRequest{ProductDetailID: productDetailID, AlertType: constant.ProductAlertBackInStock},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductAlertState", mock.Anything, productDetailID).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, body.ProductDetailNotFound),
+		},
+		{
+			name: "error target price not below current price",
+			body: body.CreateProductAlertRequest{ProductDetailID: productDetailID, AlertType: constant.ProductAlertPriceDrop, TargetPrice: &highTarget},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductAlertState", mock.Anything, productDetailID).Return(&model.ProductAlert{Price: 10000}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, body.TargetPriceNotBelowMessage),
+		},
+		{
+			name: "error create alert",
+			body: body.CreateProductAlertRequest{ProductDetailID: productDetailID, AlertType: constant.ProductAlertBackInStock},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetProductAlertState", mock.Anything, productDetailID).Return(&model.ProductAlert{Price: 10000}, nil)
+				r.On("CreateProductAlert", mock.Anything, mock.Anything).Return(fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, _, _ := sqlmock.New()
+			r := mocks.NewRepository(t)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
+
+			tc.mock(t, r)
+			_, err := u.CreateProductAlert(context.Background(), userID, tc.body)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestProductUseCase_UnsubscribeProductAlert(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success unsubscribe",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("DeleteProductAlertByToken", mock.Anything, "token").Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error alert not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("DeleteProductAlertByToken", mock.Anything, "token").Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, body.ProductAlertNotFound),
+		},
+		{
+			name: "error delete alert",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("DeleteProductAlertByToken", mock.Anything, "token").Return(int64(0), fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, _, _ := sqlmock.New()
+			r := mocks.NewRepository(t)
+			u := NewProductUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
+
+			tc.mock(t, r)
+			err := u.UnsubscribeProductAlert(context.Background(), "token")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -26,8 +26,6 @@ type Handlers interface {
 	CreatePromotionSeller(c *gin.Context)
 	UpdatePromotionSeller(c *gin.Context)
 	GetDetailPromotionSellerByID(c *gin.Context)
-	UpdateOnDeliveryOrder(c *gin.Context)
-	UpdateExpiredAtOrder(c *gin.Context)
 	CancelOrderStatus(c *gin.Context)
 	GetProductWithoutPromotionSeller(c *gin.Context)
 	WithdrawalOrderBalance(c *gin.Context)
@@ -35,4 +33,16 @@ type Handlers interface {
 	CreateRefundThreadSeller(c *gin.Context)
 	UpdateRefundAccept(c *gin.Context)
 	UpdateRefundReject(c *gin.Context)
+	GetBankAccounts(c *gin.Context)
+	CreateBankAccount(c *gin.Context)
+	DeleteBankAccount(c *gin.Context)
+	CreatePayout(c *gin.Context)
+	GetPayouts(c *gin.Context)
+	GetPayoutByID(c *gin.Context)
+	InviteShopStaff(c *gin.Context)
+	GetShopStaff(c *gin.Context)
+	UpdateShopStaff(c *gin.Context)
+	DeleteShopStaff(c *gin.Context)
+	AcceptShopStaffInvitation(c *gin.Context)
+	GetShopAuditLogs(c *gin.Context)
 }
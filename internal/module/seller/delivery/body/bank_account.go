@@ -0,0 +1,56 @@
+package body
+
+import (
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+type CreateBankAccountRequest struct {
+	BankName      string `json:"bank_name"`
+	AccountNumber string `json:"account_number"`
+	AccountHolder string `json:"account_holder"`
+}
+
+func (r *CreateBankAccountRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"bank_name":      "",
+			"account_number": "",
+			"account_holder": "",
+		},
+	}
+
+	r.BankName = strings.TrimSpace(r.BankName)
+	if r.BankName == "" {
+		unprocessableEntity = true
+		entity.Fields["bank_name"] = FieldCannotBeEmptyMessage
+	}
+
+	r.AccountNumber = strings.TrimSpace(r.AccountNumber)
+	if r.AccountNumber == "" {
+		unprocessableEntity = true
+		entity.Fields["account_number"] = FieldCannotBeEmptyMessage
+	} else if !regexp.MustCompile(`^\d{5,20}$`).MatchString(r.AccountNumber) {
+		unprocessableEntity = true
+		entity.Fields["account_number"] = InvalidAccountNumberMessage
+	}
+
+	r.AccountHolder = strings.TrimSpace(r.AccountHolder)
+	if r.AccountHolder == "" {
+		unprocessableEntity = true
+		entity.Fields["account_holder"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
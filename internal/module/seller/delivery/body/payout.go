@@ -0,0 +1,41 @@
+package body
+
+import (
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type CreatePayoutRequest struct {
+	BankAccountID string `json:"bank_account_id"`
+}
+
+func (r *CreatePayoutRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"bank_account_id": "",
+		},
+	}
+
+	r.BankAccountID = strings.TrimSpace(r.BankAccountID)
+	if r.BankAccountID == "" {
+		unprocessableEntity = true
+		entity.Fields["bank_account_id"] = FieldCannotBeEmptyMessage
+	} else if _, err := uuid.Parse(r.BankAccountID); err != nil {
+		unprocessableEntity = true
+		entity.Fields["bank_account_id"] = IDNotValidMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
@@ -25,6 +25,8 @@ const (
 	CategoryNotFoundMessage                    = "Category not found."
 	ShopNotFoundMessage                        = "Shop not found."
 	CodeVoucherAlreadyExist                    = "Code Voucher Already Exist"
+	InvalidAccountNumberMessage                = "Account number must be 5-20 digits."
+	InvalidShopScopeMessage                    = "Scope must be one of orders, products, vouchers, finance."
 )
 
 type UnprocessableEntity struct {
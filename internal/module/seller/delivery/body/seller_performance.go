@@ -55,7 +55,8 @@ type NumOrderByProvince struct {
 }
 
 type TotalSales struct {
-	TotalSales      float64 `json:"total_sales" db:"total_sales"`
-	WithdrawableSum float64 `json:"withdrawable_sum" db:"withdrawable_sum"`
-	WithdrawnSum    float64 `json:"withdrawn_sum" db:"withdrawn_sum"`
+	TotalSales       float64 `json:"total_sales" db:"total_sales"`
+	WithdrawableSum  float64 `json:"withdrawable_sum" db:"withdrawable_sum"`
+	WithdrawnSum     float64 `json:"withdrawn_sum" db:"withdrawn_sum"`
+	PendingPayoutSum float64 `json:"pending_payout_sum" db:"pending_payout_sum"`
 }
@@ -0,0 +1,131 @@
+package body
+
+import (
+	"murakali/internal/constant"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"net/mail"
+	"strings"
+)
+
+type InviteShopStaffRequest struct {
+	Email  string   `json:"email"`
+	Scopes []string `json:"scopes"`
+}
+
+type UpdateShopStaffRequest struct {
+	Scopes []string `json:"scopes"`
+}
+
+type AcceptShopStaffRequest struct {
+	Token string `json:"token"`
+}
+
+func (r *InviteShopStaffRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"email":  "",
+			"scopes": "",
+		},
+	}
+
+	r.Email = strings.ToLower(strings.TrimSpace(r.Email))
+	if r.Email == "" {
+		unprocessableEntity = true
+		entity.Fields["email"] = FieldCannotBeEmptyMessage
+	} else if _, err := mail.ParseAddress(r.Email); err != nil {
+		unprocessableEntity = true
+		entity.Fields["email"] = InvalidEmailFormatMessage
+	}
+
+	scopes, message := validateShopScopes(r.Scopes)
+	if message != "" {
+		unprocessableEntity = true
+		entity.Fields["scopes"] = message
+	}
+	r.Scopes = scopes
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+func (r *UpdateShopStaffRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"scopes": "",
+		},
+	}
+
+	scopes, message := validateShopScopes(r.Scopes)
+	if message != "" {
+		unprocessableEntity = true
+		entity.Fields["scopes"] = message
+	}
+	r.Scopes = scopes
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+func (r *AcceptShopStaffRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"token": "",
+		},
+	}
+
+	r.Token = strings.TrimSpace(r.Token)
+	if r.Token == "" {
+		unprocessableEntity = true
+		entity.Fields["token"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
+
+func validateShopScopes(scopes []string) ([]string, string) {
+	if len(scopes) == 0 {
+		return nil, FieldCannotBeEmptyMessage
+	}
+
+	seen := make(map[string]bool)
+	result := make([]string, 0)
+	for _, scope := range scopes {
+		scope = strings.ToLower(strings.TrimSpace(scope))
+		switch scope {
+		case constant.ShopScopeOrders, constant.ShopScopeProducts, constant.ShopScopeVouchers, constant.ShopScopeFinance:
+		default:
+			return nil, InvalidShopScopeMessage
+		}
+
+		if !seen[scope] {
+			seen[scope] = true
+			result = append(result, scope)
+		}
+	}
+
+	return result, ""
+}
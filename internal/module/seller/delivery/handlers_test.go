@@ -834,7 +834,7 @@ func Test_sellerHandlers_GetSellerDetailInformation(t *testing.T) {
 		{
 			name: "Success Get Seller Detail Information",
 			mock: func(s *mocks.UseCase) {
-				s.On("GetSellerByUserID", mock.Anything, mock.Anything).Return(&body.SellerResponse{}, nil)
+				s.On("GetSellerBySellerID", mock.Anything, mock.Anything).Return(&body.SellerResponse{}, nil)
 			},
 			expected:   http.StatusOK,
 			authorized: true,
@@ -851,7 +851,7 @@ func Test_sellerHandlers_GetSellerDetailInformation(t *testing.T) {
 		{
 			name: "Error Get Seller Detail Information",
 			mock: func(s *mocks.UseCase) {
-				s.On("GetSellerByUserID", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
+				s.On("GetSellerBySellerID", mock.Anything, mock.Anything).Return(nil, httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected:   http.StatusBadRequest,
 			authorized: true,
@@ -860,7 +860,7 @@ func Test_sellerHandlers_GetSellerDetailInformation(t *testing.T) {
 		{
 			name: "Error Get Seller Detail Information Error",
 			mock: func(s *mocks.UseCase) {
-				s.On("GetSellerByUserID", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
+				s.On("GetSellerBySellerID", mock.Anything, mock.Anything).Return(nil, errors.New("error"))
 			},
 			expected:   http.StatusInternalServerError,
 			authorized: true,
@@ -878,6 +878,7 @@ func Test_sellerHandlers_GetSellerDetailInformation(t *testing.T) {
 
 			if tc.authorized {
 				c.Set("userID", tc.userID)
+				c.Set("shopID", tc.userID)
 			}
 			uuid.Parse(tc.userID)
 
@@ -2064,136 +2065,6 @@ func Test_sellerHandlers_UpdateVoucherSeller(t *testing.T) {
 	}
 }
 
-func Test_sellerHandlers_UpdateOnDeliveryOrder(t *testing.T) {
-	testCase := []struct {
-		name     string
-		mock     func(s *mocks.UseCase)
-		expected int
-	}{
-		{
-			name: "Success Update On Delivery Order",
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateOnDeliveryOrder", mock.Anything).Return(nil)
-			},
-			expected: http.StatusOK,
-		},
-		{
-			name: "Failed Update On Delivery Order",
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateOnDeliveryOrder", mock.Anything).Return(errors.New("error"))
-			},
-			expected: http.StatusInternalServerError,
-		},
-		{
-			name: "Failed Update On Delivery Order HTTP ERROR",
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateOnDeliveryOrder", mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
-			},
-			expected: http.StatusBadRequest,
-		},
-	}
-
-	for _, tc := range testCase {
-		t.Run(tc.name, func(t *testing.T) {
-
-			rr := httptest.NewRecorder()
-			c, _ := gin.CreateTestContext(rr)
-
-			r := httptest.NewRequest(http.MethodPost, "/api/v1/seller/delivery", nil)
-			r.Header = make(http.Header)
-
-			c.Request = r
-
-			s := mocks.NewUseCase(t)
-
-			cfg := &config.Config{
-				Logger: config.LoggerConfig{
-					Development:       true,
-					DisableCaller:     false,
-					DisableStacktrace: false,
-					Encoding:          "json",
-					Level:             "info",
-				},
-			}
-
-			appLogger := logger.NewAPILogger(cfg)
-			appLogger.InitLogger()
-
-			h := NewSellerHandlers(cfg, s, appLogger)
-
-			tc.mock(s)
-			h.UpdateOnDeliveryOrder(c)
-
-			assert.Equal(t, rr.Code, tc.expected)
-		})
-	}
-}
-
-func Test_sellerHandlers_UpdateExpiredAtOrder(t *testing.T) {
-	testCase := []struct {
-		name     string
-		mock     func(s *mocks.UseCase)
-		expected int
-	}{
-		{
-			name: "Success Update On Delivery Order",
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateExpiredAtOrder", mock.Anything).Return(nil)
-			},
-			expected: http.StatusOK,
-		},
-		{
-			name: "Failed Update On Delivery Order",
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateExpiredAtOrder", mock.Anything).Return(errors.New("error"))
-			},
-			expected: http.StatusInternalServerError,
-		},
-		{
-			name: "Failed Update On Delivery Order HTTP ERROR",
-			mock: func(s *mocks.UseCase) {
-				s.On("UpdateExpiredAtOrder", mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
-			},
-			expected: http.StatusBadRequest,
-		},
-	}
-
-	for _, tc := range testCase {
-		t.Run(tc.name, func(t *testing.T) {
-
-			rr := httptest.NewRecorder()
-			c, _ := gin.CreateTestContext(rr)
-
-			r := httptest.NewRequest(http.MethodPost, "/api/v1/seller/expired", nil)
-			r.Header = make(http.Header)
-
-			c.Request = r
-
-			s := mocks.NewUseCase(t)
-
-			cfg := &config.Config{
-				Logger: config.LoggerConfig{
-					Development:       true,
-					DisableCaller:     false,
-					DisableStacktrace: false,
-					Encoding:          "json",
-					Level:             "info",
-				},
-			}
-
-			appLogger := logger.NewAPILogger(cfg)
-			appLogger.InitLogger()
-
-			h := NewSellerHandlers(cfg, s, appLogger)
-
-			tc.mock(s)
-			h.UpdateExpiredAtOrder(c)
-
-			assert.Equal(t, rr.Code, tc.expected)
-		})
-	}
-}
-
 func Test_sellerHandlers_DetailVoucherSeller(t *testing.T) {
 	testCase := []struct {
 		name       string
@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"murakali/internal/constant"
 	"murakali/internal/middleware"
 	"murakali/internal/module/seller"
 
@@ -11,36 +12,45 @@ func MapSellerRoutes(sellerGroup *gin.RouterGroup, h seller.Handlers, mw *middle
 	sellerGroup.GET("/", h.GetAllSeller)
 	sellerGroup.GET("/:seller_id", h.GetSellerBySellerID)
 	sellerGroup.GET("/:seller_id/category", h.GetCategoryBySellerID)
-	sellerGroup.POST("/delivery", h.UpdateOnDeliveryOrder)
-	sellerGroup.POST("/expired", h.UpdateExpiredAtOrder)
 
 	sellerGroup.Use(mw.AuthJWTMiddleware())
-	sellerGroup.Use(mw.SellerJWTMiddleware())
-	sellerGroup.GET("/performance", h.GetPerformance)
-	sellerGroup.GET("/information", h.GetSellerDetailInformation)
-	sellerGroup.PATCH("/information", h.UpdateSellerInformation)
-	sellerGroup.GET("/user/:user_id", h.GetSellerByUserID)
-	sellerGroup.GET("/order", h.GetOrder)
-	sellerGroup.GET("/order/:order_id", h.GetOrderByOrderID)
-	sellerGroup.PATCH("/order-status", h.ChangeOrderStatus)
-	sellerGroup.PATCH("/order-cancel", h.CancelOrderStatus)
-	sellerGroup.GET("/courier", h.GetCourierSeller)
-	sellerGroup.POST("/courier", h.CreateCourierSeller)
-	sellerGroup.DELETE("/courier/:id", h.DeleteCourierSellerByID)
-	sellerGroup.PATCH("/order-resi/:id", h.UpdateResiNumberInOrderSeller)
-	sellerGroup.POST("/withdrawal/:id", h.WithdrawalOrderBalance)
-	sellerGroup.GET("/voucher", h.GetAllVoucherSeller)
-	sellerGroup.POST("/voucher", h.CreateVoucherSeller)
-	sellerGroup.PUT("/voucher", h.UpdateVoucherSeller)
-	sellerGroup.GET("/voucher/:id", h.DetailVoucherSeller)
-	sellerGroup.DELETE("/voucher/:id", h.DeleteVoucherSeller)
-	sellerGroup.GET("/product/without-promotion", h.GetProductWithoutPromotionSeller)
-	sellerGroup.GET("/promotion", h.GetAllPromotionSeller)
-	sellerGroup.POST("/promotion", h.CreatePromotionSeller)
-	sellerGroup.PUT("/promotion", h.UpdatePromotionSeller)
-	sellerGroup.GET("/promotion/:id", h.GetDetailPromotionSellerByID)
-	sellerGroup.GET("/refund/:refund_id", h.GetRefundOrderSeller)
-	sellerGroup.POST("/refund-thread", h.CreateRefundThreadSeller)
-	sellerGroup.PATCH("/refund-accept", h.UpdateRefundAccept)
-	sellerGroup.PATCH("/refund-reject", h.UpdateRefundReject)
+	sellerGroup.POST("/staff/accept", h.AcceptShopStaffInvitation)
+	sellerGroup.GET("/performance", mw.RequireShopScope(constant.ShopScopeFinance), h.GetPerformance)
+	sellerGroup.GET("/information", mw.RequireShopScope(""), h.GetSellerDetailInformation)
+	sellerGroup.PATCH("/information", mw.RequireShopOwner(), h.UpdateSellerInformation)
+	sellerGroup.GET("/user/:user_id", mw.RequireShopScope(""), h.GetSellerByUserID)
+	sellerGroup.GET("/order", mw.RequireShopScope(constant.ShopScopeOrders), h.GetOrder)
+	sellerGroup.GET("/order/:order_id", mw.RequireShopScope(constant.ShopScopeOrders), h.GetOrderByOrderID)
+	sellerGroup.PATCH("/order-status", mw.RequireShopScope(constant.ShopScopeOrders), h.ChangeOrderStatus)
+	sellerGroup.PATCH("/order-cancel", mw.RequireShopScope(constant.ShopScopeOrders), h.CancelOrderStatus)
+	sellerGroup.GET("/courier", mw.RequireShopScope(constant.ShopScopeOrders), h.GetCourierSeller)
+	sellerGroup.POST("/courier", mw.RequireShopOwner(), h.CreateCourierSeller)
+	sellerGroup.DELETE("/courier/:id", mw.RequireShopOwner(), h.DeleteCourierSellerByID)
+	sellerGroup.PATCH("/order-resi/:id", mw.RequireShopScope(constant.ShopScopeOrders), h.UpdateResiNumberInOrderSeller)
+	sellerGroup.POST("/withdrawal/:id", mw.RequireShopScope(constant.ShopScopeFinance), h.WithdrawalOrderBalance)
+	sellerGroup.GET("/voucher", mw.RequireShopScope(constant.ShopScopeVouchers), h.GetAllVoucherSeller)
+	sellerGroup.POST("/voucher", mw.RequireShopScope(constant.ShopScopeVouchers), h.CreateVoucherSeller)
+	sellerGroup.PUT("/voucher", mw.RequireShopScope(constant.ShopScopeVouchers), h.UpdateVoucherSeller)
+	sellerGroup.GET("/voucher/:id", mw.RequireShopScope(constant.ShopScopeVouchers), h.DetailVoucherSeller)
+	sellerGroup.DELETE("/voucher/:id", mw.RequireShopScope(constant.ShopScopeVouchers), h.DeleteVoucherSeller)
+	sellerGroup.GET("/product/without-promotion", mw.RequireShopScope(constant.ShopScopeProducts), h.GetProductWithoutPromotionSeller)
+	sellerGroup.GET("/promotion", mw.RequireShopScope(constant.ShopScopeProducts), h.GetAllPromotionSeller)
+	sellerGroup.POST("/promotion", mw.RequireShopScope(constant.ShopScopeProducts), h.CreatePromotionSeller)
+	sellerGroup.PUT("/promotion", mw.RequireShopScope(constant.ShopScopeProducts), h.UpdatePromotionSeller)
+	sellerGroup.GET("/promotion/:id", mw.RequireShopScope(constant.ShopScopeProducts), h.GetDetailPromotionSellerByID)
+	sellerGroup.GET("/refund/:refund_id", mw.RequireShopScope(constant.ShopScopeOrders), h.GetRefundOrderSeller)
+	sellerGroup.POST("/refund-thread", mw.RequireShopScope(constant.ShopScopeOrders), h.CreateRefundThreadSeller)
+	sellerGroup.PATCH("/refund-accept", mw.RequireShopScope(constant.ShopScopeOrders), h.UpdateRefundAccept)
+	sellerGroup.PATCH("/refund-reject", mw.RequireShopScope(constant.ShopScopeOrders), h.UpdateRefundReject)
+	sellerGroup.GET("/bank-account", mw.RequireShopScope(constant.ShopScopeFinance), h.GetBankAccounts)
+	sellerGroup.POST("/bank-account", mw.RequireShopOwner(), h.CreateBankAccount)
+	sellerGroup.DELETE("/bank-account/:id", mw.RequireShopOwner(), h.DeleteBankAccount)
+	sellerGroup.GET("/payout", mw.RequireShopScope(constant.ShopScopeFinance), h.GetPayouts)
+	sellerGroup.POST("/payout", mw.RequireShopScope(constant.ShopScopeFinance), h.CreatePayout)
+	sellerGroup.GET("/payout/:id", mw.RequireShopScope(constant.ShopScopeFinance), h.GetPayoutByID)
+	sellerGroup.GET("/staff", mw.RequireShopOwner(), h.GetShopStaff)
+	sellerGroup.POST("/staff", mw.RequireShopOwner(), h.InviteShopStaff)
+	sellerGroup.PATCH("/staff/:id", mw.RequireShopOwner(), h.UpdateShopStaff)
+	sellerGroup.DELETE("/staff/:id", mw.RequireShopOwner(), h.DeleteShopStaff)
+	sellerGroup.GET("/audit-log", mw.RequireShopOwner(), h.GetShopAuditLogs)
 }
@@ -4,17 +4,16 @@ package mocks
 
 import (
 	context "context"
-	body "murakali/internal/module/seller/delivery/body"
-
-	mock "github.com/stretchr/testify/mock"
-
 	model "murakali/internal/model"
-
+	body "murakali/internal/module/seller/delivery/body"
 	pagination "murakali/pkg/pagination"
-
 	postgre "murakali/pkg/postgre"
 
+	mock "github.com/stretchr/testify/mock"
+
 	time "time"
+
+	uuid "github.com/google/uuid"
 )
 
 // Repository is an autogenerated mock type for the Repository type
@@ -22,32 +21,95 @@ type Repository struct {
 	mock.Mock
 }
 
-// CancelOrderStatus provides a mock function with given fields: ctx, tx, requestBody
-func (_m *Repository) CancelOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus) error {
-	ret := _m.Called(ctx, tx, requestBody)
+// AcceptShopStaff provides a mock function with given fields: ctx, staffID, userID
+func (_m *Repository) AcceptShopStaff(ctx context.Context, staffID string, userID string) (int64, error) {
+	ret := _m.Called(ctx, staffID, userID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, body.CancelOrderStatus) error); ok {
-		r0 = rf(ctx, tx, requestBody)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, staffID, userID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, staffID, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ChangeOrderStatus provides a mock function with given fields: ctx, requestBody
-func (_m *Repository) ChangeOrderStatus(ctx context.Context, requestBody body.ChangeOrderStatusRequest) error {
-	ret := _m.Called(ctx, requestBody)
+// AssignOrdersToPayout provides a mock function with given fields: ctx, tx, shopID, payoutID
+func (_m *Repository) AssignOrdersToPayout(ctx context.Context, tx postgre.Transaction, shopID string, payoutID string) (model.Money, int, error) {
+	ret := _m.Called(ctx, tx, shopID, payoutID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, body.ChangeOrderStatusRequest) error); ok {
-		r0 = rf(ctx, requestBody)
+	var r0 model.Money
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, string) model.Money); ok {
+		r0 = rf(ctx, tx, shopID, payoutID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(model.Money)
 	}
 
-	return r0
+	var r1 int
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string, string) int); ok {
+		r1 = rf(ctx, tx, shopID, payoutID)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, postgre.Transaction, string, string) error); ok {
+		r2 = rf(ctx, tx, shopID, payoutID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// CancelOrderStatus provides a mock function with given fields: ctx, tx, requestBody, fromStatusID
+func (_m *Repository) CancelOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus, fromStatusID int) (int64, error) {
+	ret := _m.Called(ctx, tx, requestBody, fromStatusID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, body.CancelOrderStatus, int) int64); ok {
+		r0 = rf(ctx, tx, requestBody, fromStatusID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, body.CancelOrderStatus, int) error); ok {
+		r1 = rf(ctx, tx, requestBody, fromStatusID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeOrderStatus provides a mock function with given fields: ctx, tx, requestBody, fromStatusID
+func (_m *Repository) ChangeOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.ChangeOrderStatusRequest, fromStatusID int) (int64, error) {
+	ret := _m.Called(ctx, tx, requestBody, fromStatusID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, body.ChangeOrderStatusRequest, int) int64); ok {
+		r0 = rf(ctx, tx, requestBody, fromStatusID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, body.ChangeOrderStatusRequest, int) error); ok {
+		r1 = rf(ctx, tx, requestBody, fromStatusID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // CountCodeVoucher provides a mock function with given fields: ctx, code
@@ -71,6 +133,20 @@ func (_m *Repository) CountCodeVoucher(ctx context.Context, code string) (int64,
 	return r0, r1
 }
 
+// CreateBankAccount provides a mock function with given fields: ctx, bankAccount
+func (_m *Repository) CreateBankAccount(ctx context.Context, bankAccount *model.BankAccount) error {
+	ret := _m.Called(ctx, bankAccount)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.BankAccount) error); ok {
+		r0 = rf(ctx, bankAccount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateCourierSeller provides a mock function with given fields: ctx, shopID, courierID
 func (_m *Repository) CreateCourierSeller(ctx context.Context, shopID string, courierID string) error {
 	ret := _m.Called(ctx, shopID, courierID)
@@ -85,6 +161,43 @@ func (_m *Repository) CreateCourierSeller(ctx context.Context, shopID string, co
 	return r0
 }
 
+// CreateOrderStatusHistory provides a mock function with given fields: ctx, tx, history
+func (_m *Repository) CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error {
+	ret := _m.Called(ctx, tx, history)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.OrderStatusHistory) error); ok {
+		r0 = rf(ctx, tx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePayout provides a mock function with given fields: ctx, tx, payout
+func (_m *Repository) CreatePayout(ctx context.Context, tx postgre.Transaction, payout *model.Payout) (*uuid.UUID, error) {
+	ret := _m.Called(ctx, tx, payout)
+
+	var r0 *uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.Payout) *uuid.UUID); ok {
+		r0 = rf(ctx, tx, payout)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, *model.Payout) error); ok {
+		r1 = rf(ctx, tx, payout)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreatePromotionSeller provides a mock function with given fields: ctx, tx, promotionShop
 func (_m *Repository) CreatePromotionSeller(ctx context.Context, tx postgre.Transaction, promotionShop *model.Promotion) error {
 	ret := _m.Called(ctx, tx, promotionShop)
@@ -127,6 +240,20 @@ func (_m *Repository) CreateRefundThreadSeller(ctx context.Context, refundThread
 	return r0
 }
 
+// CreateShopStaff provides a mock function with given fields: ctx, staff
+func (_m *Repository) CreateShopStaff(ctx context.Context, staff *model.ShopStaff) error {
+	ret := _m.Called(ctx, staff)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ShopStaff) error); ok {
+		r0 = rf(ctx, staff)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateVoucherSeller provides a mock function with given fields: ctx, voucherShop
 func (_m *Repository) CreateVoucherSeller(ctx context.Context, voucherShop *model.Voucher) error {
 	ret := _m.Called(ctx, voucherShop)
@@ -141,6 +268,27 @@ func (_m *Repository) CreateVoucherSeller(ctx context.Context, voucherShop *mode
 	return r0
 }
 
+// DeleteBankAccount provides a mock function with given fields: ctx, shopID, bankAccountID
+func (_m *Repository) DeleteBankAccount(ctx context.Context, shopID string, bankAccountID string) (int64, error) {
+	ret := _m.Called(ctx, shopID, bankAccountID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, shopID, bankAccountID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, bankAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteCourierSellerByID provides a mock function with given fields: ctx, shopCourierID
 func (_m *Repository) DeleteCourierSellerByID(ctx context.Context, shopCourierID string) error {
 	ret := _m.Called(ctx, shopCourierID)
@@ -155,6 +303,27 @@ func (_m *Repository) DeleteCourierSellerByID(ctx context.Context, shopCourierID
 	return r0
 }
 
+// DeleteShopStaff provides a mock function with given fields: ctx, shopID, staffID
+func (_m *Repository) DeleteShopStaff(ctx context.Context, shopID string, staffID string) (int64, error) {
+	ret := _m.Called(ctx, shopID, staffID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) int64); ok {
+		r0 = rf(ctx, shopID, staffID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, staffID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteVoucherSeller provides a mock function with given fields: ctx, voucherIDShopID
 func (_m *Repository) DeleteVoucherSeller(ctx context.Context, voucherIDShopID *body.VoucherIDShopID) error {
 	ret := _m.Called(ctx, voucherIDShopID)
@@ -330,6 +499,52 @@ func (_m *Repository) GetAllVoucherSellerByIDAndShopID(ctx context.Context, vouc
 	return r0, r1
 }
 
+// GetBankAccountByID provides a mock function with given fields: ctx, shopID, bankAccountID
+func (_m *Repository) GetBankAccountByID(ctx context.Context, shopID string, bankAccountID string) (*model.BankAccount, error) {
+	ret := _m.Called(ctx, shopID, bankAccountID)
+
+	var r0 *model.BankAccount
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.BankAccount); ok {
+		r0 = rf(ctx, shopID, bankAccountID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BankAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, bankAccountID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBankAccountsByShopID provides a mock function with given fields: ctx, shopID
+func (_m *Repository) GetBankAccountsByShopID(ctx context.Context, shopID string) ([]*model.BankAccount, error) {
+	ret := _m.Called(ctx, shopID)
+
+	var r0 []*model.BankAccount
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.BankAccount); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BankAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBuyerIDByOrderID provides a mock function with given fields: ctx, orderID
 func (_m *Repository) GetBuyerIDByOrderID(ctx context.Context, orderID string) (string, error) {
 	ret := _m.Called(ctx, orderID)
@@ -552,16 +767,39 @@ func (_m *Repository) GetOrderByTransactionID(ctx context.Context, tx postgre.Tr
 	return r0, r1
 }
 
-// GetOrderItemsByOrderID provides a mock function with given fields: ctx, tx, orderID
-func (_m *Repository) GetOrderItemsByOrderID(ctx context.Context, tx postgre.Transaction, orderID string) ([]*model.OrderItem, error) {
+// GetOrderModelByID provides a mock function with given fields: ctx, OrderID
+func (_m *Repository) GetOrderModelByID(ctx context.Context, OrderID string) (*model.OrderModel, error) {
+	ret := _m.Called(ctx, OrderID)
+
+	var r0 *model.OrderModel
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.OrderModel); ok {
+		r0 = rf(ctx, OrderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OrderModel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, OrderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOrderProductIDs provides a mock function with given fields: ctx, tx, orderID
+func (_m *Repository) GetOrderProductIDs(ctx context.Context, tx postgre.Transaction, orderID string) ([]string, error) {
 	ret := _m.Called(ctx, tx, orderID)
 
-	var r0 []*model.OrderItem
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) []*model.OrderItem); ok {
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) []string); ok {
 		r0 = rf(ctx, tx, orderID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]*model.OrderItem)
+			r0 = ret.Get(0).([]string)
 		}
 	}
 
@@ -575,22 +813,22 @@ func (_m *Repository) GetOrderItemsByOrderID(ctx context.Context, tx postgre.Tra
 	return r0, r1
 }
 
-// GetOrderModelByID provides a mock function with given fields: ctx, OrderID
-func (_m *Repository) GetOrderModelByID(ctx context.Context, OrderID string) (*model.OrderModel, error) {
-	ret := _m.Called(ctx, OrderID)
+// GetOrderStatusHistoryByOrderID provides a mock function with given fields: ctx, orderID
+func (_m *Repository) GetOrderStatusHistoryByOrderID(ctx context.Context, orderID string) ([]*model.OrderStatusHistory, error) {
+	ret := _m.Called(ctx, orderID)
 
-	var r0 *model.OrderModel
-	if rf, ok := ret.Get(0).(func(context.Context, string) *model.OrderModel); ok {
-		r0 = rf(ctx, OrderID)
+	var r0 []*model.OrderStatusHistory
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.OrderStatusHistory); ok {
+		r0 = rf(ctx, orderID)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.OrderModel)
+			r0 = ret.Get(0).([]*model.OrderStatusHistory)
 		}
 	}
 
 	var r1 error
 	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, OrderID)
+		r1 = rf(ctx, orderID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -644,6 +882,52 @@ func (_m *Repository) GetOrdersOnDelivery(ctx context.Context) ([]*model.OrderMo
 	return r0, r1
 }
 
+// GetPayoutByID provides a mock function with given fields: ctx, shopID, payoutID
+func (_m *Repository) GetPayoutByID(ctx context.Context, shopID string, payoutID string) (*model.Payout, error) {
+	ret := _m.Called(ctx, shopID, payoutID)
+
+	var r0 *model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Payout); ok {
+		r0 = rf(ctx, shopID, payoutID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, payoutID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayoutsByShopID provides a mock function with given fields: ctx, shopID, pgn
+func (_m *Repository) GetPayoutsByShopID(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.Payout, error) {
+	ret := _m.Called(ctx, shopID, pgn)
+
+	var r0 []*model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.Payout); ok {
+		r0 = rf(ctx, shopID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Payout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, shopID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPerformaceRedis provides a mock function with given fields: ctx, key
 func (_m *Repository) GetPerformaceRedis(ctx context.Context, key string) (*body.SellerPerformance, error) {
 	ret := _m.Called(ctx, key)
@@ -690,22 +974,22 @@ func (_m *Repository) GetPerformance(ctx context.Context, shopID string) (*body.
 	return r0, r1
 }
 
-// GetProductDetailByID provides a mock function with given fields: ctx, tx, productDetailID
-func (_m *Repository) GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error) {
-	ret := _m.Called(ctx, tx, productDetailID)
+// GetProductAlertsByProductIDs provides a mock function with given fields: ctx, productIDs
+func (_m *Repository) GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error) {
+	ret := _m.Called(ctx, productIDs)
 
-	var r0 *model.ProductDetail
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) *model.ProductDetail); ok {
-		r0 = rf(ctx, tx, productDetailID)
+	var r0 []*model.ProductAlert
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []*model.ProductAlert); ok {
+		r0 = rf(ctx, productIDs)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.ProductDetail)
+			r0 = ret.Get(0).([]*model.ProductAlert)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
-		r1 = rf(ctx, tx, productDetailID)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, productIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -918,6 +1202,29 @@ func (_m *Repository) GetSellerIDByOrderID(ctx context.Context, orderID string)
 	return r0, r1
 }
 
+// GetShopAuditLogs provides a mock function with given fields: ctx, shopID, pgn
+func (_m *Repository) GetShopAuditLogs(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.ShopAuditLog, error) {
+	ret := _m.Called(ctx, shopID, pgn)
+
+	var r0 []*model.ShopAuditLog
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) []*model.ShopAuditLog); ok {
+		r0 = rf(ctx, shopID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShopAuditLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, shopID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetShopByID provides a mock function with given fields: ctx, shopID
 func (_m *Repository) GetShopByID(ctx context.Context, shopID string) (*model.Shop, error) {
 	ret := _m.Called(ctx, shopID)
@@ -1004,6 +1311,98 @@ func (_m *Repository) GetShopIDByUserID(ctx context.Context, userID string) (str
 	return r0, r1
 }
 
+// GetShopStaffByEmail provides a mock function with given fields: ctx, shopID, email
+func (_m *Repository) GetShopStaffByEmail(ctx context.Context, shopID string, email string) (*model.ShopStaff, error) {
+	ret := _m.Called(ctx, shopID, email)
+
+	var r0 *model.ShopStaff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ShopStaff); ok {
+		r0 = rf(ctx, shopID, email)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShopStaff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopStaffByID provides a mock function with given fields: ctx, shopID, staffID
+func (_m *Repository) GetShopStaffByID(ctx context.Context, shopID string, staffID string) (*model.ShopStaff, error) {
+	ret := _m.Called(ctx, shopID, staffID)
+
+	var r0 *model.ShopStaff
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.ShopStaff); ok {
+		r0 = rf(ctx, shopID, staffID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShopStaff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, shopID, staffID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopStaffByInviteToken provides a mock function with given fields: ctx, inviteToken
+func (_m *Repository) GetShopStaffByInviteToken(ctx context.Context, inviteToken string) (*model.ShopStaff, error) {
+	ret := _m.Called(ctx, inviteToken)
+
+	var r0 *model.ShopStaff
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ShopStaff); ok {
+		r0 = rf(ctx, inviteToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShopStaff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, inviteToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopStaffByShopID provides a mock function with given fields: ctx, shopID
+func (_m *Repository) GetShopStaffByShopID(ctx context.Context, shopID string) ([]*model.ShopStaff, error) {
+	ret := _m.Called(ctx, shopID)
+
+	var r0 []*model.ShopStaff
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.ShopStaff); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShopStaff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalAllSeller provides a mock function with given fields: ctx, shopName
 func (_m *Repository) GetTotalAllSeller(ctx context.Context, shopName string) (int64, error) {
 	ret := _m.Called(ctx, shopName)
@@ -1046,6 +1445,27 @@ func (_m *Repository) GetTotalOrder(ctx context.Context, userID string, orderSta
 	return r0, r1
 }
 
+// GetTotalPayoutsByShopID provides a mock function with given fields: ctx, shopID
+func (_m *Repository) GetTotalPayoutsByShopID(ctx context.Context, shopID string) (int64, error) {
+	ret := _m.Called(ctx, shopID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalProductWithoutPromotionSeller provides a mock function with given fields: ctx, shopID, productName
 func (_m *Repository) GetTotalProductWithoutPromotionSeller(ctx context.Context, shopID string, productName string) (int64, error) {
 	ret := _m.Called(ctx, shopID, productName)
@@ -1088,6 +1508,27 @@ func (_m *Repository) GetTotalPromotionSeller(ctx context.Context, shopID string
 	return r0, r1
 }
 
+// GetTotalShopAuditLogs provides a mock function with given fields: ctx, shopID
+func (_m *Repository) GetTotalShopAuditLogs(ctx context.Context, shopID string) (int64, error) {
+	ret := _m.Called(ctx, shopID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, shopID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, shopID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetTotalVoucherSeller provides a mock function with given fields: ctx, shopID, voucherStatusID
 func (_m *Repository) GetTotalVoucherSeller(ctx context.Context, shopID string, voucherStatusID string) (int64, error) {
 	ret := _m.Called(ctx, shopID, voucherStatusID)
@@ -1220,6 +1661,20 @@ func (_m *Repository) InsertWalletHistory(ctx context.Context, tx postgre.Transa
 	return r0
 }
 
+// ReleaseStockReservation provides a mock function with given fields: ctx, tx, orderID
+func (_m *Repository) ReleaseStockReservation(ctx context.Context, tx postgre.Transaction, orderID string) error {
+	ret := _m.Called(ctx, tx, orderID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateCourierSellerByID provides a mock function with given fields: ctx, shopID, courierID
 func (_m *Repository) UpdateCourierSellerByID(ctx context.Context, shopID string, courierID string) error {
 	ret := _m.Called(ctx, shopID, courierID)
@@ -1262,13 +1717,34 @@ func (_m *Repository) UpdateOrderRefundRejected(ctx context.Context, tx postgre.
 	return r0
 }
 
-// UpdateProductDetailStock provides a mock function with given fields: ctx, tx, productDetailData
-func (_m *Repository) UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction, productDetailData *model.ProductDetail) error {
-	ret := _m.Called(ctx, tx, productDetailData)
+// UpdateOrderWithdrawn provides a mock function with given fields: ctx, tx, orderID
+func (_m *Repository) UpdateOrderWithdrawn(ctx context.Context, tx postgre.Transaction, orderID string) (int64, error) {
+	ret := _m.Called(ctx, tx, orderID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) int64); ok {
+		r0 = rf(ctx, tx, orderID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePayoutAmount provides a mock function with given fields: ctx, tx, payout
+func (_m *Repository) UpdatePayoutAmount(ctx context.Context, tx postgre.Transaction, payout *model.Payout) error {
+	ret := _m.Called(ctx, tx, payout)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.ProductDetail) error); ok {
-		r0 = rf(ctx, tx, productDetailData)
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.Payout) error); ok {
+		r0 = rf(ctx, tx, payout)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1276,6 +1752,27 @@ func (_m *Repository) UpdateProductDetailStock(ctx context.Context, tx postgre.T
 	return r0
 }
 
+// UpdateProductAlertTriggered provides a mock function with given fields: ctx, alertID, triggered
+func (_m *Repository) UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error) {
+	ret := _m.Called(ctx, alertID, triggered)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) bool); ok {
+		r0 = rf(ctx, alertID, triggered)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, alertID, triggered)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePromotionSeller provides a mock function with given fields: ctx, promotion
 func (_m *Repository) UpdatePromotionSeller(ctx context.Context, promotion *model.Promotion) error {
 	ret := _m.Called(ctx, promotion)
@@ -1318,13 +1815,13 @@ func (_m *Repository) UpdateRefundReject(ctx context.Context, tx postgre.Transac
 	return r0
 }
 
-// UpdateResiNumberInOrderSeller provides a mock function with given fields: ctx, noResi, orderID, shopID, arriveAt
-func (_m *Repository) UpdateResiNumberInOrderSeller(ctx context.Context, noResi string, orderID string, shopID string, arriveAt time.Time) error {
-	ret := _m.Called(ctx, noResi, orderID, shopID, arriveAt)
+// UpdateResiNumberInOrderSeller provides a mock function with given fields: ctx, tx, noResi, orderID, shopID, arriveAt
+func (_m *Repository) UpdateResiNumberInOrderSeller(ctx context.Context, tx postgre.Transaction, noResi string, orderID string, shopID string, arriveAt time.Time) error {
+	ret := _m.Called(ctx, tx, noResi, orderID, shopID, arriveAt)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Time) error); ok {
-		r0 = rf(ctx, noResi, orderID, shopID, arriveAt)
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, string, string, time.Time) error); ok {
+		r0 = rf(ctx, tx, noResi, orderID, shopID, arriveAt)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1346,6 +1843,27 @@ func (_m *Repository) UpdateSellerInformationByUserID(ctx context.Context, shopN
 	return r0
 }
 
+// UpdateShopStaffScopes provides a mock function with given fields: ctx, shopID, staffID, scopes
+func (_m *Repository) UpdateShopStaffScopes(ctx context.Context, shopID string, staffID string, scopes []string) (int64, error) {
+	ret := _m.Called(ctx, shopID, staffID, scopes)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, []string) int64); ok {
+		r0 = rf(ctx, shopID, staffID, scopes)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, []string) error); ok {
+		r1 = rf(ctx, shopID, staffID, scopes)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTransaction provides a mock function with given fields: ctx, tx, transactionData
 func (_m *Repository) UpdateTransaction(ctx context.Context, tx postgre.Transaction, transactionData *model.Transaction) error {
 	ret := _m.Called(ctx, tx, transactionData)
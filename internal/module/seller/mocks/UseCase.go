@@ -18,6 +18,20 @@ type UseCase struct {
 	mock.Mock
 }
 
+// AcceptShopStaffInvitation provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) AcceptShopStaffInvitation(ctx context.Context, userID string, requestBody body.AcceptShopStaffRequest) error {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.AcceptShopStaffRequest) error); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CancelOrderStatus provides a mock function with given fields: ctx, userID, requestBody
 func (_m *UseCase) CancelOrderStatus(ctx context.Context, userID string, requestBody body.CancelOrderStatus) error {
 	ret := _m.Called(ctx, userID, requestBody)
@@ -46,6 +60,20 @@ func (_m *UseCase) ChangeOrderStatus(ctx context.Context, userID string, request
 	return r0
 }
 
+// CreateBankAccount provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) CreateBankAccount(ctx context.Context, userID string, requestBody body.CreateBankAccountRequest) error {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.CreateBankAccountRequest) error); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateCourierSeller provides a mock function with given fields: ctx, userID, courierID
 func (_m *UseCase) CreateCourierSeller(ctx context.Context, userID string, courierID string) error {
 	ret := _m.Called(ctx, userID, courierID)
@@ -60,6 +88,29 @@ func (_m *UseCase) CreateCourierSeller(ctx context.Context, userID string, couri
 	return r0
 }
 
+// CreatePayout provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) CreatePayout(ctx context.Context, userID string, requestBody body.CreatePayoutRequest) (*model.Payout, error) {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 *model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.CreatePayoutRequest) *model.Payout); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, body.CreatePayoutRequest) error); ok {
+		r1 = rf(ctx, userID, requestBody)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreatePromotionSeller provides a mock function with given fields: ctx, userID, requestBody
 func (_m *UseCase) CreatePromotionSeller(ctx context.Context, userID string, requestBody body.CreatePromotionRequest) (int, error) {
 	ret := _m.Called(ctx, userID, requestBody)
@@ -109,6 +160,20 @@ func (_m *UseCase) CreateVoucherSeller(ctx context.Context, userID string, reque
 	return r0
 }
 
+// DeleteBankAccount provides a mock function with given fields: ctx, userID, bankAccountID
+func (_m *UseCase) DeleteBankAccount(ctx context.Context, userID string, bankAccountID string) error {
+	ret := _m.Called(ctx, userID, bankAccountID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, bankAccountID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteCourierSellerByID provides a mock function with given fields: ctx, shopCourierID
 func (_m *UseCase) DeleteCourierSellerByID(ctx context.Context, shopCourierID string) error {
 	ret := _m.Called(ctx, shopCourierID)
@@ -123,6 +188,20 @@ func (_m *UseCase) DeleteCourierSellerByID(ctx context.Context, shopCourierID st
 	return r0
 }
 
+// DeleteShopStaff provides a mock function with given fields: ctx, userID, staffID
+func (_m *UseCase) DeleteShopStaff(ctx context.Context, userID string, staffID string) error {
+	ret := _m.Called(ctx, userID, staffID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, staffID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteVoucherSeller provides a mock function with given fields: ctx, voucherIDShopID
 func (_m *UseCase) DeleteVoucherSeller(ctx context.Context, voucherIDShopID *body.VoucherIDShopID) error {
 	ret := _m.Called(ctx, voucherIDShopID)
@@ -206,6 +285,29 @@ func (_m *UseCase) GetAllVoucherSeller(ctx context.Context, userID string, vouch
 	return r0, r1
 }
 
+// GetBankAccounts provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetBankAccounts(ctx context.Context, userID string) ([]*model.BankAccount, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.BankAccount
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.BankAccount); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BankAccount)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCategoryBySellerID provides a mock function with given fields: ctx, shopID
 func (_m *UseCase) GetCategoryBySellerID(ctx context.Context, shopID string) ([]*body.CategoryResponse, error) {
 	ret := _m.Called(ctx, shopID)
@@ -344,6 +446,52 @@ func (_m *UseCase) GetOrderByOrderID(ctx context.Context, orderID string) (*mode
 	return r0, r1
 }
 
+// GetPayoutByID provides a mock function with given fields: ctx, userID, payoutID
+func (_m *UseCase) GetPayoutByID(ctx context.Context, userID string, payoutID string) (*model.Payout, error) {
+	ret := _m.Called(ctx, userID, payoutID)
+
+	var r0 *model.Payout
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.Payout); ok {
+		r0 = rf(ctx, userID, payoutID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Payout)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, payoutID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPayouts provides a mock function with given fields: ctx, userID, pgn
+func (_m *UseCase) GetPayouts(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPerformance provides a mock function with given fields: ctx, userID, update
 func (_m *UseCase) GetPerformance(ctx context.Context, userID string, update bool) (*body.SellerPerformance, error) {
 	ret := _m.Called(ctx, userID, update)
@@ -459,32 +607,115 @@ func (_m *UseCase) GetSellerByUserID(ctx context.Context, userID string) (*body.
 	return r0, r1
 }
 
+// GetShopAuditLogs provides a mock function with given fields: ctx, userID, pgn
+func (_m *UseCase) GetShopAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	ret := _m.Called(ctx, userID, pgn)
+
+	var r0 *pagination.Pagination
+	if rf, ok := ret.Get(0).(func(context.Context, string, *pagination.Pagination) *pagination.Pagination); ok {
+		r0 = rf(ctx, userID, pgn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*pagination.Pagination)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, *pagination.Pagination) error); ok {
+		r1 = rf(ctx, userID, pgn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetShopStaff provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetShopStaff(ctx context.Context, userID string) ([]*model.ShopStaff, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.ShopStaff
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.ShopStaff); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ShopStaff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// InviteShopStaff provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) InviteShopStaff(ctx context.Context, userID string, requestBody body.InviteShopStaffRequest) (*model.ShopStaff, error) {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 *model.ShopStaff
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.InviteShopStaffRequest) *model.ShopStaff); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShopStaff)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, body.InviteShopStaffRequest) error); ok {
+		r1 = rf(ctx, userID, requestBody)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateExpiredAtOrder provides a mock function with given fields: ctx
-func (_m *UseCase) UpdateExpiredAtOrder(ctx context.Context) error {
+func (_m *UseCase) UpdateExpiredAtOrder(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // UpdateOnDeliveryOrder provides a mock function with given fields: ctx
-func (_m *UseCase) UpdateOnDeliveryOrder(ctx context.Context) error {
+func (_m *UseCase) UpdateOnDeliveryOrder(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // UpdatePromotionSeller provides a mock function with given fields: ctx, userID, requestBody
@@ -557,6 +788,20 @@ func (_m *UseCase) UpdateSellerInformationByUserID(ctx context.Context, shopName
 	return r0
 }
 
+// UpdateShopStaff provides a mock function with given fields: ctx, userID, staffID, requestBody
+func (_m *UseCase) UpdateShopStaff(ctx context.Context, userID string, staffID string, requestBody body.UpdateShopStaffRequest) error {
+	ret := _m.Called(ctx, userID, staffID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, body.UpdateShopStaffRequest) error); ok {
+		r0 = rf(ctx, userID, staffID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateVoucherSeller provides a mock function with given fields: ctx, userID, requestBody
 func (_m *UseCase) UpdateVoucherSeller(ctx context.Context, userID string, requestBody body.UpdateVoucherRequest) error {
 	ret := _m.Called(ctx, userID, requestBody)
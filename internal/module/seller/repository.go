@@ -7,6 +7,8 @@ import (
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type Repository interface {
@@ -19,8 +21,8 @@ type Repository interface {
 	GetOrders(ctx context.Context, userID, orderStatusID, voucherShopID, sortQuery string, pgn *pagination.Pagination) ([]*model.Order, error)
 	GetShopIDByUser(ctx context.Context, userID string) (string, error)
 	GetShopIDByOrder(ctx context.Context, OrderID string) (string, error)
-	ChangeOrderStatus(ctx context.Context, requestBody body.ChangeOrderStatusRequest) error
-	CancelOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus) error
+	ChangeOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.ChangeOrderStatusRequest, fromStatusID int) (int64, error)
+	CancelOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus, fromStatusID int) (int64, error)
 	CreateRefundSeller(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus) error
 	GetOrderByOrderID(ctx context.Context, OrderID string) (*model.Order, error)
 	GetSellerBySellerID(ctx context.Context, sellerID string) (*body.SellerResponse, error)
@@ -40,7 +42,7 @@ type Repository interface {
 	GetSellerIDByOrderID(ctx context.Context, orderID string) (string, error)
 	GetAddressByBuyerID(ctx context.Context, userID string) (*model.Address, error)
 	GetAddressBySellerID(ctx context.Context, userID string) (*model.Address, error)
-	UpdateResiNumberInOrderSeller(ctx context.Context, noResi, orderID, shopID string, arriveAt time.Time) error
+	UpdateResiNumberInOrderSeller(ctx context.Context, tx postgre.Transaction, noResi, orderID, shopID string, arriveAt time.Time) error
 	GetCostRedis(ctx context.Context, key string) (*string, error)
 	GetOrdersOnDelivery(ctx context.Context) ([]*model.OrderModel, error)
 	InsertCostRedis(ctx context.Context, key string, value string) error
@@ -55,9 +57,6 @@ type Repository interface {
 	UpdateTransaction(ctx context.Context, tx postgre.Transaction, transactionData *model.Transaction) error
 	GetOrderByTransactionID(ctx context.Context, tx postgre.Transaction, transactionID string) ([]*model.OrderModel, error)
 	GetTransactionsExpired(ctx context.Context) ([]*model.Transaction, error)
-	GetOrderItemsByOrderID(ctx context.Context, tx postgre.Transaction, orderID string) ([]*model.OrderItem, error)
-	GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error)
-	UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction, productDetailData *model.ProductDetail) error
 	GetAllPromotionSeller(ctx context.Context, shopID string, promoStatusID string) ([]*body.PromotionSellerResponse, error)
 	GetTotalPromotionSeller(ctx context.Context, shopID string, promoStatusID string) (int64, error)
 	GetProductPromotion(ctx context.Context, shopProduct *body.ShopProduct) (*body.ProductPromotion, error)
@@ -81,4 +80,31 @@ type Repository interface {
 	UpdateRefundAccept(ctx context.Context, refundDataID string) error
 	UpdateRefundReject(ctx context.Context, tx postgre.Transaction, refundDataID string) error
 	UpdateOrderRefundRejected(ctx context.Context, tx postgre.Transaction, orderData *model.OrderModel) error
+	CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error
+	UpdateOrderWithdrawn(ctx context.Context, tx postgre.Transaction, orderID string) (int64, error)
+	GetBankAccountsByShopID(ctx context.Context, shopID string) ([]*model.BankAccount, error)
+	GetBankAccountByID(ctx context.Context, shopID, bankAccountID string) (*model.BankAccount, error)
+	CreateBankAccount(ctx context.Context, bankAccount *model.BankAccount) error
+	DeleteBankAccount(ctx context.Context, shopID, bankAccountID string) (int64, error)
+	CreatePayout(ctx context.Context, tx postgre.Transaction, payout *model.Payout) (*uuid.UUID, error)
+	AssignOrdersToPayout(ctx context.Context, tx postgre.Transaction, shopID, payoutID string) (model.Money, int, error)
+	UpdatePayoutAmount(ctx context.Context, tx postgre.Transaction, payout *model.Payout) error
+	GetTotalPayoutsByShopID(ctx context.Context, shopID string) (int64, error)
+	GetPayoutsByShopID(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.Payout, error)
+	GetPayoutByID(ctx context.Context, shopID, payoutID string) (*model.Payout, error)
+	GetOrderStatusHistoryByOrderID(ctx context.Context, orderID string) ([]*model.OrderStatusHistory, error)
+	ReleaseStockReservation(ctx context.Context, tx postgre.Transaction, orderID string) error
+	CreateShopStaff(ctx context.Context, staff *model.ShopStaff) error
+	GetShopStaffByShopID(ctx context.Context, shopID string) ([]*model.ShopStaff, error)
+	GetShopStaffByID(ctx context.Context, shopID, staffID string) (*model.ShopStaff, error)
+	GetShopStaffByEmail(ctx context.Context, shopID, email string) (*model.ShopStaff, error)
+	GetShopStaffByInviteToken(ctx context.Context, inviteToken string) (*model.ShopStaff, error)
+	UpdateShopStaffScopes(ctx context.Context, shopID, staffID string, scopes []string) (int64, error)
+	AcceptShopStaff(ctx context.Context, staffID, userID string) (int64, error)
+	DeleteShopStaff(ctx context.Context, shopID, staffID string) (int64, error)
+	GetTotalShopAuditLogs(ctx context.Context, shopID string) (int64, error)
+	GetShopAuditLogs(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.ShopAuditLog, error)
+	GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error)
+	UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error)
+	GetOrderProductIDs(ctx context.Context, tx postgre.Transaction, orderID string) ([]string, error)
 }
@@ -1,5 +1,7 @@
 package repository
 
+import "murakali/internal/rbac"
+
 const (
 	GetSellerPerformanceMetadataQuery = `
 	SELECT id AS shop_id, name AS shop_name, created_at AS shop_created_at, NOW() as report_updated_at
@@ -127,7 +129,8 @@ const (
 	SELECT 
 		SUM(total_price) as total_sales,
 		SUM(CASE WHEN is_withdraw = true THEN total_price ELSE 0 END) as withdrawn_sum,
-		SUM(CASE WHEN is_withdraw = false THEN total_price ELSE 0 END) as withdrawable_sum
+		SUM(CASE WHEN is_withdraw = false AND order_status_id = $2 AND payout_id IS NULL THEN total_price ELSE 0 END) as withdrawable_sum,
+		SUM(CASE WHEN is_withdraw = false AND payout_id IS NOT NULL THEN total_price ELSE 0 END) as pending_payout_sum
 	FROM "order"
 	WHERE shop_id = $1
 	GROUP BY shop_id;
@@ -215,18 +218,23 @@ const (
 		WHERE "pd"."id" = $1 AND "pd"."deleted_at" IS NULL
 	`
 
-	GetShopIDByUserQuery = `SELECT id from shop where user_id = $1 and deleted_at is null`
+	ShopIDByUserSubQuery = `SELECT "id" FROM "shop" WHERE "user_id" = $1 AND "deleted_at" IS NULL
+	UNION ALL
+	SELECT "ss"."shop_id" FROM "shop_staff" AS "ss"
+	INNER JOIN "shop" AS "s" ON "s"."id" = "ss"."shop_id" AND "s"."deleted_at" IS NULL
+	WHERE "ss"."user_id" = $1 AND "ss"."accepted_at" IS NOT NULL AND "ss"."deleted_at" IS NULL`
+
+	GetShopIDByUserQuery = `SELECT "shop_id" FROM (` + rbac.GetShopAccessQuery + `) AS "shop_access"`
 
 	GetShopIDByOrderQuery = `SELECT shop_id from "order" where id = $1 `
 
-	ChangeOrderStatusQuery = `UPDATE "order" SET "order_status_id" = $1 WHERE "id" = $2`
-	CancelOrderStatusQuery = `UPDATE "order" SET "order_status_id" = $1, "cancel_notes" = $2, "is_refund" = $3 WHERE "id" = $4`
+	ChangeOrderStatusQuery = `UPDATE "order" SET "order_status_id" = $1 WHERE "id" = $2 AND "order_status_id" = $3`
+	CancelOrderStatusQuery = `UPDATE "order" SET "order_status_id" = $1, "cancel_notes" = $2, "is_refund" = $3 WHERE "id" = $4 AND "order_status_id" = $5`
 
 	GetCourierSellerQuery = `
 	SELECT "sp"."id" as "shop_courier_id",	"sp"."courier_id" as "courier_id", "sp"."deleted_at" as "deleted_at"
 	FROM "shop_courier" as "sp"
-	INNER JOIN "shop" as "s" ON "s"."id" = "sp"."shop_id"
-	WHERE "s"."user_id" = $1;
+	WHERE "sp"."shop_id" IN (` + ShopIDByUserSubQuery + `);
 	`
 
 	GetOrderOnDeliveryQuery = `SELECT "id", "order_status_id", "arrived_at" FROM "order" WHERE "order_status_id" = $1 AND "arrived_at" <= current_timestamp`
@@ -253,7 +261,7 @@ const (
 	UpdateShopInformationByUserIDQuery = `UPDATE "shop" SET "name" = $1, "updated_at" = now() WHERE "user_id" = $2`
 
 	GetCourierByIDQuery                            = `SELECT id FROM "courier" WHERE id = $1 AND deleted_at IS NULL`
-	GetShopIDByUserIDQuery                         = `SELECT id from "shop" WHERE user_id = $1 AND deleted_at IS NULL `
+	GetShopIDByUserIDQuery                         = GetShopIDByUserQuery
 	GetCourierSellerNotNullByShopAndCourierIDQuery = `SELECT id from "shop_courier" WHERE shop_id = $1 AND courier_id = $2 `
 	CreateCourierSellerQuery                       = `INSERT INTO "shop_courier" 
     	(shop_id, courier_id)
@@ -391,13 +399,10 @@ const (
 	WHERE "promo"."id" = $1 AND "s"."id" = $2
 	`
 
-	UpdateOrderByID               = `UPDATE "order" SET "order_status_id" = $1, "is_withdraw" = $2 WHERE "id" = $3`
-	UpdateTransactionByID         = `UPDATE "transaction" SET "paid_at" = $1, "canceled_at" = $2 WHERE "id" = $3`
-	GetProductDetailByIDQuery     = `SELECT "id", "price", "stock", "weight", "size", "hazardous", "condition", "bulk_price" FROM "product_detail" WHERE "id" = $1 AND "deleted_at" IS NULL;`
-	GetTransactionsExpiredQuery   = `SELECT "id", "voucher_marketplace_id", "wallet_id", "card_number", "invoice", "total_price", "paid_at", "canceled_at", "expired_at" FROM "transaction" WHERE "paid_at" IS NULL AND "canceled_at" IS NULL AND "expired_at" < current_timestamp`
-	GetOrderItemsByOrderIDQuery   = `SELECT "id", "order_id", "product_detail_id", "quantity", "item_price", "total_price" FROM "order_item" WHERE "order_id" = $1`
-	UpdateProductDetailStockQuery = `UPDATE "product_detail" SET "stock" = $1, "updated_at" = now() WHERE "id" = $2;`
-	GetOrderByTransactionID       = `SELECT 
+	UpdateOrderByID             = `UPDATE "order" SET "order_status_id" = $1, "is_withdraw" = $2 WHERE "id" = $3`
+	UpdateTransactionByID       = `UPDATE "transaction" SET "paid_at" = $1, "canceled_at" = $2 WHERE "id" = $3`
+	GetTransactionsExpiredQuery = `SELECT "id", "voucher_marketplace_id", "wallet_id", "card_number", "invoice", "total_price", "paid_at", "canceled_at", "expired_at" FROM "transaction" WHERE "paid_at" IS NULL AND "canceled_at" IS NULL AND "expired_at" < current_timestamp`
+	GetOrderByTransactionID     = `SELECT 
 		"id", "transaction_id", "shop_id", "user_id", "courier_id", "voucher_shop_id", "order_status_id", "total_price", "delivery_fee", "resi_no", "created_at", "arrived_at" 
 	FROM "order" WHERE "transaction_id" = $1`
 	UpdateWalletBalanceQuery = `UPDATE "wallet" SET "balance" = $1, "updated_at" = $2 WHERE "id" = $3`
@@ -445,4 +450,123 @@ const (
 	UpdateRefundRejectQuery = `UPDATE "refund" SET "rejected_at" = now() WHERE "id" = $1;`
 
 	UpdateOrderRefundRejectedQuery = `UPDATE "order" SET "is_refund" = FALSE WHERE "id" = $1`
+
+	CreateOrderStatusHistoryQuery = `INSERT INTO "order_status_history"
+	(order_id, from_order_status_id, to_order_status_id, actor, actor_id) VALUES ($1, $2, $3, $4, $5)`
+
+	GetOrderStatusHistoryByOrderIDQuery = `SELECT "id", "order_id", "from_order_status_id", "to_order_status_id", "actor", "actor_id", "created_at"
+	FROM "order_status_history" WHERE "order_id" = $1 ORDER BY "created_at" ASC`
+
+	UpdateStockReservationStatusQuery = `UPDATE "stock_reservation" SET "status" = $1, "updated_at" = now()
+	WHERE "order_id" = $2 AND "status" = $3`
+
+	UpdateOrderWithdrawnQuery = `UPDATE "order" SET "is_withdraw" = true
+	WHERE "id" = $1 AND "is_withdraw" = false AND "payout_id" IS NULL`
+
+	GetBankAccountsByShopIDQuery = `SELECT "id", "shop_id", "bank_name", "account_number", "account_holder", "created_at", "updated_at"
+	FROM "bank_account" WHERE "shop_id" = $1 AND "deleted_at" IS NULL ORDER BY "created_at" DESC`
+
+	GetBankAccountByIDQuery = `SELECT "id", "shop_id", "bank_name", "account_number", "account_holder", "created_at", "updated_at"
+	FROM "bank_account" WHERE "id" = $1 AND "shop_id" = $2 AND "deleted_at" IS NULL`
+
+	CreateBankAccountQuery = `INSERT INTO "bank_account" (shop_id, bank_name, account_number, account_holder)
+	VALUES ($1, $2, $3, $4)`
+
+	DeleteBankAccountQuery = `UPDATE "bank_account" SET "deleted_at" = now()
+	WHERE "id" = $1 AND "shop_id" = $2 AND "deleted_at" IS NULL`
+
+	CreatePayoutQuery = `INSERT INTO "payout" (shop_id, bank_account_id, status, requested_at)
+	VALUES ($1, $2, $3, $4) RETURNING "id"`
+
+	AssignOrdersToPayoutQuery = `UPDATE "order" SET "payout_id" = $1
+	WHERE "shop_id" = $2 AND "order_status_id" = $3 AND "is_withdraw" = false AND "payout_id" IS NULL
+	RETURNING "total_price"`
+
+	UpdatePayoutAmountQuery = `UPDATE "payout" SET "amount" = $1, "order_count" = $2 WHERE "id" = $3`
+
+	GetTotalPayoutsByShopIDQuery = `SELECT count(id) FROM "payout" WHERE "shop_id" = $1`
+
+	GetPayoutsByShopIDQuery = `SELECT "p"."id", "p"."shop_id", "p"."bank_account_id", "p"."payout_batch_id", "p"."amount", "p"."order_count",
+	"p"."status", "p"."note", "p"."requested_at", "p"."approved_at", "p"."rejected_at",
+	"b"."bank_name", "b"."account_number", "b"."account_holder"
+	FROM "payout" AS "p"
+	INNER JOIN "bank_account" AS "b" ON "b"."id" = "p"."bank_account_id"
+	WHERE "p"."shop_id" = $1
+	ORDER BY "p"."requested_at" DESC LIMIT $2 OFFSET $3`
+
+	GetPayoutByIDQuery = `SELECT "p"."id", "p"."shop_id", "p"."bank_account_id", "p"."payout_batch_id", "p"."amount", "p"."order_count",
+	"p"."status", "p"."note", "p"."requested_at", "p"."approved_at", "p"."rejected_at",
+	"b"."bank_name", "b"."account_number", "b"."account_holder"
+	FROM "payout" AS "p"
+	INNER JOIN "bank_account" AS "b" ON "b"."id" = "p"."bank_account_id"
+	WHERE "p"."id" = $1 AND "p"."shop_id" = $2`
+
+	shopStaffColumns = `"id", "shop_id", "user_id", "email", "scopes", "invited_by", "expired_at", "accepted_at",
+	"created_at", "updated_at"`
+
+	CreateShopStaffQuery = `INSERT INTO "shop_staff" (shop_id, email, scopes, invite_token, invited_by, expired_at)
+	VALUES ($1, $2, $3, $4, $5, $6) RETURNING "id", "created_at"`
+
+	GetShopStaffByShopIDQuery = `SELECT ` + shopStaffColumns + ` FROM "shop_staff"
+	WHERE "shop_id" = $1 AND "deleted_at" IS NULL ORDER BY "created_at"`
+
+	GetShopStaffByIDQuery = `SELECT ` + shopStaffColumns + ` FROM "shop_staff"
+	WHERE "shop_id" = $1 AND "id" = $2 AND "deleted_at" IS NULL`
+
+	GetShopStaffByEmailQuery = `SELECT ` + shopStaffColumns + ` FROM "shop_staff"
+	WHERE "shop_id" = $1 AND "email" = $2 AND "deleted_at" IS NULL`
+
+	GetShopStaffByInviteTokenQuery = `SELECT ` + shopStaffColumns + ` FROM "shop_staff"
+	WHERE "invite_token" = $1 AND "accepted_at" IS NULL AND "deleted_at" IS NULL`
+
+	UpdateShopStaffScopesQuery = `UPDATE "shop_staff" SET "scopes" = $1, "updated_at" = now()
+	WHERE "shop_id" = $2 AND "id" = $3 AND "deleted_at" IS NULL`
+
+	AcceptShopStaffQuery = `UPDATE "shop_staff" SET "user_id" = $1, "accepted_at" = now(), "invite_token" = NULL, "updated_at" = now()
+	WHERE "id" = $2 AND "accepted_at" IS NULL AND "deleted_at" IS NULL`
+
+	DeleteShopStaffQuery = `UPDATE "shop_staff" SET "invite_token" = NULL, "deleted_at" = now()
+	WHERE "shop_id" = $1 AND "id" = $2 AND "deleted_at" IS NULL`
+
+	GetTotalShopAuditLogsQuery = `SELECT count(id) FROM "shop_audit_log" WHERE "shop_id" = $1`
+
+	GetShopAuditLogsQuery = `SELECT "l"."id", "l"."shop_id", "l"."actor_id", "u"."email", "l"."staff_id", "l"."action",
+	"l"."path", "l"."status_code", "l"."created_at"
+	FROM "shop_audit_log" AS "l"
+	INNER JOIN "user" AS "u" ON "u"."id" = "l"."actor_id"
+	WHERE "l"."shop_id" = $1
+	ORDER BY "l"."created_at" DESC LIMIT $2 OFFSET $3`
+
+	ProductAlertStateColumns = `"p"."id", coalesce("p"."title", ''), coalesce("p"."thumbnail_url", ''), coalesce("pd"."price", 0),
+	coalesce("pd"."stock", 0) - coalesce((
+		SELECT sum("sr"."quantity") FROM "stock_reservation" AS "sr"
+		WHERE "sr"."product_detail_id" = "pd"."id" AND "sr"."status" = $1 AND "sr"."expired_at" > now()
+	), 0),
+	"promo"."discount_percentage", "promo"."discount_fix_price", "promo"."min_product_price", "promo"."max_discount_price"`
+
+	GetProductAlertsByProductIDsQuery = `SELECT "pa"."id", "pa"."user_id", "pa"."product_detail_id", "pa"."alert_type", "pa"."target_price",
+	"pa"."is_triggered", "pa"."unsubscribe_token", "pa"."notified_at", "pa"."created_at", "u"."email",
+	` + ProductAlertStateColumns + `
+	FROM "product_alert" AS "pa"
+	INNER JOIN "user" AS "u" ON "u"."id" = "pa"."user_id"
+	INNER JOIN "product_detail" AS "pd" ON "pd"."id" = "pa"."product_detail_id"
+	INNER JOIN "product" AS "p" ON "p"."id" = "pd"."product_id"
+	LEFT JOIN LATERAL (
+		SELECT "discount_percentage", "discount_fix_price", "min_product_price", "max_discount_price"
+		FROM "promotion"
+		WHERE "product_id" = "p"."id" AND "deleted_at" IS NULL AND "quota" > 0
+		AND "actived_date" < now() AND "expired_date" >= now()
+		ORDER BY "actived_date" DESC LIMIT 1
+	) AS "promo" ON TRUE
+	WHERE "pd"."product_id"::text = any($2) AND "pa"."deleted_at" IS NULL AND "pd"."deleted_at" IS NULL
+	AND "p"."deleted_at" IS NULL AND "p"."listed_status" = TRUE`
+
+	UpdateProductAlertTriggeredQuery = `UPDATE "product_alert" SET "is_triggered" = $2::boolean, "updated_at" = now(),
+	"notified_at" = CASE WHEN $2::boolean THEN now() ELSE "notified_at" END
+	WHERE "id" = $1 AND "is_triggered" <> $2::boolean AND "deleted_at" IS NULL`
+
+	GetOrderProductIDsQuery = `SELECT DISTINCT "pd"."product_id"::text
+	FROM "order_item" AS "oi"
+	INNER JOIN "product_detail" AS "pd" ON "pd"."id" = "oi"."product_detail_id"
+	WHERE "oi"."order_id" = $1`
 )
@@ -17,6 +17,8 @@ import (
 	"time"
 
 	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+	"github.com/lib/pq"
 )
 
 type sellerRepo struct {
@@ -170,10 +172,11 @@ func (r *sellerRepo) GetPerformance(ctx context.Context, shopID string) (*body.S
 	performance.NumOrderByProvince = numOrderByProvince
 
 	var totalSales body.TotalSales
-	if err := r.PSQL.QueryRowContext(ctx, GetTotalSalesQuery, shopID).Scan(
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalSalesQuery, shopID, constant.OrderStatusCompleted).Scan(
 		&totalSales.TotalSales,
 		&totalSales.WithdrawnSum,
 		&totalSales.WithdrawableSum,
+		&totalSales.PendingPayoutSum,
 	); err != nil {
 		if err != sql.ErrNoRows {
 			return nil, err
@@ -561,24 +564,26 @@ func (r *sellerRepo) GetOrders(ctx context.Context, shopID, orderStatusID, vouch
 	return orders, nil
 }
 
-func (r *sellerRepo) ChangeOrderStatus(ctx context.Context, requestBody body.ChangeOrderStatusRequest) error {
-	_, err := r.PSQL.ExecContext(
-		ctx, ChangeOrderStatusQuery, requestBody.OrderStatusID, requestBody.OrderID)
+func (r *sellerRepo) ChangeOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.ChangeOrderStatusRequest,
+	fromStatusID int) (int64, error) {
+	res, err := tx.ExecContext(
+		ctx, ChangeOrderStatusQuery, requestBody.OrderStatusID, requestBody.OrderID, fromStatusID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return res.RowsAffected()
 }
 
-func (r *sellerRepo) CancelOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus) error {
-	_, err := tx.ExecContext(
-		ctx, CancelOrderStatusQuery, constant.OrderStatusCanceled, requestBody.CancelNotes, true, requestBody.OrderID)
+func (r *sellerRepo) CancelOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus,
+	fromStatusID int) (int64, error) {
+	res, err := tx.ExecContext(
+		ctx, CancelOrderStatusQuery, constant.OrderStatusCanceled, requestBody.CancelNotes, true, requestBody.OrderID, fromStatusID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return res.RowsAffected()
 }
 
 func (r *sellerRepo) CreateRefundSeller(ctx context.Context, tx postgre.Transaction, requestBody body.CancelOrderStatus) error {
@@ -812,8 +817,8 @@ func (r *sellerRepo) DeleteCourierSellerByID(ctx context.Context, shopCourierID
 	return nil
 }
 
-func (r *sellerRepo) UpdateResiNumberInOrderSeller(ctx context.Context, noResi, orderID, shopID string, arriveAt time.Time) error {
-	temp, err := r.PSQL.ExecContext(ctx,
+func (r *sellerRepo) UpdateResiNumberInOrderSeller(ctx context.Context, tx postgre.Transaction, noResi, orderID, shopID string, arriveAt time.Time) error {
+	temp, err := tx.ExecContext(ctx,
 		UpdateResiNumberInOrderSellerQuery,
 		noResi, arriveAt, constant.OrderStatusOnDelivery, orderID, shopID)
 	if err != nil {
@@ -1279,63 +1284,6 @@ func (r *sellerRepo) GetTransactionsExpired(ctx context.Context) ([]*model.Trans
 	return transactions, nil
 }
 
-func (r *sellerRepo) GetOrderItemsByOrderID(ctx context.Context, tx postgre.Transaction, orderID string) ([]*model.OrderItem, error) {
-	orderItems := make([]*model.OrderItem, 0)
-	res, err := tx.QueryContext(ctx, GetOrderItemsByOrderIDQuery, orderID)
-	if err != nil {
-		return nil, err
-	}
-	defer res.Close()
-
-	for res.Next() {
-		var orderItem model.OrderItem
-		if errScan := res.Scan(
-			&orderItem.ID,
-			&orderItem.OrderID,
-			&orderItem.ProductDetailID,
-			&orderItem.Quantity,
-			&orderItem.ItemPrice,
-			&orderItem.TotalPrice); errScan != nil {
-			return nil, err
-		}
-
-		orderItems = append(orderItems, &orderItem)
-	}
-
-	if res.Err() != nil {
-		return nil, err
-	}
-
-	return orderItems, nil
-}
-
-func (r *sellerRepo) GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error) {
-	var pd model.ProductDetail
-	if err := tx.QueryRowContext(ctx, GetProductDetailByIDQuery, productDetailID).Scan(
-		&pd.ID,
-		&pd.Price,
-		&pd.Stock,
-		&pd.Size,
-		&pd.Weight,
-		&pd.Hazardous,
-		&pd.Condition,
-		&pd.BulkPrice); err != nil {
-		return nil, err
-	}
-
-	return &pd, nil
-}
-
-func (r *sellerRepo) UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction,
-	productDetailData *model.ProductDetail) error {
-	_, err := tx.ExecContext(ctx, UpdateProductDetailStockQuery, productDetailData.Stock, productDetailData.ID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (r *sellerRepo) GetTotalProductWithoutPromotionSeller(ctx context.Context, shopID, productName string) (int64, error) {
 	var total int64
 	if err := r.PSQL.QueryRowContext(ctx, GetTotalProductWithoutPromotionQuery, shopID, fmt.Sprintf("%%%s%%", productName)).Scan(&total); err != nil {
@@ -1517,3 +1465,485 @@ func (r *sellerRepo) UpdateOrderRefundRejected(ctx context.Context, tx postgre.T
 
 	return nil
 }
+
+func (r *sellerRepo) CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error {
+	_, err := tx.ExecContext(ctx, CreateOrderStatusHistoryQuery,
+		history.OrderID,
+		history.FromOrderStatusID,
+		history.ToOrderStatusID,
+		history.Actor,
+		history.ActorID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *sellerRepo) GetOrderStatusHistoryByOrderID(ctx context.Context, orderID string) ([]*model.OrderStatusHistory, error) {
+	histories := make([]*model.OrderStatusHistory, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetOrderStatusHistoryByOrderIDQuery, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var history model.OrderStatusHistory
+		if errScan := res.Scan(
+			&history.ID,
+			&history.OrderID,
+			&history.FromOrderStatusID,
+			&history.ToOrderStatusID,
+			&history.Actor,
+			&history.ActorID,
+			&history.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+		histories = append(histories, &history)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return histories, nil
+}
+
+func (r *sellerRepo) ReleaseStockReservation(ctx context.Context, tx postgre.Transaction, orderID string) error {
+	if _, err := tx.ExecContext(ctx, UpdateStockReservationStatusQuery,
+		constant.StockReservationReleased,
+		orderID,
+		constant.StockReservationActive); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *sellerRepo) UpdateOrderWithdrawn(ctx context.Context, tx postgre.Transaction, orderID string) (int64, error) {
+	res, err := tx.ExecContext(ctx, UpdateOrderWithdrawnQuery, orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *sellerRepo) GetBankAccountsByShopID(ctx context.Context, shopID string) ([]*model.BankAccount, error) {
+	bankAccounts := make([]*model.BankAccount, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetBankAccountsByShopIDQuery, shopID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var bankAccount model.BankAccount
+		if errScan := res.Scan(
+			&bankAccount.ID,
+			&bankAccount.ShopID,
+			&bankAccount.BankName,
+			&bankAccount.AccountNumber,
+			&bankAccount.AccountHolder,
+			&bankAccount.CreatedAt,
+			&bankAccount.UpdatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		bankAccounts = append(bankAccounts, &bankAccount)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return bankAccounts, nil
+}
+
+func (r *sellerRepo) GetBankAccountByID(ctx context.Context, shopID, bankAccountID string) (*model.BankAccount, error) {
+	var bankAccount model.BankAccount
+	if err := r.PSQL.QueryRowContext(ctx, GetBankAccountByIDQuery, bankAccountID, shopID).Scan(
+		&bankAccount.ID,
+		&bankAccount.ShopID,
+		&bankAccount.BankName,
+		&bankAccount.AccountNumber,
+		&bankAccount.AccountHolder,
+		&bankAccount.CreatedAt,
+		&bankAccount.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &bankAccount, nil
+}
+
+func (r *sellerRepo) CreateBankAccount(ctx context.Context, bankAccount *model.BankAccount) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateBankAccountQuery,
+		bankAccount.ShopID,
+		bankAccount.BankName,
+		bankAccount.AccountNumber,
+		bankAccount.AccountHolder); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *sellerRepo) DeleteBankAccount(ctx context.Context, shopID, bankAccountID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, DeleteBankAccountQuery, bankAccountID, shopID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *sellerRepo) CreatePayout(ctx context.Context, tx postgre.Transaction, payout *model.Payout) (*uuid.UUID, error) {
+	var payoutID *uuid.UUID
+	if err := tx.QueryRowContext(ctx, CreatePayoutQuery,
+		payout.ShopID,
+		payout.BankAccountID,
+		payout.Status,
+		payout.RequestedAt).Scan(&payoutID); err != nil {
+		return nil, err
+	}
+
+	return payoutID, nil
+}
+
+func (r *sellerRepo) AssignOrdersToPayout(ctx context.Context, tx postgre.Transaction, shopID, payoutID string) (model.Money, int, error) {
+	res, err := tx.QueryContext(ctx, AssignOrdersToPayoutQuery, payoutID, shopID, constant.OrderStatusCompleted)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer res.Close()
+
+	var amount model.Money
+	var count int
+	for res.Next() {
+		var totalPrice model.Money
+		if errScan := res.Scan(&totalPrice); errScan != nil {
+			return 0, 0, errScan
+		}
+
+		amount += totalPrice
+		count++
+	}
+
+	if res.Err() != nil {
+		return 0, 0, res.Err()
+	}
+
+	return amount, count, nil
+}
+
+func (r *sellerRepo) UpdatePayoutAmount(ctx context.Context, tx postgre.Transaction, payout *model.Payout) error {
+	if _, err := tx.ExecContext(ctx, UpdatePayoutAmountQuery, payout.Amount, payout.OrderCount, payout.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *sellerRepo) GetTotalPayoutsByShopID(ctx context.Context, shopID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalPayoutsByShopIDQuery, shopID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *sellerRepo) GetPayoutsByShopID(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.Payout, error) {
+	payouts := make([]*model.Payout, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetPayoutsByShopIDQuery, shopID, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		payout := &model.Payout{BankAccount: &model.BankAccount{}}
+		if errScan := res.Scan(
+			&payout.ID,
+			&payout.ShopID,
+			&payout.BankAccountID,
+			&payout.PayoutBatchID,
+			&payout.Amount,
+			&payout.OrderCount,
+			&payout.Status,
+			&payout.Note,
+			&payout.RequestedAt,
+			&payout.ApprovedAt,
+			&payout.RejectedAt,
+			&payout.BankAccount.BankName,
+			&payout.BankAccount.AccountNumber,
+			&payout.BankAccount.AccountHolder); errScan != nil {
+			return nil, errScan
+		}
+
+		payout.BankAccount.ID = payout.BankAccountID
+		payouts = append(payouts, payout)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return payouts, nil
+}
+
+func (r *sellerRepo) GetPayoutByID(ctx context.Context, shopID, payoutID string) (*model.Payout, error) {
+	payout := &model.Payout{BankAccount: &model.BankAccount{}}
+	if err := r.PSQL.QueryRowContext(ctx, GetPayoutByIDQuery, payoutID, shopID).Scan(
+		&payout.ID,
+		&payout.ShopID,
+		&payout.BankAccountID,
+		&payout.PayoutBatchID,
+		&payout.Amount,
+		&payout.OrderCount,
+		&payout.Status,
+		&payout.Note,
+		&payout.RequestedAt,
+		&payout.ApprovedAt,
+		&payout.RejectedAt,
+		&payout.BankAccount.BankName,
+		&payout.BankAccount.AccountNumber,
+		&payout.BankAccount.AccountHolder); err != nil {
+		return nil, err
+	}
+
+	payout.BankAccount.ID = payout.BankAccountID
+	return payout, nil
+}
+
+func (r *sellerRepo) CreateShopStaff(ctx context.Context, staff *model.ShopStaff) error {
+	if err := r.PSQL.QueryRowContext(ctx, CreateShopStaffQuery,
+		staff.ShopID,
+		staff.Email,
+		pq.StringArray(staff.Scopes),
+		staff.InviteToken,
+		staff.InvitedBy,
+		staff.ExpiredAt).Scan(&staff.ID, &staff.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *sellerRepo) GetShopStaffByShopID(ctx context.Context, shopID string) ([]*model.ShopStaff, error) {
+	staffs := make([]*model.ShopStaff, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetShopStaffByShopIDQuery, shopID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var staff model.ShopStaff
+		if errScan := res.Scan(shopStaffFields(&staff)...); errScan != nil {
+			return nil, errScan
+		}
+
+		staffs = append(staffs, &staff)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return staffs, nil
+}
+
+func (r *sellerRepo) GetShopStaffByID(ctx context.Context, shopID, staffID string) (*model.ShopStaff, error) {
+	var staff model.ShopStaff
+	if err := r.PSQL.QueryRowContext(ctx, GetShopStaffByIDQuery, shopID, staffID).Scan(shopStaffFields(&staff)...); err != nil {
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+func (r *sellerRepo) GetShopStaffByEmail(ctx context.Context, shopID, email string) (*model.ShopStaff, error) {
+	var staff model.ShopStaff
+	if err := r.PSQL.QueryRowContext(ctx, GetShopStaffByEmailQuery, shopID, email).Scan(shopStaffFields(&staff)...); err != nil {
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+func (r *sellerRepo) GetShopStaffByInviteToken(ctx context.Context, inviteToken string) (*model.ShopStaff, error) {
+	var staff model.ShopStaff
+	if err := r.PSQL.QueryRowContext(ctx, GetShopStaffByInviteTokenQuery, inviteToken).Scan(shopStaffFields(&staff)...); err != nil {
+		return nil, err
+	}
+
+	return &staff, nil
+}
+
+func (r *sellerRepo) UpdateShopStaffScopes(ctx context.Context, shopID, staffID string, scopes []string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, UpdateShopStaffScopesQuery, pq.StringArray(scopes), shopID, staffID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *sellerRepo) AcceptShopStaff(ctx context.Context, staffID, userID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, AcceptShopStaffQuery, userID, staffID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *sellerRepo) DeleteShopStaff(ctx context.Context, shopID, staffID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, DeleteShopStaffQuery, shopID, staffID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *sellerRepo) GetTotalShopAuditLogs(ctx context.Context, shopID string) (int64, error) {
+	var total int64
+	if err := r.PSQL.QueryRowContext(ctx, GetTotalShopAuditLogsQuery, shopID).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *sellerRepo) GetShopAuditLogs(ctx context.Context, shopID string, pgn *pagination.Pagination) ([]*model.ShopAuditLog, error) {
+	auditLogs := make([]*model.ShopAuditLog, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetShopAuditLogsQuery, shopID, pgn.GetLimit(), pgn.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var auditLog model.ShopAuditLog
+		if errScan := res.Scan(
+			&auditLog.ID,
+			&auditLog.ShopID,
+			&auditLog.ActorID,
+			&auditLog.ActorEmail,
+			&auditLog.StaffID,
+			&auditLog.Action,
+			&auditLog.Path,
+			&auditLog.StatusCode,
+			&auditLog.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		auditLogs = append(auditLogs, &auditLog)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return auditLogs, nil
+}
+
+func shopStaffFields(staff *model.ShopStaff) []interface{} {
+	return []interface{}{
+		&staff.ID,
+		&staff.ShopID,
+		&staff.UserID,
+		&staff.Email,
+		(*pq.StringArray)(&staff.Scopes),
+		&staff.InvitedBy,
+		&staff.ExpiredAt,
+		&staff.AcceptedAt,
+		&staff.CreatedAt,
+		&staff.UpdatedAt,
+	}
+}
+
+func (r *sellerRepo) GetProductAlertsByProductIDs(ctx context.Context, productIDs []string) ([]*model.ProductAlert, error) {
+	alerts := make([]*model.ProductAlert, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetProductAlertsByProductIDsQuery, constant.StockReservationActive, pq.Array(productIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var productAlert model.ProductAlert
+		var discount model.Discount
+		if errScan := res.Scan(
+			&productAlert.ID,
+			&productAlert.UserID,
+			&productAlert.ProductDetailID,
+			&productAlert.AlertType,
+			&productAlert.TargetPrice,
+			&productAlert.IsTriggered,
+			&productAlert.UnsubscribeToken,
+			&productAlert.NotifiedAt,
+			&productAlert.CreatedAt,
+			&productAlert.Email,
+			&productAlert.ProductID,
+			&productAlert.Title,
+			&productAlert.ThumbnailURL,
+			&productAlert.Price,
+			&productAlert.AvailableStock,
+			&discount.DiscountPercentage,
+			&discount.DiscountFixPrice,
+			&discount.MinProductPrice,
+			&discount.MaxDiscountPrice,
+		); errScan != nil {
+			return nil, errScan
+		}
+		productAlert.Discount = &discount
+		alerts = append(alerts, &productAlert)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return alerts, nil
+}
+
+func (r *sellerRepo) UpdateProductAlertTriggered(ctx context.Context, alertID string, triggered bool) (bool, error) {
+	res, err := r.PSQL.ExecContext(ctx, UpdateProductAlertTriggeredQuery, alertID, triggered)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *sellerRepo) GetOrderProductIDs(ctx context.Context, tx postgre.Transaction, orderID string) ([]string, error) {
+	productIDs := make([]string, 0)
+	res, err := tx.QueryContext(ctx, GetOrderProductIDsQuery, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var productID string
+		if errScan := res.Scan(&productID); errScan != nil {
+			return nil, errScan
+		}
+		productIDs = append(productIDs, productID)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return productIDs, nil
+}
@@ -23,8 +23,8 @@ type UseCase interface {
 	DeleteCourierSellerByID(ctx context.Context, shopCourierID string) error
 	GetCategoryBySellerID(ctx context.Context, shopID string) ([]*body.CategoryResponse, error)
 	UpdateResiNumberInOrderSeller(ctx context.Context, userID, orderID string, requestBody body.UpdateNoResiOrderSellerRequest) error
-	UpdateOnDeliveryOrder(ctx context.Context) error
-	UpdateExpiredAtOrder(ctx context.Context) error
+	UpdateOnDeliveryOrder(ctx context.Context) (int64, error)
+	UpdateExpiredAtOrder(ctx context.Context) (int64, error)
 	WithdrawalOrderBalance(ctx context.Context, orderID string) error
 	GetAllVoucherSeller(ctx context.Context, userID, voucherStatusID, sortFilter string, pgn *pagination.Pagination) (*pagination.Pagination, error)
 	CreateVoucherSeller(ctx context.Context, userID string, requestBody body.CreateVoucherRequest) error
@@ -40,4 +40,16 @@ type UseCase interface {
 	CreateRefundThreadSeller(ctx context.Context, userID string, requestBody *body.CreateRefundThreadRequest) error
 	UpdateRefundAccept(ctx context.Context, userID string, requestBody *body.UpdateRefundRequest) error
 	UpdateRefundReject(ctx context.Context, userID string, requestBody *body.UpdateRefundRequest) error
+	GetBankAccounts(ctx context.Context, userID string) ([]*model.BankAccount, error)
+	CreateBankAccount(ctx context.Context, userID string, requestBody body.CreateBankAccountRequest) error
+	DeleteBankAccount(ctx context.Context, userID, bankAccountID string) error
+	CreatePayout(ctx context.Context, userID string, requestBody body.CreatePayoutRequest) (*model.Payout, error)
+	GetPayouts(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
+	GetPayoutByID(ctx context.Context, userID, payoutID string) (*model.Payout, error)
+	InviteShopStaff(ctx context.Context, userID string, requestBody body.InviteShopStaffRequest) (*model.ShopStaff, error)
+	GetShopStaff(ctx context.Context, userID string) ([]*model.ShopStaff, error)
+	UpdateShopStaff(ctx context.Context, userID, staffID string, requestBody body.UpdateShopStaffRequest) error
+	DeleteShopStaff(ctx context.Context, userID, staffID string) error
+	AcceptShopStaffInvitation(ctx context.Context, userID string, requestBody body.AcceptShopStaffRequest) error
+	GetShopAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
 }
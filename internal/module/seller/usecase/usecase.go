@@ -2,16 +2,22 @@ package usecase
 
 import (
 	"context"
+	"crypto/sha256"
 	"database/sql"
 	"encoding/json"
 	"fmt"
 	"math"
 	"murakali/config"
+	"murakali/internal/alert"
 	"murakali/internal/constant"
+	"murakali/internal/ledger"
 	"murakali/internal/model"
 	body2 "murakali/internal/module/location/delivery/body"
 	"murakali/internal/module/seller"
 	"murakali/internal/module/seller/delivery/body"
+	"murakali/internal/orderstatus"
+	"murakali/internal/util"
+	smtp "murakali/pkg/email"
 	"murakali/pkg/httperror"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
@@ -28,10 +34,11 @@ type sellerUC struct {
 	cfg        *config.Config
 	txRepo     *postgre.TxRepo
 	sellerRepo seller.Repository
+	ledgerRepo ledger.Repository
 }
 
-func NewSellerUseCase(cfg *config.Config, txRepo *postgre.TxRepo, sellerRepo seller.Repository) seller.UseCase {
-	return &sellerUC{cfg: cfg, txRepo: txRepo, sellerRepo: sellerRepo}
+func NewSellerUseCase(cfg *config.Config, txRepo *postgre.TxRepo, sellerRepo seller.Repository, ledgerRepo ledger.Repository) seller.UseCase {
+	return &sellerUC{cfg: cfg, txRepo: txRepo, sellerRepo: sellerRepo, ledgerRepo: ledgerRepo}
 }
 
 func (u *sellerUC) GetPerformance(ctx context.Context, userID string, update bool) (*body.SellerPerformance, error) {
@@ -80,14 +87,13 @@ func (u *sellerUC) WithdrawalOrderBalance(ctx context.Context, orderID string) e
 	}
 
 	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
-		idUUID, err := uuid.Parse(order.OrderID)
+		rows, err := u.sellerRepo.UpdateOrderWithdrawn(ctx, tx, order.OrderID)
 		if err != nil {
 			return err
 		}
 
-		if errWithdraw := u.sellerRepo.UpdateOrder(ctx, tx,
-			&model.OrderModel{ID: idUUID, OrderStatusID: order.OrderStatus, IsWithdraw: true}); err != nil {
-			return errWithdraw
+		if rows == 0 {
+			return httperror.New(http.StatusBadRequest, response.OrderInPayoutMessage)
 		}
 
 		walletMarketplace, err := u.sellerRepo.GetWalletByUserID(ctx, tx, constant.AdminMarketplaceID)
@@ -150,6 +156,13 @@ func (u *sellerUC) WithdrawalOrderBalance(ctx context.Context, orderID string) e
 			return err
 		}
 
+		ledgerTx := ledger.NewTransaction(constant.LedgerReferenceWithdrawal, walletUserHistory.Description, &transactionID)
+		ledger.Transfer(ledgerTx, ledger.EscrowAccount(walletMarketplace.ID), ledger.SellerPayableAccount(sellerID), *order.TotalPrice)
+		ledger.Transfer(ledgerTx, ledger.SellerPayableAccount(sellerID), ledger.WalletAccount(walletSeller.ID), *order.TotalPrice)
+		if err := ledger.Post(ctx, tx, u.ledgerRepo, ledgerTx); err != nil {
+			return err
+		}
+
 		return nil
 	})
 
@@ -211,18 +224,47 @@ func (u *sellerUC) ChangeOrderStatus(ctx context.Context, userID string, request
 		return err
 	}
 
-	shopIDFromOrder, err := u.sellerRepo.GetShopIDByOrder(ctx, requestBody.OrderID)
+	orderData, err := u.sellerRepo.GetOrderModelByID(ctx, requestBody.OrderID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.OrderNotExistMessage)
+		}
 		return err
 	}
-	if shopIDFromUser != shopIDFromOrder {
+
+	if shopIDFromUser != orderData.ShopID.String() {
 		return httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
 	}
 
-	err = u.sellerRepo.ChangeOrderStatus(ctx, requestBody)
+	orderStatusID, err := strconv.Atoi(requestBody.OrderStatusID)
+	if err != nil {
+		return httperror.New(http.StatusBadRequest, response.BadRequestMessage)
+	}
+
+	if err := orderstatus.ValidateTransition(constant.OrderActorSeller, orderData.OrderStatusID, orderStatusID); err != nil {
+		return err
+	}
+
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		rows, err := u.sellerRepo.ChangeOrderStatus(ctx, tx, requestBody, orderData.OrderStatusID)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return httperror.New(http.StatusConflict, response.OrderStatusChanged)
+		}
+
+		history := orderstatus.NewHistory(orderData.ID, orderData.OrderStatusID, orderStatusID, constant.OrderActorSeller, userID)
+		if err := u.sellerRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
+
 	return nil
 }
 
@@ -255,6 +297,11 @@ func (u *sellerUC) GetOrderByOrderID(ctx context.Context, orderID string) (*mode
 	order.BuyerAddress = buyerAddress
 	order.SellerAddress = sellerAddress
 
+	order.StatusHistory, err = u.sellerRepo.GetOrderStatusHistoryByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
 	totalWeight := 0
 	for _, detail := range order.Detail {
 		totalWeight += int(detail.ProductWeight) * detail.OrderQuantity
@@ -462,7 +509,34 @@ func (u *sellerUC) UpdateResiNumberInOrderSeller(ctx context.Context, userID, or
 		return err
 	}
 
-	err = u.sellerRepo.UpdateResiNumberInOrderSeller(ctx, requestBody.NoResi, orderID, shopID, requestBody.EstimateArriveAtTime)
+	orderData, err := u.sellerRepo.GetOrderModelByID(ctx, orderID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusNotFound, response.OrderNotExistMessage)
+		}
+		return err
+	}
+
+	if orderData.ShopID.String() != shopID {
+		return httperror.New(http.StatusNotFound, response.OrderNotExistMessage)
+	}
+
+	if err := orderstatus.ValidateTransition(constant.OrderActorSeller, orderData.OrderStatusID, constant.OrderStatusOnDelivery); err != nil {
+		return err
+	}
+
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		if err := u.sellerRepo.UpdateResiNumberInOrderSeller(ctx, tx, requestBody.NoResi, orderID, shopID, requestBody.EstimateArriveAtTime); err != nil {
+			return err
+		}
+
+		history := orderstatus.NewHistory(orderData.ID, orderData.OrderStatusID, constant.OrderStatusOnDelivery, constant.OrderActorSeller, userID)
+		if err := u.sellerRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
+			return err
+		}
+
+		return nil
+	})
 	if err != nil {
 		return err
 	}
@@ -470,31 +544,55 @@ func (u *sellerUC) UpdateResiNumberInOrderSeller(ctx context.Context, userID, or
 	return nil
 }
 
-func (u *sellerUC) UpdateOnDeliveryOrder(ctx context.Context) error {
+func (u *sellerUC) UpdateOnDeliveryOrder(ctx context.Context) (int64, error) {
 	orders, err := u.sellerRepo.GetOrdersOnDelivery(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
+	var rowsAffected int64
 	for _, order := range orders {
 		if order.OrderStatusID == constant.OrderStatusOnDelivery && order.ArrivedAt.Valid && time.Until(order.ArrivedAt.Time) <= 0 {
-			if err := u.sellerRepo.ChangeOrderStatus(ctx, body.ChangeOrderStatusRequest{OrderID: order.ID.String(),
-				OrderStatusID: strconv.Itoa(constant.OrderStatusDelivered)}); err != nil {
-				return err
+			if err := orderstatus.ValidateTransition(constant.OrderActorSystem, order.OrderStatusID, constant.OrderStatusDelivered); err != nil {
+				return rowsAffected, err
 			}
+
+			err := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+				rows, err := u.sellerRepo.ChangeOrderStatus(ctx, tx, body.ChangeOrderStatusRequest{OrderID: order.ID.String(),
+					OrderStatusID: strconv.Itoa(constant.OrderStatusDelivered)}, order.OrderStatusID)
+				if err != nil {
+					return err
+				}
+				if rows == 0 {
+					return httperror.New(http.StatusConflict, response.OrderStatusChanged)
+				}
+
+				history := orderstatus.NewHistory(order.ID, order.OrderStatusID, constant.OrderStatusDelivered, constant.OrderActorSystem, "")
+				if err := u.sellerRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
+					return err
+				}
+
+				return nil
+			})
+			if err != nil {
+				return rowsAffected, err
+			}
+			rowsAffected++
 		}
 	}
 
-	return nil
+	return rowsAffected, nil
 }
 
-func (u *sellerUC) UpdateExpiredAtOrder(ctx context.Context) error {
+func (u *sellerUC) UpdateExpiredAtOrder(ctx context.Context) (int64, error) {
 	transactions, err := u.sellerRepo.GetTransactionsExpired(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
+	var rowsAffected int64
 	for _, transaction := range transactions {
+		productIDs := make([]string, 0)
 		err := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
 			transaction.CanceledAt.Valid = true
 			transaction.CanceledAt.Time = time.Now()
@@ -508,40 +606,44 @@ func (u *sellerUC) UpdateExpiredAtOrder(ctx context.Context) error {
 			}
 
 			for _, order := range orders {
+				if err := orderstatus.ValidateTransition(constant.OrderActorSystem, order.OrderStatusID, constant.OrderStatusCanceled); err != nil {
+					return err
+				}
+
+				history := orderstatus.NewHistory(order.ID, order.OrderStatusID, constant.OrderStatusCanceled, constant.OrderActorSystem, "")
 				order.OrderStatusID = constant.OrderStatusCanceled
 				order.IsWithdraw = false
 				if err := u.sellerRepo.UpdateOrder(ctx, tx, order); err != nil {
 					return err
 				}
 
-				orderItems, err := u.sellerRepo.GetOrderItemsByOrderID(ctx, tx, order.ID.String())
-				if err != nil {
+				if err := u.sellerRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
 					return err
 				}
 
-				for _, item := range orderItems {
-					productDetailData, err := u.sellerRepo.GetProductDetailByID(ctx, tx, item.ProductDetailID.String())
-					if err != nil {
-						return err
-					}
-
-					productDetailData.Stock += float64(item.Quantity)
-					errProduct := u.sellerRepo.UpdateProductDetailStock(ctx, tx, productDetailData)
-					if errProduct != nil {
-						return errProduct
-					}
+				if err := u.sellerRepo.ReleaseStockReservation(ctx, tx, order.ID.String()); err != nil {
+					return err
 				}
+
+				orderProductIDs, err := u.sellerRepo.GetOrderProductIDs(ctx, tx, order.ID.String())
+				if err != nil {
+					return err
+				}
+				productIDs = append(productIDs, orderProductIDs...)
 			}
 
 			return nil
 		})
 
 		if err != nil {
-			return err
+			return rowsAffected, err
 		}
+		rowsAffected++
+
+		_, _ = alert.Evaluate(ctx, u.cfg, u.sellerRepo, productIDs...)
 	}
 
-	return nil
+	return rowsAffected, nil
 }
 
 func (u *sellerUC) GetCostRajaOngkir(origin, destination, weight int, code string) (*body2.RajaOngkirCostResponse, error) {
@@ -741,12 +843,26 @@ func (u *sellerUC) CancelOrderStatus(ctx context.Context, userID string, request
 		return httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
 	}
 
-	if order.OrderStatus != constant.OrderStatusWaitingForSeller {
-		return httperror.New(http.StatusBadRequest, response.OrderNotWaitingForSeller)
+	if err := orderstatus.ValidateTransition(constant.OrderActorSeller, order.OrderStatus, constant.OrderStatusCanceled); err != nil {
+		return err
+	}
+
+	orderID, err := uuid.Parse(order.OrderID)
+	if err != nil {
+		return err
 	}
 
 	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
-		if err := u.sellerRepo.CancelOrderStatus(ctx, tx, requestBody); err != nil {
+		rows, err := u.sellerRepo.CancelOrderStatus(ctx, tx, requestBody, order.OrderStatus)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return httperror.New(http.StatusConflict, response.OrderStatusChanged)
+		}
+
+		history := orderstatus.NewHistory(orderID, order.OrderStatus, constant.OrderStatusCanceled, constant.OrderActorSeller, userID)
+		if err := u.sellerRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
 			return err
 		}
 
@@ -849,6 +965,12 @@ func (u *sellerUC) CreatePromotionSeller(ctx context.Context, userID string, req
 		return -1, errTx
 	}
 
+	productIDs := make([]string, 0, len(requestBody.ProductPromotion))
+	for _, p := range requestBody.ProductPromotion {
+		productIDs = append(productIDs, p.ProductID)
+	}
+	_, _ = alert.Evaluate(ctx, u.cfg, u.sellerRepo, productIDs...)
+
 	return data.(int), nil
 }
 
@@ -916,6 +1038,7 @@ func (u *sellerUC) UpdatePromotionSeller(ctx context.Context, userID string, req
 		return err
 	}
 
+	_, _ = alert.Evaluate(ctx, u.cfg, u.sellerRepo, requestBody.ProductID)
 	return nil
 }
 
@@ -1230,3 +1353,348 @@ func (u *sellerUC) CalculateDiscountPromotionProduct(ctx context.Context, p *bod
 
 	return p
 }
+
+func (u *sellerUC) GetBankAccounts(ctx context.Context, userID string) ([]*model.BankAccount, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	return u.sellerRepo.GetBankAccountsByShopID(ctx, shopID)
+}
+
+func (u *sellerUC) CreateBankAccount(ctx context.Context, userID string, requestBody body.CreateBankAccountRequest) error {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return err
+	}
+
+	shopUUID, err := uuid.Parse(shopID)
+	if err != nil {
+		return err
+	}
+
+	bankAccount := &model.BankAccount{
+		ShopID:        shopUUID,
+		BankName:      requestBody.BankName,
+		AccountNumber: requestBody.AccountNumber,
+		AccountHolder: requestBody.AccountHolder,
+	}
+
+	return u.sellerRepo.CreateBankAccount(ctx, bankAccount)
+}
+
+func (u *sellerUC) DeleteBankAccount(ctx context.Context, userID, bankAccountID string) error {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return err
+	}
+
+	rows, err := u.sellerRepo.DeleteBankAccount(ctx, shopID, bankAccountID)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusBadRequest, response.BankAccountNotFound)
+	}
+
+	return nil
+}
+
+func (u *sellerUC) CreatePayout(ctx context.Context, userID string, requestBody body.CreatePayoutRequest) (*model.Payout, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	bankAccount, err := u.sellerRepo.GetBankAccountByID(ctx, shopID, requestBody.BankAccountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.BankAccountNotFound)
+		}
+		return nil, err
+	}
+
+	payout := &model.Payout{
+		ShopID:        bankAccount.ShopID,
+		BankAccountID: bankAccount.ID,
+		BankAccount:   bankAccount,
+		Status:        constant.PayoutStatusRequested,
+		RequestedAt:   time.Now(),
+	}
+
+	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		payoutID, err := u.sellerRepo.CreatePayout(ctx, tx, payout)
+		if err != nil {
+			return err
+		}
+
+		amount, count, err := u.sellerRepo.AssignOrdersToPayout(ctx, tx, shopID, payoutID.String())
+		if err != nil {
+			return err
+		}
+
+		if count == 0 {
+			return httperror.New(http.StatusBadRequest, response.NoWithdrawableOrder)
+		}
+
+		payout.ID = *payoutID
+		payout.Amount = amount
+		payout.OrderCount = count
+		return u.sellerRepo.UpdatePayoutAmount(ctx, tx, payout)
+	})
+	if errTx != nil {
+		return nil, errTx
+	}
+
+	return payout, nil
+}
+
+func (u *sellerUC) GetPayouts(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	totalRows, err := u.sellerRepo.GetTotalPayoutsByShopID(ctx, shopID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	payouts, err := u.sellerRepo.GetPayoutsByShopID(ctx, shopID, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = payouts
+	return pgn, nil
+}
+
+func (u *sellerUC) GetPayoutByID(ctx context.Context, userID, payoutID string) (*model.Payout, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	payout, err := u.sellerRepo.GetPayoutByID(ctx, shopID, payoutID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.PayoutNotFound)
+		}
+		return nil, err
+	}
+
+	return payout, nil
+}
+
+func (u *sellerUC) InviteShopStaff(ctx context.Context, userID string, requestBody body.InviteShopStaffRequest) (*model.ShopStaff, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	owner, err := u.sellerRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.EqualFold(owner.Email, requestBody.Email) {
+		return nil, httperror.New(http.StatusBadRequest, response.ShopStaffSelfInviteMessage)
+	}
+
+	_, err = u.sellerRepo.GetShopStaffByEmail(ctx, shopID, requestBody.Email)
+	if err == nil {
+		return nil, httperror.New(http.StatusBadRequest, response.ShopStaffAlreadyInvitedMessage)
+	}
+	if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	shop, err := u.sellerRepo.GetShopByID(ctx, shopID)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(constant.ShopStaffInvitationDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	inviteToken, err := util.GenerateRandomAlpaNumeric(32)
+	if err != nil {
+		return nil, err
+	}
+	hashedToken := hashInviteToken(inviteToken)
+
+	staff := &model.ShopStaff{
+		ShopID:      shop.ID,
+		Email:       requestBody.Email,
+		Scopes:      requestBody.Scopes,
+		InviteToken: &hashedToken,
+		InvitedBy:   owner.ID,
+		ExpiredAt:   sql.NullTime{Time: time.Now().Add(duration), Valid: true},
+	}
+	if err := u.sellerRepo.CreateShopStaff(ctx, staff); err != nil {
+		return nil, err
+	}
+
+	link := fmt.Sprintf("%s/seller/staff/accept?token=%s", u.cfg.Server.Origin, inviteToken)
+	subject := fmt.Sprintf("Invitation to join %s", shop.Name)
+	msg := smtp.ShopStaffInvitationBody(shop.Name, link)
+	go smtp.SendEmail(u.cfg, requestBody.Email, subject, msg)
+
+	return staff, nil
+}
+
+func (u *sellerUC) GetShopStaff(ctx context.Context, userID string) ([]*model.ShopStaff, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	return u.sellerRepo.GetShopStaffByShopID(ctx, shopID)
+}
+
+func (u *sellerUC) UpdateShopStaff(ctx context.Context, userID, staffID string, requestBody body.UpdateShopStaffRequest) error {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return err
+	}
+
+	rows, err := u.sellerRepo.UpdateShopStaffScopes(ctx, shopID, staffID, requestBody.Scopes)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusBadRequest, response.ShopStaffNotFoundMessage)
+	}
+
+	return nil
+}
+
+func (u *sellerUC) DeleteShopStaff(ctx context.Context, userID, staffID string) error {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return err
+	}
+
+	rows, err := u.sellerRepo.DeleteShopStaff(ctx, shopID, staffID)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusBadRequest, response.ShopStaffNotFoundMessage)
+	}
+
+	return nil
+}
+
+func (u *sellerUC) AcceptShopStaffInvitation(ctx context.Context, userID string, requestBody body.AcceptShopStaffRequest) error {
+	staff, err := u.sellerRepo.GetShopStaffByInviteToken(ctx, hashInviteToken(requestBody.Token))
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.InvitationNotFoundMessage)
+		}
+		return err
+	}
+
+	if staff.ExpiredAt.Valid && time.Now().After(staff.ExpiredAt.Time) {
+		return httperror.New(http.StatusBadRequest, response.InvitationExpiredMessage)
+	}
+
+	user, err := u.sellerRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(user.Email, staff.Email) {
+		return httperror.New(http.StatusForbidden, response.InvitationEmailMismatchMessage)
+	}
+
+	_, err = u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err == nil {
+		return httperror.New(http.StatusBadRequest, response.ShopStaffAlreadyMemberMessage)
+	}
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	rows, err := u.sellerRepo.AcceptShopStaff(ctx, staff.ID.String(), userID)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusBadRequest, response.InvitationNotFoundMessage)
+	}
+
+	return nil
+}
+
+func (u *sellerUC) GetShopAuditLogs(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error) {
+	shopID, err := u.sellerRepo.GetShopIDByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotHaveShop)
+		}
+		return nil, err
+	}
+
+	totalRows, err := u.sellerRepo.GetTotalShopAuditLogs(ctx, shopID)
+	if err != nil {
+		return nil, err
+	}
+
+	totalPages := int(math.Ceil(float64(totalRows) / float64(pgn.Limit)))
+	pgn.TotalRows = totalRows
+	pgn.TotalPages = totalPages
+
+	auditLogs, err := u.sellerRepo.GetShopAuditLogs(ctx, shopID, pgn)
+	if err != nil {
+		return nil, err
+	}
+
+	pgn.Rows = auditLogs
+	return pgn, nil
+}
+
+func hashInviteToken(token string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(token)))
+}
@@ -5,14 +5,17 @@ import (
 	"database/sql"
 	"errors"
 	"murakali/config"
+	"murakali/internal/constant"
 	"murakali/internal/model"
 	"murakali/internal/module/seller/delivery/body"
 	"murakali/internal/module/seller/mocks"
 	"murakali/pkg/httperror"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
+	"murakali/pkg/response"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -46,7 +49,7 @@ func Test_sellerUC_GetPerformance(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetPerformance(context.Background(), tc.userID, tc.update)
@@ -85,7 +88,7 @@ func Test_sellerUC_GetAllSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAllSeller(context.Background(), tc.shopName, tc.pgn)
@@ -130,7 +133,7 @@ func Test_sellerUC_GetOrder(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetOrder(context.Background(), tc.userID, tc.orderStatusID, tc.voucherShopID, tc.sortQuery, tc.pgn)
@@ -150,16 +153,64 @@ func Test_sellerUC_ChangeOrderStatus(t *testing.T) {
 		expectedErr error
 	}{
 		{
-			name:        "success get performance",
+			name:        "success change order status",
 			userID:      "123456",
-			requestBody: body.ChangeOrderStatusRequest{},
+			requestBody: body.ChangeOrderStatusRequest{OrderStatusID: "3"},
 			mock: func(t *testing.T, r *mocks.Repository) {
-				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return("123", nil)
-				r.On("GetShopIDByOrder", mock.Anything, mock.Anything).Return("123", nil)
-				r.On("ChangeOrderStatus", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusWaitingForSeller}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedErr: nil,
 		},
+		{
+			name:        "error order status changed concurrently",
+			userID:      "123456",
+			requestBody: body.ChangeOrderStatusRequest{OrderStatusID: "3"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusWaitingForSeller}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, constant.OrderStatusWaitingForSeller).
+					Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusConflict, response.OrderStatusChanged),
+		},
+		{
+			name:        "error order not belong to seller",
+			userID:      "123456",
+			requestBody: body.ChangeOrderStatusRequest{OrderStatusID: "3"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return("123", nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusWaitingForSeller}, nil)
+			},
+			expectedErr: httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage),
+		},
+		{
+			name:        "error order status transition not allowed",
+			userID:      "123456",
+			requestBody: body.ChangeOrderStatusRequest{OrderStatusID: "7"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusWaitingToPay}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, "Order status cannot be changed from waiting to pay to completed."),
+		},
+		{
+			name:        "error invalid order status id",
+			userID:      "123456",
+			requestBody: body.ChangeOrderStatusRequest{OrderStatusID: "abc"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusWaitingForSeller}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.BadRequestMessage),
+		},
 	}
 
 	for _, tc := range testCase {
@@ -167,7 +218,7 @@ func Test_sellerUC_ChangeOrderStatus(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.ChangeOrderStatus(context.Background(), tc.userID, tc.requestBody)
@@ -200,7 +251,7 @@ func Test_sellerUC_GetSellerBySellerID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetSellerBySellerID(context.Background(), tc.sellerID)
@@ -249,7 +300,7 @@ func Test_sellerUC_GetSellerByUserID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetSellerByUserID(context.Background(), tc.userID)
@@ -304,7 +355,7 @@ func Test_sellerUC_UpdateSellerInformationByUserID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateSellerInformationByUserID(context.Background(), tc.shopName, tc.userID)
@@ -355,7 +406,7 @@ func Test_sellerUC_DeleteCourierSellerByID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteCourierSellerByID(context.Background(), tc.shopCourierID)
@@ -396,7 +447,7 @@ func Test_sellerUC_GetCategoryBySellerID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetCategoryBySellerID(context.Background(), tc.shopID)
@@ -421,11 +472,25 @@ func Test_sellerUC_UpdateResiNumberInOrderSeller(t *testing.T) {
 			orderID: "123456",
 			userID:  "123456",
 			mock: func(t *testing.T, r *mocks.Repository) {
-				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("", nil)
-				r.On("UpdateResiNumberInOrderSeller", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusProcessed}, nil)
+				r.On("UpdateResiNumberInOrderSeller", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedErr: nil,
 		},
+		{
+			name:    "error order not processed",
+			orderID: "123456",
+			userID:  "123456",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{
+					ShopID: uuid.Nil, OrderStatusID: constant.OrderStatusWaitingForSeller}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, "Order status cannot be changed from waiting for seller to on delivery."),
+		},
 	}
 
 	for _, tc := range testCase {
@@ -433,7 +498,7 @@ func Test_sellerUC_UpdateResiNumberInOrderSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateResiNumberInOrderSeller(context.Background(), tc.userID, tc.orderID, tc.requestBody)
@@ -477,7 +542,7 @@ func Test_sellerUC_GetAllVoucherSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAllVoucherSeller(context.Background(), tc.userID, tc.voucherStatusID, tc.sortFilter, tc.pgn)
@@ -525,7 +590,7 @@ func Test_sellerUC_GetAllVoucherSeller(t *testing.T) {
 // 			sql, mock, _ := sqlmock.New()
 // 			mock.ExpectBegin()
 // 			r := mocks.NewRepository(t)
-// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 // 			tc.mock(t, r)
 // 			err := u.CreateVoucherSeller(context.Background(), tc.userID, tc.requestBody)
@@ -562,7 +627,7 @@ func Test_sellerUC_UpdateVoucherSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateVoucherSeller(context.Background(), tc.userID, tc.requestBody)
@@ -596,7 +661,7 @@ func Test_sellerUC_GetDetailVoucherSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetDetailVoucherSeller(context.Background(), tc.voucherIDShopID)
@@ -651,7 +716,7 @@ func Test_sellerUC_DeleteVoucherSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteVoucherSeller(context.Background(), tc.voucherIDShopID)
@@ -662,43 +727,71 @@ func Test_sellerUC_DeleteVoucherSeller(t *testing.T) {
 	}
 }
 
-// func Test_sellerUC_CancelOrderStatus(t *testing.T) {
-// 	testCase := []struct {
-// 		name        string
-// 		userID      string
-// 		requestBody body.CancelOrderStatus
-// 		mock        func(t *testing.T, r *mocks.Repository)
-// 		expectedErr error
-// 	}{
-// 		{
-// 			name:   "success DeleteVoucherSeller",
-// 			userID: "",
-// 			mock: func(t *testing.T, r *mocks.Repository) {
-// 				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return("", nil)
-// 				r.On("GetOrderByOrderID", mock.Anything, mock.Anything).Return(&model.Order{}, nil)
-// 				// r.On("CancelOrderStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-// 				// r.On("CreateRefundSeller", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-
-// 			},
-// 			expectedErr: nil,
-// 		},
-// 	}
+func Test_sellerUC_CancelOrderStatus(t *testing.T) {
+	orderID := uuid.New().String()
+	testCase := []struct {
+		name        string
+		userID      string
+		requestBody body.CancelOrderStatus
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:        "success cancel order status",
+			userID:      "123456",
+			requestBody: body.CancelOrderStatus{OrderID: orderID},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderByOrderID", mock.Anything, mock.Anything).Return(&model.Order{
+					OrderID: orderID, ShopID: uuid.Nil.String(), OrderStatus: constant.OrderStatusWaitingForSeller}, nil)
+				r.On("CancelOrderStatus", mock.Anything, mock.Anything, mock.Anything, constant.OrderStatusWaitingForSeller).
+					Return(int64(1), nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateRefundSeller", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "error order status changed concurrently",
+			userID:      "123456",
+			requestBody: body.CancelOrderStatus{OrderID: orderID},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderByOrderID", mock.Anything, mock.Anything).Return(&model.Order{
+					OrderID: orderID, ShopID: uuid.Nil.String(), OrderStatus: constant.OrderStatusWaitingForSeller}, nil)
+				r.On("CancelOrderStatus", mock.Anything, mock.Anything, mock.Anything, constant.OrderStatusWaitingForSeller).
+					Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusConflict, response.OrderStatusChanged),
+		},
+		{
+			name:        "error order status transition not allowed",
+			userID:      "123456",
+			requestBody: body.CancelOrderStatus{OrderID: orderID},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUser", mock.Anything, mock.Anything).Return(uuid.Nil.String(), nil)
+				r.On("GetOrderByOrderID", mock.Anything, mock.Anything).Return(&model.Order{
+					OrderID: orderID, ShopID: uuid.Nil.String(), OrderStatus: constant.OrderStatusOnDelivery}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, "Order status cannot be changed from on delivery to canceled."),
+		},
+	}
 
-// 	for _, tc := range testCase {
-// 		t.Run(tc.name, func(t *testing.T) {
-// 			sql, mock, _ := sqlmock.New()
-// 			mock.ExpectBegin()
-// 			r := mocks.NewRepository(t)
-// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+			r := mocks.NewRepository(t)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
-// 			tc.mock(t, r)
-// 			err := u.CancelOrderStatus(context.Background(), tc.userID, tc.requestBody)
-// 			if err != nil {
-// 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
-// 			}
-// 		})
-// 	}
-// }
+			tc.mock(t, r)
+			err := u.CancelOrderStatus(context.Background(), tc.userID, tc.requestBody)
+			if err != nil {
+				assert.Equal(t, err.Error(), tc.expectedErr.Error())
+			}
+		})
+	}
+}
 
 func Test_sellerUC_GetAllPromotionSeller(t *testing.T) {
 	value := int64(1)
@@ -730,7 +823,7 @@ func Test_sellerUC_GetAllPromotionSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAllPromotionSeller(context.Background(), tc.userID, tc.promoStatusID, tc.pgn)
@@ -782,7 +875,7 @@ func Test_sellerUC_GetAllPromotionSeller(t *testing.T) {
 // 			sql, mock, _ := sqlmock.New()
 // 			mock.ExpectBegin()
 // 			r := mocks.NewRepository(t)
-// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 // 			tc.mock(t, r)
 // 			_, err := u.CreatePromotionSeller(context.Background(), tc.userID, tc.requestBody)
@@ -822,7 +915,7 @@ func Test_sellerUC_GetAllPromotionSeller(t *testing.T) {
 // 			sql, mock, _ := sqlmock.New()
 // 			mock.ExpectBegin()
 // 			r := mocks.NewRepository(t)
-// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+// 			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 // 			tc.mock(t, r)
 // 			err := u.UpdatePromotionSeller(context.Background(), tc.userID, tc.requestBody)
@@ -873,7 +966,7 @@ func Test_sellerUC_GetDetailPromotionSellerByID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetDetailPromotionSellerByID(context.Background(), tc.shopProductPromo)
@@ -917,7 +1010,7 @@ func Test_sellerUC_GetProductWithoutPromotionSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetProductWithoutPromotionSeller(context.Background(), tc.userID, tc.productName, tc.pgn)
@@ -982,7 +1075,7 @@ func Test_sellerUC_GetRefundOrderSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetRefundOrderSeller(context.Background(), tc.userID, tc.orderID)
@@ -1030,7 +1123,7 @@ func Test_sellerUC_CreateRefundThreadSeller(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.CreateRefundThreadSeller(context.Background(), tc.userID, tc.requestBody)
@@ -1078,7 +1171,7 @@ func Test_sellerUC_UpdateRefundAccept(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateRefundAccept(context.Background(), tc.userID, tc.requestBody)
@@ -1127,7 +1220,7 @@ func Test_sellerUC_UpdateRefundReject(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateRefundReject(context.Background(), tc.userID, tc.requestBody)
@@ -1137,3 +1230,258 @@ func Test_sellerUC_UpdateRefundReject(t *testing.T) {
 		})
 	}
 }
+
+func Test_sellerUC_CreatePayout(t *testing.T) {
+	uuidString1, _ := uuid.Parse("008dc24d-1f30-4e13-823f-d62972f416df")
+	testCase := []struct {
+		name        string
+		userID      string
+		requestBody body.CreatePayoutRequest
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:        "success create payout",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.CreatePayoutRequest{BankAccountID: "008dc24d-1f30-4e13-823f-d62972f416df"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+				r.On("GetBankAccountByID", mock.Anything, mock.Anything, mock.Anything).Return(&model.BankAccount{ID: uuidString1, ShopID: uuidString1}, nil)
+				r.On("CreatePayout", mock.Anything, mock.Anything, mock.Anything).Return(&uuidString1, nil)
+				r.On("AssignOrdersToPayout", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Money(1500), 2, nil)
+				r.On("UpdatePayoutAmount", mock.Anything, mock.Anything, mock.MatchedBy(func(payout *model.Payout) bool {
+					return payout.Amount == 1500 && payout.OrderCount == 2
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "error bank account not found",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.CreatePayoutRequest{BankAccountID: "008dc24d-1f30-4e13-823f-d62972f416df"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+				r.On("GetBankAccountByID", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.BankAccountNotFound),
+		},
+		{
+			name:        "error no withdrawable order",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.CreatePayoutRequest{BankAccountID: "008dc24d-1f30-4e13-823f-d62972f416df"},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+				r.On("GetBankAccountByID", mock.Anything, mock.Anything, mock.Anything).Return(&model.BankAccount{ID: uuidString1, ShopID: uuidString1}, nil)
+				r.On("CreatePayout", mock.Anything, mock.Anything, mock.Anything).Return(&uuidString1, nil)
+				r.On("AssignOrdersToPayout", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(model.Money(0), 0, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.NoWithdrawableOrder),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+			if tc.expectedErr == nil {
+				mock.ExpectCommit()
+			} else {
+				mock.ExpectRollback()
+			}
+			r := mocks.NewRepository(t)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
+
+			tc.mock(t, r)
+			_, err := u.CreatePayout(context.Background(), tc.userID, tc.requestBody)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func Test_sellerUC_InviteShopStaff(t *testing.T) {
+	uuidString1, _ := uuid.Parse("008dc24d-1f30-4e13-823f-d62972f416df")
+	testCase := []struct {
+		name        string
+		userID      string
+		requestBody body.InviteShopStaffRequest
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:        "success invite staff",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.InviteShopStaffRequest{Email: "staff@murakali.com", Scopes: []string{constant.ShopScopeOrders}},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{ID: uuidString1, Email: "owner@murakali.com"}, nil)
+				r.On("GetShopStaffByEmail", mock.Anything, mock.Anything, "staff@murakali.com").Return(nil, sql.ErrNoRows)
+				r.On("GetShopByID", mock.Anything, mock.Anything).Return(&model.Shop{ID: uuidString1, Name: "shop"}, nil)
+				r.On("CreateShopStaff", mock.Anything, mock.MatchedBy(func(staff *model.ShopStaff) bool {
+					return staff.InviteToken != nil && staff.ExpiredAt.Valid && staff.InvitedBy == uuidString1
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "error invite self",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.InviteShopStaffRequest{Email: "owner@murakali.com", Scopes: []string{constant.ShopScopeOrders}},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{ID: uuidString1, Email: "Owner@murakali.com"}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.ShopStaffSelfInviteMessage),
+		},
+		{
+			name:        "error already invited",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.InviteShopStaffRequest{Email: "staff@murakali.com", Scopes: []string{constant.ShopScopeOrders}},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{ID: uuidString1, Email: "owner@murakali.com"}, nil)
+				r.On("GetShopStaffByEmail", mock.Anything, mock.Anything, "staff@murakali.com").Return(&model.ShopStaff{}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.ShopStaffAlreadyInvitedMessage),
+		},
+		{
+			name:        "error user not have shop",
+			userID:      "008dc24d-1f30-4e13-823f-d62972f416df",
+			requestBody: body.InviteShopStaffRequest{Email: "staff@murakali.com", Scopes: []string{constant.ShopScopeOrders}},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("", sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.UserNotHaveShop),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewSellerUseCase(&config.Config{}, nil, r, nil)
+
+			tc.mock(t, r)
+			_, err := u.InviteShopStaff(context.Background(), tc.userID, tc.requestBody)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func Test_sellerUC_AcceptShopStaffInvitation(t *testing.T) {
+	uuidString1, _ := uuid.Parse("008dc24d-1f30-4e13-823f-d62972f416df")
+	validStaff := &model.ShopStaff{
+		ID:        uuidString1,
+		Email:     "staff@murakali.com",
+		ExpiredAt: sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+	}
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success accept invitation",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopStaffByInviteToken", mock.Anything, hashInviteToken("token")).Return(validStaff, nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "Staff@murakali.com"}, nil)
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("", sql.ErrNoRows)
+				r.On("AcceptShopStaff", mock.Anything, uuidString1.String(), mock.Anything).Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error invitation not found",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopStaffByInviteToken", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.InvitationNotFoundMessage),
+		},
+		{
+			name: "error invitation expired",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopStaffByInviteToken", mock.Anything, mock.Anything).Return(&model.ShopStaff{
+					Email:     "staff@murakali.com",
+					ExpiredAt: sql.NullTime{Time: time.Now().Add(-time.Hour), Valid: true},
+				}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.InvitationExpiredMessage),
+		},
+		{
+			name: "error email mismatch",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopStaffByInviteToken", mock.Anything, mock.Anything).Return(validStaff, nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "other@murakali.com"}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.InvitationEmailMismatchMessage),
+		},
+		{
+			name: "error already member of a shop",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopStaffByInviteToken", mock.Anything, mock.Anything).Return(validStaff, nil)
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "staff@murakali.com"}, nil)
+				r.On("GetShopIDByUserID", mock.Anything, mock.Anything).Return("008dc24d-1f30-4e13-823f-d62972f416df", nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.ShopStaffAlreadyMemberMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewSellerUseCase(&config.Config{}, nil, r, nil)
+
+			tc.mock(t, r)
+			err := u.AcceptShopStaffInvitation(context.Background(), "008dc24d-1f30-4e13-823f-d62972f416df",
+				body.AcceptShopStaffRequest{Token: "token"})
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func Test_sellerUC_UpdateExpiredAtOrder(t *testing.T) {
+	transactionID := uuid.New()
+	orderID := uuid.New()
+	productID := uuid.New().String()
+	alertID := uuid.New()
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expected    int64
+		expectedErr error
+	}{
+		{
+			name: "success cancel expired order and notify back in stock alert",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetTransactionsExpired", mock.Anything).Return([]*model.Transaction{{ID: transactionID}}, nil)
+				r.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("GetOrderByTransactionID", mock.Anything, mock.Anything, transactionID.String()).Return([]*model.OrderModel{
+					{ID: orderID, OrderStatusID: constant.OrderStatusWaitingToPay},
+				}, nil)
+				r.On("UpdateOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("ReleaseStockReservation", mock.Anything, mock.Anything, orderID.String()).Return(nil)
+				r.On("GetOrderProductIDs", mock.Anything, mock.Anything, orderID.String()).Return([]string{productID}, nil)
+				r.On("GetProductAlertsByProductIDs", mock.Anything, []string{productID}).Return([]*model.ProductAlert{
+					{ID: alertID, AlertType: constant.ProductAlertBackInStock, AvailableStock: 2},
+				}, nil)
+				r.On("UpdateProductAlertTriggered", mock.Anything, alertID.String(), true).Return(true, nil)
+			},
+			expected:    1,
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+			mock.ExpectCommit()
+			r := mocks.NewRepository(t)
+			u := NewSellerUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil)
+
+			tc.mock(t, r)
+			rowsAffected, err := u.UpdateExpiredAtOrder(context.Background())
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, rowsAffected)
+		})
+	}
+}
@@ -46,5 +46,11 @@ type Handlers interface {
 	CreateRefundUser(c *gin.Context)
 	GetRefundOrder(c *gin.Context)
 	CreateRefundThreadUser(c *gin.Context)
-	CompletedRejectedRefund(c *gin.Context)
+	SetupTOTP(c *gin.Context)
+	ConfirmTOTP(c *gin.Context)
+	DisableTOTP(c *gin.Context)
+	GetAccountDeletion(c *gin.Context)
+	RequestAccountDeletion(c *gin.Context)
+	CancelAccountDeletion(c *gin.Context)
+	ExportUserData(c *gin.Context)
 }
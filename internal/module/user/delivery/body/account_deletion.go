@@ -0,0 +1,35 @@
+package body
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type AccountDeletionRequest struct {
+	Password string `json:"password"`
+}
+
+type AccountDeletionResponse struct {
+	RequestedAt *time.Time `json:"requested_at"`
+	ScheduledAt *time.Time `json:"scheduled_at"`
+}
+
+type UserDataExport struct {
+	Profile       json.RawMessage `json:"profile"`
+	Addresses     json.RawMessage `json:"addresses"`
+	Orders        json.RawMessage `json:"orders"`
+	Reviews       json.RawMessage `json:"reviews"`
+	WalletHistory json.RawMessage `json:"wallet_history"`
+	RefundThreads json.RawMessage `json:"refund_threads"`
+}
+
+func (e *UserDataExport) Files() map[string]json.RawMessage {
+	return map[string]json.RawMessage{
+		"profile.json":        e.Profile,
+		"addresses.json":      e.Addresses,
+		"orders.json":         e.Orders,
+		"reviews.json":        e.Reviews,
+		"wallet_history.json": e.WalletHistory,
+		"refund_threads.json": e.RefundThreads,
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 type ChangeWalletPinStepUpRequest struct {
 	Password string `json:"password"`
+	TOTPCode string `json:"totp_code"`
 }
 
 type ChangeWalletPinRequest struct {
@@ -54,14 +55,17 @@ func (r *ChangeWalletPinStepUpRequest) Validate() (UnprocessableEntity, error) {
 	unprocessableEntity := false
 	entity := UnprocessableEntity{
 		Fields: map[string]string{
-			"password": "",
+			"password":  "",
+			"totp_code": "",
 		},
 	}
 
 	r.Password = strings.TrimSpace(r.Password)
-	if r.Password == "" {
+	r.TOTPCode = strings.TrimSpace(r.TOTPCode)
+	if r.Password == "" && r.TOTPCode == "" {
 		unprocessableEntity = true
 		entity.Fields["password"] = FieldCannotBeEmptyMessage
+		entity.Fields["totp_code"] = FieldCannotBeEmptyMessage
 	}
 
 	if unprocessableEntity {
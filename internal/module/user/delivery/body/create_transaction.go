@@ -23,7 +23,7 @@ type CartItem struct {
 	ShopID         string          `json:"shop_id"`
 	VoucherShopID  string          `json:"voucher_shop_id"`
 	CourierID      string          `json:"courier_id"`
-	CourierFee     float64         `json:"courier_fee"`
+	CourierFee     model.Money     `json:"courier_fee"`
 	ProductDetails []ProductDetail `json:"product_details"`
 }
 
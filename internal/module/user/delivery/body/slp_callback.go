@@ -6,9 +6,12 @@ import (
 	"encoding/hex"
 	"fmt"
 	"murakali/config"
+	"murakali/internal/constant"
 	"murakali/pkg/httperror"
 	"murakali/pkg/response"
 	"net/http"
+	"strings"
+	"time"
 )
 
 type SLPCallbackRequest struct {
@@ -17,9 +20,25 @@ type SLPCallbackRequest struct {
 	MerchantCode string `json:"merchant_code"`
 	Status       string `json:"status"`
 	Message      string `json:"message"`
+	Timestamp    int64  `json:"timestamp"`
+	Nonce        string `json:"nonce"`
 	Signature    string `json:"signature"`
 }
 
+func (r *SLPCallbackRequest) Sign(cfg *config.Config) string {
+	signFormat := fmt.Sprintf("%s:%s:%s:%s:%s:%d:%s",
+		r.TxnID, r.Amount, cfg.External.SlpMerchantCode, r.Status, r.Message, r.Timestamp, r.Nonce)
+	h := hmac.New(sha256.New, []byte(cfg.External.SlpAPIKey))
+	h.Write([]byte(signFormat))
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (r *SLPCallbackRequest) IsExpired() bool {
+	sentAt := time.Unix(r.Timestamp, 0)
+	return time.Since(sentAt) > constant.SLPCallbackTolerance || time.Until(sentAt) > constant.SLPCallbackTolerance
+}
+
 func (r *SLPCallbackRequest) Validate(cfg *config.Config) (UnprocessableEntity, error) {
 	unprocessableEntity := false
 	entity := UnprocessableEntity{
@@ -29,22 +48,25 @@ func (r *SLPCallbackRequest) Validate(cfg *config.Config) (UnprocessableEntity,
 			"merchant_code": "",
 			"status":        "",
 			"message":       "",
+			"timestamp":     "",
+			"nonce":         "",
 			"signature":     "",
 		},
 	}
 
-	signFormat := fmt.Sprintf("%s:%s:%s:%s:%s", r.TxnID, r.Amount, cfg.External.SlpMerchantCode, r.Status, r.Message)
-	h := hmac.New(sha256.New, []byte(cfg.External.SlpAPIKey))
-	h.Write([]byte(signFormat))
-	sign := hex.EncodeToString(h.Sum(nil))
+	r.Nonce = strings.TrimSpace(r.Nonce)
+	if r.Nonce == "" {
+		unprocessableEntity = true
+		entity.Fields["nonce"] = FieldCannotBeEmptyMessage
+	}
+
+	if r.MerchantCode != cfg.External.SlpMerchantCode {
+		unprocessableEntity = true
+		entity.Fields["merchant_code"] = InvalidMerchantCodeMessage
+	}
 
-	if sign != r.Signature {
+	if !hmac.Equal([]byte(r.Sign(cfg)), []byte(r.Signature)) {
 		unprocessableEntity = true
-		entity.Fields["txn_id"] = r.TxnID
-		entity.Fields["amount"] = r.Amount
-		entity.Fields["merchant_code"] = r.MerchantCode
-		entity.Fields["status"] = r.Status
-		entity.Fields["message"] = r.Message
 		entity.Fields["signature"] = InvalidSignatureMessage
 	}
 
@@ -9,8 +9,9 @@ import (
 )
 
 type WalletStepUpRequest struct {
-	Pin    string `json:"pin"`
-	Amount int    `json:"amount"`
+	Pin      string `json:"pin"`
+	Amount   int    `json:"amount"`
+	TOTPCode string `json:"totp_code"`
 }
 
 func (r *WalletStepUpRequest) Validate() (UnprocessableEntity, error) {
@@ -38,6 +39,8 @@ func (r *WalletStepUpRequest) Validate() (UnprocessableEntity, error) {
 		entity.Fields["pin"] = InvalidPinFormatMessage
 	}
 
+	r.TOTPCode = strings.TrimSpace(r.TOTPCode)
+
 	if r.Amount <= 0 {
 		unprocessableEntity = true
 		entity.Fields["amount"] = FieldCannotBeEmptyMessage
@@ -0,0 +1,49 @@
+package body
+
+import (
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+	"strings"
+)
+
+type TOTPCodeRequest struct {
+	Code         string `json:"code"`
+	RecoveryCode string `json:"recovery_code"`
+}
+
+type TOTPSetupResponse struct {
+	Secret          string `json:"secret"`
+	ProvisioningURI string `json:"provisioning_uri"`
+}
+
+type TOTPRecoveryCodesResponse struct {
+	RecoveryCodes []string `json:"recovery_codes"`
+}
+
+func (r *TOTPCodeRequest) Validate() (UnprocessableEntity, error) {
+	unprocessableEntity := false
+	entity := UnprocessableEntity{
+		Fields: map[string]string{
+			"code":          "",
+			"recovery_code": "",
+		},
+	}
+
+	r.Code = strings.TrimSpace(r.Code)
+	r.RecoveryCode = strings.TrimSpace(strings.ToLower(r.RecoveryCode))
+	if r.Code == "" && r.RecoveryCode == "" {
+		unprocessableEntity = true
+		entity.Fields["code"] = FieldCannotBeEmptyMessage
+		entity.Fields["recovery_code"] = FieldCannotBeEmptyMessage
+	}
+
+	if unprocessableEntity {
+		return entity, httperror.New(
+			http.StatusUnprocessableEntity,
+			response.UnprocessableEntityMessage,
+		)
+	}
+
+	return entity, nil
+}
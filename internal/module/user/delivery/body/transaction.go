@@ -17,7 +17,7 @@ type TransactionDetailResponse struct {
 	WalletID             *uuid.UUID     `json:"wallet_id"`
 	CardNumber           *string        `json:"card_number"`
 	Invoice              *string        `json:"invoice"`
-	TotalPrice           float64        `json:"total_price"`
+	TotalPrice           model.Money    `json:"total_price"`
 	PaidAt               sql.NullTime   `json:"paid_at"`
 	CanceledAt           sql.NullTime   `json:"canceled_at"`
 	ExpiredAt            sql.NullTime   `json:"expired_at"`
@@ -30,7 +30,7 @@ type GetTransactionByUserIDResponse struct {
 	WalletID           *uuid.UUID          `json:"wallet_id"`
 	CardNumber         *string             `json:"card_number"`
 	Invoice            *string             `json:"invoice"`
-	TotalPrice         float64             `json:"total_price"`
+	TotalPrice         model.Money         `json:"total_price"`
 	ExpiredAt          sql.NullTime        `json:"expired_at"`
 	Orders             []*model.OrderModel `json:"orders"`
 }
@@ -41,7 +41,7 @@ type GetTransactionByIDResponse struct {
 	WalletID           *uuid.UUID     `json:"wallet_id"`
 	CardNumber         *string        `json:"card_number"`
 	Invoice            *string        `json:"invoice"`
-	TotalPrice         float64        `json:"total_price"`
+	TotalPrice         model.Money    `json:"total_price"`
 	ExpiredAt          sql.NullTime   `json:"expired_at"`
 	Orders             []*model.Order `json:"orders"`
 }
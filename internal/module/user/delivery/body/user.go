@@ -13,6 +13,8 @@ const (
 		"at least 1 number, 1 Upper case, 1 special character, and not contains username"
 	InvalidPaymentMethod       = "Invalid payment Method."
 	InvalidSignatureMessage    = "Invalid Signature."
+	InvalidMerchantCodeMessage = "Invalid merchant code."
+	CallbackExpiredMessage     = "Callback timestamp is expired."
 	InvalidPinFormatMessage    = "Invalid pin format."
 	TopUpAmountNotValidMessage = "Top up at least 10000"
 )
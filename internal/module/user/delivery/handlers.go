@@ -1,8 +1,11 @@
 package delivery
 
 import (
+	"archive/zip"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"murakali/config"
 	"murakali/internal/constant"
 	"murakali/internal/module/user"
@@ -14,8 +17,10 @@ import (
 	"murakali/pkg/pagination"
 	"murakali/pkg/response"
 	"net/http"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
@@ -24,11 +29,12 @@ import (
 type userHandlers struct {
 	cfg    *config.Config
 	userUC user.UseCase
+	keySet *jwt.KeySet
 	logger logger.Logger
 }
 
-func NewUserHandlers(cfg *config.Config, userUC user.UseCase, log logger.Logger) user.Handlers {
-	return &userHandlers{cfg: cfg, userUC: userUC, logger: log}
+func NewUserHandlers(cfg *config.Config, userUC user.UseCase, keySet *jwt.KeySet, log logger.Logger) user.Handlers {
+	return &userHandlers{cfg: cfg, userUC: userUC, keySet: keySet, logger: log}
 }
 
 func (h *userHandlers) RegisterMerchant(c *gin.Context) {
@@ -1067,22 +1073,6 @@ func (h *userHandlers) VerifyOTP(c *gin.Context) {
 	response.SuccessResponse(c.Writer, nil, http.StatusOK)
 }
 
-func (h *userHandlers) CompletedRejectedRefund(c *gin.Context) {
-	if err := h.userUC.CompletedRejectedRefund(c); err != nil {
-		var e *httperror.Error
-		if !errors.As(err, &e) {
-			h.logger.Errorf("HandlerUser, Error: %s", err)
-			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
-			return
-		}
-
-		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
-		return
-	}
-
-	response.SuccessResponse(c.Writer, nil, http.StatusOK)
-}
-
 func (h *userHandlers) ChangePassword(c *gin.Context) {
 	changePasswordToken, err := c.Cookie(constant.ChangePasswordTokenCookie)
 	if err != nil {
@@ -1090,7 +1080,7 @@ func (h *userHandlers) ChangePassword(c *gin.Context) {
 		return
 	}
 
-	claims, err := jwt.ExtractJWT(changePasswordToken, h.cfg.JWT.JwtSecretKey)
+	claims, err := jwt.ExtractJWT(changePasswordToken, h.keySet)
 	if err != nil {
 		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 		return
@@ -1213,7 +1203,7 @@ func (h *userHandlers) ChangeWalletPin(c *gin.Context) {
 		return
 	}
 
-	claims, err := jwt.ExtractJWT(walletToken, h.cfg.JWT.JwtSecretKey)
+	claims, err := jwt.ExtractJWT(walletToken, h.keySet)
 	if err != nil {
 		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 		return
@@ -1291,7 +1281,7 @@ func (h *userHandlers) CreateWalletPayment(c *gin.Context) {
 		return
 	}
 
-	claims, err := jwt.ExtractJWT(walletToken, h.cfg.JWT.JwtSecretKey)
+	claims, err := jwt.ExtractJWT(walletToken, h.keySet)
 	if err != nil {
 		response.ErrorResponse(c.Writer, response.ForbiddenMessage, http.StatusForbidden)
 		return
@@ -1302,6 +1292,8 @@ func (h *userHandlers) CreateWalletPayment(c *gin.Context) {
 		return
 	}
 
+	mfa, _ := claims["mfa"].(bool)
+
 	var requestBody body.CreatePaymentRequest
 	if errBind := c.ShouldBind(&requestBody); errBind != nil {
 		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
@@ -1314,7 +1306,7 @@ func (h *userHandlers) CreateWalletPayment(c *gin.Context) {
 		return
 	}
 
-	if err := h.userUC.CreateWalletPayment(c, requestBody.TransactionID); err != nil {
+	if err := h.userUC.CreateWalletPayment(c, requestBody.TransactionID, mfa); err != nil {
 		var e *httperror.Error
 		if !errors.As(err, &e) {
 			h.logger.Errorf("HandlerUser, Error: %s", err)
@@ -1332,8 +1324,14 @@ func (h *userHandlers) CreateWalletPayment(c *gin.Context) {
 }
 
 func (h *userHandlers) SLPPaymentCallback(c *gin.Context) {
+	rawBody, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
 	var requestBody body.SLPCallbackRequest
-	if err := c.ShouldBindJSON(&requestBody); err != nil {
+	if errBind := json.Unmarshal(rawBody, &requestBody); errBind != nil {
 		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
 		return
 	}
@@ -1351,7 +1349,7 @@ func (h *userHandlers) SLPPaymentCallback(c *gin.Context) {
 		return
 	}
 
-	if err := h.userUC.UpdateTransaction(c, transactionID.String(), requestBody); err != nil {
+	if err := h.userUC.HandlePaymentCallback(c, constant.PaymentCallbackSLP, transactionID.String(), string(rawBody), requestBody); err != nil {
 		var e *httperror.Error
 		if !errors.As(err, &e) {
 			h.logger.Errorf("HandlerUser, Error: %s", err)
@@ -1367,8 +1365,14 @@ func (h *userHandlers) SLPPaymentCallback(c *gin.Context) {
 }
 
 func (h *userHandlers) WalletPaymentCallback(c *gin.Context) {
+	rawBody, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
 	var requestBody body.SLPCallbackRequest
-	if err := c.ShouldBindJSON(&requestBody); err != nil {
+	if errBind := json.Unmarshal(rawBody, &requestBody); errBind != nil {
 		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
 		return
 	}
@@ -1386,7 +1390,7 @@ func (h *userHandlers) WalletPaymentCallback(c *gin.Context) {
 		return
 	}
 
-	if err := h.userUC.UpdateWalletTransaction(c, transactionID.String(), requestBody); err != nil {
+	if err := h.userUC.HandlePaymentCallback(c, constant.PaymentCallbackWallet, transactionID.String(), string(rawBody), requestBody); err != nil {
 		var e *httperror.Error
 		if !errors.As(err, &e) {
 			h.logger.Errorf("HandlerUser, Error: %s", err)
@@ -1656,3 +1660,232 @@ func (h *userHandlers) ChangeWalletPinStepUpVerify(c *gin.Context) {
 	c.SetCookie(constant.ChangeWalletPinTokenCookie, changeWalletPinToken, h.cfg.JWT.RefreshExpMin*60, "/", h.cfg.Server.Domain, true, true)
 	response.SuccessResponse(c.Writer, nil, http.StatusOK)
 }
+
+func (h *userHandlers) SetupTOTP(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	setup, err := h.userUC.SetupTOTP(c, userID.(string))
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, setup, http.StatusOK)
+}
+
+func (h *userHandlers) ConfirmTOTP(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	var requestBody body.TOTPCodeRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	invalidFields, err := requestBody.Validate()
+	if err != nil {
+		response.ErrorResponseData(c.Writer, invalidFields, response.UnprocessableEntityMessage, http.StatusUnprocessableEntity)
+		return
+	}
+
+	recoveryCodes, err := h.userUC.ConfirmTOTP(c, userID.(string), requestBody)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, body.TOTPRecoveryCodesResponse{RecoveryCodes: recoveryCodes}, http.StatusOK)
+}
+
+func (h *userHandlers) DisableTOTP(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	var requestBody body.TOTPCodeRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	invalidFields, err := requestBody.Validate()
+	if err != nil {
+		response.ErrorResponseData(c.Writer, invalidFields, response.UnprocessableEntityMessage, http.StatusUnprocessableEntity)
+		return
+	}
+
+	if err := h.userUC.DisableTOTP(c, userID.(string), requestBody); err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
+
+func (h *userHandlers) GetAccountDeletion(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	accountDeletion, err := h.userUC.GetAccountDeletion(c, userID.(string))
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, accountDeletion, http.StatusOK)
+}
+
+func (h *userHandlers) RequestAccountDeletion(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	var requestBody body.AccountDeletionRequest
+	if err := c.ShouldBind(&requestBody); err != nil {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	accountDeletion, err := h.userUC.RequestAccountDeletion(c, userID.(string), requestBody)
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, accountDeletion, http.StatusOK)
+}
+
+func (h *userHandlers) CancelAccountDeletion(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	if err := h.userUC.CancelAccountDeletion(c, userID.(string)); err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	response.SuccessResponse(c.Writer, nil, http.StatusOK)
+}
+
+func (h *userHandlers) ExportUserData(c *gin.Context) {
+	userID, exist := c.Get("userID")
+	if !exist {
+		response.ErrorResponse(c.Writer, response.UnauthorizedMessage, http.StatusUnauthorized)
+		return
+	}
+
+	format := c.DefaultQuery("format", constant.ExportFormatZIP)
+	if format != constant.ExportFormatZIP && format != constant.ExportFormatJSON {
+		response.ErrorResponse(c.Writer, response.BadRequestMessage, http.StatusBadRequest)
+		return
+	}
+
+	export, err := h.userUC.ExportUserData(c, userID.(string))
+	if err != nil {
+		var e *httperror.Error
+		if !errors.As(err, &e) {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			response.ErrorResponse(c.Writer, response.InternalServerErrorMessage, http.StatusInternalServerError)
+			return
+		}
+
+		response.ErrorResponse(c.Writer, e.Err.Error(), e.Status)
+		return
+	}
+
+	filename := fmt.Sprintf("murakali-export-%s", time.Now().Format("20060102"))
+	if format == constant.ExportFormatJSON {
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.json", filename))
+		c.JSON(http.StatusOK, export)
+		return
+	}
+
+	c.Header("Content-Type", "application/zip")
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%s.zip", filename))
+	c.Status(http.StatusOK)
+
+	files := export.Files()
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := zip.NewWriter(c.Writer)
+	for _, name := range names {
+		f, err := w.Create(name)
+		if err != nil {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			return
+		}
+
+		if _, err := f.Write(files[name]); err != nil {
+			h.logger.Errorf("HandlerUser, Error: %s", err)
+			return
+		}
+	}
+
+	if err := w.Close(); err != nil {
+		h.logger.Errorf("HandlerUser, Error: %s", err)
+	}
+}
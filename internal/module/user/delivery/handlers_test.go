@@ -65,6 +65,20 @@ func MockJsonPut(c *gin.Context, content interface{}) {
 	c.Request.Body = io.NopCloser(bytes.NewBuffer(jsonBytes))
 }
 
+func signedSLPCallbackRequest(sentAt time.Time) body.SLPCallbackRequest {
+	requestBody := body.SLPCallbackRequest{
+		TxnID:     "1",
+		Amount:    "10000",
+		Status:    constant.SLPStatusPaid,
+		Message:   constant.SlPMessagePaid,
+		Timestamp: sentAt.Unix(),
+		Nonce:     "8302755e-25c5-4523-8498-7dc8b9e3a098",
+	}
+	requestBody.Signature = requestBody.Sign(&config.Config{})
+
+	return requestBody
+}
+
 func TestUserHandlers_RegisterMerchant(t *testing.T) {
 	invalidRequestBody := struct {
 		ShopName int `json:"shop_name"`
@@ -170,7 +184,8 @@ func TestUserHandlers_RegisterMerchant(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.RegisterMerchant(c)
@@ -256,7 +271,8 @@ func TestUserHandlers_GetWallet(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetWallet(c)
@@ -356,7 +372,8 @@ func TestUserHandlers_GetWalletHistory(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetWalletHistory(c)
@@ -441,7 +458,8 @@ func TestUserHandlers_GetWalletHistoryByID(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetWalletHistoryByID(c)
@@ -561,7 +579,8 @@ func TestUserHandlers_TopUpWallet(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.TopUpWallet(c)
@@ -676,7 +695,8 @@ func TestUserHandlers_ActivateWallet(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ActivateWallet(c)
@@ -774,7 +794,8 @@ func TestUserHandlers_DeleteAddressByID(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.DeleteAddressByID(c)
@@ -872,7 +893,8 @@ func TestUserHandlers_GetAddressByID(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetAddressByID(c)
@@ -1015,7 +1037,8 @@ func TestUserHandlers_CreateAddress(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CreateAddress(c)
@@ -1182,7 +1205,8 @@ func TestUserHandlers_UpdateAddressByID(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.UpdateAddressByID(c)
@@ -1279,7 +1303,8 @@ func TestUserHandlers_GetAddress(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetAddress(c)
@@ -1390,7 +1415,8 @@ func TestUserHandlers_GetOrder(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetOrder(c)
@@ -1483,7 +1509,8 @@ func TestUserHandlers_GetOrderByOrderID(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetOrderByOrderID(c)
@@ -1620,7 +1647,8 @@ func TestUserHandlers_ChangeOrderStatus(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangeOrderStatus(c)
@@ -1715,7 +1743,8 @@ func TestUserHandlers_GetTransactionDetailByID(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetTransactionDetailByID(c)
@@ -1829,7 +1858,8 @@ func TestUserHandlers_ChangeTransactionPaymentMethod(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangeTransactionPaymentMethod(c)
@@ -1961,7 +1991,8 @@ func TestUserHandlers_EditUser(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.EditUser(c)
@@ -2077,7 +2108,8 @@ func TestUserHandlers_EditEmail(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.EditEmail(c)
@@ -2212,7 +2244,8 @@ func TestUserHandlers_EditEmailUser(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.EditEmailUser(c)
@@ -2293,7 +2326,8 @@ func TestUserHandlers_GetSealabsPay(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetSealabsPay(c)
@@ -2417,7 +2451,8 @@ func TestUserHandlers_AddSealabsPay(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.AddSealabsPay(c)
@@ -2522,7 +2557,8 @@ func TestUserHandlers_PatchSealabsPay(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.PatchSealabsPay(c)
@@ -2623,7 +2659,8 @@ func TestUserHandlers_DeleteSealabsPay(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.DeleteSealabsPay(c)
@@ -2703,7 +2740,8 @@ func TestUserHandlers_GetUserProfile(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetUserProfile(c)
@@ -2849,7 +2887,8 @@ func TestUserHandlers_VerifyPasswordChange(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.VerifyPasswordChange(c)
@@ -2967,7 +3006,8 @@ func TestUserHandlers_VerifyOTP(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.VerifyOTP(c)
@@ -2977,72 +3017,6 @@ func TestUserHandlers_VerifyOTP(t *testing.T) {
 	}
 }
 
-func TestUserHandlers_CompletedRejectedRefund(t *testing.T) {
-	testCase := []struct {
-		name     string
-		mock     func(s *mocks.UseCase)
-		expected int
-	}{
-		{
-			name: "Success Completed Rejected Refund",
-			mock: func(s *mocks.UseCase) {
-				s.On("CompletedRejectedRefund", mock.Anything).Return(nil)
-			},
-			expected: http.StatusOK,
-		},
-		{
-			name: "Completed Rejected Refund Internal Error",
-			mock: func(s *mocks.UseCase) {
-				s.On("CompletedRejectedRefund", mock.Anything).Return(errors.New("test"))
-			},
-			expected: http.StatusInternalServerError,
-		},
-		{
-			name: "Completed Rejected Refund Error Custom",
-			mock: func(s *mocks.UseCase) {
-				s.On("CompletedRejectedRefund", mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
-			},
-			expected: http.StatusBadRequest,
-		},
-	}
-
-	for _, tc := range testCase {
-		t.Run(tc.name, func(t *testing.T) {
-
-			rr := httptest.NewRecorder()
-			c, _ := gin.CreateTestContext(rr)
-			r := httptest.NewRequest(http.MethodPost, "/api/v1/user/rejected-refund", nil)
-			r.Header = make(http.Header)
-
-			c.Request = r
-			c.Request.Header.Set("Content-Type", "application/json")
-
-			c.Request = r
-			s := mocks.NewUseCase(t)
-
-			cfg := &config.Config{
-				Logger: config.LoggerConfig{
-					Development:       true,
-					DisableCaller:     false,
-					DisableStacktrace: false,
-					Encoding:          "json",
-					Level:             "info",
-				},
-			}
-
-			appLogger := logger.NewAPILogger(cfg)
-			appLogger.InitLogger()
-
-			h := NewUserHandlers(cfg, s, appLogger)
-
-			tc.mock(s)
-			h.CompletedRejectedRefund(c)
-
-			assert.Equal(t, rr.Code, tc.expected)
-		})
-	}
-}
-
 func TestUserHandlers_ChangePassword(t *testing.T) {
 	invalidRequestBody := struct {
 		NewPassword int `json:"password"`
@@ -3164,7 +3138,8 @@ func TestUserHandlers_ChangePassword(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangePassword(c)
@@ -3281,7 +3256,8 @@ func TestUserHandlers_WalletStepUp(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.WalletStepUp(c)
@@ -3395,7 +3371,8 @@ func TestUserHandlers_ChangeWalletPinStepUp(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangeWalletPinStepUp(c)
@@ -3565,7 +3542,8 @@ func TestUserHandlers_ChangeWalletPin(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangeWalletPin(c)
@@ -3662,7 +3640,8 @@ func TestUserHandlers_CreateSLPPayment(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CreateSLPPayment(c)
@@ -3692,7 +3671,7 @@ func TestUserHandlers_CreateWalletPayment(t *testing.T) {
 				TransactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
 			},
 			mock: func(s *mocks.UseCase) {
-				s.On("CreateWalletPayment", mock.Anything, mock.Anything).Return(nil)
+				s.On("CreateWalletPayment", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expected:  http.StatusOK,
 			isCookie:  true,
@@ -3750,7 +3729,7 @@ func TestUserHandlers_CreateWalletPayment(t *testing.T) {
 				TransactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
 			},
 			mock: func(s *mocks.UseCase) {
-				s.On("CreateWalletPayment", mock.Anything, mock.Anything).Return(errors.New("test"))
+				s.On("CreateWalletPayment", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
 			},
 			expected:  http.StatusInternalServerError,
 			isCookie:  true,
@@ -3763,7 +3742,7 @@ func TestUserHandlers_CreateWalletPayment(t *testing.T) {
 				TransactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
 			},
 			mock: func(s *mocks.UseCase) {
-				s.On("CreateWalletPayment", mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
+				s.On("CreateWalletPayment", mock.Anything, mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected:  http.StatusBadRequest,
 			isCookie:  true,
@@ -3809,7 +3788,8 @@ func TestUserHandlers_CreateWalletPayment(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CreateWalletPayment(c)
@@ -3834,14 +3814,33 @@ func TestUserHandlers_SLPPaymentCallback(t *testing.T) {
 		{
 			name:  "Success SLP Payment Callback",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			body:  signedSLPCallbackRequest(time.Now()),
 			mock: func(s *mocks.UseCase) {
-				s.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expected: http.StatusOK,
 		},
+		{
+			name:  "SLP Payment Callback Tampered Payload",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			body: func() body.SLPCallbackRequest {
+				requestBody := signedSLPCallbackRequest(time.Now())
+				requestBody.Amount = "1"
+				return requestBody
+			}(),
+			mock:     func(s *mocks.UseCase) {},
+			expected: http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "SLP Payment Callback Stale Timestamp",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			body:  signedSLPCallbackRequest(time.Now().Add(-time.Hour)),
+			mock: func(s *mocks.UseCase) {
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+					httperror.New(http.StatusUnprocessableEntity, body.CallbackExpiredMessage))
+			},
+			expected: http.StatusUnprocessableEntity,
+		},
 		{
 			name:     "Invalid Request Body ShouldBind Error",
 			param:    "",
@@ -3857,33 +3856,27 @@ func TestUserHandlers_SLPPaymentCallback(t *testing.T) {
 			expected: http.StatusUnprocessableEntity,
 		},
 		{
-			name:  "Invalid Request Body Validate",
-			param: "test",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			name:     "Invalid Request Body Validate",
+			param:    "test",
+			body:     signedSLPCallbackRequest(time.Now()),
 			mock:     func(s *mocks.UseCase) {},
 			expected: http.StatusBadRequest,
 		},
 		{
 			name:  "SLP Payment Callback Internal Error",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			body:  signedSLPCallbackRequest(time.Now()),
 			mock: func(s *mocks.UseCase) {
-				s.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
 			},
 			expected: http.StatusInternalServerError,
 		},
 		{
 			name:  "SLP Payment Callback Error Custom",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			body:  signedSLPCallbackRequest(time.Now()),
 			mock: func(s *mocks.UseCase) {
-				s.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected: http.StatusBadRequest,
 		},
@@ -3930,7 +3923,8 @@ func TestUserHandlers_SLPPaymentCallback(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.SLPPaymentCallback(c)
@@ -3955,14 +3949,33 @@ func TestUserHandlers_WalletPaymentCallback(t *testing.T) {
 		{
 			name:  "Success Wallet Payment Callback",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			body:  signedSLPCallbackRequest(time.Now()),
 			mock: func(s *mocks.UseCase) {
-				s.On("UpdateWalletTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expected: http.StatusOK,
 		},
+		{
+			name:  "Wallet Payment Callback Tampered Payload",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			body: func() body.SLPCallbackRequest {
+				requestBody := signedSLPCallbackRequest(time.Now())
+				requestBody.Amount = "1"
+				return requestBody
+			}(),
+			mock:     func(s *mocks.UseCase) {},
+			expected: http.StatusUnprocessableEntity,
+		},
+		{
+			name:  "Wallet Payment Callback Stale Timestamp",
+			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			body:  signedSLPCallbackRequest(time.Now().Add(-time.Hour)),
+			mock: func(s *mocks.UseCase) {
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
+					httperror.New(http.StatusUnprocessableEntity, body.CallbackExpiredMessage))
+			},
+			expected: http.StatusUnprocessableEntity,
+		},
 		{
 			name:     "Invalid Request Body ShouldBind Error",
 			param:    "",
@@ -3978,33 +3991,27 @@ func TestUserHandlers_WalletPaymentCallback(t *testing.T) {
 			expected: http.StatusUnprocessableEntity,
 		},
 		{
-			name:  "Invalid Request Body Validate",
-			param: "test",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			name:     "Invalid Request Body Validate",
+			param:    "test",
+			body:     signedSLPCallbackRequest(time.Now()),
 			mock:     func(s *mocks.UseCase) {},
 			expected: http.StatusBadRequest,
 		},
 		{
 			name:  "Wallet Payment Callback Internal Error",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			body:  signedSLPCallbackRequest(time.Now()),
 			mock: func(s *mocks.UseCase) {
-				s.On("UpdateWalletTransaction", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
 			},
 			expected: http.StatusInternalServerError,
 		},
 		{
 			name:  "Wallet Payment Callback Error Custom",
 			param: "8302755e-25c5-4523-8498-7dc8b9e3a098",
-			body: body.SLPCallbackRequest{
-				Signature: "8343c086714a9950026bdc6d0c195fcee3141f7b0b1eddfb465bb8fda283076b",
-			},
+			body:  signedSLPCallbackRequest(time.Now()),
 			mock: func(s *mocks.UseCase) {
-				s.On("UpdateWalletTransaction", mock.Anything, mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
+				s.On("HandlePaymentCallback", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(httperror.New(http.StatusBadRequest, "test"))
 			},
 			expected: http.StatusBadRequest,
 		},
@@ -4051,7 +4058,8 @@ func TestUserHandlers_WalletPaymentCallback(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.WalletPaymentCallback(c)
@@ -4143,7 +4151,8 @@ func TestUserHandlers_GetTransactions(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetTransactions(c)
@@ -4235,7 +4244,8 @@ func TestUserHandlers_GetTransaction(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetTransaction(c)
@@ -4362,7 +4372,8 @@ func TestUserHandlers_CreateRefundUser(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CreateRefundUser(c)
@@ -4462,7 +4473,8 @@ func TestUserHandlers_GetRefundOrder(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.GetRefundOrder(c)
@@ -4585,7 +4597,8 @@ func TestUserHandlers_CreateRefundThreadUser(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CreateRefundThreadUser(c)
@@ -4713,7 +4726,8 @@ func TestUserHandlers_CreateTransaction(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.CreateTransaction(c)
@@ -4793,7 +4807,8 @@ func TestUserHandlers_ChangeWalletPinStepUpEmail(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangeWalletPinStepUpEmail(c)
@@ -4907,7 +4922,8 @@ func TestUserHandlers_ChangeWalletPinStepUpVerify(t *testing.T) {
 			appLogger := logger.NewAPILogger(cfg)
 			appLogger.InitLogger()
 
-			h := NewUserHandlers(cfg, s, appLogger)
+			keySet, _ := jwt2.NewKeySet(cfg)
+			h := NewUserHandlers(cfg, s, keySet, appLogger)
 
 			tc.mock(s)
 			h.ChangeWalletPinStepUpVerify(c)
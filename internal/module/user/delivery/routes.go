@@ -1,6 +1,7 @@
 package delivery
 
 import (
+	"murakali/internal/limiter"
 	"murakali/internal/middleware"
 	"murakali/internal/module/user"
 
@@ -10,7 +11,6 @@ import (
 func MapUserRoutes(userGroup *gin.RouterGroup, h user.Handlers, mw *middleware.MWManager) {
 	userGroup.POST("/transaction/slp-payment/:id", h.SLPPaymentCallback)
 	userGroup.POST("/transaction/wallet-payment/:id", h.WalletPaymentCallback)
-	userGroup.POST("/rejected-refund", h.CompletedRejectedRefund)
 
 	userGroup.Use(mw.AuthJWTMiddleware())
 	userGroup.GET("/address", h.GetAddress)
@@ -29,7 +29,7 @@ func MapUserRoutes(userGroup *gin.RouterGroup, h user.Handlers, mw *middleware.M
 	userGroup.GET("/profile", h.GetUserProfile)
 	userGroup.POST("/profile/picture", h.UploadProfilePicture)
 	userGroup.POST("/password", h.VerifyPasswordChange)
-	userGroup.POST("/verify", h.VerifyOTP)
+	userGroup.POST("/verify", mw.BruteForceMiddleware(limiter.OTPRule), h.VerifyOTP)
 	userGroup.PATCH("/password", h.ChangePassword)
 	userGroup.GET("/transaction/detail/:transaction_id", h.GetTransactionDetailByID)
 	userGroup.GET("/transaction", h.GetTransactions)
@@ -46,11 +46,18 @@ func MapUserRoutes(userGroup *gin.RouterGroup, h user.Handlers, mw *middleware.M
 	userGroup.GET("/wallet/history", h.GetWalletHistory)
 	userGroup.GET("/wallet/history/:wallet_history_id", h.GetWalletHistoryByID)
 	userGroup.PATCH("/wallet", h.TopUpWallet)
-	userGroup.POST("/wallet/step-up/pin", h.WalletStepUp)
-	userGroup.POST("/wallet/step-up/password", h.ChangeWalletPinStepUp)
+	userGroup.POST("/wallet/step-up/pin", mw.BruteForceMiddleware(limiter.WalletPinRule), h.WalletStepUp)
+	userGroup.POST("/wallet/step-up/password", mw.BruteForceMiddleware(limiter.WalletPinRule), h.ChangeWalletPinStepUp)
 	userGroup.POST("/wallet/step-up/email", h.ChangeWalletPinStepUpEmail)
-	userGroup.POST("/wallet/step-up/verify", h.ChangeWalletPinStepUpVerify)
+	userGroup.POST("/wallet/step-up/verify", mw.BruteForceMiddleware(limiter.OTPRule), h.ChangeWalletPinStepUpVerify)
 	userGroup.PATCH("/wallet/pin", h.ChangeWalletPin)
+	userGroup.POST("/totp", h.SetupTOTP)
+	userGroup.POST("/totp/confirm", mw.BruteForceMiddleware(limiter.OTPRule), h.ConfirmTOTP)
+	userGroup.DELETE("/totp", mw.BruteForceMiddleware(limiter.OTPRule), h.DisableTOTP)
+	userGroup.GET("/export", h.ExportUserData)
+	userGroup.GET("/deletion", h.GetAccountDeletion)
+	userGroup.POST("/deletion", h.RequestAccountDeletion)
+	userGroup.DELETE("/deletion", h.CancelAccountDeletion)
 	userGroup.POST("/refund", h.CreateRefundUser)
 	userGroup.GET("/refund/:refund_id", h.GetRefundOrder)
 	userGroup.POST("/refund-thread", h.CreateRefundThreadUser)
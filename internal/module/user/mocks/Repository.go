@@ -4,15 +4,13 @@ package mocks
 
 import (
 	context "context"
-	body "murakali/internal/module/user/delivery/body"
-
-	mock "github.com/stretchr/testify/mock"
-
 	model "murakali/internal/model"
-
+	body "murakali/internal/module/user/delivery/body"
 	pagination "murakali/pkg/pagination"
-
 	postgre "murakali/pkg/postgre"
+	time "time"
+
+	mock "github.com/stretchr/testify/mock"
 
 	uuid "github.com/google/uuid"
 )
@@ -64,18 +62,67 @@ func (_m *Repository) AddShop(ctx context.Context, userID string, shopName strin
 	return r0
 }
 
-// ChangeOrderStatus provides a mock function with given fields: ctx, requestBody
-func (_m *Repository) ChangeOrderStatus(ctx context.Context, requestBody body.ChangeOrderStatusRequest) error {
-	ret := _m.Called(ctx, requestBody)
+// AnonymizeUser provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) AnonymizeUser(ctx context.Context, tx postgre.Transaction, userID string) (int64, error) {
+	ret := _m.Called(ctx, tx, userID)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, body.ChangeOrderStatusRequest) error); ok {
-		r0 = rf(ctx, requestBody)
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) int64); ok {
+		r0 = rf(ctx, tx, userID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelAccountDeletion provides a mock function with given fields: ctx, userID
+func (_m *Repository) CancelAccountDeletion(ctx context.Context, userID string) (int64, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangeOrderStatus provides a mock function with given fields: ctx, tx, requestBody, fromStatusID
+func (_m *Repository) ChangeOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.ChangeOrderStatusRequest, fromStatusID int) (int64, error) {
+	ret := _m.Called(ctx, tx, requestBody, fromStatusID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, body.ChangeOrderStatusRequest, int) int64); ok {
+		r0 = rf(ctx, tx, requestBody, fromStatusID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, body.ChangeOrderStatusRequest, int) error); ok {
+		r1 = rf(ctx, tx, requestBody, fromStatusID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // CheckDefaultSealabsPay provides a mock function with given fields: ctx, userid
@@ -208,6 +255,62 @@ func (_m *Repository) CheckUserSealabsPay(ctx context.Context, userid string) (i
 	return r0, r1
 }
 
+// ConfirmUserTOTP provides a mock function with given fields: ctx, tx, userID, step
+func (_m *Repository) ConfirmUserTOTP(ctx context.Context, tx postgre.Transaction, userID string, step int64) (int64, error) {
+	ret := _m.Called(ctx, tx, userID, step)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, int64) int64); ok {
+		r0 = rf(ctx, tx, userID, step)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string, int64) error); ok {
+		r1 = rf(ctx, tx, userID, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConvertStockReservation provides a mock function with given fields: ctx, tx, orderID
+func (_m *Repository) ConvertStockReservation(ctx context.Context, tx postgre.Transaction, orderID string) error {
+	ret := _m.Called(ctx, tx, orderID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, orderID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountActiveOrdersByUserID provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) CountActiveOrdersByUserID(ctx context.Context, tx postgre.Transaction, userID string) (int64, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) int64); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateAddress provides a mock function with given fields: ctx, tx, userID, requestBody
 func (_m *Repository) CreateAddress(ctx context.Context, tx postgre.Transaction, userID string, requestBody body.CreateAddressRequest) error {
 	ret := _m.Called(ctx, tx, userID, requestBody)
@@ -282,6 +385,57 @@ func (_m *Repository) CreateOrderItem(ctx context.Context, tx postgre.Transactio
 	return r0, r1
 }
 
+// CreateOrderStatusHistory provides a mock function with given fields: ctx, tx, history
+func (_m *Repository) CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error {
+	ret := _m.Called(ctx, tx, history)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.OrderStatusHistory) error); ok {
+		r0 = rf(ctx, tx, history)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePaymentCallback provides a mock function with given fields: ctx, callback
+func (_m *Repository) CreatePaymentCallback(ctx context.Context, callback *model.PaymentCallback) (*uuid.UUID, error) {
+	ret := _m.Called(ctx, callback)
+
+	var r0 *uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PaymentCallback) *uuid.UUID); ok {
+		r0 = rf(ctx, callback)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PaymentCallback) error); ok {
+		r1 = rf(ctx, callback)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateRecoveryCode provides a mock function with given fields: ctx, tx, userID, codeHash
+func (_m *Repository) CreateRecoveryCode(ctx context.Context, tx postgre.Transaction, userID string, codeHash string) error {
+	ret := _m.Called(ctx, tx, userID, codeHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, string) error); ok {
+		r0 = rf(ctx, tx, userID, codeHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateRefundThreadUser provides a mock function with given fields: ctx, refundThreadData
 func (_m *Repository) CreateRefundThreadUser(ctx context.Context, refundThreadData *model.RefundThread) error {
 	ret := _m.Called(ctx, refundThreadData)
@@ -310,6 +464,20 @@ func (_m *Repository) CreateRefundUser(ctx context.Context, tx postgre.Transacti
 	return r0
 }
 
+// CreateStockReservation provides a mock function with given fields: ctx, tx, reservation
+func (_m *Repository) CreateStockReservation(ctx context.Context, tx postgre.Transaction, reservation *model.StockReservation) error {
+	ret := _m.Called(ctx, tx, reservation)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.StockReservation) error); ok {
+		r0 = rf(ctx, tx, reservation)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateTransaction provides a mock function with given fields: ctx, tx, transactionData
 func (_m *Repository) CreateTransaction(ctx context.Context, tx postgre.Transaction, transactionData *model.Transaction) (*uuid.UUID, error) {
 	ret := _m.Called(ctx, tx, transactionData)
@@ -333,6 +501,20 @@ func (_m *Repository) CreateTransaction(ctx context.Context, tx postgre.Transact
 	return r0, r1
 }
 
+// CreateUserTOTP provides a mock function with given fields: ctx, userID, secret
+func (_m *Repository) CreateUserTOTP(ctx context.Context, userID string, secret string) error {
+	ret := _m.Called(ctx, userID, secret)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateWallet provides a mock function with given fields: ctx, walletData
 func (_m *Repository) CreateWallet(ctx context.Context, walletData *model.Wallet) error {
 	ret := _m.Called(ctx, walletData)
@@ -361,6 +543,20 @@ func (_m *Repository) DeleteAddress(ctx context.Context, addressID string) error
 	return r0
 }
 
+// DeleteCallbackNonceRedis provides a mock function with given fields: ctx, nonce
+func (_m *Repository) DeleteCallbackNonceRedis(ctx context.Context, nonce string) error {
+	ret := _m.Called(ctx, nonce)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, nonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteCartItemByID provides a mock function with given fields: ctx, tx, cartItemData
 func (_m *Repository) DeleteCartItemByID(ctx context.Context, tx postgre.Transaction, cartItemData *model.CartItem) error {
 	ret := _m.Called(ctx, tx, cartItemData)
@@ -417,6 +613,20 @@ func (_m *Repository) DeleteOTPValueChangeWalletPin(ctx context.Context, email s
 	return r0, r1
 }
 
+// DeleteRecoveryCodes provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) DeleteRecoveryCodes(ctx context.Context, tx postgre.Transaction, userID string) error {
+	ret := _m.Called(ctx, tx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteSealabsPay provides a mock function with given fields: ctx, cardNmber
 func (_m *Repository) DeleteSealabsPay(ctx context.Context, cardNmber string) error {
 	ret := _m.Called(ctx, cardNmber)
@@ -431,6 +641,80 @@ func (_m *Repository) DeleteSealabsPay(ctx context.Context, cardNmber string) er
 	return r0
 }
 
+// DeleteUserPersonalData provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) DeleteUserPersonalData(ctx context.Context, tx postgre.Transaction, userID string) error {
+	ret := _m.Called(ctx, tx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserTOTP provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) DeleteUserTOTP(ctx context.Context, tx postgre.Transaction, userID string) error {
+	ret := _m.Called(ctx, tx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAccountDeletion provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetAccountDeletion(ctx context.Context, userID string) (*body.AccountDeletionResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.AccountDeletionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.AccountDeletionResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.AccountDeletionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountsDueForDeletion provides a mock function with given fields: ctx
+func (_m *Repository) GetAccountsDueForDeletion(ctx context.Context) ([]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context) []string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAddressByBuyerID provides a mock function with given fields: ctx, userID
 func (_m *Repository) GetAddressByBuyerID(ctx context.Context, userID string) (*model.Address, error) {
 	ret := _m.Called(ctx, userID)
@@ -816,6 +1100,29 @@ func (_m *Repository) GetOrderModelByID(ctx context.Context, OrderID string) (*m
 	return r0, r1
 }
 
+// GetOrderStatusHistoryByOrderID provides a mock function with given fields: ctx, orderID
+func (_m *Repository) GetOrderStatusHistoryByOrderID(ctx context.Context, orderID string) ([]*model.OrderStatusHistory, error) {
+	ret := _m.Called(ctx, orderID)
+
+	var r0 []*model.OrderStatusHistory
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.OrderStatusHistory); ok {
+		r0 = rf(ctx, orderID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.OrderStatusHistory)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, orderID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOrders provides a mock function with given fields: ctx, userID, orderStatusID, pgn
 func (_m *Repository) GetOrders(ctx context.Context, userID string, orderStatusID string, pgn *pagination.Pagination) ([]*model.Order, error) {
 	ret := _m.Called(ctx, userID, orderStatusID, pgn)
@@ -862,6 +1169,50 @@ func (_m *Repository) GetOrdersByTransactionID(ctx context.Context, transactionI
 	return r0, r1
 }
 
+// GetOriginalPaymentCallback provides a mock function with given fields: ctx, idempotencyKey
+func (_m *Repository) GetOriginalPaymentCallback(ctx context.Context, idempotencyKey string) (*model.PaymentCallback, error) {
+	ret := _m.Called(ctx, idempotencyKey)
+
+	var r0 *model.PaymentCallback
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PaymentCallback); ok {
+		r0 = rf(ctx, idempotencyKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PaymentCallback)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, idempotencyKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOwnedShopIDByUserID provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) GetOwnedShopIDByUserID(ctx context.Context, tx postgre.Transaction, userID string) (string, error) {
+	ret := _m.Called(ctx, tx, userID)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) string); ok {
+		r0 = rf(ctx, tx, userID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetPasswordByID provides a mock function with given fields: ctx, id
 func (_m *Repository) GetPasswordByID(ctx context.Context, id string) (string, error) {
 	ret := _m.Called(ctx, id)
@@ -883,6 +1234,52 @@ func (_m *Repository) GetPasswordByID(ctx context.Context, id string) (string, e
 	return r0, r1
 }
 
+// GetPaymentCallbackByNonce provides a mock function with given fields: ctx, txnID, nonce
+func (_m *Repository) GetPaymentCallbackByNonce(ctx context.Context, txnID string, nonce string) (*model.PaymentCallback, error) {
+	ret := _m.Called(ctx, txnID, nonce)
+
+	var r0 *model.PaymentCallback
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.PaymentCallback); ok {
+		r0 = rf(ctx, txnID, nonce)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PaymentCallback)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, txnID, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceTiers provides a mock function with given fields: ctx, tx, productDetailID
+func (_m *Repository) GetPriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) ([]*model.PriceTier, error) {
+	ret := _m.Called(ctx, tx, productDetailID)
+
+	var r0 []*model.PriceTier
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) []*model.PriceTier); ok {
+		r0 = rf(ctx, tx, productDetailID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PriceTier)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, productDetailID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductDetailByID provides a mock function with given fields: ctx, tx, productDetailID
 func (_m *Repository) GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error) {
 	ret := _m.Called(ctx, tx, productDetailID)
@@ -1044,6 +1441,27 @@ func (_m *Repository) GetRejectedRefund(ctx context.Context) ([]*model.RefundOrd
 	return r0, r1
 }
 
+// GetReservedStock provides a mock function with given fields: ctx, tx, productDetailID
+func (_m *Repository) GetReservedStock(ctx context.Context, tx postgre.Transaction, productDetailID string) (float64, error) {
+	ret := _m.Called(ctx, tx, productDetailID)
+
+	var r0 float64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) float64); ok {
+		r0 = rf(ctx, tx, productDetailID)
+	} else {
+		r0 = ret.Get(0).(float64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string) error); ok {
+		r1 = rf(ctx, tx, productDetailID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetSealabsPay provides a mock function with given fields: ctx, userid
 func (_m *Repository) GetSealabsPay(ctx context.Context, userid string) ([]*model.SealabsPay, error) {
 	ret := _m.Called(ctx, userid)
@@ -1111,29 +1529,6 @@ func (_m *Repository) GetSellerIDByOrderID(ctx context.Context, orderID string)
 	return r0, r1
 }
 
-// GetSessionKeyRedis provides a mock function with given fields: ctx, key
-func (_m *Repository) GetSessionKeyRedis(ctx context.Context, key string) ([]string, error) {
-	ret := _m.Called(ctx, key)
-
-	var r0 []string
-	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
-		r0 = rf(ctx, key)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]string)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, key)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
 // GetShopByID provides a mock function with given fields: ctx, shopID
 func (_m *Repository) GetShopByID(ctx context.Context, shopID string) (*model.Shop, error) {
 	ret := _m.Called(ctx, shopID)
@@ -1377,6 +1772,29 @@ func (_m *Repository) GetUserByUsername(ctx context.Context, username string) (*
 	return r0, r1
 }
 
+// GetUserDataExport provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserDataExport(ctx context.Context, userID string) (*body.UserDataExport, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.UserDataExport
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.UserDataExport); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.UserDataExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserPasswordByID provides a mock function with given fields: ctx, id
 func (_m *Repository) GetUserPasswordByID(ctx context.Context, id string) (*model.User, error) {
 	ret := _m.Called(ctx, id)
@@ -1538,6 +1956,27 @@ func (_m *Repository) GetWalletUser(ctx context.Context, walletID string) (*mode
 	return r0, r1
 }
 
+// InsertCallbackNonceRedis provides a mock function with given fields: ctx, nonce
+func (_m *Repository) InsertCallbackNonceRedis(ctx context.Context, nonce string) (bool, error) {
+	ret := _m.Called(ctx, nonce)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, nonce)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // InsertCostRedis provides a mock function with given fields: ctx, key, value
 func (_m *Repository) InsertCostRedis(ctx context.Context, key string, value string) error {
 	ret := _m.Called(ctx, key, value)
@@ -1580,13 +2019,13 @@ func (_m *Repository) InsertNewOTPKeyChangeWalletPin(ctx context.Context, email
 	return r0
 }
 
-// InsertSessionRedis provides a mock function with given fields: ctx, duration, key, status
-func (_m *Repository) InsertSessionRedis(ctx context.Context, duration int, key string, status string) error {
-	ret := _m.Called(ctx, duration, key, status)
+// InsertWalletHistory provides a mock function with given fields: ctx, tx, walletHistory
+func (_m *Repository) InsertWalletHistory(ctx context.Context, tx postgre.Transaction, walletHistory *model.WalletHistory) error {
+	ret := _m.Called(ctx, tx, walletHistory)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, int, string, string) error); ok {
-		r0 = rf(ctx, duration, key, status)
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.WalletHistory) error); ok {
+		r0 = rf(ctx, tx, walletHistory)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1594,13 +2033,13 @@ func (_m *Repository) InsertSessionRedis(ctx context.Context, duration int, key
 	return r0
 }
 
-// InsertWalletHistory provides a mock function with given fields: ctx, tx, walletHistory
-func (_m *Repository) InsertWalletHistory(ctx context.Context, tx postgre.Transaction, walletHistory *model.WalletHistory) error {
-	ret := _m.Called(ctx, tx, walletHistory)
+// LockUserDueForDeletion provides a mock function with given fields: ctx, tx, userID
+func (_m *Repository) LockUserDueForDeletion(ctx context.Context, tx postgre.Transaction, userID string) error {
+	ret := _m.Called(ctx, tx, userID)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.WalletHistory) error); ok {
-		r0 = rf(ctx, tx, walletHistory)
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string) error); ok {
+		r0 = rf(ctx, tx, userID)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -1622,6 +2061,41 @@ func (_m *Repository) PatchSealabsPay(ctx context.Context, cardNumber string) er
 	return r0
 }
 
+// PostponeAccountDeletion provides a mock function with given fields: ctx, tx, userID, scheduledAt
+func (_m *Repository) PostponeAccountDeletion(ctx context.Context, tx postgre.Transaction, userID string, scheduledAt time.Time) error {
+	ret := _m.Called(ctx, tx, userID, scheduledAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, time.Time) error); ok {
+		r0 = rf(ctx, tx, userID, scheduledAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RequestAccountDeletion provides a mock function with given fields: ctx, tx, userID, scheduledAt
+func (_m *Repository) RequestAccountDeletion(ctx context.Context, tx postgre.Transaction, userID string, scheduledAt time.Time) (int64, error) {
+	ret := _m.Called(ctx, tx, userID, scheduledAt)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, string, time.Time) int64); ok {
+		r0 = rf(ctx, tx, userID, scheduledAt)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, postgre.Transaction, string, time.Time) error); ok {
+		r1 = rf(ctx, tx, userID, scheduledAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetDefaultSealabsPay provides a mock function with given fields: ctx, cardNumber, userid
 func (_m *Repository) SetDefaultSealabsPay(ctx context.Context, cardNumber string, userid string) error {
 	ret := _m.Called(ctx, cardNumber, userid)
@@ -1734,13 +2208,13 @@ func (_m *Repository) UpdatePasswordByID(ctx context.Context, userID string, new
 	return r0
 }
 
-// UpdateProductDetailStock provides a mock function with given fields: ctx, tx, productDetailData
-func (_m *Repository) UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction, productDetailData *model.ProductDetail) error {
-	ret := _m.Called(ctx, tx, productDetailData)
+// UpdatePaymentCallback provides a mock function with given fields: ctx, callback
+func (_m *Repository) UpdatePaymentCallback(ctx context.Context, callback *model.PaymentCallback) error {
+	ret := _m.Called(ctx, callback)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, postgre.Transaction, *model.ProductDetail) error); ok {
-		r0 = rf(ctx, tx, productDetailData)
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PaymentCallback) error); ok {
+		r0 = rf(ctx, callback)
 	} else {
 		r0 = ret.Error(0)
 	}
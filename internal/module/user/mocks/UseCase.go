@@ -46,6 +46,41 @@ func (_m *UseCase) AddSealabsPay(ctx context.Context, request body.AddSealabsPay
 	return r0
 }
 
+// AnonymizeDeletedAccounts provides a mock function with given fields: ctx
+func (_m *UseCase) AnonymizeDeletedAccounts(ctx context.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelAccountDeletion provides a mock function with given fields: ctx, userID
+func (_m *UseCase) CancelAccountDeletion(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ChangeOrderStatus provides a mock function with given fields: ctx, userID, requestBody
 func (_m *UseCase) ChangeOrderStatus(ctx context.Context, userID string, requestBody body.ChangeOrderStatusRequest) error {
 	ret := _m.Called(ctx, userID, requestBody)
@@ -145,17 +180,47 @@ func (_m *UseCase) ChangeWalletPinStepUpVerify(ctx context.Context, requestBody
 }
 
 // CompletedRejectedRefund provides a mock function with given fields: ctx
-func (_m *UseCase) CompletedRejectedRefund(ctx context.Context) error {
+func (_m *UseCase) CompletedRejectedRefund(ctx context.Context) (int64, error) {
 	ret := _m.Called(ctx)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context) int64); ok {
 		r0 = rf(ctx)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(int64)
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ConfirmTOTP provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) ConfirmTOTP(ctx context.Context, userID string, requestBody body.TOTPCodeRequest) ([]string, error) {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.TOTPCodeRequest) []string); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, body.TOTPCodeRequest) error); ok {
+		r1 = rf(ctx, userID, requestBody)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
 // CreateAddress provides a mock function with given fields: ctx, userID, requestBody
@@ -242,13 +307,13 @@ func (_m *UseCase) CreateTransaction(ctx context.Context, userID string, request
 	return r0, r1
 }
 
-// CreateWalletPayment provides a mock function with given fields: ctx, transactionID
-func (_m *UseCase) CreateWalletPayment(ctx context.Context, transactionID string) error {
-	ret := _m.Called(ctx, transactionID)
+// CreateWalletPayment provides a mock function with given fields: ctx, transactionID, mfa
+func (_m *UseCase) CreateWalletPayment(ctx context.Context, transactionID string, mfa bool) error {
+	ret := _m.Called(ctx, transactionID, mfa)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, transactionID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) error); ok {
+		r0 = rf(ctx, transactionID, mfa)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -284,6 +349,20 @@ func (_m *UseCase) DeleteSealabsPay(ctx context.Context, userID string, cardNumb
 	return r0
 }
 
+// DisableTOTP provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) DisableTOTP(ctx context.Context, userID string, requestBody body.TOTPCodeRequest) error {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.TOTPCodeRequest) error); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // EditEmail provides a mock function with given fields: ctx, userID, requestBody
 func (_m *UseCase) EditEmail(ctx context.Context, userID string, requestBody body.EditEmailRequest) (*model.User, error) {
 	ret := _m.Called(ctx, userID, requestBody)
@@ -353,6 +432,52 @@ func (_m *UseCase) EditUser(ctx context.Context, userID string, requestBody body
 	return r0, r1
 }
 
+// ExportUserData provides a mock function with given fields: ctx, userID
+func (_m *UseCase) ExportUserData(ctx context.Context, userID string) (*body.UserDataExport, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.UserDataExport
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.UserDataExport); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.UserDataExport)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAccountDeletion provides a mock function with given fields: ctx, userID
+func (_m *UseCase) GetAccountDeletion(ctx context.Context, userID string) (*body.AccountDeletionResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.AccountDeletionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.AccountDeletionResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.AccountDeletionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAddress provides a mock function with given fields: ctx, userID, _a2, queryRequest
 func (_m *UseCase) GetAddress(ctx context.Context, userID string, _a2 *pagination.Pagination, queryRequest *body.GetAddressQueryRequest) (*pagination.Pagination, error) {
 	ret := _m.Called(ctx, userID, _a2, queryRequest)
@@ -652,6 +777,20 @@ func (_m *UseCase) GetWalletHistory(ctx context.Context, userID string, pgn *pag
 	return r0, r1
 }
 
+// HandlePaymentCallback provides a mock function with given fields: ctx, callbackType, transactionID, rawBody, requestBody
+func (_m *UseCase) HandlePaymentCallback(ctx context.Context, callbackType string, transactionID string, rawBody string, requestBody body.SLPCallbackRequest) error {
+	ret := _m.Called(ctx, callbackType, transactionID, rawBody, requestBody)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, body.SLPCallbackRequest) error); ok {
+		r0 = rf(ctx, callbackType, transactionID, rawBody, requestBody)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PatchSealabsPay provides a mock function with given fields: ctx, cardNumber, userid
 func (_m *UseCase) PatchSealabsPay(ctx context.Context, cardNumber string, userid string) error {
 	ret := _m.Called(ctx, cardNumber, userid)
@@ -680,6 +819,29 @@ func (_m *UseCase) RegisterMerchant(ctx context.Context, userID string, shopName
 	return r0
 }
 
+// RequestAccountDeletion provides a mock function with given fields: ctx, userID, requestBody
+func (_m *UseCase) RequestAccountDeletion(ctx context.Context, userID string, requestBody body.AccountDeletionRequest) (*body.AccountDeletionResponse, error) {
+	ret := _m.Called(ctx, userID, requestBody)
+
+	var r0 *body.AccountDeletionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, body.AccountDeletionRequest) *body.AccountDeletionResponse); ok {
+		r0 = rf(ctx, userID, requestBody)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.AccountDeletionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, body.AccountDeletionRequest) error); ok {
+		r1 = rf(ctx, userID, requestBody)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SendOTPEmail provides a mock function with given fields: ctx, email
 func (_m *UseCase) SendOTPEmail(ctx context.Context, email string) error {
 	ret := _m.Called(ctx, email)
@@ -694,6 +856,29 @@ func (_m *UseCase) SendOTPEmail(ctx context.Context, email string) error {
 	return r0
 }
 
+// SetupTOTP provides a mock function with given fields: ctx, userID
+func (_m *UseCase) SetupTOTP(ctx context.Context, userID string) (*body.TOTPSetupResponse, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *body.TOTPSetupResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string) *body.TOTPSetupResponse); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*body.TOTPSetupResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // TopUpWallet provides a mock function with given fields: ctx, userID, requestBody
 func (_m *UseCase) TopUpWallet(ctx context.Context, userID string, requestBody body.TopUpWalletRequest) (string, error) {
 	ret := _m.Called(ctx, userID, requestBody)
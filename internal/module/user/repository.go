@@ -6,6 +6,7 @@ import (
 	"murakali/internal/module/user/delivery/body"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -51,7 +52,7 @@ type Repository interface {
 	UpdateProfileImage(ctx context.Context, imgURL, userID string) error
 	UpdatePasswordByID(ctx context.Context, userID, newPassword string) error
 	GetPasswordByID(ctx context.Context, id string) (string, error)
-	ChangeOrderStatus(ctx context.Context, requestBody body.ChangeOrderStatusRequest) error
+	ChangeOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.ChangeOrderStatusRequest, fromStatusID int) (int64, error)
 	GetOrdersByTransactionID(ctx context.Context, transactionID, userID string) ([]*model.Order, error)
 	GetTotalOrder(ctx context.Context, userID, orderStatusID string) (int64, error)
 	GetProductUnitSoldByOrderID(ctx context.Context, tx postgre.Transaction, orderID string) ([]*body.ProductUnitSoldOrderQty, error)
@@ -74,12 +75,12 @@ type Repository interface {
 	GetVoucherShopByID(ctx context.Context, VoucherShopID, shopID string) (*model.Voucher, error)
 	GetCourierShopByID(ctx context.Context, CourierID, shopID string) (*model.Courier, error)
 	GetProductDetailByID(ctx context.Context, tx postgre.Transaction, productDetailID string) (*model.ProductDetail, error)
+	GetPriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) ([]*model.PriceTier, error)
 	GetCartItemUser(ctx context.Context, userID, productDetailID string) (*model.CartItem, error)
 	CreateTransaction(ctx context.Context, tx postgre.Transaction, transactionData *model.Transaction) (*uuid.UUID, error)
 	UpdateTransaction(ctx context.Context, tx postgre.Transaction, transactionData *model.Transaction) error
 	CreateOrder(ctx context.Context, tx postgre.Transaction, orderData *model.OrderModel) (*uuid.UUID, error)
 	CreateOrderItem(ctx context.Context, tx postgre.Transaction, item *model.OrderItem) (*uuid.UUID, error)
-	UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction, productDetailData *model.ProductDetail) error
 	DeleteCartItemByID(ctx context.Context, tx postgre.Transaction, cartItemData *model.CartItem) error
 	GetOrderByTransactionID(ctx context.Context, transactionID string) ([]*model.OrderModel, error)
 	GetOrderDetailByTransactionID(ctx context.Context, TransactionID string) ([]*model.Order, error)
@@ -105,10 +106,35 @@ type Repository interface {
 	GetRefundOrderByID(ctx context.Context, refundID string) (*model.Refund, error)
 	GetRefundThreadByRefundID(ctx context.Context, refundID string) ([]*body.RThread, error)
 	CreateRefundThreadUser(ctx context.Context, refundThreadData *model.RefundThread) error
-	InsertSessionRedis(ctx context.Context, duration int, key, status string) error
-	GetSessionKeyRedis(ctx context.Context, key string) ([]string, error)
+	InsertCallbackNonceRedis(ctx context.Context, nonce string) (bool, error)
+	DeleteCallbackNonceRedis(ctx context.Context, nonce string) error
+	CreatePaymentCallback(ctx context.Context, callback *model.PaymentCallback) (*uuid.UUID, error)
+	GetOriginalPaymentCallback(ctx context.Context, idempotencyKey string) (*model.PaymentCallback, error)
+	GetPaymentCallbackByNonce(ctx context.Context, txnID, nonce string) (*model.PaymentCallback, error)
+	UpdatePaymentCallback(ctx context.Context, callback *model.PaymentCallback) error
 	GetRejectedRefund(ctx context.Context) ([]*model.RefundOrder, error)
 	InsertNewOTPKeyChangeWalletPin(ctx context.Context, email, otp string) error
 	GetOTPValueChangeWalletPin(ctx context.Context, email string) (string, error)
 	DeleteOTPValueChangeWalletPin(ctx context.Context, email string) (int64, error)
+	CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error
+	GetOrderStatusHistoryByOrderID(ctx context.Context, orderID string) ([]*model.OrderStatusHistory, error)
+	GetReservedStock(ctx context.Context, tx postgre.Transaction, productDetailID string) (float64, error)
+	CreateStockReservation(ctx context.Context, tx postgre.Transaction, reservation *model.StockReservation) error
+	ConvertStockReservation(ctx context.Context, tx postgre.Transaction, orderID string) error
+	CreateUserTOTP(ctx context.Context, userID, secret string) error
+	ConfirmUserTOTP(ctx context.Context, tx postgre.Transaction, userID string, step int64) (int64, error)
+	DeleteUserTOTP(ctx context.Context, tx postgre.Transaction, userID string) error
+	CreateRecoveryCode(ctx context.Context, tx postgre.Transaction, userID, codeHash string) error
+	DeleteRecoveryCodes(ctx context.Context, tx postgre.Transaction, userID string) error
+	GetAccountDeletion(ctx context.Context, userID string) (*body.AccountDeletionResponse, error)
+	RequestAccountDeletion(ctx context.Context, tx postgre.Transaction, userID string, scheduledAt time.Time) (int64, error)
+	CancelAccountDeletion(ctx context.Context, userID string) (int64, error)
+	PostponeAccountDeletion(ctx context.Context, tx postgre.Transaction, userID string, scheduledAt time.Time) error
+	LockUserDueForDeletion(ctx context.Context, tx postgre.Transaction, userID string) error
+	GetOwnedShopIDByUserID(ctx context.Context, tx postgre.Transaction, userID string) (string, error)
+	CountActiveOrdersByUserID(ctx context.Context, tx postgre.Transaction, userID string) (int64, error)
+	GetAccountsDueForDeletion(ctx context.Context) ([]string, error)
+	DeleteUserPersonalData(ctx context.Context, tx postgre.Transaction, userID string) error
+	AnonymizeUser(ctx context.Context, tx postgre.Transaction, userID string) (int64, error)
+	GetUserDataExport(ctx context.Context, userID string) (*body.UserDataExport, error)
 }
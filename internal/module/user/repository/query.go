@@ -137,23 +137,23 @@ const (
 	GetCourierShopByIDQuery = `SELECT "c"."id", "c"."name", "c"."code", "c"."service", "c"."description" FROM "courier" as "c"
 		INNER JOIN "shop_courier" as sc ON "sc"."courier_id" = "c"."id"
 		WHERE "c"."id" = $1 AND "sc"."shop_id" = $2 AND "c"."deleted_at" IS NULL;`
-	GetProductDetailByIDQuery     = `SELECT "id", "product_id", "price", "stock", "weight", "size", "hazardous", "condition", "bulk_price" FROM "product_detail" WHERE "id" = $1 AND "deleted_at" IS NULL;`
-	GetShopByIDQuery              = `SELECT "id", "name", "user_id" FROM "shop" WHERE "id" = $1 AND "deleted_at" IS NULL;`
-	CreateTransactionQuery        = `INSERT INTO "transaction" (voucher_marketplace_id, wallet_id, card_number, invoice, total_price, expired_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING "id";`
-	CreateOrderQuery              = `INSERT INTO "order" (transaction_id, shop_id, user_id, courier_id, voucher_shop_id, order_status_id, total_price, delivery_fee, buyer_address, shop_address) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING "id";`
-	CreateOrderItemQuery          = `INSERT INTO "order_item" (order_id, product_detail_id, quantity, item_price, total_price, note) VALUES ($1, $2, $3, $4, $5, $6) RETURNING "id";`
-	CreateWalletQuery             = `INSERT INTO "wallet" (user_id, balance, pin, attempt_count, active_date) VALUES ($1, $2, $3, $4, $5)`
-	CreateWalletHistoryQuery      = `INSERT INTO "wallet_history" (transaction_id, wallet_id, "from", "to", description, amount, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
-	UpdateWalletBalanceQuery      = `UPDATE "wallet" SET "balance" = $1, "updated_at" = $2 WHERE "id" = $3`
-	UpdateWalletQuery             = `UPDATE "wallet" SET "attempt_count" = $1, "attempt_at" = $2, "unlocked_at" = $3, "updated_at" = CURRENT_TIMESTAMP WHERE "id" = $4`
-	GetWalletByUserIDQuery        = `SELECT "id", "user_id", "balance", "pin", "attempt_count", "attempt_at", "unlocked_at", "active_date" FROM "wallet" WHERE "user_id" = $1 AND "deleted_at" IS NULL`
-	GetCartItemUserQuery          = `SELECT "id", "user_id", "product_detail_id", "quantity" FROM "cart_item" WHERE "user_id" = $1 AND "product_detail_id" = $2 AND "deleted_at" IS NULL;`
-	UpdateProductDetailStockQuery = `UPDATE "product_detail" SET "stock" = $1, "updated_at" = now() WHERE "id" = $2;`
-	DeleteCartItemByIDQuery       = `DELETE FROM "cart_item" WHERE "id" = $1`
-	GetTransactionByIDQuery       = `SELECT "id", "voucher_marketplace_id", "wallet_id", "card_number", "invoice", "total_price", "paid_at", "canceled_at", "expired_at" FROM "transaction" WHERE "id" = $1;`
-	UpdateTransactionByID         = `UPDATE "transaction" SET "paid_at" = $1, "canceled_at" = $2, "card_number" = $3 WHERE "id" = $4`
-	UpdateOrderByID               = `UPDATE "order" SET "order_status_id" = $1 WHERE "id" = $2`
-	GetOrderByTransactionID       = `SELECT 
+	GetProductDetailByIDQuery = `SELECT "id", "product_id", "price", "stock", "weight", "size", "hazardous", "condition", "bulk_price" FROM "product_detail" WHERE "id" = $1 AND "deleted_at" IS NULL FOR UPDATE;`
+	GetShopByIDQuery          = `SELECT "id", "name", "user_id" FROM "shop" WHERE "id" = $1 AND "deleted_at" IS NULL;`
+	CreateTransactionQuery    = `INSERT INTO "transaction" (voucher_marketplace_id, wallet_id, card_number, invoice, total_price, expired_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING "id";`
+	CreateOrderQuery          = `INSERT INTO "order" (transaction_id, shop_id, user_id, courier_id, voucher_shop_id, order_status_id, total_price, delivery_fee, buyer_address, shop_address) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10) RETURNING "id";`
+	CreateOrderItemQuery      = `INSERT INTO "order_item" (order_id, product_detail_id, quantity, item_price, total_price, note) VALUES ($1, $2, $3, $4, $5, $6) RETURNING "id";`
+	CreateWalletQuery         = `INSERT INTO "wallet" (user_id, balance, pin, attempt_count, active_date) VALUES ($1, $2, $3, $4, $5)`
+	CreateWalletHistoryQuery  = `INSERT INTO "wallet_history" (transaction_id, wallet_id, "from", "to", description, amount, created_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	UpdateWalletBalanceQuery  = `UPDATE "wallet" SET "balance" = $1, "updated_at" = $2 WHERE "id" = $3`
+	UpdateWalletQuery         = `UPDATE "wallet" SET "attempt_count" = $1, "attempt_at" = $2, "unlocked_at" = $3, "updated_at" = CURRENT_TIMESTAMP WHERE "id" = $4`
+	GetWalletByUserIDQuery    = `SELECT "id", "user_id", "balance", "pin", "attempt_count", "attempt_at", "unlocked_at", "active_date" FROM "wallet" WHERE "user_id" = $1 AND "deleted_at" IS NULL`
+	GetCartItemUserQuery      = `SELECT "id", "user_id", "product_detail_id", "quantity" FROM "cart_item" WHERE "user_id" = $1 AND "product_detail_id" = $2 AND "deleted_at" IS NULL;`
+	DeleteCartItemByIDQuery   = `DELETE FROM "cart_item" WHERE "id" = $1`
+	GetTransactionByIDQuery   = `SELECT "id", "voucher_marketplace_id", "wallet_id", "card_number", "invoice", "total_price", "paid_at", "canceled_at", "expired_at" FROM "transaction" WHERE "id" = $1;`
+	UpdateTransactionByID     = `UPDATE "transaction" SET "paid_at" = $1, "canceled_at" = $2, "card_number" = $3 WHERE "id" = $4`
+	UpdateOrderByID           = `UPDATE "order" SET "order_status_id" = $1 WHERE "id" = $2`
+	ChangeOrderStatusQuery    = `UPDATE "order" SET "order_status_id" = $1 WHERE "id" = $2 AND "order_status_id" = $3`
+	GetOrderByTransactionID   = `SELECT 
 		"id", "transaction_id", "shop_id", "user_id", "courier_id", "voucher_shop_id", "order_status_id", "total_price", "delivery_fee", "resi_no", "created_at", "arrived_at" 
 	FROM "order" WHERE "transaction_id" = $1`
 	GetOrderByTransactionIDUserID = `SELECT 
@@ -253,4 +253,163 @@ const (
 	(refund_id, user_id, is_seller, is_buyer, text)
 	VALUES ($1, $2, $3, $4, $5)`
 	UpdateProductUnitSoldQuery = `UPDATE "product" SET "unit_sold" = $1, "updated_at" = now() WHERE "id" = $2;`
+
+	CreatePaymentCallbackQuery = `INSERT INTO "payment_callback"
+	(transaction_id, original_id, idempotency_key, callback_type, txn_id, nonce, status, message, raw_body, outcome, response_status, response_message, received_at, processed_at)
+	VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14)
+	ON CONFLICT ("idempotency_key") WHERE "original_id" IS NULL AND "outcome" <> 'error' DO NOTHING
+	RETURNING "id";`
+
+	GetOriginalPaymentCallbackQuery = `SELECT "id", "transaction_id", "original_id", "idempotency_key", "callback_type", "txn_id", "nonce", "status", "message",
+	"raw_body", "outcome", "response_status", "response_message", "received_at", "processed_at"
+	FROM "payment_callback" WHERE "idempotency_key" = $1 AND "original_id" IS NULL AND "outcome" <> 'error'`
+
+	GetPaymentCallbackByNonceQuery = `SELECT "id", "transaction_id", "original_id", "idempotency_key", "callback_type", "txn_id", "nonce", "status", "message",
+	"raw_body", "outcome", "response_status", "response_message", "received_at", "processed_at"
+	FROM "payment_callback" WHERE "txn_id" = $1 AND "nonce" = $2 AND "original_id" IS NULL AND "outcome" <> 'error'`
+
+	UpdatePaymentCallbackQuery = `UPDATE "payment_callback"
+	SET "outcome" = $1, "response_status" = $2, "response_message" = $3, "processed_at" = $4 WHERE "id" = $5`
+
+	CreateOrderStatusHistoryQuery = `INSERT INTO "order_status_history"
+	(order_id, from_order_status_id, to_order_status_id, actor, actor_id) VALUES ($1, $2, $3, $4, $5)`
+
+	GetOrderStatusHistoryByOrderIDQuery = `SELECT "id", "order_id", "from_order_status_id", "to_order_status_id", "actor", "actor_id", "created_at"
+	FROM "order_status_history" WHERE "order_id" = $1 ORDER BY "created_at" ASC`
+
+	GetReservedStockQuery = `SELECT COALESCE(SUM("quantity"), 0) FROM "stock_reservation"
+	WHERE "product_detail_id" = $1 AND "status" = $2 AND "expired_at" > now()`
+
+	CreateStockReservationQuery = `INSERT INTO "stock_reservation"
+	(order_id, order_item_id, product_detail_id, quantity, status, expired_at) VALUES ($1, $2, $3, $4, $5, $6)`
+
+	ConvertStockReservationQuery = `UPDATE "product_detail" AS "pd" SET "stock" = "pd"."stock" - "sr"."quantity", "updated_at" = now()
+	FROM (SELECT "product_detail_id", SUM("quantity") AS "quantity" FROM "stock_reservation"
+		WHERE "order_id" = $1 AND "status" = $2 GROUP BY "product_detail_id") AS "sr"
+	WHERE "pd"."id" = "sr"."product_detail_id"`
+
+	UpdateStockReservationStatusQuery = `UPDATE "stock_reservation" SET "status" = $1, "updated_at" = now()
+	WHERE "order_id" = $2 AND "status" = $3`
+
+	CreateUserTOTPQuery = `INSERT INTO "user_totp" (user_id, secret) VALUES ($1, $2)
+	ON CONFLICT ("user_id") DO UPDATE SET "secret" = EXCLUDED."secret", "last_used_step" = NULL, "updated_at" = now()
+	WHERE "user_totp"."confirmed_at" IS NULL`
+
+	ConfirmUserTOTPQuery = `UPDATE "user_totp" SET "confirmed_at" = now(), "last_used_step" = $1, "updated_at" = now()
+	WHERE "user_id" = $2 AND "confirmed_at" IS NULL`
+
+	DeleteUserTOTPQuery = `DELETE FROM "user_totp" WHERE "user_id" = $1`
+
+	CreateRecoveryCodeQuery = `INSERT INTO "user_recovery_code" (user_id, code_hash) VALUES ($1, $2)`
+
+	DeleteRecoveryCodesQuery = `DELETE FROM "user_recovery_code" WHERE "user_id" = $1`
+
+	GetAccountDeletionQuery = `SELECT "deletion_requested_at", "deletion_scheduled_at" FROM "user" WHERE "id" = $1`
+
+	RequestAccountDeletionQuery = `UPDATE "user" SET "deletion_requested_at" = now(), "deletion_scheduled_at" = $2, "updated_at" = now()
+	WHERE "id" = $1 AND "deletion_scheduled_at" IS NULL AND "deleted_at" IS NULL`
+
+	CancelAccountDeletionQuery = `UPDATE "user" SET "deletion_requested_at" = NULL, "deletion_scheduled_at" = NULL, "updated_at" = now()
+	WHERE "id" = $1 AND "deletion_scheduled_at" IS NOT NULL AND "deleted_at" IS NULL`
+
+	PostponeAccountDeletionQuery = `UPDATE "user" SET "deletion_scheduled_at" = $2, "updated_at" = now()
+	WHERE "id" = $1 AND "deletion_scheduled_at" IS NOT NULL AND "deleted_at" IS NULL`
+
+	LockUserDueForDeletionQuery = `SELECT "id" FROM "user"
+	WHERE "id" = $1 AND "deletion_scheduled_at" <= now() AND "deleted_at" IS NULL FOR UPDATE`
+
+	GetOwnedShopIDByUserIDQuery = `SELECT "id" FROM "shop" WHERE "user_id" = $1 AND "deleted_at" IS NULL`
+
+	CountActiveOrdersByUserIDQuery = `SELECT count("id") FROM "order" WHERE "user_id" = $1 AND "order_status_id" NOT IN ($2, $3, $4)`
+
+	GetAccountsDueForDeletionQuery = `SELECT "id" FROM "user"
+	WHERE "deletion_scheduled_at" <= now() AND "deleted_at" IS NULL ORDER BY "deletion_scheduled_at" LIMIT 100`
+
+	DeleteUserEmailHistoryQuery = `DELETE FROM "email_history" WHERE "email" = (SELECT "email" FROM "user" WHERE "id" = $1)`
+
+	AnonymizeUserAddressesQuery = `UPDATE "address" SET "name" = '', "address_detail" = '', "zip_code" = '',
+	"updated_at" = now(), "deleted_at" = COALESCE("deleted_at", now()) WHERE "user_id" = $1`
+
+	DeleteUserCartItemsQuery = `DELETE FROM "cart_item" WHERE "user_id" = $1`
+
+	DeleteUserFavoritesQuery = `DELETE FROM "favorite" WHERE "user_id" = $1`
+
+	DeleteUserProductAlertsQuery = `DELETE FROM "product_alert" WHERE "user_id" = $1`
+
+	DeleteUserSealabsPayQuery = `DELETE FROM "sealabs_pay" WHERE "user_id" = $1`
+
+	DeleteUserIdentitiesQuery = `DELETE FROM "user_identity" WHERE "user_id" = $1`
+
+	DeleteUserRolesQuery = `DELETE FROM "user_role" WHERE "user_id" = $1`
+
+	DeleteUserShopStaffQuery = `UPDATE "shop_staff" SET "invite_token" = NULL, "deleted_at" = now()
+	WHERE "user_id" = $1 AND "deleted_at" IS NULL`
+
+	AnonymizeUserQuery = `UPDATE "user" SET "email" = 'deleted-' || "id" || '@deleted.murakali', "username" = NULL,
+	"phone_no" = NULL, "fullname" = 'Deleted User', "password" = '', "gender" = NULL, "birth_date" = NULL,
+	"photo_url" = NULL, "is_sso" = FALSE, "is_verify" = FALSE, "deletion_scheduled_at" = NULL,
+	"updated_at" = now(), "deleted_at" = now()
+	WHERE "id" = $1 AND "deleted_at" IS NULL`
+
+	ExportUserProfileQuery = `SELECT row_to_json("u") FROM (
+		SELECT "id", "email", "username", "phone_no", "fullname", "gender", "birth_date", "photo_url",
+		"is_sso", "is_verify", "created_at", "updated_at"
+		FROM "user" WHERE "id" = $1
+	) AS "u"`
+
+	ExportUserAddressesQuery = `SELECT COALESCE(json_agg("a" ORDER BY "a"."created_at"), '[]') FROM (
+		SELECT "id", "name", "province", "city", "district", "sub_district", "address_detail", "zip_code",
+		"is_default", "is_shop_default", "created_at", "updated_at", "deleted_at"
+		FROM "address" WHERE "user_id" = $1
+	) AS "a"`
+
+	ExportUserOrdersQuery = `SELECT COALESCE(json_agg("o" ORDER BY "o"."created_at"), '[]') FROM (
+		SELECT "o"."id", "o"."transaction_id", "o"."shop_id", "s"."name" AS "shop_name", "os"."name" AS "order_status",
+		"o"."total_price", "o"."delivery_fee", "o"."resi_no", "o"."buyer_address", "o"."cancel_notes", "o"."is_refund",
+		"o"."created_at", "o"."arrived_at",
+		(
+			SELECT COALESCE(json_agg(json_build_object(
+				'product_detail_id', "oi"."product_detail_id",
+				'product_title', "p"."title",
+				'quantity', "oi"."quantity",
+				'item_price', "oi"."item_price",
+				'total_price', "oi"."total_price",
+				'note', "oi"."note"
+			)), '[]')
+			FROM "order_item" AS "oi"
+			LEFT JOIN "product_detail" AS "pd" ON "pd"."id" = "oi"."product_detail_id"
+			LEFT JOIN "product" AS "p" ON "p"."id" = "pd"."product_id"
+			WHERE "oi"."order_id" = "o"."id"
+		) AS "items"
+		FROM "order" AS "o"
+		LEFT JOIN "shop" AS "s" ON "s"."id" = "o"."shop_id"
+		LEFT JOIN "order_status" AS "os" ON "os"."id" = "o"."order_status_id"
+		WHERE "o"."user_id" = $1
+	) AS "o"`
+
+	ExportUserReviewsQuery = `SELECT COALESCE(json_agg("r" ORDER BY "r"."created_at"), '[]') FROM (
+		SELECT "r"."id", "r"."product_id", "p"."title" AS "product_title", "r"."comment", "r"."rating", "r"."image_url",
+		"r"."created_at", "r"."updated_at", "r"."deleted_at"
+		FROM "review" AS "r"
+		LEFT JOIN "product" AS "p" ON "p"."id" = "r"."product_id"
+		WHERE "r"."user_id" = $1
+	) AS "r"`
+
+	ExportUserWalletHistoryQuery = `SELECT COALESCE(json_agg("wh" ORDER BY "wh"."created_at"), '[]') FROM (
+		SELECT "wh"."id", "wh"."transaction_id", "wh"."from", "wh"."to", "wh"."amount", "wh"."description", "wh"."created_at"
+		FROM "wallet_history" AS "wh"
+		INNER JOIN "wallet" AS "w" ON "w"."id" = "wh"."wallet_id"
+		WHERE "w"."user_id" = $1
+	) AS "wh"`
+
+	ExportUserRefundThreadsQuery = `SELECT COALESCE(json_agg("rt" ORDER BY "rt"."created_at"), '[]') FROM (
+		SELECT "rt"."id", "rt"."refund_id", "r"."order_id", "rt"."is_seller", "rt"."is_buyer", "rt"."text", "rt"."created_at"
+		FROM "refund_thread" AS "rt"
+		INNER JOIN "refund" AS "r" ON "r"."id" = "rt"."refund_id"
+		INNER JOIN "order" AS "o" ON "o"."id" = "r"."order_id"
+		WHERE "o"."user_id" = $1
+	) AS "rt"`
+
+	GetPriceTiersQuery = `SELECT "min_quantity", "max_quantity", "price" FROM "product_price_tier"
+	WHERE "product_detail_id" = $1 ORDER BY "min_quantity" ASC`
 )
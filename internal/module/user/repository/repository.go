@@ -1200,7 +1200,7 @@ func (r *userRepo) UpdateRole(ctx context.Context, userID string) error {
 		return err
 	}
 
-	return nil
+	return r.RedisClient.Del(ctx, fmt.Sprintf("%s:%s", constant.PermissionKey, userID)).Err()
 }
 
 func (r *userRepo) UpdateProfileImage(ctx context.Context, imgURL, userID string) error {
@@ -1331,6 +1331,29 @@ func (r *userRepo) GetProductDetailByID(ctx context.Context, tx postgre.Transact
 	return &pd, nil
 }
 
+func (r *userRepo) GetPriceTiers(ctx context.Context, tx postgre.Transaction, productDetailID string) ([]*model.PriceTier, error) {
+	priceTiers := make([]*model.PriceTier, 0)
+	res, err := tx.QueryContext(ctx, GetPriceTiersQuery, productDetailID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var tier model.PriceTier
+		if errScan := res.Scan(&tier.MinQuantity, &tier.MaxQuantity, &tier.Price); errScan != nil {
+			return nil, errScan
+		}
+		priceTiers = append(priceTiers, &tier)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return priceTiers, nil
+}
+
 func (r *userRepo) CreateTransaction(ctx context.Context, tx postgre.Transaction,
 	transactionData *model.Transaction) (*uuid.UUID, error) {
 	var transactionID *uuid.UUID
@@ -1395,16 +1418,6 @@ func (r *userRepo) GetCartItemUser(ctx context.Context, userID, productDetailID
 	return &CartItemResult, nil
 }
 
-func (r *userRepo) UpdateProductDetailStock(ctx context.Context, tx postgre.Transaction,
-	productDetailData *model.ProductDetail) error {
-	_, err := tx.ExecContext(ctx, UpdateProductDetailStockQuery, productDetailData.Stock, productDetailData.ID)
-	if err != nil {
-		return err
-	}
-
-	return nil
-}
-
 func (r *userRepo) UpdateWalletBalance(ctx context.Context, tx postgre.Transaction, wallet *model.Wallet) error {
 	_, err := tx.ExecContext(ctx, UpdateWalletBalanceQuery, wallet.Balance, wallet.UpdatedAt, wallet.ID)
 	if err != nil {
@@ -1432,14 +1445,15 @@ func (r *userRepo) InsertWalletHistory(ctx context.Context, tx postgre.Transacti
 	return nil
 }
 
-func (r *userRepo) ChangeOrderStatus(ctx context.Context, requestBody body.ChangeOrderStatusRequest) error {
-	_, err := r.PSQL.ExecContext(
-		ctx, UpdateOrderByID, requestBody.OrderStatusID, requestBody.OrderID)
+func (r *userRepo) ChangeOrderStatus(ctx context.Context, tx postgre.Transaction, requestBody body.ChangeOrderStatusRequest,
+	fromStatusID int) (int64, error) {
+	res, err := tx.ExecContext(
+		ctx, ChangeOrderStatusQuery, requestBody.OrderStatusID, requestBody.OrderID, fromStatusID)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return res.RowsAffected()
 }
 
 func (r *userRepo) GetRejectedRefund(ctx context.Context) ([]*model.RefundOrder, error) {
@@ -1709,27 +1723,103 @@ func (r *userRepo) CreateRefundThreadUser(ctx context.Context, refundThreadData
 	return nil
 }
 
-func (r *userRepo) InsertSessionRedis(ctx context.Context, duration int, key, status string) error {
-	if err := r.RedisClient.Set(ctx, key, status, time.Duration(duration)*time.Minute); err != nil {
-		return err.Err()
+func (r *userRepo) InsertCallbackNonceRedis(ctx context.Context, nonce string) (bool, error) {
+	key := fmt.Sprintf("%s:%s", constant.SLPCallbackNonceKey, nonce)
+
+	res := r.RedisClient.SetNX(ctx, key, time.Now().Unix(), 2*constant.SLPCallbackTolerance)
+	if res.Err() != nil {
+		return false, res.Err()
 	}
 
-	return nil
+	return res.Val(), nil
 }
 
-func (r *userRepo) GetSessionKeyRedis(ctx context.Context, key string) ([]string, error) {
-	keys := make([]string, 0)
+func (r *userRepo) DeleteCallbackNonceRedis(ctx context.Context, nonce string) error {
+	return r.RedisClient.Del(ctx, fmt.Sprintf("%s:%s", constant.SLPCallbackNonceKey, nonce)).Err()
+}
+
+func (r *userRepo) CreatePaymentCallback(ctx context.Context, callback *model.PaymentCallback) (*uuid.UUID, error) {
+	var callbackID *uuid.UUID
+	if err := r.PSQL.QueryRowContext(ctx, CreatePaymentCallbackQuery,
+		callback.TransactionID,
+		callback.OriginalID,
+		callback.IdempotencyKey,
+		callback.CallbackType,
+		callback.TxnID,
+		callback.Nonce,
+		callback.Status,
+		callback.Message,
+		callback.RawBody,
+		callback.Outcome,
+		callback.ResponseStatus,
+		callback.ResponseMessage,
+		callback.ReceivedAt,
+		callback.ProcessedAt).Scan(&callbackID); err != nil {
+		return nil, err
+	}
 
-	iter := r.RedisClient.Scan(ctx, 0, key, 0).Iterator()
-	for iter.Next(ctx) {
-		keys = append(keys, iter.Val())
+	return callbackID, nil
+}
+
+func (r *userRepo) GetOriginalPaymentCallback(ctx context.Context, idempotencyKey string) (*model.PaymentCallback, error) {
+	var callback model.PaymentCallback
+	if err := r.PSQL.QueryRowContext(ctx, GetOriginalPaymentCallbackQuery, idempotencyKey).Scan(
+		&callback.ID,
+		&callback.TransactionID,
+		&callback.OriginalID,
+		&callback.IdempotencyKey,
+		&callback.CallbackType,
+		&callback.TxnID,
+		&callback.Nonce,
+		&callback.Status,
+		&callback.Message,
+		&callback.RawBody,
+		&callback.Outcome,
+		&callback.ResponseStatus,
+		&callback.ResponseMessage,
+		&callback.ReceivedAt,
+		&callback.ProcessedAt); err != nil {
+		return nil, err
 	}
 
-	if err := iter.Err(); err != nil {
-		return keys, err
+	return &callback, nil
+}
+
+func (r *userRepo) GetPaymentCallbackByNonce(ctx context.Context, txnID, nonce string) (*model.PaymentCallback, error) {
+	var callback model.PaymentCallback
+	if err := r.PSQL.QueryRowContext(ctx, GetPaymentCallbackByNonceQuery, txnID, nonce).Scan(
+		&callback.ID,
+		&callback.TransactionID,
+		&callback.OriginalID,
+		&callback.IdempotencyKey,
+		&callback.CallbackType,
+		&callback.TxnID,
+		&callback.Nonce,
+		&callback.Status,
+		&callback.Message,
+		&callback.RawBody,
+		&callback.Outcome,
+		&callback.ResponseStatus,
+		&callback.ResponseMessage,
+		&callback.ReceivedAt,
+		&callback.ProcessedAt); err != nil {
+		return nil, err
 	}
 
-	return keys, nil
+	return &callback, nil
+}
+
+func (r *userRepo) UpdatePaymentCallback(ctx context.Context, callback *model.PaymentCallback) error {
+	if _, err := r.PSQL.ExecContext(ctx, UpdatePaymentCallbackQuery,
+		callback.Outcome,
+		callback.ResponseStatus,
+		callback.ResponseMessage,
+		callback.ProcessedAt,
+		callback.ID); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 func (r *userRepo) InsertNewOTPKeyChangeWalletPin(ctx context.Context, email, otp string) error {
@@ -1778,3 +1868,275 @@ func (r *userRepo) DeleteOTPValueChangeWalletPin(ctx context.Context, email stri
 
 	return value, nil
 }
+
+func (r *userRepo) CreateOrderStatusHistory(ctx context.Context, tx postgre.Transaction, history *model.OrderStatusHistory) error {
+	_, err := tx.ExecContext(ctx, CreateOrderStatusHistoryQuery,
+		history.OrderID,
+		history.FromOrderStatusID,
+		history.ToOrderStatusID,
+		history.Actor,
+		history.ActorID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) GetOrderStatusHistoryByOrderID(ctx context.Context, orderID string) ([]*model.OrderStatusHistory, error) {
+	histories := make([]*model.OrderStatusHistory, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetOrderStatusHistoryByOrderIDQuery, orderID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var history model.OrderStatusHistory
+		if errScan := res.Scan(
+			&history.ID,
+			&history.OrderID,
+			&history.FromOrderStatusID,
+			&history.ToOrderStatusID,
+			&history.Actor,
+			&history.ActorID,
+			&history.CreatedAt); errScan != nil {
+			return nil, errScan
+		}
+		histories = append(histories, &history)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return histories, nil
+}
+
+func (r *userRepo) GetReservedStock(ctx context.Context, tx postgre.Transaction, productDetailID string) (float64, error) {
+	var reserved float64
+	if err := tx.QueryRowContext(ctx, GetReservedStockQuery,
+		productDetailID,
+		constant.StockReservationActive).Scan(&reserved); err != nil {
+		return 0, err
+	}
+
+	return reserved, nil
+}
+
+func (r *userRepo) CreateStockReservation(ctx context.Context, tx postgre.Transaction, reservation *model.StockReservation) error {
+	_, err := tx.ExecContext(ctx, CreateStockReservationQuery,
+		reservation.OrderID,
+		reservation.OrderItemID,
+		reservation.ProductDetailID,
+		reservation.Quantity,
+		reservation.Status,
+		reservation.ExpiredAt)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) ConvertStockReservation(ctx context.Context, tx postgre.Transaction, orderID string) error {
+	if _, err := tx.ExecContext(ctx, ConvertStockReservationQuery, orderID, constant.StockReservationActive); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, UpdateStockReservationStatusQuery,
+		constant.StockReservationConverted,
+		orderID,
+		constant.StockReservationActive); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) CreateUserTOTP(ctx context.Context, userID, secret string) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateUserTOTPQuery, userID, secret); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) ConfirmUserTOTP(ctx context.Context, tx postgre.Transaction, userID string, step int64) (int64, error) {
+	res, err := tx.ExecContext(ctx, ConfirmUserTOTPQuery, step, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *userRepo) DeleteUserTOTP(ctx context.Context, tx postgre.Transaction, userID string) error {
+	if _, err := tx.ExecContext(ctx, DeleteUserTOTPQuery, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) CreateRecoveryCode(ctx context.Context, tx postgre.Transaction, userID, codeHash string) error {
+	if _, err := tx.ExecContext(ctx, CreateRecoveryCodeQuery, userID, codeHash); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) DeleteRecoveryCodes(ctx context.Context, tx postgre.Transaction, userID string) error {
+	if _, err := tx.ExecContext(ctx, DeleteRecoveryCodesQuery, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) GetAccountDeletion(ctx context.Context, userID string) (*body.AccountDeletionResponse, error) {
+	var accountDeletion body.AccountDeletionResponse
+	if err := r.PSQL.QueryRowContext(ctx, GetAccountDeletionQuery, userID).
+		Scan(&accountDeletion.RequestedAt, &accountDeletion.ScheduledAt); err != nil {
+		return nil, err
+	}
+
+	return &accountDeletion, nil
+}
+
+func (r *userRepo) RequestAccountDeletion(ctx context.Context, tx postgre.Transaction, userID string, scheduledAt time.Time) (int64, error) {
+	res, err := tx.ExecContext(ctx, RequestAccountDeletionQuery, userID, scheduledAt)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *userRepo) CancelAccountDeletion(ctx context.Context, userID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, CancelAccountDeletionQuery, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *userRepo) PostponeAccountDeletion(ctx context.Context, tx postgre.Transaction, userID string, scheduledAt time.Time) error {
+	_, err := tx.ExecContext(ctx, PostponeAccountDeletionQuery, userID, scheduledAt)
+	return err
+}
+
+func (r *userRepo) LockUserDueForDeletion(ctx context.Context, tx postgre.Transaction, userID string) error {
+	var id string
+	if err := tx.QueryRowContext(ctx, LockUserDueForDeletionQuery, userID).Scan(&id); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *userRepo) GetOwnedShopIDByUserID(ctx context.Context, tx postgre.Transaction, userID string) (string, error) {
+	var shopID string
+	if err := tx.QueryRowContext(ctx, GetOwnedShopIDByUserIDQuery, userID).Scan(&shopID); err != nil {
+		return "", err
+	}
+
+	return shopID, nil
+}
+
+func (r *userRepo) CountActiveOrdersByUserID(ctx context.Context, tx postgre.Transaction, userID string) (int64, error) {
+	var total int64
+	if err := tx.QueryRowContext(ctx, CountActiveOrdersByUserIDQuery, userID,
+		constant.OrderStatusCompleted,
+		constant.OrderStatusCanceled,
+		constant.OrderStatusRefunded).Scan(&total); err != nil {
+		return 0, err
+	}
+
+	return total, nil
+}
+
+func (r *userRepo) GetAccountsDueForDeletion(ctx context.Context) ([]string, error) {
+	userIDs := make([]string, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetAccountsDueForDeletionQuery)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var userID string
+		if errScan := res.Scan(&userID); errScan != nil {
+			return nil, errScan
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return userIDs, nil
+}
+
+func (r *userRepo) DeleteUserPersonalData(ctx context.Context, tx postgre.Transaction, userID string) error {
+	queries := []string{
+		DeleteUserEmailHistoryQuery,
+		AnonymizeUserAddressesQuery,
+		DeleteUserCartItemsQuery,
+		DeleteUserFavoritesQuery,
+		DeleteUserProductAlertsQuery,
+		DeleteUserSealabsPayQuery,
+		DeleteUserIdentitiesQuery,
+		DeleteRecoveryCodesQuery,
+		DeleteUserTOTPQuery,
+		DeleteUserRolesQuery,
+		DeleteUserShopStaffQuery,
+	}
+
+	for _, query := range queries {
+		if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *userRepo) AnonymizeUser(ctx context.Context, tx postgre.Transaction, userID string) (int64, error) {
+	res, err := tx.ExecContext(ctx, AnonymizeUserQuery, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *userRepo) GetUserDataExport(ctx context.Context, userID string) (*body.UserDataExport, error) {
+	var export body.UserDataExport
+	sections := []struct {
+		query string
+		dest  *json.RawMessage
+	}{
+		{ExportUserProfileQuery, &export.Profile},
+		{ExportUserAddressesQuery, &export.Addresses},
+		{ExportUserOrdersQuery, &export.Orders},
+		{ExportUserReviewsQuery, &export.Reviews},
+		{ExportUserWalletHistoryQuery, &export.WalletHistory},
+		{ExportUserRefundThreadsQuery, &export.RefundThreads},
+	}
+
+	for _, section := range sections {
+		var data []byte
+		if err := r.PSQL.QueryRowContext(ctx, section.query, userID).Scan(&data); err != nil {
+			return nil, err
+		}
+
+		*section.dest = data
+	}
+
+	return &export, nil
+}
@@ -31,6 +31,7 @@ type UseCase interface {
 	GetTransactionByID(ctx context.Context, transactionID string) (*body.GetTransactionByIDResponse, error)
 	GetTransactionByUserID(ctx context.Context, userID string, status int, pgn *pagination.Pagination) (*pagination.Pagination, error)
 	CreateTransaction(ctx context.Context, userID string, requestBody body.CreateTransactionRequest) (string, error)
+	HandlePaymentCallback(ctx context.Context, callbackType, transactionID, rawBody string, requestBody body.SLPCallbackRequest) error
 	UpdateTransaction(ctx context.Context, transactionID string, requestBody body.SLPCallbackRequest) error
 	UpdateTransactionPaymentMethod(ctx context.Context, transactionID, cardNumber string) error
 	UpdateWalletTransaction(ctx context.Context, transactionID string, requestBody body.SLPCallbackRequest) error
@@ -45,7 +46,7 @@ type UseCase interface {
 	GetWalletHistory(ctx context.Context, userID string, pgn *pagination.Pagination) (*pagination.Pagination, error)
 	TopUpWallet(ctx context.Context, userID string, requestBody body.TopUpWalletRequest) (string, error)
 	WalletStepUp(ctx context.Context, userID string, requestBody body.WalletStepUpRequest) (string, error)
-	CreateWalletPayment(ctx context.Context, transactionID string) error
+	CreateWalletPayment(ctx context.Context, transactionID string, mfa bool) error
 	ChangeWalletPinStepUp(ctx context.Context, userID string, requestBody body.ChangeWalletPinStepUpRequest) (string, error)
 	ChangeWalletPinStepUpEmail(ctx context.Context, userID string) error
 	ChangeWalletPinStepUpVerify(ctx context.Context, requestBody body.VerifyOTPRequest, userID string) (string, error)
@@ -53,5 +54,13 @@ type UseCase interface {
 	CreateRefundUser(ctx context.Context, userID string, requestBody body.CreateRefundUserRequest) error
 	GetRefundOrder(ctx context.Context, userID string, refundID string) (*body.GetRefundThreadResponse, error)
 	CreateRefundThreadUser(ctx context.Context, userID string, requestBody *body.CreateRefundThreadRequest) error
-	CompletedRejectedRefund(ctx context.Context) error
+	CompletedRejectedRefund(ctx context.Context) (int64, error)
+	SetupTOTP(ctx context.Context, userID string) (*body.TOTPSetupResponse, error)
+	ConfirmTOTP(ctx context.Context, userID string, requestBody body.TOTPCodeRequest) ([]string, error)
+	DisableTOTP(ctx context.Context, userID string, requestBody body.TOTPCodeRequest) error
+	GetAccountDeletion(ctx context.Context, userID string) (*body.AccountDeletionResponse, error)
+	RequestAccountDeletion(ctx context.Context, userID string, requestBody body.AccountDeletionRequest) (*body.AccountDeletionResponse, error)
+	CancelAccountDeletion(ctx context.Context, userID string) error
+	AnonymizeDeletedAccounts(ctx context.Context) (int64, error)
+	ExportUserData(ctx context.Context, userID string) (*body.UserDataExport, error)
 }
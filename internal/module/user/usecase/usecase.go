@@ -7,14 +7,19 @@ import (
 	"database/sql"
 	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"murakali/config"
 	"murakali/internal/constant"
+	"murakali/internal/ledger"
 	"murakali/internal/model"
 	body2 "murakali/internal/module/location/delivery/body"
 	"murakali/internal/module/user"
 	"murakali/internal/module/user/delivery/body"
+	"murakali/internal/orderstatus"
+	"murakali/internal/session"
+	"murakali/internal/twofactor"
 	"murakali/internal/util"
 	smtp "murakali/pkg/email"
 	"murakali/pkg/httperror"
@@ -22,6 +27,7 @@ import (
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
+	"murakali/pkg/totp"
 	"net/http"
 	"strings"
 	"time"
@@ -32,13 +38,19 @@ import (
 )
 
 type userUC struct {
-	cfg      *config.Config
-	txRepo   *postgre.TxRepo
-	userRepo user.Repository
+	cfg        *config.Config
+	txRepo     *postgre.TxRepo
+	userRepo   user.Repository
+	ledgerRepo ledger.Repository
+	twoFactor  *twofactor.Verifier
+	sessions   *session.Revoker
+	keySet     *jwt.KeySet
 }
 
-func NewUserUseCase(cfg *config.Config, txRepo *postgre.TxRepo, userRepo user.Repository) user.UseCase {
-	return &userUC{cfg: cfg, txRepo: txRepo, userRepo: userRepo}
+func NewUserUseCase(cfg *config.Config, txRepo *postgre.TxRepo, userRepo user.Repository, ledgerRepo ledger.Repository,
+	twoFactor *twofactor.Verifier, sessionRevoker *session.Revoker, keySet *jwt.KeySet) user.UseCase {
+	return &userUC{cfg: cfg, txRepo: txRepo, userRepo: userRepo, ledgerRepo: ledgerRepo, twoFactor: twoFactor, sessions: sessionRevoker,
+		keySet: keySet}
 }
 
 func (u *userUC) CreateAddress(ctx context.Context, userID string, requestBody body.CreateAddressRequest) error {
@@ -262,6 +274,11 @@ func (u *userUC) GetOrderByOrderID(ctx context.Context, orderID string) (*model.
 		return nil, err
 	}
 
+	order.StatusHistory, err = u.userRepo.GetOrderStatusHistoryByOrderID(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
 	totalWeight := 0
 	for _, detail := range order.Detail {
 		totalWeight += int(detail.ProductWeight) * detail.OrderQuantity
@@ -315,17 +332,37 @@ func (u *userUC) ChangeOrderStatus(ctx context.Context, userID string, requestBo
 		return httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
 	}
 
-	if requestBody.OrderStatusID != constant.OrderStatusReceived && requestBody.OrderStatusID != constant.OrderStatusCompleted {
-		return httperror.New(http.StatusBadRequest, response.BadRequestMessage)
-	}
+	return u.changeOrderStatus(ctx, constant.OrderActorBuyer, userID, requestBody)
+}
 
-	err = u.userRepo.ChangeOrderStatus(ctx, requestBody)
+func (u *userUC) changeOrderStatus(ctx context.Context, actor, actorID string, requestBody body.ChangeOrderStatusRequest) error {
+	orderData, err := u.userRepo.GetOrderModelByID(ctx, requestBody.OrderID)
 	if err != nil {
+		if err == sql.ErrNoRows {
+			return httperror.New(http.StatusBadRequest, response.OrderNotExistMessage)
+		}
+		return err
+	}
+
+	if err := orderstatus.ValidateTransition(actor, orderData.OrderStatusID, requestBody.OrderStatusID); err != nil {
 		return err
 	}
 
-	if requestBody.OrderStatusID == constant.OrderStatusCompleted {
-		if err := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		rows, err := u.userRepo.ChangeOrderStatus(ctx, tx, requestBody, orderData.OrderStatusID)
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			return httperror.New(http.StatusConflict, response.OrderStatusChanged)
+		}
+
+		history := orderstatus.NewHistory(orderData.ID, orderData.OrderStatusID, requestBody.OrderStatusID, actor, actorID)
+		if err := u.userRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
+			return err
+		}
+
+		if requestBody.OrderStatusID == constant.OrderStatusCompleted {
 			productUnitSolds, errGet := u.userRepo.GetProductUnitSoldByOrderID(ctx, tx, requestBody.OrderID)
 			if errGet != nil {
 				return errGet
@@ -336,11 +373,12 @@ func (u *userUC) ChangeOrderStatus(ctx context.Context, userID string, requestBo
 					return err
 				}
 			}
-			return nil
-		},
-		); err != nil {
-			return err
 		}
+
+		return nil
+	})
+	if err != nil {
+		return err
 	}
 
 	return nil
@@ -458,20 +496,22 @@ func (u *userUC) DeleteAddressByID(ctx context.Context, userID, addressID string
 	return nil
 }
 
-func (u *userUC) CompletedRejectedRefund(ctx context.Context) error {
+func (u *userUC) CompletedRejectedRefund(ctx context.Context) (int64, error) {
 	orderRefund, err := u.userRepo.GetRejectedRefund(ctx)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
+	var rowsAffected int64
 	for _, refund := range orderRefund {
-		if errUpdate := u.ChangeOrderStatus(ctx, refund.Order.UserID.String(),
+		if errUpdate := u.changeOrderStatus(ctx, constant.OrderActorSystem, "",
 			body.ChangeOrderStatusRequest{OrderID: refund.Order.ID.String(), OrderStatusID: constant.OrderStatusCompleted}); errUpdate != nil {
-			return errUpdate
+			return rowsAffected, errUpdate
 		}
+		rowsAffected++
 	}
 
-	return nil
+	return rowsAffected, nil
 }
 
 func (u *userUC) EditUser(ctx context.Context, userID string, requestBody body.EditUserRequest) (*model.User, error) {
@@ -573,7 +613,7 @@ func (u *userUC) EditEmailUser(ctx context.Context, userID string, requestBody b
 	hashedOTP := fmt.Sprintf("%x", h.Sum(nil))
 
 	if hashedOTP != requestBody.Code {
-		return nil, httperror.New(http.StatusBadRequest, response.OTPIsNotValidMessage)
+		return nil, httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage)
 	}
 
 	userModel, err := u.userRepo.GetUserByID(ctx, userID)
@@ -876,10 +916,10 @@ func (u *userUC) VerifyOTP(ctx context.Context, requestBody body.VerifyOTPReques
 	}
 
 	if value != requestBody.OTP {
-		return "", httperror.New(http.StatusBadRequest, response.OTPIsNotValidMessage)
+		return "", httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage)
 	}
 
-	changePasswordToken, err := jwt.GenerateJWTChangePasswordToken(userInfo.ID.String(), u.cfg)
+	changePasswordToken, err := jwt.GenerateJWTChangePasswordToken(userInfo.ID.String(), u.keySet)
 	if err != nil {
 		return "", err
 	}
@@ -922,18 +962,7 @@ func (u *userUC) ChangePassword(ctx context.Context, userID, newPassword string)
 		return err
 	}
 
-	keys, errKey := u.userRepo.GetSessionKeyRedis(ctx, fmt.Sprintf("session:%s:*", userID))
-	if errKey != nil {
-		return errKey
-	}
-
-	for _, key := range keys {
-		if err := u.userRepo.InsertSessionRedis(ctx, u.cfg.JWT.AccessExpMin, key, constant.FALSE); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return u.sessions.RevokeUserSessions(ctx, userID)
 }
 
 func (u *userUC) TopUpWallet(ctx context.Context, userID string, requestBody body.TopUpWalletRequest) (string, error) {
@@ -957,7 +986,7 @@ func (u *userUC) TopUpWallet(ctx context.Context, userID string, requestBody bod
 		transaction := &model.Transaction{}
 		transaction.WalletID = &wallet.ID
 		transaction.CardNumber = &card.CardNumber
-		transaction.TotalPrice = float64(requestBody.Amount)
+		transaction.TotalPrice = model.Money(requestBody.Amount)
 		transaction.ExpiredAt.Valid = true
 		transaction.ExpiredAt.Time = time.Now().Add(time.Hour * 24)
 
@@ -998,7 +1027,7 @@ func (u *userUC) CreateSLPPayment(ctx context.Context, transactionID string) (st
 		return "", httperror.New(http.StatusBadRequest, response.InvalidPaymentMethod)
 	}
 
-	signFormat := fmt.Sprintf("%s:%d:%s", *transaction.CardNumber, int(transaction.TotalPrice), u.cfg.External.SlpMerchantCode)
+	signFormat := fmt.Sprintf("%s:%d:%s", *transaction.CardNumber, transaction.TotalPrice.Int64(), u.cfg.External.SlpMerchantCode)
 	h := hmac.New(sha256.New, []byte(u.cfg.External.SlpAPIKey))
 	h.Write([]byte(signFormat))
 	sign := hex.EncodeToString(h.Sum(nil))
@@ -1011,7 +1040,7 @@ func (u *userUC) CreateSLPPayment(ctx context.Context, transactionID string) (st
 	return redirectURL, nil
 }
 
-func (u *userUC) CreateWalletPayment(ctx context.Context, transactionID string) error {
+func (u *userUC) CreateWalletPayment(ctx context.Context, transactionID string, mfa bool) error {
 	transaction, err := u.userRepo.GetTransactionByID(ctx, transactionID)
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -1042,6 +1071,17 @@ func (u *userUC) CreateWalletPayment(ctx context.Context, transactionID string)
 		return httperror.New(http.StatusBadRequest, response.WalletBalanceNotEnough)
 	}
 
+	if !mfa && transaction.TotalPrice >= constant.WalletTOTPThreshold {
+		enabled, errTOTP := u.twoFactor.IsEnabled(ctx, wallet.UserID.String())
+		if errTOTP != nil {
+			return errTOTP
+		}
+
+		if enabled {
+			return httperror.New(http.StatusForbidden, response.TOTPRequiredMessage)
+		}
+	}
+
 	orders, err := u.userRepo.GetOrderByTransactionID(ctx, transactionID)
 	if err != nil {
 		return err
@@ -1055,10 +1095,24 @@ func (u *userUC) CreateWalletPayment(ctx context.Context, transactionID string)
 		}
 
 		for _, order := range orders {
+			if errStatus := orderstatus.ValidateTransition(constant.OrderActorSystem, order.OrderStatusID,
+				constant.OrderStatusWaitingForSeller); errStatus != nil {
+				return errStatus
+			}
+
+			history := orderstatus.NewHistory(order.ID, order.OrderStatusID, constant.OrderStatusWaitingForSeller, constant.OrderActorSystem, "")
 			order.OrderStatusID = constant.OrderStatusWaitingForSeller
 			if errOrder := u.userRepo.UpdateOrder(ctx, tx, order); errOrder != nil {
 				return errOrder
 			}
+
+			if errHistory := u.userRepo.CreateOrderStatusHistory(ctx, tx, history); errHistory != nil {
+				return errHistory
+			}
+
+			if errReservation := u.userRepo.ConvertStockReservation(ctx, tx, order.ID.String()); errReservation != nil {
+				return errReservation
+			}
 		}
 
 		walletHistory := &model.WalletHistory{}
@@ -1106,7 +1160,7 @@ func (u *userUC) GetRedirectURL(transaction *model.Transaction, sign string) (st
 	payload := fmt.Sprintf(
 		"card_number=%s&amount=%d&merchant_code=%s&redirect_url=%s&callback_url=%s&signature=%s",
 		*transaction.CardNumber,
-		int(transaction.TotalPrice),
+		transaction.TotalPrice.Int64(),
 		u.cfg.External.SlpMerchantCode,
 		"https://www.google.com",
 		callbackURL,
@@ -1215,20 +1269,132 @@ func (u *userUC) GetTransactionByID(ctx context.Context, transactionID string) (
 	return res, nil
 }
 
-func (u *userUC) UpdateTransaction(ctx context.Context, transactionID string, requestBody body.SLPCallbackRequest) error {
-	transaction, err := u.userRepo.GetTransactionByID(ctx, transactionID)
+func (u *userUC) HandlePaymentCallback(ctx context.Context, callbackType, transactionID, rawBody string,
+	requestBody body.SLPCallbackRequest) error {
+	id, err := uuid.Parse(transactionID)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return httperror.New(http.StatusBadRequest, response.TransactionIDNotExist)
+		return httperror.New(http.StatusBadRequest, response.TransactionIDNotExist)
+	}
+
+	callback := &model.PaymentCallback{
+		TransactionID:  id,
+		IdempotencyKey: fmt.Sprintf("%s:%s:%s:%s", callbackType, transactionID, requestBody.TxnID, requestBody.Status),
+		CallbackType:   callbackType,
+		TxnID:          requestBody.TxnID,
+		Nonce:          requestBody.Nonce,
+		Status:         requestBody.Status,
+		Message:        requestBody.Message,
+		RawBody:        rawBody,
+		Outcome:        constant.PaymentCallbackProcessing,
+		ReceivedAt:     time.Now(),
+	}
+
+	original, err := u.userRepo.GetPaymentCallbackByNonce(ctx, requestBody.TxnID, requestBody.Nonce)
+	if err == nil {
+		return u.replayPaymentCallback(ctx, callback, original)
+	}
+
+	if err != sql.ErrNoRows {
+		return err
+	}
+
+	if requestBody.IsExpired() {
+		return httperror.New(http.StatusUnprocessableEntity, body.CallbackExpiredMessage)
+	}
+
+	callbackID, err := u.userRepo.CreatePaymentCallback(ctx, callback)
+	if err != nil {
+		if err != sql.ErrNoRows {
+			return err
+		}
+
+		original, err = u.userRepo.GetOriginalPaymentCallback(ctx, callback.IdempotencyKey)
+		if err != nil {
+			return err
+		}
+
+		return u.replayPaymentCallback(ctx, callback, original)
+	}
+	callback.ID = *callbackID
+
+	if callbackType == constant.PaymentCallbackWallet {
+		err = u.UpdateWalletTransaction(ctx, transactionID, requestBody)
+	} else {
+		err = u.UpdateTransaction(ctx, transactionID, requestBody)
+	}
+
+	responseStatus := http.StatusOK
+	callback.Outcome = constant.PaymentCallbackProcessed
+	callback.ResponseMessage = "success"
+	if err != nil {
+		var e *httperror.Error
+		if errors.As(err, &e) {
+			responseStatus = e.Status
+			callback.Outcome = constant.PaymentCallbackRejected
+			callback.ResponseMessage = e.Err.Error()
+		} else {
+			responseStatus = http.StatusInternalServerError
+			callback.Outcome = constant.PaymentCallbackError
+			callback.ResponseMessage = response.InternalServerErrorMessage
 		}
+	}
+
+	callback.ResponseStatus = &responseStatus
+	callback.ProcessedAt.Valid = true
+	callback.ProcessedAt.Time = time.Now()
+	if errCallback := u.userRepo.UpdatePaymentCallback(ctx, callback); errCallback != nil {
+		return errCallback
+	}
+
+	if callback.Outcome == constant.PaymentCallbackError {
+		if errNonce := u.userRepo.DeleteCallbackNonceRedis(ctx, requestBody.Nonce); errNonce != nil {
+			return errNonce
+		}
+	}
+
+	return err
+}
 
+func (u *userUC) replayPaymentCallback(ctx context.Context, callback, original *model.PaymentCallback) error {
+	callback.OriginalID = &original.ID
+	callback.Outcome = constant.PaymentCallbackDuplicate
+	callback.ResponseStatus = original.ResponseStatus
+	callback.ResponseMessage = original.ResponseMessage
+	callback.ProcessedAt.Valid = true
+	callback.ProcessedAt.Time = time.Now()
+	if _, errCallback := u.userRepo.CreatePaymentCallback(ctx, callback); errCallback != nil {
+		return errCallback
+	}
+
+	if original.ResponseStatus == nil {
+		return httperror.New(http.StatusConflict, response.CallbackStillProcessing)
+	}
+
+	if *original.ResponseStatus != http.StatusOK {
+		return httperror.New(*original.ResponseStatus, original.ResponseMessage)
+	}
+
+	return nil
+}
+
+func (u *userUC) UpdateTransaction(ctx context.Context, transactionID string, requestBody body.SLPCallbackRequest) error {
+	transaction, err := u.getSLPCallbackTransaction(ctx, transactionID, requestBody)
+	if err != nil {
 		return err
 	}
 
+	if transaction.WalletID != nil {
+		return httperror.New(http.StatusBadRequest, response.InvalidPaymentMethod)
+	}
+
 	if transaction.PaidAt.Valid || transaction.CanceledAt.Valid {
 		return httperror.New(http.StatusBadRequest, response.TransactionAlreadyFinished)
 	}
 
+	if transaction.ExpiredAt.Valid && time.Until(transaction.ExpiredAt.Time) < 0 {
+		return httperror.New(http.StatusBadRequest, response.TransactionAlreadyExpired)
+	}
+
 	orders, err := u.userRepo.GetOrderByTransactionID(ctx, transactionID)
 	if err != nil {
 		return err
@@ -1243,10 +1409,24 @@ func (u *userUC) UpdateTransaction(ctx context.Context, transactionID string, re
 			}
 
 			for _, order := range orders {
+				if err := orderstatus.ValidateTransition(constant.OrderActorSystem, order.OrderStatusID,
+					constant.OrderStatusWaitingForSeller); err != nil {
+					return err
+				}
+
+				history := orderstatus.NewHistory(order.ID, order.OrderStatusID, constant.OrderStatusWaitingForSeller, constant.OrderActorSystem, "")
 				order.OrderStatusID = constant.OrderStatusWaitingForSeller
 				if err := u.userRepo.UpdateOrder(ctx, tx, order); err != nil {
 					return err
 				}
+
+				if err := u.userRepo.CreateOrderStatusHistory(ctx, tx, history); err != nil {
+					return err
+				}
+
+				if err := u.userRepo.ConvertStockReservation(ctx, tx, order.ID.String()); err != nil {
+					return err
+				}
 			}
 
 			if err := u.CreditToMarketplaceAccount(ctx, tx, transaction); err != nil {
@@ -1296,15 +1476,15 @@ func (u *userUC) UpdateTransactionPaymentMethod(ctx context.Context, transaction
 }
 
 func (u *userUC) UpdateWalletTransaction(ctx context.Context, transactionID string, requestBody body.SLPCallbackRequest) error {
-	transaction, err := u.userRepo.GetTransactionByID(ctx, transactionID)
+	transaction, err := u.getSLPCallbackTransaction(ctx, transactionID, requestBody)
 	if err != nil {
-		if err == sql.ErrNoRows {
-			return httperror.New(http.StatusBadRequest, response.TransactionIDNotExist)
-		}
-
 		return err
 	}
 
+	if transaction.WalletID == nil {
+		return httperror.New(http.StatusBadRequest, response.InvalidPaymentMethod)
+	}
+
 	wallet, err := u.userRepo.GetWalletUser(ctx, transaction.WalletID.String())
 	if err != nil {
 		if err == sql.ErrNoRows {
@@ -1361,6 +1541,12 @@ func (u *userUC) UpdateWalletTransaction(ctx context.Context, transactionID stri
 			if err := u.userRepo.UpdateWalletBalance(ctx, tx, wallet); err != nil {
 				return err
 			}
+
+			ledgerTx := ledger.NewTransaction(constant.LedgerReferenceTopUp, walletHistory.Description, &transaction.ID)
+			ledger.Transfer(ledgerTx, ledger.ExternalAccount(), ledger.WalletAccount(wallet.ID), transaction.TotalPrice)
+			if err := ledger.Post(ctx, tx, u.ledgerRepo, ledgerTx); err != nil {
+				return err
+			}
 			return nil
 		})
 
@@ -1372,6 +1558,37 @@ func (u *userUC) UpdateWalletTransaction(ctx context.Context, transactionID stri
 	return nil
 }
 
+func (u *userUC) getSLPCallbackTransaction(ctx context.Context, transactionID string,
+	requestBody body.SLPCallbackRequest) (*model.Transaction, error) {
+	firstDelivery, err := u.userRepo.InsertCallbackNonceRedis(ctx, requestBody.Nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	if !firstDelivery {
+		return nil, httperror.New(http.StatusBadRequest, response.CallbackAlreadyReceived)
+	}
+
+	transaction, err := u.userRepo.GetTransactionByID(ctx, transactionID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.TransactionIDNotExist)
+		}
+
+		return nil, err
+	}
+
+	if requestBody.TxnID != transaction.ID.String() && (transaction.Invoice == nil || requestBody.TxnID != *transaction.Invoice) {
+		return nil, httperror.New(http.StatusBadRequest, response.CallbackTransactionNotMatch)
+	}
+
+	if requestBody.Amount != transaction.TotalPrice.String() {
+		return nil, httperror.New(http.StatusBadRequest, response.CallbackAmountNotMatch)
+	}
+
+	return transaction, nil
+}
+
 func (u *userUC) CreditToMarketplaceAccount(ctx context.Context, tx postgre.Transaction, transaction *model.Transaction) error {
 	walletMarketplace, err := u.userRepo.GetWalletByUserID(ctx, constant.AdminMarketplaceID)
 	if err != nil {
@@ -1406,6 +1623,17 @@ func (u *userUC) CreditToMarketplaceAccount(ctx context.Context, tx postgre.Tran
 		return err
 	}
 
+	from := ledger.ExternalAccount()
+	if transaction.WalletID != nil {
+		from = ledger.WalletAccount(*transaction.WalletID)
+	}
+
+	ledgerTx := ledger.NewTransaction(constant.LedgerReferencePayment, walletHistory.Description, &transaction.ID)
+	ledger.Transfer(ledgerTx, from, ledger.EscrowAccount(walletMarketplace.ID), transaction.TotalPrice)
+	if err := ledger.Post(ctx, tx, u.ledgerRepo, ledgerTx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -1514,7 +1742,7 @@ func (u *userUC) WalletStepUp(ctx context.Context, userID string, requestBody bo
 		return "", err
 	}
 
-	if wallet.Balance-float64(requestBody.Amount) < 0 {
+	if wallet.Balance-model.Money(requestBody.Amount) < 0 {
 		return "", httperror.New(http.StatusBadRequest, response.WalletBalanceNotEnough)
 	}
 
@@ -1553,10 +1781,28 @@ func (u *userUC) WalletStepUp(ctx context.Context, userID string, requestBody bo
 	}
 
 	if invalidPin {
-		return "", httperror.New(http.StatusBadRequest, response.WalletPinIsInvalid)
+		return "", httperror.New(http.StatusForbidden, response.WalletPinIsInvalid)
+	}
+
+	mfa := false
+	if requestBody.TOTPCode != "" {
+		if err := u.twoFactor.Verify(ctx, userID, requestBody.TOTPCode, ""); err != nil {
+			return "", err
+		}
+
+		mfa = true
+	} else if requestBody.Amount >= constant.WalletTOTPThreshold {
+		enabled, err := u.twoFactor.IsEnabled(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+
+		if enabled {
+			return "", httperror.New(http.StatusBadRequest, response.TOTPRequiredMessage)
+		}
 	}
 
-	walletToken, err := jwt.GenerateJWTWalletToken(userID, "level1", u.cfg)
+	walletToken, err := jwt.GenerateJWTWalletToken(userID, "level1", mfa, u.keySet)
 	if err != nil {
 		return "", err
 	}
@@ -1573,16 +1819,24 @@ func (u *userUC) ChangeWalletPinStepUp(ctx context.Context, userID string, reque
 		return "", err
 	}
 
+	if requestBody.TOTPCode != "" {
+		if err := u.twoFactor.Verify(ctx, wallet.UserID.String(), requestBody.TOTPCode, ""); err != nil {
+			return "", err
+		}
+
+		return jwt.GenerateJWTWalletToken(userID, "level2", true, u.keySet)
+	}
+
 	userModel, err := u.userRepo.GetUserPasswordByID(ctx, wallet.UserID.String())
 	if err != nil {
 		return "", err
 	}
 
 	if bcrypt.CompareHashAndPassword([]byte(*userModel.Password), []byte(requestBody.Password)) != nil {
-		return "", httperror.New(http.StatusBadRequest, response.InvalidPasswordMessage)
+		return "", httperror.New(http.StatusForbidden, response.InvalidPasswordMessage)
 	}
 
-	walletToken, err := jwt.GenerateJWTWalletToken(userID, "level2", u.cfg)
+	walletToken, err := jwt.GenerateJWTWalletToken(userID, "level2", false, u.keySet)
 	if err != nil {
 		return "", err
 	}
@@ -1667,7 +1921,7 @@ func (u *userUC) CreateTransaction(ctx context.Context, userID string, requestBo
 	promotionList := make([]*model.Promotion, 0)
 
 	data, err := u.txRepo.WithTransactionReturnData(func(tx postgre.Transaction) (interface{}, error) {
-		var totalDeliveryFee float64
+		var totalDeliveryFee model.Money
 		if len(requestBody.CartItems) == 0 {
 			return nil, httperror.New(http.StatusBadRequest, response.CartIsEmpty)
 		}
@@ -1749,7 +2003,15 @@ func (u *userUC) CreateTransaction(ctx context.Context, userID string, requestBo
 					}
 				}
 				totalQuantity := qtyTotalProduct[productDetailData.ProductID.String()]
-				subPrice := productDetailData.Price
+				unitPrice := productDetailData.Price
+				if productDetailData.BulkPrice {
+					priceTiers, errTier := u.userRepo.GetPriceTiers(ctx, tx, productDetailData.ID.String())
+					if errTier != nil {
+						return nil, errTier
+					}
+					unitPrice = util.TierPrice(productDetailData.Price, priceTiers, bodyProductDetail.Quantity)
+				}
+				subPrice := unitPrice
 
 				if (totalQuantity <= promo.MaxQuantity) && (totalQuantity <= promo.Quota) && (promo.ID != uuid.Nil) {
 					DiscountPromotion := &model.Discount{
@@ -1758,14 +2020,19 @@ func (u *userUC) CreateTransaction(ctx context.Context, userID string, requestBo
 						MinProductPrice:    promo.MinProductPrice,
 						MaxDiscountPrice:   promo.MaxDiscountPrice,
 					}
-					_, subPrice = util.CalculateDiscount(productDetailData.Price, DiscountPromotion)
+					_, subPrice = util.CalculateDiscount(unitPrice, DiscountPromotion)
 					if promotionMap[promo.ID.String()] == 0 {
 						promotionList = append(promotionList, promo)
 					}
 					promotionMap[promo.ID.String()] = 1
 				}
-				totalPrice := subPrice * float64(bodyProductDetail.Quantity)
-				if int(productDetailData.Stock)-bodyProductDetail.Quantity < 0 {
+				totalPrice := subPrice * model.Money(bodyProductDetail.Quantity)
+				reservedStock, err := u.userRepo.GetReservedStock(ctx, tx, productDetailData.ID.String())
+				if err != nil {
+					return nil, err
+				}
+
+				if int(productDetailData.Stock-reservedStock)-bodyProductDetail.Quantity < 0 {
 					isAvail = false
 					errCart := u.userRepo.DeleteCartItemByID(ctx, tx, cartData)
 					if errCart != nil {
@@ -1892,14 +2159,22 @@ func (u *userUC) CreateTransaction(ctx context.Context, userID string, requestBo
 
 			for _, i := range o.Items {
 				i.Item.OrderID = *orderID
-				_, errItem := u.userRepo.CreateOrderItem(ctx, tx, i.Item)
+				orderItemID, errItem := u.userRepo.CreateOrderItem(ctx, tx, i.Item)
 				if errItem != nil {
 					return nil, errItem
 				}
-				i.ProductDetailData.Stock -= i.CartItemData.Quantity
-				errProduct := u.userRepo.UpdateProductDetailStock(ctx, tx, i.ProductDetailData)
-				if errProduct != nil {
-					return nil, errProduct
+
+				reservation := &model.StockReservation{
+					OrderID:         *orderID,
+					OrderItemID:     orderItemID,
+					ProductDetailID: i.ProductDetailData.ID,
+					Quantity:        i.Item.Quantity,
+					Status:          constant.StockReservationActive,
+					ExpiredAt:       transactionData.ExpiredAt.Time,
+				}
+				errReservation := u.userRepo.CreateStockReservation(ctx, tx, reservation)
+				if errReservation != nil {
+					return nil, errReservation
 				}
 				errCart := u.userRepo.DeleteCartItemByID(ctx, tx, i.CartItemData)
 				if errCart != nil {
@@ -2163,10 +2438,10 @@ func (u *userUC) ChangeWalletPinStepUpVerify(ctx context.Context, requestBody bo
 	}
 
 	if value != requestBody.OTP {
-		return "", httperror.New(http.StatusBadRequest, response.OTPIsNotValidMessage)
+		return "", httperror.New(http.StatusForbidden, response.OTPIsNotValidMessage)
 	}
 
-	changeWalletPinToken, err := jwt.GenerateJWTWalletToken(userID, "level2", u.cfg)
+	changeWalletPinToken, err := jwt.GenerateJWTWalletToken(userID, "level2", false, u.keySet)
 	if err != nil {
 		return "", err
 	}
@@ -2195,3 +2470,290 @@ func (u *userUC) SendOTPChangeWalletPinEmail(ctx context.Context, email string)
 
 	return nil
 }
+
+func (u *userUC) SetupTOTP(ctx context.Context, userID string) (*body.TOTPSetupResponse, error) {
+	userModel, err := u.userRepo.GetUserByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusUnauthorized, response.UnauthorizedMessage)
+		}
+
+		return nil, err
+	}
+
+	enabled, err := u.twoFactor.IsEnabled(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if enabled {
+		return nil, httperror.New(http.StatusBadRequest, response.TOTPAlreadyEnabledMessage)
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.userRepo.CreateUserTOTP(ctx, userID, secret); err != nil {
+		return nil, err
+	}
+
+	return &body.TOTPSetupResponse{
+		Secret:          secret,
+		ProvisioningURI: totp.ProvisioningURI(constant.TOTPIssuer, userModel.Email, secret),
+	}, nil
+}
+
+func (u *userUC) ConfirmTOTP(ctx context.Context, userID string, requestBody body.TOTPCodeRequest) ([]string, error) {
+	userTOTP, err := u.twoFactor.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if userTOTP.ConfirmedAt.Valid {
+		return nil, httperror.New(http.StatusBadRequest, response.TOTPAlreadyEnabledMessage)
+	}
+
+	step, ok := totp.Validate(userTOTP.Secret, requestBody.Code, time.Now())
+	if !ok {
+		return nil, twofactor.ErrInvalidCode
+	}
+
+	recoveryCodes, err := totp.GenerateRecoveryCodes(constant.TOTPRecoveryCodes)
+	if err != nil {
+		return nil, err
+	}
+
+	hashedCodes := make([]string, 0, len(recoveryCodes))
+	for _, code := range recoveryCodes {
+		hashedCode, errHash := bcrypt.GenerateFromPassword([]byte(code), bcrypt.DefaultCost)
+		if errHash != nil {
+			return nil, errHash
+		}
+
+		hashedCodes = append(hashedCodes, string(hashedCode))
+	}
+
+	err = u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		rows, errConfirm := u.userRepo.ConfirmUserTOTP(ctx, tx, userID, step)
+		if errConfirm != nil {
+			return errConfirm
+		}
+
+		if rows == 0 {
+			return httperror.New(http.StatusBadRequest, response.TOTPAlreadyEnabledMessage)
+		}
+
+		if errDelete := u.userRepo.DeleteRecoveryCodes(ctx, tx, userID); errDelete != nil {
+			return errDelete
+		}
+
+		for _, hashedCode := range hashedCodes {
+			if errCode := u.userRepo.CreateRecoveryCode(ctx, tx, userID, hashedCode); errCode != nil {
+				return errCode
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return recoveryCodes, nil
+}
+
+func (u *userUC) DisableTOTP(ctx context.Context, userID string, requestBody body.TOTPCodeRequest) error {
+	if err := u.twoFactor.Verify(ctx, userID, requestBody.Code, requestBody.RecoveryCode); err != nil {
+		return err
+	}
+
+	return u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		if err := u.userRepo.DeleteRecoveryCodes(ctx, tx, userID); err != nil {
+			return err
+		}
+
+		return u.userRepo.DeleteUserTOTP(ctx, tx, userID)
+	})
+}
+
+func (u *userUC) GetAccountDeletion(ctx context.Context, userID string) (*body.AccountDeletionResponse, error) {
+	accountDeletion, err := u.userRepo.GetAccountDeletion(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return nil, err
+	}
+
+	if accountDeletion.ScheduledAt == nil {
+		return nil, httperror.New(http.StatusNotFound, response.AccountDeletionNotFoundMessage)
+	}
+
+	return accountDeletion, nil
+}
+
+func (u *userUC) RequestAccountDeletion(ctx context.Context, userID string,
+	requestBody body.AccountDeletionRequest) (*body.AccountDeletionResponse, error) {
+	userModel, err := u.userRepo.GetUserPasswordByID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return nil, err
+	}
+
+	if userModel.Password != nil && *userModel.Password != "" {
+		if bcrypt.CompareHashAndPassword([]byte(*userModel.Password), []byte(requestBody.Password)) != nil {
+			return nil, httperror.New(http.StatusBadRequest, response.InvalidPasswordMessage)
+		}
+	}
+
+	gracePeriod, err := time.ParseDuration(constant.AccountDeletionGracePeriod)
+	if err != nil {
+		return nil, err
+	}
+
+	requestedAt := time.Now()
+	scheduledAt := requestedAt.Add(gracePeriod)
+	errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+		blocker, err := u.getAccountDeletionBlocker(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+
+		if blocker != "" {
+			return httperror.New(http.StatusBadRequest, blocker)
+		}
+
+		rows, err := u.userRepo.RequestAccountDeletion(ctx, tx, userID, scheduledAt)
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return httperror.New(http.StatusBadRequest, response.AccountDeletionRequestedMessage)
+		}
+
+		return nil
+	})
+	if errTx != nil {
+		return nil, errTx
+	}
+
+	subject := "Account Deletion Requested"
+	msg := smtp.AccountDeletionScheduledBody(scheduledAt.Format("02 January 2006 15:04 MST"))
+	go smtp.SendEmail(u.cfg, userModel.Email, subject, msg)
+
+	return &body.AccountDeletionResponse{
+		RequestedAt: &requestedAt,
+		ScheduledAt: &scheduledAt,
+	}, nil
+}
+
+func (u *userUC) CancelAccountDeletion(ctx context.Context, userID string) error {
+	rows, err := u.userRepo.CancelAccountDeletion(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if rows == 0 {
+		return httperror.New(http.StatusNotFound, response.AccountDeletionNotFoundMessage)
+	}
+
+	return nil
+}
+
+func (u *userUC) AnonymizeDeletedAccounts(ctx context.Context) (int64, error) {
+	userIDs, err := u.userRepo.GetAccountsDueForDeletion(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	retryDelay, err := time.ParseDuration(constant.AccountDeletionRetryDelay)
+	if err != nil {
+		return 0, err
+	}
+
+	var rowsAffected int64
+	for _, userID := range userIDs {
+		skipped := false
+		errTx := u.txRepo.WithTransaction(func(tx postgre.Transaction) error {
+			if err := u.userRepo.LockUserDueForDeletion(ctx, tx, userID); err != nil {
+				if err == sql.ErrNoRows {
+					skipped = true
+					return nil
+				}
+
+				return err
+			}
+
+			blocker, err := u.getAccountDeletionBlocker(ctx, tx, userID)
+			if err != nil {
+				return err
+			}
+
+			if blocker != "" {
+				skipped = true
+				return u.userRepo.PostponeAccountDeletion(ctx, tx, userID, time.Now().Add(retryDelay))
+			}
+
+			if err := u.userRepo.DeleteUserPersonalData(ctx, tx, userID); err != nil {
+				return err
+			}
+
+			rows, err := u.userRepo.AnonymizeUser(ctx, tx, userID)
+			if err != nil {
+				return err
+			}
+
+			rowsAffected += rows
+			return nil
+		})
+		if errTx != nil {
+			return rowsAffected, errTx
+		}
+
+		if skipped {
+			continue
+		}
+
+		if err := u.sessions.RevokeUserSessions(ctx, userID); err != nil {
+			return rowsAffected, err
+		}
+	}
+
+	return rowsAffected, nil
+}
+
+func (u *userUC) getAccountDeletionBlocker(ctx context.Context, tx postgre.Transaction, userID string) (string, error) {
+	if _, err := u.userRepo.GetOwnedShopIDByUserID(ctx, tx, userID); err == nil {
+		return response.AccountDeletionShopOwnerMessage, nil
+	} else if err != sql.ErrNoRows {
+		return "", err
+	}
+
+	activeOrders, err := u.userRepo.CountActiveOrdersByUserID(ctx, tx, userID)
+	if err != nil {
+		return "", err
+	}
+
+	if activeOrders > 0 {
+		return response.AccountDeletionActiveOrderMessage, nil
+	}
+
+	return "", nil
+}
+
+func (u *userUC) ExportUserData(ctx context.Context, userID string) (*body.UserDataExport, error) {
+	export, err := u.userRepo.GetUserDataExport(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, httperror.New(http.StatusBadRequest, response.UserNotExistMessage)
+		}
+		return nil, err
+	}
+
+	return export, nil
+}
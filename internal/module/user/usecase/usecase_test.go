@@ -7,13 +7,22 @@ import (
 	"fmt"
 	"murakali/config"
 	"murakali/internal/constant"
+	ledgerMocks "murakali/internal/ledger/mocks"
 	"murakali/internal/model"
 	body2 "murakali/internal/module/location/delivery/body"
 	"murakali/internal/module/user/delivery/body"
 	"murakali/internal/module/user/mocks"
+	"murakali/internal/session"
+	sessionMocks "murakali/internal/session/mocks"
+	"murakali/internal/twofactor"
+	twoFactorMocks "murakali/internal/twofactor/mocks"
+	"murakali/pkg/httperror"
+	"murakali/pkg/jwt"
 	"murakali/pkg/pagination"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
+	"murakali/pkg/totp"
+	"net/http"
 	"testing"
 	"time"
 
@@ -21,6 +30,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
 )
 
 func TestUserUC_CreateAddress(t *testing.T) {
@@ -131,7 +141,7 @@ func TestUserUC_CreateAddress(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.CreateAddress(context.Background(), tc.userID, tc.body)
@@ -286,7 +296,7 @@ func Test_userUC_UpdateAddressByID(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateAddressByID(context.Background(), tc.userID, tc.addressID, tc.body)
@@ -434,7 +444,7 @@ func Test_userUC_GetAddress(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAddress(context.Background(), tc.userID, tc.pgn, tc.queryRequest)
@@ -493,7 +503,7 @@ func Test_userUC_GetOrder(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetOrder(context.Background(), tc.userID, tc.orderStatusID, tc.pgn)
@@ -669,6 +679,7 @@ func Test_userUC_GetOrderByOrderID(t *testing.T) {
 				}, nil)
 				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return("buyer", nil)
 				r.On("GetSellerIDByOrderID", mock.Anything, mock.Anything).Return("seller", nil)
+				r.On("GetOrderStatusHistoryByOrderID", mock.Anything, mock.Anything).Return([]*model.OrderStatusHistory{}, nil)
 				r.On("GetCostRedis", mock.Anything, mock.Anything).Return(&tempString, nil)
 				// r.On("GetCostRajaOngkir", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(&tempRajaOngkir, nil)
 				// r.On("InsertCostRedis", mock.Anything, mock.Anything, mock.Anything).Return(nil)
@@ -679,7 +690,7 @@ func Test_userUC_GetOrderByOrderID(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetOrderByOrderID(context.Background(), tc.orderID)
@@ -713,7 +724,9 @@ func Test_userUC_ChangeOrderStatus(t *testing.T) {
 				}
 				tempProductSold = append(tempProductSold, tempBody)
 				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
-				r.On("ChangeOrderStatus", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetProductUnitSoldByOrderID", mock.Anything, mock.Anything, mock.Anything).Return(tempProductSold, nil)
 				r.On("UpdateProductUnitSold", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
@@ -739,17 +752,18 @@ func Test_userUC_ChangeOrderStatus(t *testing.T) {
 			expectedErr: errors.New("Invalid Credentials."),
 		},
 		{
-			name:   "Error Order Status ID is not 7 and 6",
+			name:   "Error Order Status Transition Not Allowed",
 			userID: "123456",
 			requestBody: body.ChangeOrderStatusRequest{
 				OrderID:       "123",
-				OrderStatusID: 1,
+				OrderStatusID: 7,
 			},
 			mock: func(t *testing.T, r *mocks.Repository) {
 				tempBuyerID := "123456"
 				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusOnDelivery}, nil)
 			},
-			expectedErr: errors.New("Invalid request."),
+			expectedErr: errors.New("Order status cannot be changed from on delivery to completed."),
 		},
 		{
 			name:   "Error ChangeOrderStatus",
@@ -761,10 +775,26 @@ func Test_userUC_ChangeOrderStatus(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				tempBuyerID := "123456"
 				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
-				r.On("ChangeOrderStatus", mock.Anything, mock.Anything).Return(errors.New("test"))
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(0), errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
 		},
+		{
+			name:   "Error ChangeOrderStatus changed concurrently",
+			userID: "123456",
+			requestBody: body.ChangeOrderStatusRequest{
+				OrderID:       "123",
+				OrderStatusID: 7,
+			},
+			mock: func(t *testing.T, r *mocks.Repository) {
+				tempBuyerID := "123456"
+				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, constant.OrderStatusReceived).Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusConflict, response.OrderStatusChanged),
+		},
 		{
 			name:   "Error GetProductUnitSoldByOrderID",
 			userID: "123456",
@@ -775,7 +805,9 @@ func Test_userUC_ChangeOrderStatus(t *testing.T) {
 			mock: func(t *testing.T, r *mocks.Repository) {
 				tempBuyerID := "123456"
 				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
-				r.On("ChangeOrderStatus", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetProductUnitSoldByOrderID", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
@@ -795,7 +827,9 @@ func Test_userUC_ChangeOrderStatus(t *testing.T) {
 				}
 				tempProductSold = append(tempProductSold, tempBody)
 				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
-				r.On("ChangeOrderStatus", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetProductUnitSoldByOrderID", mock.Anything, mock.Anything, mock.Anything).Return(tempProductSold, nil)
 				r.On("UpdateProductUnitSold", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
 			},
@@ -807,7 +841,7 @@ func Test_userUC_ChangeOrderStatus(t *testing.T) {
 			sql, mock, _ := sqlmock.New()
 			mock.ExpectBegin()
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.ChangeOrderStatus(context.Background(), tc.userID, tc.requestBody)
@@ -869,7 +903,7 @@ func Test_userUC_GetTransactionDetailByID(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetTransactionDetailByID(context.Background(), tc.transactionID, tc.userID)
@@ -941,7 +975,7 @@ func Test_userUC_GetAddressByID(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetAddressByID(context.Background(), tc.userID, tc.addressID)
@@ -1035,7 +1069,7 @@ func Test_userUC_DeleteAddressByID(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteAddressByID(context.Background(), tc.userID, tc.addressID)
@@ -1055,7 +1089,6 @@ func Test_userUC_CompletedRejectedRefund(t *testing.T) {
 		{
 			name: "success Completed Rejected Refund",
 			mock: func(t *testing.T, r *mocks.Repository) {
-				tempBuyerID := uuid.Nil.String()
 				tempProductSold := make([]*body.ProductUnitSoldOrderQty, 0)
 				tempBody := &body.ProductUnitSoldOrderQty{
 					Quantity: 1, UnitSold: 1,
@@ -1066,8 +1099,9 @@ func Test_userUC_CompletedRejectedRefund(t *testing.T) {
 				refund := &model.RefundOrder{Order: &model.OrderModel{UserID: uuid.Nil, ID: uuid.Nil}}
 				tempRefunds = append(tempRefunds, refund)
 				r.On("GetRejectedRefund", mock.Anything).Return(tempRefunds, nil)
-				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return(tempBuyerID, nil)
-				r.On("ChangeOrderStatus", mock.Anything, mock.Anything).Return(nil)
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(&model.OrderModel{OrderStatusID: constant.OrderStatusReceived}, nil)
+				r.On("ChangeOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetProductUnitSoldByOrderID", mock.Anything, mock.Anything, mock.Anything).Return(tempProductSold, nil)
 				r.On("UpdateProductUnitSold", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
@@ -1080,7 +1114,7 @@ func Test_userUC_CompletedRejectedRefund(t *testing.T) {
 				refund := &model.RefundOrder{Order: &model.OrderModel{UserID: uuid.Nil, ID: uuid.Nil}}
 				tempRefunds = append(tempRefunds, refund)
 				r.On("GetRejectedRefund", mock.Anything).Return(tempRefunds, nil)
-				r.On("GetBuyerIDByOrderID", mock.Anything, mock.Anything).Return("", errors.New("test"))
+				r.On("GetOrderModelByID", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
 		},
@@ -1099,10 +1133,10 @@ func Test_userUC_CompletedRejectedRefund(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
-			err := u.CompletedRejectedRefund(context.Background())
+			_, err := u.CompletedRejectedRefund(context.Background())
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
 			}
@@ -1245,7 +1279,7 @@ func Test_userUC_EditUser(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.EditUser(context.Background(), tc.userID, tc.requestBody)
@@ -1342,7 +1376,7 @@ func Test_userUC_EditEmail(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.EditEmail(context.Background(), tc.userID, tc.requestBody)
@@ -1469,7 +1503,7 @@ func Test_userUC_EditEmailUser(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.EditEmailUser(context.Background(), tc.userID, tc.requestBody)
@@ -1508,7 +1542,7 @@ func Test_userUC_GetSealabsPay(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetSealabsPay(context.Background(), tc.userID)
@@ -1666,7 +1700,7 @@ func Test_userUC_AddSealabsPay(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.AddSealabsPay(context.Background(), tc.request, tc.name)
@@ -1719,7 +1753,7 @@ func Test_userUC_PatchSealabsPay(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.PatchSealabsPay(context.Background(), tc.cardNumber, tc.userid)
@@ -1790,7 +1824,7 @@ func Test_userUC_DeleteSealabsPay(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.DeleteSealabsPay(context.Background(), tc.cardNumber, tc.userID)
@@ -1865,7 +1899,7 @@ func Test_userUC_ActivateWallet(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.ActivateWallet(context.Background(), tc.userID, tc.pin)
@@ -1995,7 +2029,7 @@ func Test_userUC_RegisterMerchant(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.RegisterMerchant(context.Background(), tc.userID, tc.shopName)
@@ -2042,7 +2076,7 @@ func Test_userUC_GetUserProfile(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetUserProfile(context.Background(), tc.userID)
@@ -2084,7 +2118,7 @@ func Test_userUC_UploadProfilePicture(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.UploadProfilePicture(context.Background(), tc.imgURL, tc.name)
@@ -2133,7 +2167,7 @@ func Test_userUC_VerifyPasswordChange(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.VerifyPasswordChange(context.Background(), tc.userID)
@@ -2218,7 +2252,8 @@ func Test_userUC_VerifyOTP(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, keySet)
 
 			tc.mock(t, r)
 			_, err := u.VerifyOTP(context.Background(), tc.requestBody, tc.userID)
@@ -2235,20 +2270,21 @@ func Test_userUC_ChangePassword(t *testing.T) {
 		name        string
 		userID      string
 		newPassword string
-		mock        func(t *testing.T, r *mocks.Repository)
+		mock        func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository)
 		expectedErr error
 	}{
 		{
 			name:        "success ChangePassword",
 			userID:      "123456",
 			newPassword: "Tested7*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
 				r.On("UpdatePasswordByID", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				r.On("GetSessionKeyRedis", mock.Anything, mock.Anything, mock.Anything).Return([]string{"asd"}, nil)
-				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				sr.On("RevokeUserSessions", mock.Anything, mock.Anything).Return(nil)
+				sr.On("GetSessionKeyRedis", mock.Anything, mock.Anything).Return([]string{"asd"}, nil)
+				sr.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedErr: nil,
 		},
@@ -2256,13 +2292,14 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error repo InsertSessionRedis",
 			userID:      "123456",
 			newPassword: "Tested7*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
 				r.On("UpdatePasswordByID", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				r.On("GetSessionKeyRedis", mock.Anything, mock.Anything, mock.Anything).Return([]string{"asd"}, nil)
-				r.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
+				sr.On("RevokeUserSessions", mock.Anything, mock.Anything).Return(nil)
+				sr.On("GetSessionKeyRedis", mock.Anything, mock.Anything).Return([]string{"asd"}, nil)
+				sr.On("InsertSessionRedis", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
 		},
@@ -2270,12 +2307,26 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error repo GetSessionKeyRedis",
 			userID:      "123456",
 			newPassword: "Tested7*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
+				tempUsername := "juww"
+				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
+				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
+				r.On("UpdatePasswordByID", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				sr.On("RevokeUserSessions", mock.Anything, mock.Anything).Return(nil)
+				sr.On("GetSessionKeyRedis", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name:        "error repo RevokeUserSessions",
+			userID:      "123456",
+			newPassword: "Tested7*",
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
 				r.On("UpdatePasswordByID", mock.Anything, mock.Anything, mock.Anything).Return(nil)
-				r.On("GetSessionKeyRedis", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+				sr.On("RevokeUserSessions", mock.Anything, mock.Anything).Return(errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
 		},
@@ -2283,7 +2334,7 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error repo UpdatePasswordByID",
 			userID:      "123456",
 			newPassword: "Tested7*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
@@ -2295,7 +2346,7 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error password contain",
 			userID:      "123456",
 			newPassword: "Tested7juww",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
@@ -2306,7 +2357,7 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error password same old password",
 			userID:      "123456",
 			newPassword: "Tested8*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return(passwordHash, nil)
@@ -2317,7 +2368,7 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error repo GetPasswordByID",
 			userID:      "123456",
 			newPassword: "Tested8*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				tempUsername := "juww"
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(&model.User{Email: "email@gmail.com", Username: &tempUsername}, nil)
 				r.On("GetPasswordByID", mock.Anything, mock.Anything).Return("", errors.New("test"))
@@ -2328,7 +2379,7 @@ func Test_userUC_ChangePassword(t *testing.T) {
 			name:        "error repo GetUserByID",
 			userID:      "123456",
 			newPassword: "Tested8*",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, sr *sessionMocks.Repository) {
 				r.On("GetUserByID", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
@@ -2338,9 +2389,10 @@ func Test_userUC_ChangePassword(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			sr := sessionMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, session.NewRevoker(&config.Config{}, sr), nil)
 
-			tc.mock(t, r)
+			tc.mock(t, r, sr)
 			err := u.ChangePassword(context.Background(), tc.userID, tc.newPassword)
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -2438,7 +2490,7 @@ func Test_userUC_TopUpWallet(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.TopUpWallet(context.Background(), tc.userID, tc.requestBody)
@@ -2495,7 +2547,7 @@ func Test_userUC_CreateSLPPayment(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.CreateSLPPayment(context.Background(), tc.transactionID)
@@ -2510,13 +2562,13 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 	testCase := []struct {
 		name          string
 		transactionID string
-		mock          func(t *testing.T, r *mocks.Repository)
+		mock          func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository)
 		expectedErr   error
 	}{
 		{
 			name:          "success CreateSLPPayment",
 			transactionID: "123456",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				tempCardNumber := "123456"
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ID: uuid.Nil,
@@ -2529,9 +2581,11 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 					WalletID:   &uuid.Nil,
 				}, nil)
 				r.On("GetWalletUser", mock.Anything, mock.Anything).Return(&model.Wallet{Balance: 1000}, nil)
-				r.On("GetOrderByTransactionID", mock.Anything, mock.Anything).Return([]*model.OrderModel{{OrderStatusID: 1}}, nil)
+				r.On("GetOrderByTransactionID", mock.Anything, mock.Anything).Return([]*model.OrderModel{{OrderStatusID: constant.OrderStatusWaitingToPay}}, nil)
 				r.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("ConvertStockReservation", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetWalletByUserID", mock.Anything, mock.Anything).Return(&model.Wallet{
@@ -2539,6 +2593,12 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 				}, nil)
 				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				lr.On("CreateLedgerTransaction", mock.Anything, mock.Anything, mock.MatchedBy(func(ledgerTx *model.LedgerTransaction) bool {
+					return ledgerTx.Reference == constant.LedgerReferencePayment &&
+						ledgerTx.Entries[0].Account.AccountType == constant.LedgerAccountWallet &&
+						ledgerTx.Entries[1].Account.AccountType == constant.LedgerAccountEscrow &&
+						ledgerTx.Entries[1].Amount == 100
+				})).Return(nil)
 
 			},
 			expectedErr: nil,
@@ -2546,7 +2606,7 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 		{
 			name:          "error GetTransactionByID",
 			transactionID: "123456",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
 			},
 			expectedErr: errors.New("test"),
@@ -2554,7 +2614,7 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 		{
 			name:          "error GetTransactionByID sql no rows",
 			transactionID: "123456",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
 			},
 			expectedErr: errors.New(response.TransactionIDNotExist),
@@ -2562,7 +2622,7 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 		{
 			name:          "error transaction expired",
 			transactionID: "123456",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ExpiredAt: sql.NullTime{
 						Valid: true,
@@ -2576,7 +2636,7 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 		{
 			name:          "error transaction has paid",
 			transactionID: "123456",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ExpiredAt: sql.NullTime{
 						Valid: true,
@@ -2593,7 +2653,7 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 		{
 			name:          "error Card number nil",
 			transactionID: "123456",
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ExpiredAt: sql.NullTime{
 						Valid: true,
@@ -2612,10 +2672,11 @@ func Test_userUC_CreateWalletPayment(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			lr := ledgerMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, lr, nil, nil, nil)
 
-			tc.mock(t, r)
-			err := u.CreateWalletPayment(context.Background(), tc.transactionID)
+			tc.mock(t, r, lr)
+			err := u.CreateWalletPayment(context.Background(), tc.transactionID, false)
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
 			}
@@ -2654,7 +2715,7 @@ func Test_userUC_GetTransactionByUserID(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetTransactionByUserID(context.Background(), tc.userID, tc.status, tc.pgn)
@@ -2686,7 +2747,7 @@ func Test_userUC_GetTransactionByID(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetTransactionByID(context.Background(), tc.transactionID)
@@ -2697,23 +2758,244 @@ func Test_userUC_GetTransactionByID(t *testing.T) {
 	}
 }
 
+func Test_userUC_HandlePaymentCallback(t *testing.T) {
+	callbackID := uuid.New()
+	statusOK := http.StatusOK
+	statusBadRequest := http.StatusBadRequest
+	sentAt := time.Now().Unix()
+	testCase := []struct {
+		name          string
+		callbackType  string
+		transactionID string
+		requestBody   body.SLPCallbackRequest
+		mock          func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository)
+		expectedErr   error
+	}{
+		{
+			name:          "success HandlePaymentCallback first delivery",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:     uuid.Nil.String(),
+				Amount:    "100",
+				Status:    constant.SLPStatusPaid,
+				Message:   constant.SlPMessagePaid,
+				Timestamp: sentAt,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				tempCardNumber := "123456"
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(&callbackID, nil)
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
+					ID:         uuid.Nil,
+					CardNumber: &tempCardNumber,
+					TotalPrice: 100,
+				}, nil)
+				r.On("GetOrderByTransactionID", mock.Anything, mock.Anything).Return([]*model.OrderModel{{OrderStatusID: constant.OrderStatusWaitingToPay}}, nil)
+				r.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("UpdateOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("ConvertStockReservation", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("GetWalletByUserID", mock.Anything, mock.Anything).Return(&model.Wallet{}, nil)
+				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				lr.On("CreateLedgerTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("UpdatePaymentCallback", mock.Anything, mock.MatchedBy(func(callback *model.PaymentCallback) bool {
+					return callback.Outcome == constant.PaymentCallbackProcessed && *callback.ResponseStatus == http.StatusOK
+				})).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:          "error HandlePaymentCallback records rejection",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:     uuid.Nil.String(),
+				Amount:    "1",
+				Timestamp: sentAt,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(&callbackID, nil)
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{TotalPrice: 100}, nil)
+				r.On("UpdatePaymentCallback", mock.Anything, mock.MatchedBy(func(callback *model.PaymentCallback) bool {
+					return callback.Outcome == constant.PaymentCallbackRejected && *callback.ResponseStatus == http.StatusBadRequest
+				})).Return(nil)
+			},
+			expectedErr: errors.New(response.CallbackAmountNotMatch),
+		},
+		{
+			name:          "error HandlePaymentCallback releases nonce for retry",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:     uuid.Nil.String(),
+				Amount:    "100",
+				Timestamp: sentAt,
+				Nonce:     "nonce",
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(&callbackID, nil)
+				r.On("InsertCallbackNonceRedis", mock.Anything, "nonce").Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+				r.On("UpdatePaymentCallback", mock.Anything, mock.MatchedBy(func(callback *model.PaymentCallback) bool {
+					return callback.Outcome == constant.PaymentCallbackError && *callback.ResponseStatus == http.StatusInternalServerError
+				})).Return(nil)
+				r.On("DeleteCallbackNonceRedis", mock.Anything, "nonce").Return(nil)
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name:          "success HandlePaymentCallback duplicate of processed callback",
+			callbackType:  constant.PaymentCallbackWallet,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody:   body.SLPCallbackRequest{Timestamp: sentAt},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Once()
+				r.On("GetOriginalPaymentCallback", mock.Anything, mock.Anything).Return(&model.PaymentCallback{
+					ID:              callbackID,
+					ResponseStatus:  &statusOK,
+					ResponseMessage: "success",
+				}, nil)
+				r.On("CreatePaymentCallback", mock.Anything, mock.MatchedBy(func(callback *model.PaymentCallback) bool {
+					return callback.Outcome == constant.PaymentCallbackDuplicate && *callback.OriginalID == callbackID
+				})).Return(&callbackID, nil).Once()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:          "error HandlePaymentCallback duplicate of rejected callback",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody:   body.SLPCallbackRequest{Timestamp: sentAt},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Once()
+				r.On("GetOriginalPaymentCallback", mock.Anything, mock.Anything).Return(&model.PaymentCallback{
+					ID:              callbackID,
+					ResponseStatus:  &statusBadRequest,
+					ResponseMessage: response.TransactionAlreadyFinished,
+				}, nil)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(&callbackID, nil).Once()
+			},
+			expectedErr: errors.New(response.TransactionAlreadyFinished),
+		},
+		{
+			name:          "error HandlePaymentCallback duplicate still processing",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody:   body.SLPCallbackRequest{Timestamp: sentAt},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Once()
+				r.On("GetOriginalPaymentCallback", mock.Anything, mock.Anything).Return(&model.PaymentCallback{ID: callbackID}, nil)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(&callbackID, nil).Once()
+			},
+			expectedErr: errors.New(response.CallbackStillProcessing),
+		},
+		{
+			name:          "success HandlePaymentCallback replays stale retry by nonce",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:     uuid.Nil.String(),
+				Timestamp: time.Now().Add(-time.Hour).Unix(),
+				Nonce:     "nonce",
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, uuid.Nil.String(), "nonce").Return(&model.PaymentCallback{
+					ID:              callbackID,
+					ResponseStatus:  &statusOK,
+					ResponseMessage: "success",
+				}, nil)
+				r.On("CreatePaymentCallback", mock.Anything, mock.MatchedBy(func(callback *model.PaymentCallback) bool {
+					return callback.Outcome == constant.PaymentCallbackDuplicate && *callback.OriginalID == callbackID
+				})).Return(&callbackID, nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:          "error HandlePaymentCallback stale timestamp",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:     uuid.Nil.String(),
+				Timestamp: time.Now().Add(-time.Hour).Unix(),
+				Nonce:     "nonce",
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, uuid.Nil.String(), "nonce").Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: errors.New(body.CallbackExpiredMessage),
+		},
+		{
+			name:          "error HandlePaymentCallback get callback by nonce",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody:   body.SLPCallbackRequest{Timestamp: sentAt},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name:          "error HandlePaymentCallback create callback",
+			callbackType:  constant.PaymentCallbackSLP,
+			transactionID: "8302755e-25c5-4523-8498-7dc8b9e3a098",
+			requestBody:   body.SLPCallbackRequest{Timestamp: sentAt},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("GetPaymentCallbackByNonce", mock.Anything, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows)
+				r.On("CreatePaymentCallback", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, mock, _ := sqlmock.New()
+			mock.ExpectBegin()
+
+			r := mocks.NewRepository(t)
+			lr := ledgerMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, lr, nil, nil, nil)
+
+			tc.mock(t, r, lr)
+			err := u.HandlePaymentCallback(context.Background(), tc.callbackType, tc.transactionID, "{}", tc.requestBody)
+			if err != nil {
+				assert.Equal(t, err.Error(), tc.expectedErr.Error())
+			} else {
+				assert.Nil(t, tc.expectedErr)
+			}
+		})
+	}
+}
+
 func Test_userUC_UpdateTransaction(t *testing.T) {
 	testCase := []struct {
 		name          string
 		transactionID string
 		requestBody   body.SLPCallbackRequest
-		mock          func(t *testing.T, r *mocks.Repository)
+		mock          func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository)
 		expectedErr   error
 	}{
 		{
 			name:          "success UpdateTransaction",
 			transactionID: "123456",
 			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "100",
 				Status:  constant.SLPStatusPaid,
 				Message: constant.SlPMessagePaid,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				tempCardNumber := "123456"
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ID: uuid.Nil,
 					ExpiredAt: sql.NullTime{
@@ -2722,17 +3004,118 @@ func Test_userUC_UpdateTransaction(t *testing.T) {
 					},
 					CardNumber: &tempCardNumber,
 					TotalPrice: 100,
-					WalletID:   &uuid.Nil,
 				}, nil)
-				r.On("GetOrderByTransactionID", mock.Anything, mock.Anything).Return([]*model.OrderModel{{}}, nil)
+				r.On("GetOrderByTransactionID", mock.Anything, mock.Anything).Return([]*model.OrderModel{{OrderStatusID: constant.OrderStatusWaitingToPay}}, nil)
 				r.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateOrder", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("CreateOrderStatusHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("ConvertStockReservation", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("GetWalletByUserID", mock.Anything, mock.Anything).Return(&model.Wallet{}, nil)
 				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				lr.On("CreateLedgerTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 			},
 			expectedErr: nil,
 		},
+		{
+			name:          "error UpdateTransaction replayed callback",
+			transactionID: "123456",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "100",
+				Status:  constant.SLPStatusPaid,
+				Message: constant.SlPMessagePaid,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(false, nil)
+			},
+			expectedErr: errors.New(response.CallbackAlreadyReceived),
+		},
+		{
+			name:          "error UpdateTransaction txn id not match",
+			transactionID: "123456",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.New().String(),
+				Amount:  "100",
+				Status:  constant.SLPStatusPaid,
+				Message: constant.SlPMessagePaid,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				tempCardNumber := "123456"
+				tempInvoice := "INV-123456"
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
+					ID:         uuid.Nil,
+					Invoice:    &tempInvoice,
+					CardNumber: &tempCardNumber,
+					TotalPrice: 100,
+				}, nil)
+			},
+			expectedErr: errors.New(response.CallbackTransactionNotMatch),
+		},
+		{
+			name:          "error UpdateTransaction amount not match",
+			transactionID: "123456",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "1",
+				Status:  constant.SLPStatusPaid,
+				Message: constant.SlPMessagePaid,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				tempCardNumber := "123456"
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
+					ID:         uuid.Nil,
+					CardNumber: &tempCardNumber,
+					TotalPrice: 100,
+				}, nil)
+			},
+			expectedErr: errors.New(response.CallbackAmountNotMatch),
+		},
+		{
+			name:          "error UpdateTransaction wallet top up",
+			transactionID: "123456",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "100",
+				Status:  constant.SLPStatusPaid,
+				Message: constant.SlPMessagePaid,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
+					ID:         uuid.Nil,
+					TotalPrice: 100,
+					WalletID:   &uuid.Nil,
+				}, nil)
+			},
+			expectedErr: errors.New(response.InvalidPaymentMethod),
+		},
+		{
+			name:          "error UpdateTransaction paid after expiry",
+			transactionID: "123456",
+			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "100",
+				Status:  constant.SLPStatusPaid,
+				Message: constant.SlPMessagePaid,
+			},
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
+				tempCardNumber := "123456"
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
+				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
+					ID: uuid.Nil,
+					ExpiredAt: sql.NullTime{
+						Valid: true,
+						Time:  time.Now().Add(-time.Minute),
+					},
+					CardNumber: &tempCardNumber,
+					TotalPrice: 100,
+				}, nil)
+			},
+			expectedErr: errors.New(response.TransactionAlreadyExpired),
+		},
 	}
 
 	for _, tc := range testCase {
@@ -2741,9 +3124,10 @@ func Test_userUC_UpdateTransaction(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			lr := ledgerMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, lr, nil, nil, nil)
 
-			tc.mock(t, r)
+			tc.mock(t, r, lr)
 			err := u.UpdateTransaction(context.Background(), tc.transactionID, tc.requestBody)
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -2788,7 +3172,7 @@ func Test_userUC_UpdateTransactionPaymentMethod(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.UpdateTransactionPaymentMethod(context.Background(), tc.transactionID, tc.cardNumber)
@@ -2804,18 +3188,21 @@ func Test_userUC_UpdateWalletTransaction(t *testing.T) {
 		name          string
 		transactionID string
 		requestBody   body.SLPCallbackRequest
-		mock          func(t *testing.T, r *mocks.Repository)
+		mock          func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository)
 		expectedErr   error
 	}{
 		{
 			name:          "success UpdateWalletTransaction cancel",
 			transactionID: "123456",
 			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "100",
 				Status:  constant.SLPStatusCanceled,
 				Message: constant.SLPMessageCanceled,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				tempCardNumber := "123456"
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ID: uuid.Nil,
 					ExpiredAt: sql.NullTime{
@@ -2835,11 +3222,14 @@ func Test_userUC_UpdateWalletTransaction(t *testing.T) {
 			name:          "success UpdateWalletTransaction paid",
 			transactionID: "123456",
 			requestBody: body.SLPCallbackRequest{
+				TxnID:   uuid.Nil.String(),
+				Amount:  "100",
 				Status:  constant.SLPStatusPaid,
 				Message: constant.SlPMessagePaid,
 			},
-			mock: func(t *testing.T, r *mocks.Repository) {
+			mock: func(t *testing.T, r *mocks.Repository, lr *ledgerMocks.Repository) {
 				tempCardNumber := "123456"
+				r.On("InsertCallbackNonceRedis", mock.Anything, mock.Anything).Return(true, nil)
 				r.On("GetTransactionByID", mock.Anything, mock.Anything).Return(&model.Transaction{
 					ID: uuid.Nil,
 					ExpiredAt: sql.NullTime{
@@ -2854,6 +3244,11 @@ func Test_userUC_UpdateWalletTransaction(t *testing.T) {
 				r.On("UpdateTransaction", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("InsertWalletHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil)
 				r.On("UpdateWalletBalance", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				lr.On("CreateLedgerTransaction", mock.Anything, mock.Anything, mock.MatchedBy(func(ledgerTx *model.LedgerTransaction) bool {
+					return ledgerTx.Reference == constant.LedgerReferenceTopUp &&
+						ledgerTx.Entries[0].Account.AccountType == constant.LedgerAccountExternal &&
+						ledgerTx.Entries[1].Account.AccountType == constant.LedgerAccountWallet
+				})).Return(nil)
 			},
 			expectedErr: nil,
 		},
@@ -2865,9 +3260,10 @@ func Test_userUC_UpdateWalletTransaction(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			lr := ledgerMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, lr, nil, nil, nil)
 
-			tc.mock(t, r)
+			tc.mock(t, r, lr)
 			err := u.UpdateWalletTransaction(context.Background(), tc.transactionID, tc.requestBody)
 			if err != nil {
 				assert.Equal(t, err.Error(), tc.expectedErr.Error())
@@ -2912,7 +3308,7 @@ func Test_userUC_GetWallet(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetWallet(context.Background(), tc.userID)
@@ -2968,7 +3364,7 @@ func Test_userUC_GetWalletHistory(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetWalletHistory(context.Background(), tc.userID, tc.pgn)
@@ -3027,7 +3423,7 @@ func Test_userUC_GetDetailWalletHistory(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.GetDetailWalletHistory(context.Background(), tc.walletHistoryID, tc.userID)
@@ -3067,7 +3463,7 @@ func Test_userUC_WalletStepUp(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.WalletStepUp(context.Background(), tc.userID, tc.requestBody)
@@ -3132,7 +3528,8 @@ func Test_userUC_ChangeWalletPinStepUp(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, keySet)
 
 			tc.mock(t, r)
 			_, err := u.ChangeWalletPinStepUp(context.Background(), tc.userID, tc.requestBody)
@@ -3188,7 +3585,7 @@ func Test_userUC_ChangeWalletPin(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			err := u.ChangeWalletPin(context.Background(), tc.userID, tc.pin)
@@ -3247,9 +3644,9 @@ func Test_userUC_CreateTransaction(t *testing.T) {
 				tempVoucherID, _ := uuid.Parse("f483bf6b-6293-428b-b87a-5892aacb4efa")
 				tempVoucherID1, _ := uuid.Parse("f483bf6b-6293-428b-b87a-5892aacb4efa")
 				tempVDiscountPercentage := float64(0)
-				tempVDiscountFixPrice := float64(10)
-				tempVMinProductPrice := float64(1)
-				tempVMaxDiscountPrice := float64(100000)
+				tempVDiscountFixPrice := model.Money(10)
+				tempVMinProductPrice := model.Money(1)
+				tempVMaxDiscountPrice := model.Money(100000)
 				r.On("GetVoucherMarketplaceByID", mock.Anything, mock.Anything).Return(&model.Voucher{
 					ID:                 tempVoucherID,
 					ShopID:             uuid.Nil,
@@ -3330,6 +3727,7 @@ func Test_userUC_CreateTransaction(t *testing.T) {
 					UpdatedAt:          sql.NullTime{},
 					DeletedAt:          sql.NullTime{},
 				}, nil)
+				r.On("GetReservedStock", mock.Anything, mock.Anything, mock.Anything).Once().Return(float64(0), nil)
 
 				tempTransactionID, _ := uuid.Parse("b7938be2-0d48-4ba8-af6b-465b79eb0891")
 				tempOrderID, _ := uuid.Parse("ccbcbe3e-3cb1-4aae-abb8-e42d6bc587c0")
@@ -3341,7 +3739,7 @@ func Test_userUC_CreateTransaction(t *testing.T) {
 				r.On("UpdatePromotionQuota", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
 				r.On("CreateOrder", mock.Anything, mock.Anything, mock.Anything).Once().Return(&tempOrderID, nil)
 				r.On("CreateOrderItem", mock.Anything, mock.Anything, mock.Anything).Once().Return(&tempProductDetailID, nil)
-				r.On("UpdateProductDetailStock", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
+				r.On("CreateStockReservation", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
 				r.On("DeleteCartItemByID", mock.Anything, mock.Anything, mock.Anything).Once().Return(nil)
 			},
 			expectedErr: nil,
@@ -3354,7 +3752,7 @@ func Test_userUC_CreateTransaction(t *testing.T) {
 			mock.ExpectBegin()
 
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
 
 			tc.mock(t, r)
 			_, err := u.CreateTransaction(context.Background(), tc.userID, tc.requestBody)
@@ -3479,7 +3877,7 @@ func Test_userUC_GetRefundOrder(t *testing.T) {
 	for _, tc := range testCase {
 		t.Run(tc.name, func(t *testing.T) {
 			r := mocks.NewRepository(t)
-			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
 			tc.mock(t, r)
 			_, err := u.GetRefundOrder(context.Background(), tc.userID, tc.orderID)
 			if tc.expectedErr {
@@ -3490,3 +3888,343 @@ func Test_userUC_GetRefundOrder(t *testing.T) {
 		})
 	}
 }
+
+func Test_userUC_ConfirmTOTP(t *testing.T) {
+	secret, _ := totp.GenerateSecret()
+	code, _ := totp.GenerateCode(secret, totp.Step(time.Now()))
+	testCase := []struct {
+		name        string
+		requestBody body.TOTPCodeRequest
+		mock        func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository, sqlMock sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name:        "success ConfirmTOTP",
+			requestBody: body.TOTPCodeRequest{Code: code},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository, sqlMock sqlmock.Sqlmock) {
+				sqlMock.ExpectBegin()
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(&model.UserTOTP{Secret: secret}, nil)
+				r.On("ConfirmUserTOTP", mock.Anything, mock.Anything, "123456", totp.Step(time.Now())).Return(int64(1), nil)
+				r.On("DeleteRecoveryCodes", mock.Anything, mock.Anything, "123456").Return(nil)
+				r.On("CreateRecoveryCode", mock.Anything, mock.Anything, "123456", mock.Anything).Times(constant.TOTPRecoveryCodes).Return(nil)
+				sqlMock.ExpectCommit()
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "error ConfirmTOTP invalid code",
+			requestBody: body.TOTPCodeRequest{Code: "abcdef"},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository, sqlMock sqlmock.Sqlmock) {
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(&model.UserTOTP{Secret: secret}, nil)
+			},
+			expectedErr: httperror.New(http.StatusForbidden, response.TOTPInvalidCodeMessage),
+		},
+		{
+			name:        "error ConfirmTOTP already enabled",
+			requestBody: body.TOTPCodeRequest{Code: code},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository, sqlMock sqlmock.Sqlmock) {
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(&model.UserTOTP{
+					Secret: secret, ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.TOTPAlreadyEnabledMessage),
+		},
+		{
+			name:        "error ConfirmTOTP not setup",
+			requestBody: body.TOTPCodeRequest{Code: code},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository, sqlMock sqlmock.Sqlmock) {
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.TOTPNotEnabledMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, sqlMock, _ := sqlmock.New()
+			r := mocks.NewRepository(t)
+			tf := twoFactorMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, twofactor.NewVerifier(tf), nil, nil)
+
+			tc.mock(t, r, tf, sqlMock)
+			codes, err := u.ConfirmTOTP(context.Background(), "123456", tc.requestBody)
+			assert.Equal(t, tc.expectedErr, err)
+			if err == nil {
+				assert.Len(t, codes, constant.TOTPRecoveryCodes)
+			}
+		})
+	}
+}
+
+func Test_userUC_WalletStepUpTOTP(t *testing.T) {
+	pinHash, _ := bcrypt.GenerateFromPassword([]byte("123456"), bcrypt.MinCost)
+	secret, _ := totp.GenerateSecret()
+	code, _ := totp.GenerateCode(secret, totp.Step(time.Now()))
+	userTOTP := &model.UserTOTP{Secret: secret, ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+	testCase := []struct {
+		name        string
+		requestBody body.WalletStepUpRequest
+		mock        func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name:        "error large payment requires totp",
+			requestBody: body.WalletStepUpRequest{Pin: "123456", Amount: constant.WalletTOTPThreshold},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository) {
+				r.On("GetWalletByUserID", mock.Anything, "123456").Return(&model.Wallet{
+					Balance: constant.WalletTOTPThreshold, PIN: string(pinHash)}, nil)
+				r.On("UpdateWallet", mock.Anything, mock.Anything).Return(nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.TOTPRequiredMessage),
+		},
+		{
+			name:        "success large payment with totp",
+			requestBody: body.WalletStepUpRequest{Pin: "123456", Amount: constant.WalletTOTPThreshold, TOTPCode: code},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository) {
+				r.On("GetWalletByUserID", mock.Anything, "123456").Return(&model.Wallet{
+					Balance: constant.WalletTOTPThreshold, PIN: string(pinHash)}, nil)
+				r.On("UpdateWallet", mock.Anything, mock.Anything).Return(nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+				tf.On("UpdateUserTOTPLastUsedStep", mock.Anything, "123456", mock.Anything).Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "success large payment without totp enrolment",
+			requestBody: body.WalletStepUpRequest{Pin: "123456", Amount: constant.WalletTOTPThreshold},
+			mock: func(t *testing.T, r *mocks.Repository, tf *twoFactorMocks.Repository) {
+				r.On("GetWalletByUserID", mock.Anything, "123456").Return(&model.Wallet{
+					Balance: constant.WalletTOTPThreshold, PIN: string(pinHash)}, nil)
+				r.On("UpdateWallet", mock.Anything, mock.Anything).Return(nil)
+				tf.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: nil,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			tf := twoFactorMocks.NewRepository(t)
+			keySet, _ := jwt.NewKeySet(&config.Config{})
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, twofactor.NewVerifier(tf), nil, keySet)
+
+			tc.mock(t, r, tf)
+			_, err := u.WalletStepUp(context.Background(), "123456", tc.requestBody)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func Test_userUC_RequestAccountDeletion(t *testing.T) {
+	hashed, _ := bcrypt.GenerateFromPassword([]byte("Password123"), bcrypt.MinCost)
+	password := string(hashed)
+	userModel := &model.User{Email: "user@murakali.com", Password: &password}
+	shopID := uuid.New()
+
+	testCase := []struct {
+		name        string
+		requestBody body.AccountDeletionRequest
+		mock        func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock)
+		expectedErr error
+	}{
+		{
+			name:        "success request account deletion",
+			requestBody: body.AccountDeletionRequest{Password: "Password123"},
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectCommit()
+				r.On("GetUserPasswordByID", mock.Anything, mock.Anything).Return(userModel, nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("", sql.ErrNoRows)
+				r.On("CountActiveOrdersByUserID", mock.Anything, mock.Anything, "123456").Return(int64(0), nil)
+				r.On("RequestAccountDeletion", mock.Anything, mock.Anything, "123456", mock.Anything).Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:        "error wrong password",
+			requestBody: body.AccountDeletionRequest{Password: "Wrong123"},
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock) {
+				r.On("GetUserPasswordByID", mock.Anything, mock.Anything).Return(userModel, nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.InvalidPasswordMessage),
+		},
+		{
+			name:        "error user owns a shop",
+			requestBody: body.AccountDeletionRequest{Password: "Password123"},
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectRollback()
+				r.On("GetUserPasswordByID", mock.Anything, mock.Anything).Return(userModel, nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return(shopID.String(), nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.AccountDeletionShopOwnerMessage),
+		},
+		{
+			name:        "error user has active orders",
+			requestBody: body.AccountDeletionRequest{Password: "Password123"},
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectRollback()
+				r.On("GetUserPasswordByID", mock.Anything, mock.Anything).Return(userModel, nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("", sql.ErrNoRows)
+				r.On("CountActiveOrdersByUserID", mock.Anything, mock.Anything, "123456").Return(int64(2), nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.AccountDeletionActiveOrderMessage),
+		},
+		{
+			name:        "error deletion already requested",
+			requestBody: body.AccountDeletionRequest{Password: "Password123"},
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock) {
+				m.ExpectBegin()
+				m.ExpectRollback()
+				r.On("GetUserPasswordByID", mock.Anything, mock.Anything).Return(userModel, nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("", sql.ErrNoRows)
+				r.On("CountActiveOrdersByUserID", mock.Anything, mock.Anything, "123456").Return(int64(0), nil)
+				r.On("RequestAccountDeletion", mock.Anything, mock.Anything, "123456", mock.Anything).Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusBadRequest, response.AccountDeletionRequestedMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, m, _ := sqlmock.New()
+			r := mocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, nil, nil)
+
+			tc.mock(t, r, m)
+			_, err := u.RequestAccountDeletion(context.Background(), "123456", tc.requestBody)
+			assert.Equal(t, tc.expectedErr, err)
+			assert.NoError(t, m.ExpectationsWereMet())
+		})
+	}
+}
+
+func Test_userUC_CancelAccountDeletion(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success cancel account deletion",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("CancelAccountDeletion", mock.Anything, mock.Anything).Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error no pending deletion",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("CancelAccountDeletion", mock.Anything, mock.Anything).Return(int64(0), nil)
+			},
+			expectedErr: httperror.New(http.StatusNotFound, response.AccountDeletionNotFoundMessage),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{}, r, nil, nil, nil, nil)
+
+			tc.mock(t, r)
+			err := u.CancelAccountDeletion(context.Background(), "123456")
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func Test_userUC_AnonymizeDeletedAccounts(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success anonymize deleted accounts",
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository) {
+				m.ExpectBegin()
+				m.ExpectCommit()
+				r.On("GetAccountsDueForDeletion", mock.Anything).Return([]string{"123456"}, nil)
+				r.On("LockUserDueForDeletion", mock.Anything, mock.Anything, "123456").Return(nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("", sql.ErrNoRows)
+				r.On("CountActiveOrdersByUserID", mock.Anything, mock.Anything, "123456").Return(int64(0), nil)
+				r.On("DeleteUserPersonalData", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+				r.On("AnonymizeUser", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+				sr.On("RevokeUserSessions", mock.Anything, "123456").Return(nil)
+				sr.On("GetSessionKeyRedis", mock.Anything, "session:123456:*").Return([]string{"session:123456:abc"}, nil)
+				sr.On("InsertSessionRedis", mock.Anything, mock.Anything, "session:123456:abc", constant.FALSE).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success postpone account with active order",
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository) {
+				m.ExpectBegin()
+				m.ExpectCommit()
+				r.On("GetAccountsDueForDeletion", mock.Anything).Return([]string{"123456"}, nil)
+				r.On("LockUserDueForDeletion", mock.Anything, mock.Anything, "123456").Return(nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("", sql.ErrNoRows)
+				r.On("CountActiveOrdersByUserID", mock.Anything, mock.Anything, "123456").Return(int64(1), nil)
+				r.On("PostponeAccountDeletion", mock.Anything, mock.Anything, "123456", mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success postpone shop owner",
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository) {
+				m.ExpectBegin()
+				m.ExpectCommit()
+				r.On("GetAccountsDueForDeletion", mock.Anything).Return([]string{"123456"}, nil)
+				r.On("LockUserDueForDeletion", mock.Anything, mock.Anything, "123456").Return(nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("shop", nil)
+				r.On("PostponeAccountDeletion", mock.Anything, mock.Anything, "123456", mock.Anything).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "success skip account no longer due for deletion",
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository) {
+				m.ExpectBegin()
+				m.ExpectCommit()
+				r.On("GetAccountsDueForDeletion", mock.Anything).Return([]string{"123456"}, nil)
+				r.On("LockUserDueForDeletion", mock.Anything, mock.Anything, "123456").Return(sql.ErrNoRows)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error delete personal data",
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository) {
+				m.ExpectBegin()
+				m.ExpectRollback()
+				r.On("GetAccountsDueForDeletion", mock.Anything).Return([]string{"123456"}, nil)
+				r.On("LockUserDueForDeletion", mock.Anything, mock.Anything, "123456").Return(nil)
+				r.On("GetOwnedShopIDByUserID", mock.Anything, mock.Anything, "123456").Return("", sql.ErrNoRows)
+				r.On("CountActiveOrdersByUserID", mock.Anything, mock.Anything, "123456").Return(int64(0), nil)
+				r.On("DeleteUserPersonalData", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name: "error get accounts due for deletion",
+			mock: func(t *testing.T, r *mocks.Repository, m sqlmock.Sqlmock, sr *sessionMocks.Repository) {
+				r.On("GetAccountsDueForDeletion", mock.Anything).Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			sql, m, _ := sqlmock.New()
+			r := mocks.NewRepository(t)
+			sr := sessionMocks.NewRepository(t)
+			u := NewUserUseCase(&config.Config{}, &postgre.TxRepo{PSQL: sql}, r, nil, nil, session.NewRevoker(&config.Config{}, sr), nil)
+
+			tc.mock(t, r, m, sr)
+			_, err := u.AnonymizeDeletedAccounts(context.Background())
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
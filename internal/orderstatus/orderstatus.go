@@ -0,0 +1,86 @@
+package orderstatus
+
+import (
+	"fmt"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+var statusNames = map[int]string{
+	constant.OrderStatusWaitingToPay:     "waiting to pay",
+	constant.OrderStatusWaitingForSeller: "waiting for seller",
+	constant.OrderStatusProcessed:        "processed",
+	constant.OrderStatusOnDelivery:       "on delivery",
+	constant.OrderStatusDelivered:        "delivered",
+	constant.OrderStatusReceived:         "received",
+	constant.OrderStatusCompleted:        "completed",
+	constant.OrderStatusCanceled:         "canceled",
+	constant.OrderStatusRefunded:         "refunded",
+}
+
+var transitions = map[string]map[int][]int{
+	constant.OrderActorBuyer: {
+		constant.OrderStatusDelivered: {constant.OrderStatusReceived},
+		constant.OrderStatusReceived:  {constant.OrderStatusCompleted},
+	},
+	constant.OrderActorSeller: {
+		constant.OrderStatusWaitingForSeller: {constant.OrderStatusProcessed, constant.OrderStatusCanceled},
+		constant.OrderStatusProcessed:        {constant.OrderStatusOnDelivery},
+	},
+	constant.OrderActorSystem: {
+		constant.OrderStatusWaitingToPay: {constant.OrderStatusWaitingForSeller, constant.OrderStatusCanceled},
+		constant.OrderStatusOnDelivery:   {constant.OrderStatusDelivered},
+		constant.OrderStatusReceived:     {constant.OrderStatusCompleted},
+	},
+	constant.OrderActorAdmin: {
+		constant.OrderStatusReceived: {constant.OrderStatusRefunded},
+		constant.OrderStatusCanceled: {constant.OrderStatusRefunded},
+	},
+}
+
+func Name(status int) string {
+	if name, ok := statusNames[status]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("unknown (%d)", status)
+}
+
+func CanTransition(actor string, from, to int) bool {
+	for _, next := range transitions[actor][from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+func ValidateTransition(actor string, from, to int) error {
+	if !CanTransition(actor, from, to) {
+		return httperror.New(http.StatusBadRequest,
+			fmt.Sprintf(response.OrderStatusTransitionInvalid, Name(from), Name(to)))
+	}
+
+	return nil
+}
+
+func NewHistory(orderID uuid.UUID, from, to int, actor, actorID string) *model.OrderStatusHistory {
+	history := &model.OrderStatusHistory{
+		OrderID:           orderID,
+		FromOrderStatusID: from,
+		ToOrderStatusID:   to,
+		Actor:             actor,
+	}
+
+	if id, err := uuid.Parse(actorID); err == nil {
+		history.ActorID = &id
+	}
+
+	return history
+}
@@ -0,0 +1,90 @@
+package orderstatus
+
+import (
+	"murakali/internal/constant"
+	"murakali/pkg/httperror"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateTransition(t *testing.T) {
+	testCase := []struct {
+		name        string
+		actor       string
+		from        int
+		to          int
+		expectedErr error
+	}{
+		{
+			name:  "seller process order",
+			actor: constant.OrderActorSeller,
+			from:  constant.OrderStatusWaitingForSeller,
+			to:    constant.OrderStatusProcessed,
+		},
+		{
+			name:  "buyer receive order",
+			actor: constant.OrderActorBuyer,
+			from:  constant.OrderStatusDelivered,
+			to:    constant.OrderStatusReceived,
+		},
+		{
+			name:  "system cancel unpaid order",
+			actor: constant.OrderActorSystem,
+			from:  constant.OrderStatusWaitingToPay,
+			to:    constant.OrderStatusCanceled,
+		},
+		{
+			name:  "admin refund canceled order",
+			actor: constant.OrderActorAdmin,
+			from:  constant.OrderStatusCanceled,
+			to:    constant.OrderStatusRefunded,
+		},
+		{
+			name:  "seller cannot complete unpaid order",
+			actor: constant.OrderActorSeller,
+			from:  constant.OrderStatusWaitingToPay,
+			to:    constant.OrderStatusCompleted,
+			expectedErr: httperror.New(http.StatusBadRequest,
+				"Order status cannot be changed from waiting to pay to completed."),
+		},
+		{
+			name:  "buyer cannot skip delivery",
+			actor: constant.OrderActorBuyer,
+			from:  constant.OrderStatusProcessed,
+			to:    constant.OrderStatusCompleted,
+			expectedErr: httperror.New(http.StatusBadRequest,
+				"Order status cannot be changed from processed to completed."),
+		},
+		{
+			name:  "unknown actor",
+			actor: "guest",
+			from:  constant.OrderStatusWaitingForSeller,
+			to:    constant.OrderStatusProcessed,
+			expectedErr: httperror.New(http.StatusBadRequest,
+				"Order status cannot be changed from waiting for seller to processed."),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTransition(tc.actor, tc.from, tc.to)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
+
+func TestNewHistory(t *testing.T) {
+	orderID := uuid.New()
+	actorID := uuid.New()
+
+	history := NewHistory(orderID, constant.OrderStatusWaitingForSeller, constant.OrderStatusProcessed,
+		constant.OrderActorSeller, actorID.String())
+	assert.Equal(t, orderID, history.OrderID)
+	assert.Equal(t, &actorID, history.ActorID)
+
+	history = NewHistory(orderID, constant.OrderStatusOnDelivery, constant.OrderStatusDelivered, constant.OrderActorSystem, "")
+	assert.Nil(t, history.ActorID)
+}
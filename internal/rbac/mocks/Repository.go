@@ -0,0 +1,150 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "murakali/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// CachePermissions provides a mock function with given fields: ctx, userID, permissions, duration
+func (_m *Repository) CachePermissions(ctx context.Context, userID string, permissions []string, duration time.Duration) error {
+	ret := _m.Called(ctx, userID, permissions, duration)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string, time.Duration) error); ok {
+		r0 = rf(ctx, userID, permissions, duration)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateAdminAuditLog provides a mock function with given fields: ctx, auditLog
+func (_m *Repository) CreateAdminAuditLog(ctx context.Context, auditLog *model.AdminAuditLog) error {
+	ret := _m.Called(ctx, auditLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.AdminAuditLog) error); ok {
+		r0 = rf(ctx, auditLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateShopAuditLog provides a mock function with given fields: ctx, auditLog
+func (_m *Repository) CreateShopAuditLog(ctx context.Context, auditLog *model.ShopAuditLog) error {
+	ret := _m.Called(ctx, auditLog)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.ShopAuditLog) error); ok {
+		r0 = rf(ctx, auditLog)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCachedPermissions provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetCachedPermissions(ctx context.Context, userID string) ([]string, bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(context.Context, string) bool); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string) error); ok {
+		r2 = rf(ctx, userID)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// GetShopAccess provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetShopAccess(ctx context.Context, userID string) (*model.ShopAccess, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *model.ShopAccess
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.ShopAccess); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ShopAccess)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserPermissions provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserPermissions(ctx context.Context, userID string) ([]string, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
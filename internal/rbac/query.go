@@ -0,0 +1,36 @@
+package rbac
+
+const (
+	GetUserPermissionsQuery = `
+		SELECT DISTINCT "p"."name"
+		FROM "permission" AS "p"
+		INNER JOIN "role_permission" AS "rp" ON "rp"."permission_id" = "p"."id"
+		WHERE "rp"."role_id" IN (
+			SELECT "role_id" FROM "user_role" WHERE "user_id" = $1
+			UNION
+			SELECT "role_id" FROM "user" WHERE "id" = $1 AND "role_id" IS NOT NULL
+			UNION
+			SELECT $2::int
+		)
+		ORDER BY "p"."name"`
+
+	GetShopAccessQuery = `
+		SELECT "shop_id", "staff_id", "scopes" FROM (
+			SELECT "s"."id" AS "shop_id", NULL::uuid AS "staff_id", '{}'::varchar[] AS "scopes", 0 AS "rank"
+			FROM "shop" AS "s"
+			WHERE "s"."user_id" = $1 AND "s"."deleted_at" IS NULL
+			UNION ALL
+			SELECT "ss"."shop_id", "ss"."id", "ss"."scopes", 1
+			FROM "shop_staff" AS "ss"
+			INNER JOIN "shop" AS "s" ON "s"."id" = "ss"."shop_id" AND "s"."deleted_at" IS NULL
+			WHERE "ss"."user_id" = $1 AND "ss"."accepted_at" IS NOT NULL AND "ss"."deleted_at" IS NULL
+		) AS "access"
+		ORDER BY "rank", "shop_id"
+		LIMIT 1`
+
+	CreateShopAuditLogQuery = `INSERT INTO "shop_audit_log" (shop_id, actor_id, staff_id, action, path, status_code)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+
+	CreateAdminAuditLogQuery = `INSERT INTO "admin_audit_log" (admin_id, user_id, impersonation_id, action, detail)
+		VALUES ($1, $2, $3, $4, $5)`
+)
@@ -0,0 +1,82 @@
+package rbac
+
+import (
+	"context"
+	"database/sql"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"time"
+)
+
+type Authorizer struct {
+	repo Repository
+}
+
+func NewAuthorizer(repo Repository) *Authorizer {
+	return &Authorizer{
+		repo: repo,
+	}
+}
+
+func (a *Authorizer) Permissions(ctx context.Context, userID string) ([]string, error) {
+	permissions, cached, err := a.repo.GetCachedPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cached {
+		return permissions, nil
+	}
+
+	permissions, err = a.repo.GetUserPermissions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	duration, err := time.ParseDuration(constant.PermissionCacheDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.repo.CachePermissions(ctx, userID, permissions, duration); err != nil {
+		return nil, err
+	}
+
+	return permissions, nil
+}
+
+func (a *Authorizer) HasPermission(ctx context.Context, userID, permission string) (bool, error) {
+	permissions, err := a.Permissions(ctx, userID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, p := range permissions {
+		if p == permission {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func (a *Authorizer) ShopAccess(ctx context.Context, userID string) (*model.ShopAccess, error) {
+	access, err := a.repo.GetShopAccess(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return access, nil
+}
+
+func (a *Authorizer) AuditShopAction(ctx context.Context, auditLog *model.ShopAuditLog) error {
+	return a.repo.CreateShopAuditLog(ctx, auditLog)
+}
+
+func (a *Authorizer) AuditAdminAction(ctx context.Context, auditLog *model.AdminAuditLog) error {
+	return a.repo.CreateAdminAuditLog(ctx, auditLog)
+}
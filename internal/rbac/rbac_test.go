@@ -0,0 +1,154 @@
+package rbac
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"murakali/internal/rbac/mocks"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuthorizer_HasPermission(t *testing.T) {
+	testCase := []struct {
+		name        string
+		permission  string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expected    bool
+		expectedErr error
+	}{
+		{
+			name:       "success from cache",
+			permission: constant.PermissionVoucherRead,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetCachedPermissions", mock.Anything, "user-id").
+					Return([]string{constant.PermissionVoucherRead}, true, nil)
+			},
+			expected: true,
+		},
+		{
+			name:       "success from database and cached",
+			permission: constant.PermissionShopManage,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetCachedPermissions", mock.Anything, "user-id").Return(nil, false, nil)
+				r.On("GetUserPermissions", mock.Anything, "user-id").
+					Return([]string{constant.PermissionShopManage}, nil)
+				r.On("CachePermissions", mock.Anything, "user-id", []string{constant.PermissionShopManage}, mock.Anything).
+					Return(nil)
+			},
+			expected: true,
+		},
+		{
+			name:       "missing permission",
+			permission: constant.PermissionRoleWrite,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetCachedPermissions", mock.Anything, "user-id").
+					Return([]string{constant.PermissionVoucherRead}, true, nil)
+			},
+			expected: false,
+		},
+		{
+			name:       "error cache",
+			permission: constant.PermissionRoleWrite,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetCachedPermissions", mock.Anything, "user-id").Return(nil, false, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name:       "error database",
+			permission: constant.PermissionRoleWrite,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetCachedPermissions", mock.Anything, "user-id").Return(nil, false, nil)
+				r.On("GetUserPermissions", mock.Anything, "user-id").Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			a := NewAuthorizer(r)
+
+			tc.mock(t, r)
+			allowed, err := a.HasPermission(context.Background(), "user-id", tc.permission)
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, allowed)
+		})
+	}
+}
+
+func TestAuthorizer_ShopAccess(t *testing.T) {
+	shopID := uuid.New()
+	staffID := uuid.New()
+	testCase := []struct {
+		name        string
+		scope       string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expected    bool
+		expectedErr error
+	}{
+		{
+			name:  "owner has every scope",
+			scope: constant.ShopScopeFinance,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopAccess", mock.Anything, "user-id").Return(&model.ShopAccess{ShopID: shopID}, nil)
+			},
+			expected: true,
+		},
+		{
+			name:  "staff with scope",
+			scope: constant.ShopScopeOrders,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopAccess", mock.Anything, "user-id").
+					Return(&model.ShopAccess{ShopID: shopID, StaffID: &staffID, Scopes: []string{constant.ShopScopeOrders}}, nil)
+			},
+			expected: true,
+		},
+		{
+			name:  "staff without scope",
+			scope: constant.ShopScopeFinance,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopAccess", mock.Anything, "user-id").
+					Return(&model.ShopAccess{ShopID: shopID, StaffID: &staffID, Scopes: []string{constant.ShopScopeOrders}}, nil)
+			},
+			expected: false,
+		},
+		{
+			name:  "not a shop member",
+			scope: constant.ShopScopeOrders,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopAccess", mock.Anything, "user-id").Return(nil, sql.ErrNoRows)
+			},
+			expected: false,
+		},
+		{
+			name:  "error database",
+			scope: constant.ShopScopeOrders,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetShopAccess", mock.Anything, "user-id").Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			a := NewAuthorizer(r)
+
+			tc.mock(t, r)
+			access, err := a.ShopAccess(context.Background(), "user-id")
+
+			assert.Equal(t, tc.expectedErr, err)
+			assert.Equal(t, tc.expected, access != nil && access.HasScope(tc.scope))
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package rbac
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"strings"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/lib/pq"
+)
+
+type Repository interface {
+	GetUserPermissions(ctx context.Context, userID string) ([]string, error)
+	GetCachedPermissions(ctx context.Context, userID string) ([]string, bool, error)
+	CachePermissions(ctx context.Context, userID string, permissions []string, duration time.Duration) error
+	GetShopAccess(ctx context.Context, userID string) (*model.ShopAccess, error)
+	CreateShopAuditLog(ctx context.Context, auditLog *model.ShopAuditLog) error
+	CreateAdminAuditLog(ctx context.Context, auditLog *model.AdminAuditLog) error
+}
+
+type rbacRepo struct {
+	PSQL        *sql.DB
+	RedisClient *redis.Client
+}
+
+func NewRBACRepository(psql *sql.DB, client *redis.Client) Repository {
+	return &rbacRepo{
+		PSQL:        psql,
+		RedisClient: client,
+	}
+}
+
+func (r *rbacRepo) GetUserPermissions(ctx context.Context, userID string) ([]string, error) {
+	permissions := make([]string, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetUserPermissionsQuery, userID, constant.RoleUser)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var permission string
+		if errScan := res.Scan(&permission); errScan != nil {
+			return nil, errScan
+		}
+
+		permissions = append(permissions, permission)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return permissions, nil
+}
+
+func (r *rbacRepo) GetCachedPermissions(ctx context.Context, userID string) ([]string, bool, error) {
+	value, err := r.RedisClient.Get(ctx, CacheKey(userID)).Result()
+	if err != nil {
+		if err == redis.Nil {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	if value == "" {
+		return []string{}, true, nil
+	}
+
+	return strings.Split(value, ","), true, nil
+}
+
+func (r *rbacRepo) CachePermissions(ctx context.Context, userID string, permissions []string, duration time.Duration) error {
+	return r.RedisClient.Set(ctx, CacheKey(userID), strings.Join(permissions, ","), duration).Err()
+}
+
+func (r *rbacRepo) GetShopAccess(ctx context.Context, userID string) (*model.ShopAccess, error) {
+	var access model.ShopAccess
+	if err := r.PSQL.QueryRowContext(ctx, GetShopAccessQuery, userID).
+		Scan(&access.ShopID, &access.StaffID, (*pq.StringArray)(&access.Scopes)); err != nil {
+		return nil, err
+	}
+
+	return &access, nil
+}
+
+func (r *rbacRepo) CreateShopAuditLog(ctx context.Context, auditLog *model.ShopAuditLog) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateShopAuditLogQuery,
+		auditLog.ShopID,
+		auditLog.ActorID,
+		auditLog.StaffID,
+		auditLog.Action,
+		auditLog.Path,
+		auditLog.StatusCode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func CacheKey(userID string) string {
+	return fmt.Sprintf("%s:%s", constant.PermissionKey, userID)
+}
+
+func (r *rbacRepo) CreateAdminAuditLog(ctx context.Context, auditLog *model.AdminAuditLog) error {
+	if _, err := r.PSQL.ExecContext(ctx, CreateAdminAuditLogQuery,
+		auditLog.AdminID,
+		auditLog.UserID,
+		auditLog.ImpersonationID,
+		auditLog.Action,
+		auditLog.Detail); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,92 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "murakali/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	uuid "github.com/google/uuid"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// AcquireJobLock provides a mock function with given fields: ctx, jobName, instance, tick, ttl
+func (_m *Repository) AcquireJobLock(ctx context.Context, jobName string, instance string, tick time.Time, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, jobName, instance, tick, ttl)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time, time.Duration) bool); ok {
+		r0 = rf(ctx, jobName, instance, tick, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time, time.Duration) error); ok {
+		r1 = rf(ctx, jobName, instance, tick, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateJobRun provides a mock function with given fields: ctx, jobRun
+func (_m *Repository) CreateJobRun(ctx context.Context, jobRun *model.JobRun) (*uuid.UUID, error) {
+	ret := _m.Called(ctx, jobRun)
+
+	var r0 *uuid.UUID
+	if rf, ok := ret.Get(0).(func(context.Context, *model.JobRun) *uuid.UUID); ok {
+		r0 = rf(ctx, jobRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*uuid.UUID)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.JobRun) error); ok {
+		r1 = rf(ctx, jobRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FinishJobRun provides a mock function with given fields: ctx, jobRun
+func (_m *Repository) FinishJobRun(ctx context.Context, jobRun *model.JobRun) error {
+	ret := _m.Called(ctx, jobRun)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *model.JobRun) error); ok {
+		r0 = rf(ctx, jobRun)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,6 @@
+package scheduler
+
+const (
+	CreateJobRunQuery = `INSERT INTO "job_run" (job_name, instance, started_at) VALUES ($1, $2, $3) RETURNING "id";`
+	FinishJobRunQuery = `UPDATE "job_run" SET "finished_at" = $1, "rows_affected" = $2, "error" = $3 WHERE "id" = $4`
+)
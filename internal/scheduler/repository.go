@@ -0,0 +1,67 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"murakali/internal/constant"
+	"murakali/internal/model"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	AcquireJobLock(ctx context.Context, jobName, instance string, tick time.Time, ttl time.Duration) (bool, error)
+	CreateJobRun(ctx context.Context, jobRun *model.JobRun) (*uuid.UUID, error)
+	FinishJobRun(ctx context.Context, jobRun *model.JobRun) error
+}
+
+type schedulerRepo struct {
+	PSQL        *sql.DB
+	RedisClient *redis.Client
+}
+
+func NewSchedulerRepository(psql *sql.DB, client *redis.Client) Repository {
+	return &schedulerRepo{
+		PSQL:        psql,
+		RedisClient: client,
+	}
+}
+
+func (r *schedulerRepo) AcquireJobLock(ctx context.Context, jobName, instance string, tick time.Time,
+	ttl time.Duration) (bool, error) {
+	key := fmt.Sprintf("%s:%s:%d", constant.JobLockKey, jobName, tick.Unix())
+
+	res := r.RedisClient.SetNX(ctx, key, instance, ttl)
+	if res.Err() != nil {
+		return false, res.Err()
+	}
+
+	return res.Val(), nil
+}
+
+func (r *schedulerRepo) CreateJobRun(ctx context.Context, jobRun *model.JobRun) (*uuid.UUID, error) {
+	var jobRunID *uuid.UUID
+	if err := r.PSQL.QueryRowContext(ctx, CreateJobRunQuery,
+		jobRun.JobName,
+		jobRun.Instance,
+		jobRun.StartedAt).Scan(&jobRunID); err != nil {
+		return nil, err
+	}
+
+	return jobRunID, nil
+}
+
+func (r *schedulerRepo) FinishJobRun(ctx context.Context, jobRun *model.JobRun) error {
+	if _, err := r.PSQL.ExecContext(ctx, FinishJobRunQuery,
+		jobRun.FinishedAt,
+		jobRun.RowsAffected,
+		jobRun.Error,
+		jobRun.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package scheduler
+
+import (
+	"context"
+	"database/sql"
+	"murakali/internal/model"
+	"murakali/pkg/logger"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/robfig/cron/v3"
+)
+
+type Job struct {
+	Name    string
+	Spec    string
+	LockTTL time.Duration
+	Run     func(ctx context.Context) (int64, error)
+}
+
+type Scheduler struct {
+	cron     *cron.Cron
+	repo     Repository
+	log      logger.Logger
+	instance string
+}
+
+func NewScheduler(repo Repository, log logger.Logger) *Scheduler {
+	instance, err := os.Hostname()
+	if err != nil {
+		instance = uuid.NewString()
+	}
+
+	return &Scheduler{
+		cron:     cron.New(),
+		repo:     repo,
+		log:      log,
+		instance: instance,
+	}
+}
+
+func (s *Scheduler) Register(job Job) error {
+	_, err := s.cron.AddFunc(job.Spec, func() {
+		s.RunJob(context.Background(), job)
+	})
+
+	return err
+}
+
+func (s *Scheduler) Start() {
+	s.cron.Start()
+}
+
+func (s *Scheduler) Stop() {
+	<-s.cron.Stop().Done()
+}
+
+func (s *Scheduler) RunJob(ctx context.Context, job Job) {
+	interval, err := scheduleInterval(job.Spec)
+	if err != nil {
+		s.log.Errorf("job %s: parse schedule: %s", job.Name, err.Error())
+		return
+	}
+
+	lockTTL := job.LockTTL
+	if lockTTL < interval {
+		lockTTL = interval
+	}
+
+	acquired, err := s.repo.AcquireJobLock(ctx, job.Name, s.instance, time.Now().Truncate(interval), lockTTL)
+	if err != nil {
+		s.log.Errorf("job %s: acquire lock: %s", job.Name, err.Error())
+		return
+	}
+
+	if !acquired {
+		s.log.Infof("job %s: skipped, running on another instance", job.Name)
+		return
+	}
+
+	jobRun := &model.JobRun{
+		JobName:   job.Name,
+		Instance:  s.instance,
+		StartedAt: time.Now(),
+	}
+
+	jobRunID, err := s.repo.CreateJobRun(ctx, jobRun)
+	if err != nil {
+		s.log.Errorf("job %s: create run history: %s", job.Name, err.Error())
+		return
+	}
+	jobRun.ID = *jobRunID
+
+	s.log.Infof("job %s: start", job.Name)
+	jobRun.RowsAffected, err = job.Run(ctx)
+	if err != nil {
+		jobRun.Error = err.Error()
+		s.log.Errorf("job %s: %s", job.Name, err.Error())
+	}
+
+	jobRun.FinishedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	if err := s.repo.FinishJobRun(ctx, jobRun); err != nil {
+		s.log.Errorf("job %s: finish run history: %s", job.Name, err.Error())
+		return
+	}
+
+	s.log.Infof("job %s: finished, %d rows affected", job.Name, jobRun.RowsAffected)
+}
+
+func scheduleInterval(spec string) (time.Duration, error) {
+	schedule, err := cron.ParseStandard(spec)
+	if err != nil {
+		return 0, err
+	}
+
+	if every, ok := schedule.(cron.ConstantDelaySchedule); ok {
+		return every.Delay, nil
+	}
+
+	return time.Minute, nil
+}
@@ -0,0 +1,136 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"murakali/config"
+	"murakali/internal/model"
+	"murakali/internal/scheduler/mocks"
+	"murakali/pkg/logger"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestScheduler_RunJob(t *testing.T) {
+	jobRunID := uuid.New()
+	testCase := []struct {
+		name         string
+		mock         func(r *mocks.Repository)
+		jobErr       error
+		expectedRun  bool
+		expectedRows int64
+		expectedErr  string
+	}{
+		{
+			name: "success run job",
+			mock: func(r *mocks.Repository) {
+				r.On("AcquireJobLock", mock.Anything, "test-job", mock.Anything, mock.Anything, time.Minute).Return(true, nil)
+				r.On("CreateJobRun", mock.Anything, mock.Anything).Return(&jobRunID, nil)
+				r.On("FinishJobRun", mock.Anything, mock.Anything).Return(nil)
+			},
+			expectedRun:  true,
+			expectedRows: 3,
+		},
+		{
+			name: "job error recorded",
+			mock: func(r *mocks.Repository) {
+				r.On("AcquireJobLock", mock.Anything, "test-job", mock.Anything, mock.Anything, time.Minute).Return(true, nil)
+				r.On("CreateJobRun", mock.Anything, mock.Anything).Return(&jobRunID, nil)
+				r.On("FinishJobRun", mock.Anything, mock.Anything).Return(nil)
+			},
+			jobErr:       errors.New("test"),
+			expectedRun:  true,
+			expectedRows: 3,
+			expectedErr:  "test",
+		},
+		{
+			name: "lock held by another instance",
+			mock: func(r *mocks.Repository) {
+				r.On("AcquireJobLock", mock.Anything, "test-job", mock.Anything, mock.Anything, time.Minute).Return(false, nil)
+			},
+			expectedRun: false,
+		},
+		{
+			name: "error acquire lock",
+			mock: func(r *mocks.Repository) {
+				r.On("AcquireJobLock", mock.Anything, "test-job", mock.Anything, mock.Anything, time.Minute).Return(false, errors.New("test"))
+			},
+			expectedRun: false,
+		},
+		{
+			name: "error create job run",
+			mock: func(r *mocks.Repository) {
+				r.On("AcquireJobLock", mock.Anything, "test-job", mock.Anything, mock.Anything, time.Minute).Return(true, nil)
+				r.On("CreateJobRun", mock.Anything, mock.Anything).Return(nil, errors.New("test"))
+			},
+			expectedRun: false,
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{
+				Logger: config.LoggerConfig{
+					Development: true,
+					Encoding:    "json",
+					Level:       "info",
+				},
+			}
+
+			appLogger := logger.NewAPILogger(cfg)
+			appLogger.InitLogger()
+
+			r := mocks.NewRepository(t)
+			tc.mock(r)
+
+			ran := false
+			s := NewScheduler(r, appLogger)
+			s.RunJob(context.Background(), Job{
+				Name:    "test-job",
+				Spec:    "@every 1m",
+				LockTTL: time.Minute,
+				Run: func(ctx context.Context) (int64, error) {
+					ran = true
+					return 3, tc.jobErr
+				},
+			})
+
+			assert.Equal(t, tc.expectedRun, ran)
+			if tc.expectedRun {
+				r.AssertCalled(t, "FinishJobRun", mock.Anything, mock.MatchedBy(func(jobRun *model.JobRun) bool {
+					return jobRun.ID == jobRunID &&
+						jobRun.JobName == "test-job" &&
+						jobRun.FinishedAt.Valid &&
+						jobRun.RowsAffected == tc.expectedRows &&
+						jobRun.Error == tc.expectedErr
+				}))
+			}
+		})
+	}
+}
+
+func TestScheduler_RunJobLocksPerTick(t *testing.T) {
+	appLogger := logger.NewAPILogger(&config.Config{})
+	appLogger.InitLogger()
+
+	r := mocks.NewRepository(t)
+	r.On("AcquireJobLock", mock.Anything, "test-job", mock.Anything, mock.MatchedBy(func(tick time.Time) bool {
+		return tick.Equal(tick.Truncate(10*time.Minute)) && time.Since(tick) < 10*time.Minute
+	}), 10*time.Minute).Return(false, nil)
+
+	s := NewScheduler(r, appLogger)
+	s.RunJob(context.Background(), Job{
+		Name:    "test-job",
+		Spec:    "@every 10m",
+		LockTTL: time.Minute,
+		Run: func(ctx context.Context) (int64, error) {
+			return 0, nil
+		},
+	})
+
+	r.AssertNotCalled(t, "CreateJobRun", mock.Anything, mock.Anything)
+}
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"murakali/internal/ledger"
+	"murakali/internal/limiter"
 	"murakali/internal/middleware"
 	adminDelivery "murakali/internal/module/admin/delivery"
 	adminRepository "murakali/internal/module/admin/repository"
@@ -23,6 +25,11 @@ import (
 	userDelivery "murakali/internal/module/user/delivery"
 	userRepository "murakali/internal/module/user/repository"
 	userUseCase "murakali/internal/module/user/usecase"
+	"murakali/internal/rbac"
+	"murakali/internal/session"
+	"murakali/internal/twofactor"
+	"murakali/pkg/jwt"
+	"murakali/pkg/oauth"
 	"murakali/pkg/postgre"
 	"murakali/pkg/response"
 	"net/http"
@@ -33,22 +40,37 @@ import (
 )
 
 func (s *Server) MapHandlers() error {
+	keySet, err := jwt.NewKeySet(s.cfg)
+	if err != nil {
+		return err
+	}
+
+	oidcClients, err := oauth.NewOIDCClients(s.cfg)
+	if err != nil {
+		return err
+	}
+
 	txRepo := postgre.NewTxRepository(s.db)
+	bruteForceLimiter := limiter.NewLimiter(limiter.NewLimiterRepository(s.redisClient))
+	authorizer := rbac.NewAuthorizer(rbac.NewRBACRepository(s.db, s.redisClient))
+	twoFactor := twofactor.NewVerifier(twofactor.NewTwoFactorRepository(s.db))
+	ledgerRepo := ledger.NewLedgerRepository(s.db)
+	sessionRevoker := session.NewRevoker(s.cfg, session.NewSessionRepository(s.db, s.redisClient))
 
 	adminRepo := adminRepository.NewAdminRepository(s.db, s.redisClient)
-	adminUC := adminUseCase.NewAdminUseCase(s.cfg, txRepo, adminRepo)
+	adminUC := adminUseCase.NewAdminUseCase(s.cfg, txRepo, adminRepo, ledgerRepo, bruteForceLimiter, authorizer, sessionRevoker, keySet)
 	adminHandlers := adminDelivery.NewAdminHandlers(s.cfg, adminUC, s.log)
 
 	authRepo := authRepository.NewAuthRepository(s.db, s.redisClient)
-	authUC := authUseCase.NewAuthUseCase(s.cfg, txRepo, authRepo)
-	authHandlers := authDelivery.NewAuthHandlers(s.cfg, authUC, s.log)
+	authUC := authUseCase.NewAuthUseCase(s.cfg, txRepo, authRepo, bruteForceLimiter, twoFactor, sessionRevoker, oidcClients, keySet)
+	authHandlers := authDelivery.NewAuthHandlers(s.cfg, authUC, keySet, s.log)
 
 	userRepo := userRepository.NewUserRepository(s.db, s.redisClient)
-	userUC := userUseCase.NewUserUseCase(s.cfg, txRepo, userRepo)
-	userHandlers := userDelivery.NewUserHandlers(s.cfg, userUC, s.log)
+	userUC := userUseCase.NewUserUseCase(s.cfg, txRepo, userRepo, ledgerRepo, twoFactor, sessionRevoker, keySet)
+	userHandlers := userDelivery.NewUserHandlers(s.cfg, userUC, keySet, s.log)
 
 	productRepo := productRepository.NewProductRepository(s.db, s.redisClient)
-	productUC := productUseCase.NewProductUseCase(s.cfg, txRepo, productRepo)
+	productUC := productUseCase.NewProductUseCase(s.cfg, txRepo, productRepo, s.log)
 	productHandlers := productDelivery.NewProductHandlers(s.cfg, productUC, s.log)
 
 	cartRepo := cartRepository.NewCartRepository(s.db, s.redisClient)
@@ -60,7 +82,7 @@ func (s *Server) MapHandlers() error {
 	locationHandlers := locationDelivery.NewLocationHandlers(s.cfg, locationUC, s.log)
 
 	sellerRepo := sellerRepository.NewSellerRepository(s.db, s.redisClient)
-	sellerUC := sellerUseCase.NewSellerUseCase(s.cfg, txRepo, sellerRepo)
+	sellerUC := sellerUseCase.NewSellerUseCase(s.cfg, txRepo, sellerRepo, ledgerRepo)
 	sellerHandlers := sellerDelivery.NewSellerHandlers(s.cfg, sellerUC, s.log)
 
 	s.gin.Use(cors.New(cors.Config{
@@ -80,6 +102,8 @@ func (s *Server) MapHandlers() error {
 		response.ErrorResponse(c.Writer, response.NotFoundMessage, http.StatusNotFound)
 	})
 
+	authDelivery.MapWellKnownRoutes(s.gin.Group("/.well-known"), authHandlers)
+
 	v1 := s.gin.Group("/api/v1")
 	authGroup := v1.Group("/auth")
 	userGroup := v1.Group("/user")
@@ -89,8 +113,8 @@ func (s *Server) MapHandlers() error {
 	sellerGroup := v1.Group("/seller")
 	adminGroup := v1.Group("/admin")
 
-	mw := middleware.NewMiddlewareManager(s.cfg, []string{"*"}, s.log, s.redisClient)
-	authDelivery.MapAuthRoutes(authGroup, authHandlers)
+	mw := middleware.NewMiddlewareManager(s.cfg, []string{"*"}, s.log, s.redisClient, bruteForceLimiter, authorizer, keySet)
+	authDelivery.MapAuthRoutes(authGroup, authHandlers, mw)
 	userDelivery.MapUserRoutes(userGroup, userHandlers, mw)
 	productDelivery.MapProductRoutes(productGroup, productHandlers, mw)
 	cartDelivery.MapCartRoutes(cartGroup, cartHandlers, mw)
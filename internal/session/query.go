@@ -0,0 +1,5 @@
+package session
+
+const (
+	RevokeUserSessionsQuery = `UPDATE "user_session" SET "revoked_at" = now() WHERE "user_id" = $1 AND "revoked_at" IS NULL`
+)
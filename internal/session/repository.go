@@ -0,0 +1,58 @@
+package session
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+)
+
+type Repository interface {
+	RevokeUserSessions(ctx context.Context, userID string) error
+	GetSessionKeyRedis(ctx context.Context, key string) ([]string, error)
+	InsertSessionRedis(ctx context.Context, duration int, key, status string) error
+}
+
+type sessionRepo struct {
+	PSQL        *sql.DB
+	RedisClient *redis.Client
+}
+
+func NewSessionRepository(psql *sql.DB, client *redis.Client) Repository {
+	return &sessionRepo{
+		PSQL:        psql,
+		RedisClient: client,
+	}
+}
+
+func (r *sessionRepo) RevokeUserSessions(ctx context.Context, userID string) error {
+	if _, err := r.PSQL.ExecContext(ctx, RevokeUserSessionsQuery, userID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *sessionRepo) GetSessionKeyRedis(ctx context.Context, key string) ([]string, error) {
+	keys := make([]string, 0)
+
+	iter := r.RedisClient.Scan(ctx, 0, key, 0).Iterator()
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+	}
+
+	if err := iter.Err(); err != nil {
+		return keys, err
+	}
+
+	return keys, nil
+}
+
+func (r *sessionRepo) InsertSessionRedis(ctx context.Context, duration int, key, status string) error {
+	if err := r.RedisClient.Set(ctx, key, status, time.Duration(duration)*time.Minute); err != nil {
+		return err.Err()
+	}
+
+	return nil
+}
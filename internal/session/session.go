@@ -0,0 +1,39 @@
+package session
+
+import (
+	"context"
+	"fmt"
+	"murakali/config"
+	"murakali/internal/constant"
+)
+
+type Revoker struct {
+	cfg  *config.Config
+	repo Repository
+}
+
+func NewRevoker(cfg *config.Config, repo Repository) *Revoker {
+	return &Revoker{
+		cfg:  cfg,
+		repo: repo,
+	}
+}
+
+func (r *Revoker) RevokeUserSessions(ctx context.Context, userID string) error {
+	if err := r.repo.RevokeUserSessions(ctx, userID); err != nil {
+		return err
+	}
+
+	keys, err := r.repo.GetSessionKeyRedis(ctx, fmt.Sprintf("session:%s:*", userID))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := r.repo.InsertSessionRedis(ctx, r.cfg.JWT.AccessExpMin, key, constant.FALSE); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
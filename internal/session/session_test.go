@@ -0,0 +1,68 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"murakali/config"
+	"murakali/internal/constant"
+	"murakali/internal/session/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRevoker_RevokeUserSessions(t *testing.T) {
+	testCase := []struct {
+		name        string
+		mock        func(t *testing.T, r *mocks.Repository)
+		expectedErr error
+	}{
+		{
+			name: "success revoke sessions",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("RevokeUserSessions", mock.Anything, "123456").Return(nil)
+				r.On("GetSessionKeyRedis", mock.Anything, "session:123456:*").Return([]string{"session:123456:a", "session:123456:b"}, nil)
+				r.On("InsertSessionRedis", mock.Anything, 15, "session:123456:a", constant.FALSE).Return(nil)
+				r.On("InsertSessionRedis", mock.Anything, 15, "session:123456:b", constant.FALSE).Return(nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error revoke sessions keeps redis untouched",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("RevokeUserSessions", mock.Anything, "123456").Return(errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name: "error get session keys",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("RevokeUserSessions", mock.Anything, "123456").Return(nil)
+				r.On("GetSessionKeyRedis", mock.Anything, "session:123456:*").Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+		{
+			name: "error mark session key",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("RevokeUserSessions", mock.Anything, "123456").Return(nil)
+				r.On("GetSessionKeyRedis", mock.Anything, "session:123456:*").Return([]string{"session:123456:a"}, nil)
+				r.On("InsertSessionRedis", mock.Anything, 15, "session:123456:a", constant.FALSE).Return(errors.New("test"))
+			},
+			expectedErr: errors.New("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			revoker := NewRevoker(&config.Config{JWT: config.JWTConfig{AccessExpMin: 15}}, r)
+
+			tc.mock(t, r)
+			err := revoker.RevokeUserSessions(context.Background(), "123456")
+
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
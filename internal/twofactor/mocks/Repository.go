@@ -0,0 +1,118 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	model "murakali/internal/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// Repository is an autogenerated mock type for the Repository type
+type Repository struct {
+	mock.Mock
+}
+
+// GetUnusedRecoveryCodes provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUnusedRecoveryCodes(ctx context.Context, userID string) ([]*model.UserRecoveryCode, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []*model.UserRecoveryCode
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*model.UserRecoveryCode); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserRecoveryCode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserTOTPByUserID provides a mock function with given fields: ctx, userID
+func (_m *Repository) GetUserTOTPByUserID(ctx context.Context, userID string) (*model.UserTOTP, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 *model.UserTOTP
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.UserTOTP); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserTOTP)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUserTOTPLastUsedStep provides a mock function with given fields: ctx, userID, step
+func (_m *Repository) UpdateUserTOTPLastUsedStep(ctx context.Context, userID string, step int64) (int64, error) {
+	ret := _m.Called(ctx, userID, step)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64) int64); ok {
+		r0 = rf(ctx, userID, step)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64) error); ok {
+		r1 = rf(ctx, userID, step)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UseRecoveryCode provides a mock function with given fields: ctx, codeID
+func (_m *Repository) UseRecoveryCode(ctx context.Context, codeID string) (int64, error) {
+	ret := _m.Called(ctx, codeID)
+
+	var r0 int64
+	if rf, ok := ret.Get(0).(func(context.Context, string) int64); ok {
+		r0 = rf(ctx, codeID)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, codeID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewRepository interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewRepository creates a new instance of Repository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewRepository(t mockConstructorTestingTNewRepository) *Repository {
+	mock := &Repository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
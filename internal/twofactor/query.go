@@ -0,0 +1,14 @@
+package twofactor
+
+const (
+	GetUserTOTPByUserIDQuery = `SELECT "user_id", "secret", "confirmed_at", "last_used_step", "created_at", "updated_at"
+	FROM "user_totp" WHERE "user_id" = $1`
+
+	UpdateUserTOTPLastUsedStepQuery = `UPDATE "user_totp" SET "last_used_step" = $1, "updated_at" = now()
+	WHERE "user_id" = $2 AND ("last_used_step" IS NULL OR "last_used_step" < $1)`
+
+	GetUnusedRecoveryCodesQuery = `SELECT "id", "user_id", "code_hash", "created_at", "used_at"
+	FROM "user_recovery_code" WHERE "user_id" = $1 AND "used_at" IS NULL`
+
+	UseRecoveryCodeQuery = `UPDATE "user_recovery_code" SET "used_at" = now() WHERE "id" = $1 AND "used_at" IS NULL`
+)
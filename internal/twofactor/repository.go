@@ -0,0 +1,86 @@
+package twofactor
+
+import (
+	"context"
+	"database/sql"
+	"murakali/internal/model"
+)
+
+type Repository interface {
+	GetUserTOTPByUserID(ctx context.Context, userID string) (*model.UserTOTP, error)
+	UpdateUserTOTPLastUsedStep(ctx context.Context, userID string, step int64) (int64, error)
+	GetUnusedRecoveryCodes(ctx context.Context, userID string) ([]*model.UserRecoveryCode, error)
+	UseRecoveryCode(ctx context.Context, codeID string) (int64, error)
+}
+
+type twoFactorRepo struct {
+	PSQL *sql.DB
+}
+
+func NewTwoFactorRepository(psql *sql.DB) Repository {
+	return &twoFactorRepo{
+		PSQL: psql,
+	}
+}
+
+func (r *twoFactorRepo) GetUserTOTPByUserID(ctx context.Context, userID string) (*model.UserTOTP, error) {
+	var userTOTP model.UserTOTP
+	if err := r.PSQL.QueryRowContext(ctx, GetUserTOTPByUserIDQuery, userID).Scan(
+		&userTOTP.UserID,
+		&userTOTP.Secret,
+		&userTOTP.ConfirmedAt,
+		&userTOTP.LastUsedStep,
+		&userTOTP.CreatedAt,
+		&userTOTP.UpdatedAt); err != nil {
+		return nil, err
+	}
+
+	return &userTOTP, nil
+}
+
+func (r *twoFactorRepo) UpdateUserTOTPLastUsedStep(ctx context.Context, userID string, step int64) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, UpdateUserTOTPLastUsedStepQuery, step, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
+
+func (r *twoFactorRepo) GetUnusedRecoveryCodes(ctx context.Context, userID string) ([]*model.UserRecoveryCode, error) {
+	codes := make([]*model.UserRecoveryCode, 0)
+	res, err := r.PSQL.QueryContext(ctx, GetUnusedRecoveryCodesQuery, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close()
+
+	for res.Next() {
+		var code model.UserRecoveryCode
+		if errScan := res.Scan(
+			&code.ID,
+			&code.UserID,
+			&code.CodeHash,
+			&code.CreatedAt,
+			&code.UsedAt); errScan != nil {
+			return nil, errScan
+		}
+
+		codes = append(codes, &code)
+	}
+
+	if res.Err() != nil {
+		return nil, res.Err()
+	}
+
+	return codes, nil
+}
+
+func (r *twoFactorRepo) UseRecoveryCode(ctx context.Context, codeID string) (int64, error) {
+	res, err := r.PSQL.ExecContext(ctx, UseRecoveryCodeQuery, codeID)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.RowsAffected()
+}
@@ -0,0 +1,106 @@
+package twofactor
+
+import (
+	"context"
+	"database/sql"
+	"murakali/internal/model"
+	"murakali/pkg/httperror"
+	"murakali/pkg/response"
+	"murakali/pkg/totp"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrNotEnabled  = httperror.New(http.StatusBadRequest, response.TOTPNotEnabledMessage)
+	ErrInvalidCode = httperror.New(http.StatusForbidden, response.TOTPInvalidCodeMessage)
+)
+
+type Verifier struct {
+	repo Repository
+}
+
+func NewVerifier(repo Repository) *Verifier {
+	return &Verifier{repo: repo}
+}
+
+func (v *Verifier) GetUserTOTP(ctx context.Context, userID string) (*model.UserTOTP, error) {
+	userTOTP, err := v.repo.GetUserTOTPByUserID(ctx, userID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNotEnabled
+		}
+
+		return nil, err
+	}
+
+	return userTOTP, nil
+}
+
+func (v *Verifier) IsEnabled(ctx context.Context, userID string) (bool, error) {
+	userTOTP, err := v.GetUserTOTP(ctx, userID)
+	if err != nil {
+		if err == ErrNotEnabled {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return userTOTP.ConfirmedAt.Valid, nil
+}
+
+func (v *Verifier) Verify(ctx context.Context, userID, code, recoveryCode string) error {
+	userTOTP, err := v.GetUserTOTP(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if !userTOTP.ConfirmedAt.Valid {
+		return ErrNotEnabled
+	}
+
+	if code != "" {
+		step, ok := totp.Validate(userTOTP.Secret, code, time.Now())
+		if !ok {
+			return ErrInvalidCode
+		}
+
+		rows, err := v.repo.UpdateUserTOTPLastUsedStep(ctx, userID, step)
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			return ErrInvalidCode
+		}
+
+		return nil
+	}
+
+	recoveryCodes, err := v.repo.GetUnusedRecoveryCodes(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, recovery := range recoveryCodes {
+		if bcrypt.CompareHashAndPassword([]byte(recovery.CodeHash), []byte(recoveryCode)) != nil {
+			continue
+		}
+
+		rows, err := v.repo.UseRecoveryCode(ctx, recovery.ID.String())
+		if err != nil {
+			return err
+		}
+
+		if rows == 0 {
+			break
+		}
+
+		return nil
+	}
+
+	return ErrInvalidCode
+}
@@ -0,0 +1,114 @@
+package twofactor
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"murakali/internal/model"
+	"murakali/internal/twofactor/mocks"
+	"murakali/pkg/totp"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestVerifier_Verify(t *testing.T) {
+	secret, _ := totp.GenerateSecret()
+	code, _ := totp.GenerateCode(secret, totp.Step(time.Now()))
+	recoveryHash, _ := bcrypt.GenerateFromPassword([]byte("abcd-efgh"), bcrypt.MinCost)
+	userTOTP := &model.UserTOTP{Secret: secret, ConfirmedAt: sql.NullTime{Time: time.Now(), Valid: true}}
+	testCase := []struct {
+		name         string
+		code         string
+		recoveryCode string
+		mock         func(t *testing.T, r *mocks.Repository)
+		expectedErr  error
+	}{
+		{
+			name: "success totp code",
+			code: code,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+				r.On("UpdateUserTOTPLastUsedStep", mock.Anything, "123456", totp.Step(time.Now())).Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name: "error replayed totp code",
+			code: code,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+				r.On("UpdateUserTOTPLastUsedStep", mock.Anything, "123456", mock.Anything).Return(int64(0), nil)
+			},
+			expectedErr: ErrInvalidCode,
+		},
+		{
+			name: "error invalid totp code",
+			code: "abcdef",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+			},
+			expectedErr: ErrInvalidCode,
+		},
+		{
+			name:         "success recovery code",
+			recoveryCode: "abcd-efgh",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+				r.On("GetUnusedRecoveryCodes", mock.Anything, "123456").Return([]*model.UserRecoveryCode{
+					{ID: uuid.Nil, CodeHash: string(recoveryHash)}}, nil)
+				r.On("UseRecoveryCode", mock.Anything, uuid.Nil.String()).Return(int64(1), nil)
+			},
+			expectedErr: nil,
+		},
+		{
+			name:         "error unknown recovery code",
+			recoveryCode: "zzzz-zzzz",
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(userTOTP, nil)
+				r.On("GetUnusedRecoveryCodes", mock.Anything, "123456").Return([]*model.UserRecoveryCode{
+					{ID: uuid.Nil, CodeHash: string(recoveryHash)}}, nil)
+			},
+			expectedErr: ErrInvalidCode,
+		},
+		{
+			name: "error not confirmed",
+			code: code,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(&model.UserTOTP{Secret: secret}, nil)
+			},
+			expectedErr: ErrNotEnabled,
+		},
+		{
+			name: "error not setup",
+			code: code,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(nil, sql.ErrNoRows)
+			},
+			expectedErr: ErrNotEnabled,
+		},
+		{
+			name: "error get totp",
+			code: code,
+			mock: func(t *testing.T, r *mocks.Repository) {
+				r.On("GetUserTOTPByUserID", mock.Anything, "123456").Return(nil, fmt.Errorf("test"))
+			},
+			expectedErr: fmt.Errorf("test"),
+		},
+	}
+
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			r := mocks.NewRepository(t)
+			v := NewVerifier(r)
+
+			tc.mock(t, r)
+			err := v.Verify(context.Background(), "123456", tc.code, tc.recoveryCode)
+			assert.Equal(t, tc.expectedErr, err)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package util
 import (
 	"crypto/rand"
 	"fmt"
-	"math"
 	"mime/multipart"
 	"murakali/config"
 	"murakali/internal/model"
@@ -109,17 +108,17 @@ func GenerateInvoice() (string, error) {
 	return invoice, nil
 }
 
-func CalculateDiscount(price float64, disc *model.Discount) (float64, float64) {
+func CalculateDiscount(price model.Money, disc *model.Discount) (model.Money, model.Money) {
 	if disc.MaxDiscountPrice == nil {
 		return 0, price
 	}
 
-	var maxDiscountPrice float64
-	var minProductPrice float64
+	var maxDiscountPrice model.Money
+	var minProductPrice model.Money
 	var discountPercentage float64
-	var discountFixPrice float64
+	var discountFixPrice model.Money
 
-	var resultDiscount float64
+	var resultDiscount model.Money
 	tempPrice := price
 
 	maxDiscountPrice = *disc.MaxDiscountPrice
@@ -134,15 +133,15 @@ func CalculateDiscount(price float64, disc *model.Discount) (float64, float64) {
 	if disc.DiscountPercentage != nil {
 		discountPercentage = *disc.DiscountPercentage
 		if price >= minProductPrice && discountPercentage > 0 {
-			resultDiscount = math.Min(maxDiscountPrice, price*(discountPercentage/100.00))
+			resultDiscount = minMoney(maxDiscountPrice, model.NewMoney(price.Float64()*(discountPercentage/100.00)))
 		}
 	}
 
 	if disc.DiscountFixPrice != nil {
 		discountFixPrice = *disc.DiscountFixPrice
 		if price >= minProductPrice && discountFixPrice > 0 {
-			resultDiscount = math.Max(resultDiscount, discountFixPrice)
-			resultDiscount = math.Min(resultDiscount, maxDiscountPrice)
+			resultDiscount = maxMoney(resultDiscount, discountFixPrice)
+			resultDiscount = minMoney(resultDiscount, maxDiscountPrice)
 		}
 	}
 	price -= resultDiscount
@@ -152,3 +151,27 @@ func CalculateDiscount(price float64, disc *model.Discount) (float64, float64) {
 	}
 	return resultDiscount, price
 }
+
+func TierPrice(price model.Money, tiers []*model.PriceTier, quantity int) model.Money {
+	for _, tier := range tiers {
+		if quantity >= tier.MinQuantity && (tier.MaxQuantity == nil || quantity <= *tier.MaxQuantity) {
+			return tier.Price
+		}
+	}
+
+	return price
+}
+
+func minMoney(a, b model.Money) model.Money {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxMoney(a, b model.Money) model.Money {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -1,5 +1,7 @@
 package email
 
+import "html"
+
 func VerificationEmailBody(otp string) string {
 	return `<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN" "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
 <html xmlns="http://www.w3.org/1999/xhtml" xmlns:o="urn:schemas-microsoft-com:office:office" style="width:100%;-webkit-text-size-adjust:100%;-ms-text-size-adjust:100%;padding:0;Margin:0">
@@ -385,3 +387,157 @@ a[x-apple-data-detectors] {
  </body>
 </html>`
 }
+
+func ShopStaffInvitationBody(shopName, link string) string {
+	return `<!DOCTYPE html>
+<html>
+ <head>
+  <meta charset="UTF-8">
+  <meta content="width=device-width, initial-scale=1" name="viewport">
+  <title>Murakali</title>
+ </head>
+ <body style="width:100%;padding:0;Margin:0;background-color:#F0F0F0;font-family:arial, 'helvetica neue', helvetica, sans-serif">
+  <table width="100%" cellspacing="0" cellpadding="0" style="border-collapse:collapse;border-spacing:0px">
+   <tr>
+    <td align="center" style="padding:30px">
+     <table width="600" cellspacing="0" cellpadding="0" bgcolor="#ffffff" style="border-collapse:collapse;border-spacing:0px;background-color:#FFFFFF">
+      <tr>
+       <td align="center" style="padding:20px 30px"><h2 style="Margin:0;font-size:24px;font-weight:normal;color:#0081ff">Murakali.</h2></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px"><h1 style="Margin:0;font-size:28px;font-weight:normal;color:#333333">Join ` + shopName + `</h1></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px 20px 30px"><p style="Margin:0;font-size:14px;line-height:21px;color:#999999">you have been invited to help manage this shop, click this link to accept the invitation</p></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:0 30px 30px 30px"><a href="` + link + `" style="font-size:16px;color:#0081ff">` + link + `</a></td>
+      </tr>
+     </table>
+    </td>
+   </tr>
+  </table>
+ </body>
+</html>`
+}
+
+func AccountDeletionScheduledBody(scheduledAt string) string {
+	return `<!DOCTYPE html>
+<html>
+ <head>
+  <meta charset="UTF-8">
+  <meta content="width=device-width, initial-scale=1" name="viewport">
+  <title>Murakali</title>
+ </head>
+ <body style="width:100%;padding:0;Margin:0;background-color:#F0F0F0;font-family:arial, 'helvetica neue', helvetica, sans-serif">
+  <table width="100%" cellspacing="0" cellpadding="0" style="border-collapse:collapse;border-spacing:0px">
+   <tr>
+    <td align="center" style="padding:30px">
+     <table width="600" cellspacing="0" cellpadding="0" bgcolor="#ffffff" style="border-collapse:collapse;border-spacing:0px;background-color:#FFFFFF">
+      <tr>
+       <td align="center" style="padding:20px 30px"><h2 style="Margin:0;font-size:24px;font-weight:normal;color:#0081ff">Murakali.</h2></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px"><h1 style="Margin:0;font-size:28px;font-weight:normal;color:#333333">Account deletion requested</h1></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px 30px 30px"><p style="Margin:0;font-size:14px;line-height:21px;color:#999999">your account and personal data will be deleted on ` + scheduledAt + `, log in and cancel the request before then if you want to keep your account</p></td>
+      </tr>
+     </table>
+    </td>
+   </tr>
+  </table>
+ </body>
+</html>`
+}
+
+func AccountSuspendedBody(reason, until string) string {
+	return accountNoticeBody("Account suspended",
+		"your account is suspended until "+until+" for the following reason: "+html.EscapeString(reason))
+}
+
+func AccountBannedBody(reason string) string {
+	return accountNoticeBody("Account banned",
+		"your account is banned for the following reason: "+html.EscapeString(reason))
+}
+
+func PasswordResetRequiredBody() string {
+	return accountNoticeBody("Password reset required",
+		"you have been logged out of all devices, use forgot password on the login page to set a new password")
+}
+
+func accountNoticeBody(title, message string) string {
+	return `<!DOCTYPE html>
+<html>
+ <head>
+  <meta charset="UTF-8">
+  <meta content="width=device-width, initial-scale=1" name="viewport">
+  <title>Murakali</title>
+ </head>
+ <body style="width:100%;padding:0;Margin:0;background-color:#F0F0F0;font-family:arial, 'helvetica neue', helvetica, sans-serif">
+  <table width="100%" cellspacing="0" cellpadding="0" style="border-collapse:collapse;border-spacing:0px">
+   <tr>
+    <td align="center" style="padding:30px">
+     <table width="600" cellspacing="0" cellpadding="0" bgcolor="#ffffff" style="border-collapse:collapse;border-spacing:0px;background-color:#FFFFFF">
+      <tr>
+       <td align="center" style="padding:20px 30px"><h2 style="Margin:0;font-size:24px;font-weight:normal;color:#0081ff">Murakali.</h2></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px"><h1 style="Margin:0;font-size:28px;font-weight:normal;color:#333333">` + title + `</h1></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px 30px 30px"><p style="Margin:0;font-size:14px;line-height:21px;color:#999999">` + message + `</p></td>
+      </tr>
+     </table>
+    </td>
+   </tr>
+  </table>
+ </body>
+</html>`
+}
+
+func ProductPriceDropBody(title, price, link, unsubscribeLink string) string {
+	return productAlertBody("Price drop",
+		html.EscapeString(title)+" is now Rp"+price+", the price you were waiting for", link, unsubscribeLink)
+}
+
+func ProductBackInStockBody(title, link, unsubscribeLink string) string {
+	return productAlertBody("Back in stock",
+		html.EscapeString(title)+" is available again, get it before it runs out", link, unsubscribeLink)
+}
+
+func productAlertBody(title, message, link, unsubscribeLink string) string {
+	return `<!DOCTYPE html>
+<html>
+ <head>
+  <meta charset="UTF-8">
+  <meta content="width=device-width, initial-scale=1" name="viewport">
+  <title>Murakali</title>
+ </head>
+ <body style="width:100%;padding:0;Margin:0;background-color:#F0F0F0;font-family:arial, 'helvetica neue', helvetica, sans-serif">
+  <table width="100%" cellspacing="0" cellpadding="0" style="border-collapse:collapse;border-spacing:0px">
+   <tr>
+    <td align="center" style="padding:30px">
+     <table width="600" cellspacing="0" cellpadding="0" bgcolor="#ffffff" style="border-collapse:collapse;border-spacing:0px;background-color:#FFFFFF">
+      <tr>
+       <td align="center" style="padding:20px 30px"><h2 style="Margin:0;font-size:24px;font-weight:normal;color:#0081ff">Murakali.</h2></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px"><h1 style="Margin:0;font-size:28px;font-weight:normal;color:#333333">` + title + `</h1></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:10px 30px 20px 30px"><p style="Margin:0;font-size:14px;line-height:21px;color:#999999">` + message + `</p></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:0 30px 20px 30px"><a href="` + link + `" style="font-size:16px;color:#0081ff">` + link + `</a></td>
+      </tr>
+      <tr>
+       <td align="center" style="padding:0 30px 30px 30px"><a href="` + unsubscribeLink + `" style="font-size:12px;color:#999999">unsubscribe from this alert</a></td>
+      </tr>
+     </table>
+    </td>
+   </tr>
+  </table>
+ </body>
+</html>`
+}
@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"github.com/go-redis/redis/v8"
-	"murakali/config"
 	"murakali/internal/constant"
 	"murakali/internal/model"
 	"net/http"
@@ -12,16 +11,20 @@ import (
 	"time"
 
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 )
 
 type AccessClaims struct {
-	ID     string `json:"id"`
-	RoleID int    `json:"role_id"`
+	ID        string `json:"id"`
+	RoleID    int    `json:"role_id"`
+	SessionID string `json:"sid"`
+	ActorID   string `json:"act,omitempty"`
 	jwt.RegisteredClaims
 }
 
 type RefreshClaims struct {
-	ID string `json:"id"`
+	ID        string `json:"id"`
+	SessionID string `json:"sid"`
 	jwt.RegisteredClaims
 }
 
@@ -42,25 +45,57 @@ type ChangePasswordClaims struct {
 }
 
 type WalletClaims struct {
+	ID    string `json:"id"`
+	Scope string `json:"scope"`
+	MFA   bool   `json:"mfa"`
+	jwt.RegisteredClaims
+}
+
+type PreAuthClaims struct {
 	ID    string `json:"id"`
 	Scope string `json:"scope"`
 	jwt.RegisteredClaims
 }
 
-func GenerateJWTAccessToken(userID string, userRole int, cfg *config.Config) (*model.AccessToken, error) {
+func GenerateJWTAccessToken(userID string, userRole int, sessionID string, ks *KeySet) (*model.AccessToken, error) {
 	claims := &AccessClaims{
-		ID:     userID,
-		RoleID: userRole,
+		ID:        userID,
+		RoleID:    userRole,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.JWT.AccessExpMin) * time.Minute)),
-			Issuer:    cfg.JWT.JwtIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ks.cfg.AccessExpMin) * time.Minute)),
+			Issuer:    ks.cfg.JwtIssuer,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := ks.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	accessToken := &model.AccessToken{
+		Token:     tokenString,
+		ExpiredAt: claims.ExpiresAt.Time,
+	}
+	return accessToken, nil
+}
+
+func GenerateJWTImpersonationToken(userID string, userRole int, impersonationID, adminID string, expiredAt time.Time,
+	ks *KeySet) (*model.AccessToken, error) {
+	claims := &AccessClaims{
+		ID:        userID,
+		RoleID:    userRole,
+		SessionID: impersonationID,
+		ActorID:   adminID,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiredAt),
+			Issuer:    ks.cfg.JwtIssuer,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
 
-	tokenString, err := token.SignedString([]byte(cfg.JWT.JwtSecretKey))
+	tokenString, err := ks.Sign(claims)
 	if err != nil {
 		return nil, err
 	}
@@ -72,43 +107,44 @@ func GenerateJWTAccessToken(userID string, userRole int, cfg *config.Config) (*m
 	return accessToken, nil
 }
 
-func GenerateJWTRefreshToken(userID string, cfg *config.Config) (*model.RefreshToken, error) {
+func GenerateJWTRefreshToken(userID, sessionID string, ks *KeySet) (*model.RefreshToken, error) {
 	claims := &RefreshClaims{
-		ID: userID,
+		ID:        userID,
+		SessionID: sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.JWT.RefreshExpMin) * time.Minute)),
-			Issuer:    cfg.JWT.JwtIssuer,
+			ID:        uuid.NewString(),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ks.cfg.RefreshExpMin) * time.Minute)),
+			Issuer:    ks.cfg.JwtIssuer,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, err := token.SignedString([]byte(cfg.JWT.JwtSecretKey))
+	tokenString, err := ks.Sign(claims)
 	if err != nil {
 		return nil, err
 	}
 
 	refreshToken := &model.RefreshToken{
+		ID:        claims.RegisteredClaims.ID,
 		Token:     tokenString,
 		ExpiredAt: claims.ExpiresAt.Time,
 	}
 	return refreshToken, nil
 }
 
-func GenerateJWTWalletToken(userID, scope string, cfg *config.Config) (string, error) {
+func GenerateJWTWalletToken(userID, scope string, mfa bool, ks *KeySet) (string, error) {
 	claims := &WalletClaims{
 		ID:    userID,
 		Scope: scope,
+		MFA:   mfa,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.JWT.RefreshExpMin) * time.Minute)),
-			Issuer:    cfg.JWT.JwtIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ks.cfg.RefreshExpMin) * time.Minute)),
+			Issuer:    ks.cfg.JwtIssuer,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	tokenString, err := token.SignedString([]byte(cfg.JWT.JwtSecretKey))
+	tokenString, err := ks.Sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -116,19 +152,45 @@ func GenerateJWTWalletToken(userID, scope string, cfg *config.Config) (string, e
 	return tokenString, nil
 }
 
-func GenerateJWTRegisterToken(email string, cfg *config.Config) (string, error) {
+func GenerateJWTPreAuthToken(userID string, ks *KeySet) (*model.PreAuthToken, error) {
+	duration, err := time.ParseDuration(constant.PreAuthTokenDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := &PreAuthClaims{
+		ID:    userID,
+		Scope: constant.PreAuthScope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(duration)),
+			Issuer:    ks.cfg.JwtIssuer,
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	tokenString, err := ks.Sign(claims)
+	if err != nil {
+		return nil, err
+	}
+
+	preAuthToken := &model.PreAuthToken{
+		Token:     tokenString,
+		ExpiredAt: claims.ExpiresAt.Time,
+	}
+	return preAuthToken, nil
+}
+
+func GenerateJWTRegisterToken(email string, ks *KeySet) (string, error) {
 	claims := &RegisterClaims{
 		Email: email,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.JWT.RefreshExpMin) * time.Minute)),
-			Issuer:    cfg.JWT.JwtIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ks.cfg.RefreshExpMin) * time.Minute)),
+			Issuer:    ks.cfg.JwtIssuer,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, err := token.SignedString([]byte(cfg.JWT.JwtSecretKey))
+	tokenString, err := ks.Sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -136,19 +198,17 @@ func GenerateJWTRegisterToken(email string, cfg *config.Config) (string, error)
 	return tokenString, nil
 }
 
-func GenerateJWTChangePasswordToken(userID string, cfg *config.Config) (string, error) {
+func GenerateJWTChangePasswordToken(userID string, ks *KeySet) (string, error) {
 	claims := &RefreshClaims{
 		ID: userID,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.JWT.RefreshExpMin) * time.Minute)),
-			Issuer:    cfg.JWT.JwtIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ks.cfg.RefreshExpMin) * time.Minute)),
+			Issuer:    ks.cfg.JwtIssuer,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, err := token.SignedString([]byte(cfg.JWT.JwtSecretKey))
+	tokenString, err := ks.Sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -156,20 +216,18 @@ func GenerateJWTChangePasswordToken(userID string, cfg *config.Config) (string,
 	return tokenString, nil
 }
 
-func GenerateJWTResetPasswordToken(email, otp string, cfg *config.Config) (string, error) {
+func GenerateJWTResetPasswordToken(email, otp string, ks *KeySet) (string, error) {
 	claims := &ResetPasswordClaims{
 		Email: email,
 		OTP:   otp,
 		RegisteredClaims: jwt.RegisteredClaims{
-			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(cfg.JWT.RefreshExpMin) * time.Minute)),
-			Issuer:    cfg.JWT.JwtIssuer,
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Duration(ks.cfg.RefreshExpMin) * time.Minute)),
+			Issuer:    ks.cfg.JwtIssuer,
 			IssuedAt:  jwt.NewNumericDate(time.Now()),
 		},
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-
-	tokenString, err := token.SignedString([]byte(cfg.JWT.JwtSecretKey))
+	tokenString, err := ks.Sign(claims)
 	if err != nil {
 		return "", err
 	}
@@ -177,14 +235,9 @@ func GenerateJWTResetPasswordToken(email, otp string, cfg *config.Config) (strin
 	return tokenString, nil
 }
 
-func ExtractJWT(tokenString, jwtKey string) (map[string]interface{}, error) {
+func ExtractJWT(tokenString string, ks *KeySet) (map[string]interface{}, error) {
 	claims := jwt.MapClaims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(jwtKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, ks.Keyfunc)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrSignatureInvalid) {
@@ -200,16 +253,11 @@ func ExtractJWT(tokenString, jwtKey string) (map[string]interface{}, error) {
 	return claims, nil
 }
 
-func ExtractJWTFromRequest(r *http.Request, redisClient *redis.Client, jwtKey string) (map[string]interface{}, error) {
+func ExtractJWTFromRequest(r *http.Request, redisClient *redis.Client, ks *KeySet) (map[string]interface{}, error) {
 	tokenString := ExtractBearerToken(r)
 
 	claims := jwt.MapClaims{}
-	token, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(jwtKey), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, claims, ks.Keyfunc)
 
 	if err != nil {
 		if errors.Is(err, jwt.ErrSignatureInvalid) {
@@ -0,0 +1,219 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"murakali/config"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type Key struct {
+	ID         string
+	Method     jwt.SigningMethod
+	PrivateKey interface{}
+	PublicKey  interface{}
+}
+
+type KeySet struct {
+	cfg         config.JWTConfig
+	signingKey  *Key
+	keys        map[string]*Key
+	secret      []byte
+	legacyUntil time.Time
+}
+
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+func NewKeySet(cfg *config.Config) (*KeySet, error) {
+	ks := &KeySet{
+		cfg:    cfg.JWT,
+		keys:   make(map[string]*Key),
+		secret: []byte(cfg.JWT.JwtSecretKey),
+	}
+
+	if cfg.JWT.JwtLegacyHS256Until != "" {
+		legacyUntil, err := time.Parse(time.RFC3339, cfg.JWT.JwtLegacyHS256Until)
+		if err != nil {
+			return nil, fmt.Errorf("jwt legacy hs256 until: %w", err)
+		}
+
+		ks.legacyUntil = legacyUntil
+	}
+
+	if cfg.JWT.JwtKeysDir != "" {
+		files, err := filepath.Glob(filepath.Join(cfg.JWT.JwtKeysDir, "*.pem"))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, file := range files {
+			data, err := os.ReadFile(file)
+			if err != nil {
+				return nil, err
+			}
+
+			kid := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+			key, err := ParseKey(kid, data)
+			if err != nil {
+				return nil, fmt.Errorf("jwt key %s: %w", kid, err)
+			}
+
+			ks.keys[kid] = key
+		}
+	}
+
+	if cfg.JWT.JwtKeyID == "" {
+		if len(ks.secret) == 0 && len(ks.keys) > 0 {
+			return nil, errors.New("jwt key id is required to sign with asymmetric keys")
+		}
+
+		return ks, nil
+	}
+
+	if cfg.JWT.JwtPrivateKey != "" {
+		key, err := ParseKey(cfg.JWT.JwtKeyID, []byte(strings.ReplaceAll(cfg.JWT.JwtPrivateKey, `\n`, "\n")))
+		if err != nil {
+			return nil, fmt.Errorf("jwt key %s: %w", cfg.JWT.JwtKeyID, err)
+		}
+
+		ks.keys[cfg.JWT.JwtKeyID] = key
+	}
+
+	signingKey, ok := ks.keys[cfg.JWT.JwtKeyID]
+	if !ok || signingKey.PrivateKey == nil {
+		return nil, fmt.Errorf("jwt signing key %s not found", cfg.JWT.JwtKeyID)
+	}
+
+	ks.signingKey = signingKey
+	return ks, nil
+}
+
+func ParseKey(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("invalid pem data")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported pem type %s", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, PrivateKey: k, PublicKey: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, PublicKey: k}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, PrivateKey: k, PublicKey: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, PublicKey: k}, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", parsed)
+	}
+}
+
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	if ks.signingKey == nil {
+		return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(ks.secret)
+	}
+
+	token := jwt.NewWithClaims(ks.signingKey.Method, claims)
+	token.Header["kid"] = ks.signingKey.ID
+
+	return token.SignedString(ks.signingKey.PrivateKey)
+}
+
+func (ks *KeySet) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, ok := token.Header["kid"].(string)
+	if !ok {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok || (len(ks.secret) == 0 && ks.signingKey != nil) {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+
+		if ks.signingKey != nil && !time.Now().Before(ks.legacyUntil) {
+			return nil, errors.New("token without key id is no longer accepted")
+		}
+
+		return ks.secret, nil
+	}
+
+	key, ok := ks.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+
+	return key.PublicKey, nil
+}
+
+func (ks *KeySet) JWKS() *JWKS {
+	kids := make([]string, 0, len(ks.keys))
+	for kid := range ks.keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
+
+	jwks := &JWKS{Keys: make([]JWK, 0, len(kids))}
+	for _, kid := range kids {
+		key := ks.keys[kid]
+		jwk := JWK{Kid: kid, Use: "sig", Alg: key.Method.Alg()}
+		switch k := key.PublicKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(k.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(k.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(k)
+		default:
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks
+}
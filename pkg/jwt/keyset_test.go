@@ -0,0 +1,202 @@
+package jwt
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"murakali/config"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func writeRSAKey(t *testing.T, dir, kid string) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+	return key
+}
+
+func writeEdPublicKey(t *testing.T, dir, kid string) ed25519.PrivateKey {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	assert.NoError(t, err)
+
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, kid+".pem"), data, 0o600))
+	return priv
+}
+
+func TestKeySet_SignAndExtract(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "key-1")
+	writeEdPublicKey(t, dir, "key-2")
+
+	ks, err := NewKeySet(&config.Config{JWT: config.JWTConfig{JwtKeyID: "key-1", JwtKeysDir: dir, AccessExpMin: 5}})
+	assert.NoError(t, err)
+
+	accessToken, err := GenerateJWTAccessToken("user-1", 1, "session-1", ks)
+	assert.NoError(t, err)
+
+	token, _, err := jwt.NewParser().ParseUnverified(accessToken.Token, jwt.MapClaims{})
+	assert.NoError(t, err)
+	assert.Equal(t, "key-1", token.Header["kid"])
+	assert.Equal(t, "RS256", token.Header["alg"])
+
+	claims, err := ExtractJWT(accessToken.Token, ks)
+	assert.NoError(t, err)
+	assert.Equal(t, "user-1", claims["id"])
+
+	jwks := ks.JWKS()
+	assert.Len(t, jwks.Keys, 2)
+	assert.Equal(t, "key-1", jwks.Keys[0].Kid)
+	assert.Equal(t, "RSA", jwks.Keys[0].Kty)
+	assert.Equal(t, "AQAB", jwks.Keys[0].E)
+	assert.Equal(t, "key-2", jwks.Keys[1].Kid)
+	assert.Equal(t, "OKP", jwks.Keys[1].Kty)
+	assert.Equal(t, "EdDSA", jwks.Keys[1].Alg)
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "old")
+
+	oldKeySet, err := NewKeySet(&config.Config{JWT: config.JWTConfig{JwtKeyID: "old", JwtKeysDir: dir, RefreshExpMin: 5}})
+	assert.NoError(t, err)
+
+	oldToken, err := GenerateJWTRegisterToken("a@test.com", oldKeySet)
+	assert.NoError(t, err)
+
+	_, priv, err := ed25519.GenerateKey(rand.Reader)
+	assert.NoError(t, err)
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	assert.NoError(t, err)
+
+	newKeySet, err := NewKeySet(&config.Config{JWT: config.JWTConfig{
+		JwtKeyID:      "new",
+		JwtPrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der})),
+		JwtKeysDir:    dir,
+		RefreshExpMin: 5,
+	}})
+	assert.NoError(t, err)
+
+	claims, err := ExtractJWT(oldToken, newKeySet)
+	assert.NoError(t, err)
+	assert.Equal(t, "a@test.com", claims["email"])
+
+	newToken, err := GenerateJWTRegisterToken("b@test.com", newKeySet)
+	assert.NoError(t, err)
+
+	_, err = ExtractJWT(newToken, oldKeySet)
+	assert.Error(t, err)
+
+	claims, err = ExtractJWT(newToken, newKeySet)
+	assert.NoError(t, err)
+	assert.Equal(t, "b@test.com", claims["email"])
+}
+
+func TestKeySet_Keyfunc(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "key-1")
+	edKey := writeEdPublicKey(t, dir, "key-2")
+
+	ks, err := NewKeySet(&config.Config{JWT: config.JWTConfig{
+		JwtSecretKey:        "secret",
+		JwtKeyID:            "key-1",
+		JwtKeysDir:          dir,
+		JwtLegacyHS256Until: time.Now().Add(time.Hour).Format(time.RFC3339),
+	}})
+	assert.NoError(t, err)
+
+	legacyToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, &RegisterClaims{Email: "a@test.com"}).SignedString([]byte("secret"))
+	_, err = ExtractJWT(legacyToken, ks)
+	assert.NoError(t, err)
+
+	unknownKid := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &RegisterClaims{})
+	unknownKid.Header["kid"] = "key-3"
+	unknownToken, _ := unknownKid.SignedString(edKey)
+	_, err = ExtractJWT(unknownToken, ks)
+	assert.Error(t, err)
+
+	confused := jwt.NewWithClaims(jwt.SigningMethodHS256, &RegisterClaims{})
+	confused.Header["kid"] = "key-1"
+	confusedToken, _ := confused.SignedString([]byte("secret"))
+	_, err = ExtractJWT(confusedToken, ks)
+	assert.Error(t, err)
+
+	edToken := jwt.NewWithClaims(jwt.SigningMethodEdDSA, &RegisterClaims{Email: "b@test.com"})
+	edToken.Header["kid"] = "key-2"
+	edTokenString, _ := edToken.SignedString(edKey)
+	claims, err := ExtractJWT(edTokenString, ks)
+	assert.NoError(t, err)
+	assert.Equal(t, "b@test.com", claims["email"])
+
+	_, err = NewKeySet(&config.Config{JWT: config.JWTConfig{JwtKeyID: "key-2", JwtKeysDir: dir}})
+	assert.Error(t, err)
+}
+
+func TestKeySet_LegacyHS256(t *testing.T) {
+	dir := t.TempDir()
+	writeRSAKey(t, dir, "key-1")
+
+	legacyToken, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, &RegisterClaims{Email: "a@test.com"}).SignedString([]byte("secret"))
+
+	testCase := []struct {
+		name        string
+		cfg         config.JWTConfig
+		expectedErr bool
+	}{
+		{
+			name: "success before the legacy deadline",
+			cfg: config.JWTConfig{
+				JwtSecretKey:        "secret",
+				JwtKeyID:            "key-1",
+				JwtKeysDir:          dir,
+				JwtLegacyHS256Until: time.Now().Add(time.Hour).Format(time.RFC3339),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "error after the legacy deadline",
+			cfg: config.JWTConfig{
+				JwtSecretKey:        "secret",
+				JwtKeyID:            "key-1",
+				JwtKeysDir:          dir,
+				JwtLegacyHS256Until: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			},
+			expectedErr: true,
+		},
+		{
+			name:        "error without a legacy deadline",
+			cfg:         config.JWTConfig{JwtSecretKey: "secret", JwtKeyID: "key-1", JwtKeysDir: dir},
+			expectedErr: true,
+		},
+		{
+			name:        "success without a key id",
+			cfg:         config.JWTConfig{JwtSecretKey: "secret"},
+			expectedErr: false,
+		},
+	}
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			ks, err := NewKeySet(&config.Config{JWT: tc.cfg})
+			assert.NoError(t, err)
+
+			_, err = ExtractJWT(legacyToken, ks)
+			assert.Equal(t, tc.expectedErr, err != nil)
+		})
+	}
+
+	_, err := NewKeySet(&config.Config{JWT: config.JWTConfig{JwtSecretKey: "secret", JwtLegacyHS256Until: "tomorrow"}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,97 @@
+// Code generated by mockery v2.14.0. DO NOT EDIT.
+
+package mocks
+
+import (
+	context "context"
+	oauth "murakali/pkg/oauth"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// OIDCClient is an autogenerated mock type for the OIDCClient type
+type OIDCClient struct {
+	mock.Mock
+}
+
+// AuthCodeURL provides a mock function with given fields: ctx, state, nonce, codeChallenge
+func (_m *OIDCClient) AuthCodeURL(ctx context.Context, state string, nonce string, codeChallenge string) (string, error) {
+	ret := _m.Called(ctx, state, nonce, codeChallenge)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) string); ok {
+		r0 = rf(ctx, state, nonce, codeChallenge)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, state, nonce, codeChallenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Exchange provides a mock function with given fields: ctx, code, codeVerifier
+func (_m *OIDCClient) Exchange(ctx context.Context, code string, codeVerifier string) (*oauth.OIDCToken, error) {
+	ret := _m.Called(ctx, code, codeVerifier)
+
+	var r0 *oauth.OIDCToken
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *oauth.OIDCToken); ok {
+		r0 = rf(ctx, code, codeVerifier)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oauth.OIDCToken)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, code, codeVerifier)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyIDToken provides a mock function with given fields: ctx, idToken, nonce
+func (_m *OIDCClient) VerifyIDToken(ctx context.Context, idToken string, nonce string) (*oauth.IDTokenClaims, error) {
+	ret := _m.Called(ctx, idToken, nonce)
+
+	var r0 *oauth.IDTokenClaims
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *oauth.IDTokenClaims); ok {
+		r0 = rf(ctx, idToken, nonce)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*oauth.IDTokenClaims)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, idToken, nonce)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+type mockConstructorTestingTNewOIDCClient interface {
+	mock.TestingT
+	Cleanup(func())
+}
+
+// NewOIDCClient creates a new instance of OIDCClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+func NewOIDCClient(t mockConstructorTestingTNewOIDCClient) *OIDCClient {
+	mock := &OIDCClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,410 @@
+package oauth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"murakali/config"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+)
+
+type OIDCProvider struct {
+	Name         string   `json:"name"`
+	Issuer       string   `json:"issuer"`
+	ClientID     string   `json:"client_id"`
+	ClientSecret string   `json:"client_secret"`
+	RedirectURL  string   `json:"redirect_url"`
+	Scopes       []string `json:"scopes"`
+}
+
+type OIDCDiscovery struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	UserinfoEndpoint      string `json:"userinfo_endpoint"`
+	JwksURI               string `json:"jwks_uri"`
+}
+
+type OIDCToken struct {
+	AccessToken string `json:"access_token"`
+	IDToken     string `json:"id_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+type IDTokenClaims struct {
+	Email         string `json:"email"`
+	EmailVerified bool   `json:"email_verified"`
+	Name          string `json:"name"`
+	Picture       string `json:"picture"`
+	Nonce         string `json:"nonce"`
+	jwt.RegisteredClaims
+}
+
+type OIDCClient interface {
+	AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, code, codeVerifier string) (*OIDCToken, error)
+	VerifyIDToken(ctx context.Context, idToken, nonce string) (*IDTokenClaims, error)
+}
+
+type oidcClient struct {
+	provider   OIDCProvider
+	httpClient *http.Client
+	mu         sync.RWMutex
+	discovery  *OIDCDiscovery
+	keys       map[string]interface{}
+	keysAt     time.Time
+}
+
+type jsonWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Crv string `json:"crv"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+const (
+	oidcDiscoveryPath = "/.well-known/openid-configuration"
+	oidcKeysTTL       = time.Hour
+	oidcLeeway        = time.Minute
+)
+
+func NewOIDCClient(provider OIDCProvider) OIDCClient {
+	if len(provider.Scopes) == 0 {
+		provider.Scopes = []string{"openid", "email", "profile"}
+	}
+
+	return &oidcClient{
+		provider:   provider,
+		httpClient: &http.Client{Timeout: time.Second * 30},
+	}
+}
+
+func NewOIDCClients(cfg *config.Config) (map[string]OIDCClient, error) {
+	clients := make(map[string]OIDCClient)
+	if cfg.External.OIDCProvidersFile == "" {
+		return clients, nil
+	}
+
+	data, err := os.ReadFile(cfg.External.OIDCProvidersFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var providers []OIDCProvider
+	if err := json.Unmarshal(data, &providers); err != nil {
+		return nil, err
+	}
+
+	for _, provider := range providers {
+		if provider.Name == "" || provider.Issuer == "" || provider.ClientID == "" {
+			return nil, fmt.Errorf("oidc provider %q is missing name, issuer or client_id", provider.Name)
+		}
+
+		if _, ok := clients[provider.Name]; ok {
+			return nil, fmt.Errorf("oidc provider %q is defined twice", provider.Name)
+		}
+
+		clients[provider.Name] = NewOIDCClient(provider)
+	}
+
+	return clients, nil
+}
+
+func GenerateRandomString(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func CodeChallenge(codeVerifier string) string {
+	sum := sha256.Sum256([]byte(codeVerifier))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func (c *oidcClient) Discover(ctx context.Context) (*OIDCDiscovery, error) {
+	c.mu.RLock()
+	discovery := c.discovery
+	c.mu.RUnlock()
+	if discovery != nil {
+		return discovery, nil
+	}
+
+	discovery = &OIDCDiscovery{}
+	if err := c.getJSON(ctx, strings.TrimSuffix(c.provider.Issuer, "/")+oidcDiscoveryPath, discovery); err != nil {
+		return nil, err
+	}
+
+	if discovery.Issuer != c.provider.Issuer {
+		return nil, fmt.Errorf("oidc issuer mismatch: %s", discovery.Issuer)
+	}
+
+	c.mu.Lock()
+	c.discovery = discovery
+	c.mu.Unlock()
+
+	return discovery, nil
+}
+
+func (c *oidcClient) AuthCodeURL(ctx context.Context, state, nonce, codeChallenge string) (string, error) {
+	discovery, err := c.Discover(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	values := url.Values{}
+	values.Add("response_type", "code")
+	values.Add("client_id", c.provider.ClientID)
+	values.Add("redirect_uri", c.provider.RedirectURL)
+	values.Add("scope", strings.Join(c.provider.Scopes, " "))
+	values.Add("state", state)
+	values.Add("nonce", nonce)
+	values.Add("code_challenge", codeChallenge)
+	values.Add("code_challenge_method", "S256")
+
+	separator := "?"
+	if strings.Contains(discovery.AuthorizationEndpoint, "?") {
+		separator = "&"
+	}
+
+	return discovery.AuthorizationEndpoint + separator + values.Encode(), nil
+}
+
+func (c *oidcClient) Exchange(ctx context.Context, code, codeVerifier string) (*OIDCToken, error) {
+	discovery, err := c.Discover(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	values := url.Values{}
+	values.Add("grant_type", "authorization_code")
+	values.Add("code", code)
+	values.Add("client_id", c.provider.ClientID)
+	values.Add("client_secret", c.provider.ClientSecret)
+	values.Add("redirect_uri", c.provider.RedirectURL)
+	values.Add("code_verifier", codeVerifier)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, discovery.TokenEndpoint, strings.NewReader(values.Encode()))
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, errors.New("could not retrieve token")
+	}
+
+	token := &OIDCToken{}
+	if err := json.NewDecoder(res.Body).Decode(token); err != nil {
+		return nil, err
+	}
+
+	if token.IDToken == "" {
+		return nil, errors.New("id token is missing")
+	}
+
+	return token, nil
+}
+
+func (c *oidcClient) VerifyIDToken(ctx context.Context, idToken, nonce string) (*IDTokenClaims, error) {
+	claims := &IDTokenClaims{}
+	parser := jwt.NewParser(jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "ES256", "ES384", "ES512", "EdDSA"}))
+	_, err := parser.ParseWithClaims(idToken, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		return c.getKey(ctx, kid)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.Issuer != c.provider.Issuer {
+		return nil, fmt.Errorf("unexpected issuer: %s", claims.Issuer)
+	}
+
+	if !claims.VerifyAudience(c.provider.ClientID, true) {
+		return nil, errors.New("unexpected audience")
+	}
+
+	now := time.Now()
+	if !claims.VerifyExpiresAt(now.Add(-oidcLeeway), true) {
+		return nil, errors.New("id token is expired")
+	}
+
+	if claims.Nonce == "" || claims.Nonce != nonce {
+		return nil, errors.New("invalid nonce")
+	}
+
+	if claims.Subject == "" {
+		return nil, errors.New("subject is missing")
+	}
+
+	return claims, nil
+}
+
+func (c *oidcClient) getKey(ctx context.Context, kid string) (interface{}, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	fresh := time.Since(c.keysAt) < oidcKeysTTL
+	c.mu.RUnlock()
+	if ok && fresh {
+		return key, nil
+	}
+
+	if err := c.refreshKeys(ctx); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if key, ok := c.keys[kid]; ok {
+		return key, nil
+	}
+
+	if kid == "" && len(c.keys) == 1 {
+		for _, key := range c.keys {
+			return key, nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown key id: %s", kid)
+}
+
+func (c *oidcClient) refreshKeys(ctx context.Context) error {
+	discovery, err := c.Discover(ctx)
+	if err != nil {
+		return err
+	}
+
+	var jwks struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+	if err := c.getJSON(ctx, discovery.JwksURI, &jwks); err != nil {
+		return err
+	}
+
+	keys := make(map[string]interface{})
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.publicKey()
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.Kid] = key
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.keysAt = time.Now()
+	c.mu.Unlock()
+
+	return nil
+}
+
+func (c *oidcClient) getJSON(ctx context.Context, rawURL string, v interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, http.NoBody)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("could not retrieve %s", rawURL)
+	}
+
+	resBody, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(resBody, v)
+}
+
+func (k *jsonWebKey) publicKey() (interface{}, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+	case "EC":
+		var curve elliptic.Curve
+		switch k.Crv {
+		case "P-256":
+			curve = elliptic.P256()
+		case "P-384":
+			curve = elliptic.P384()
+		case "P-521":
+			curve = elliptic.P521()
+		default:
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}, nil
+	case "OKP":
+		if k.Crv != "Ed25519" {
+			return nil, fmt.Errorf("unsupported curve %s", k.Crv)
+		}
+
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+
+		return ed25519.PublicKey(x), nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %s", k.Kty)
+	}
+}
@@ -0,0 +1,183 @@
+package oauth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestProvider(t *testing.T) (*httptest.Server, *rsa.PrivateKey) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+
+	mux.HandleFunc(oidcDiscoveryPath, func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(OIDCDiscovery{
+			Issuer:                server.URL,
+			AuthorizationEndpoint: server.URL + "/authorize",
+			TokenEndpoint:         server.URL + "/token",
+			JwksURI:               server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"keys": []jsonWebKey{{
+				Kty: "RSA",
+				Kid: "test",
+				N:   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+				E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.Form.Get("code") != "code" || CodeChallenge(r.Form.Get("code_verifier")) != CodeChallenge("verifier") {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(OIDCToken{AccessToken: "access", IDToken: "id-token"})
+	})
+
+	return server, key
+}
+
+func signIDToken(t *testing.T, key *rsa.PrivateKey, claims *IDTokenClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = "test"
+	tokenString, err := token.SignedString(key)
+	assert.NoError(t, err)
+	return tokenString
+}
+
+func TestOIDCClient_AuthCodeURLAndExchange(t *testing.T) {
+	server, _ := newTestProvider(t)
+	defer server.Close()
+
+	client := NewOIDCClient(OIDCProvider{Name: "test", Issuer: server.URL, ClientID: "client", RedirectURL: "http://localhost/callback"})
+
+	authURL, err := client.AuthCodeURL(context.Background(), "state", "nonce", CodeChallenge("verifier"))
+	assert.NoError(t, err)
+
+	parsed, err := url.Parse(authURL)
+	assert.NoError(t, err)
+	assert.Equal(t, "/authorize", parsed.Path)
+	assert.Equal(t, "state", parsed.Query().Get("state"))
+	assert.Equal(t, "nonce", parsed.Query().Get("nonce"))
+	assert.Equal(t, "S256", parsed.Query().Get("code_challenge_method"))
+	assert.Equal(t, "openid email profile", parsed.Query().Get("scope"))
+
+	token, err := client.Exchange(context.Background(), "code", "verifier")
+	assert.NoError(t, err)
+	assert.Equal(t, "id-token", token.IDToken)
+
+	_, err = client.Exchange(context.Background(), "code", "wrong-verifier")
+	assert.Error(t, err)
+}
+
+func TestOIDCClient_VerifyIDToken(t *testing.T) {
+	server, key := newTestProvider(t)
+	defer server.Close()
+
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	assert.NoError(t, err)
+
+	validClaims := func() *IDTokenClaims {
+		return &IDTokenClaims{
+			Email:         "a@test.com",
+			EmailVerified: true,
+			Nonce:         "nonce",
+			RegisteredClaims: jwt.RegisteredClaims{
+				Issuer:    server.URL,
+				Subject:   "subject",
+				Audience:  jwt.ClaimStrings{"client"},
+				ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				IssuedAt:  jwt.NewNumericDate(time.Now()),
+			},
+		}
+	}
+
+	testCase := []struct {
+		name        string
+		idToken     func() string
+		expectedErr bool
+	}{
+		{
+			name: "valid id token",
+			idToken: func() string {
+				return signIDToken(t, key, validClaims())
+			},
+		},
+		{
+			name: "wrong nonce",
+			idToken: func() string {
+				claims := validClaims()
+				claims.Nonce = "other"
+				return signIDToken(t, key, claims)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "wrong audience",
+			idToken: func() string {
+				claims := validClaims()
+				claims.Audience = jwt.ClaimStrings{"other"}
+				return signIDToken(t, key, claims)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "wrong issuer",
+			idToken: func() string {
+				claims := validClaims()
+				claims.Issuer = "https://evil.test"
+				return signIDToken(t, key, claims)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "expired",
+			idToken: func() string {
+				claims := validClaims()
+				claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				return signIDToken(t, key, claims)
+			},
+			expectedErr: true,
+		},
+		{
+			name: "wrong signature",
+			idToken: func() string {
+				return signIDToken(t, otherKey, validClaims())
+			},
+			expectedErr: true,
+		},
+	}
+
+	client := NewOIDCClient(OIDCProvider{Name: "test", Issuer: server.URL, ClientID: "client"})
+	for _, tc := range testCase {
+		t.Run(tc.name, func(t *testing.T) {
+			claims, err := client.VerifyIDToken(context.Background(), tc.idToken(), "nonce")
+			if tc.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, "subject", claims.Subject)
+			assert.Equal(t, "a@test.com", claims.Email)
+		})
+	}
+}
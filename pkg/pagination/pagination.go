@@ -7,6 +7,7 @@ type Pagination struct {
 	TotalRows  int64       `json:"total_rows"`
 	TotalPages int         `json:"total_pages"`
 	Rows       interface{} `json:"rows"`
+	Facets     interface{} `json:"facets,omitempty"`
 }
 
 func (p *Pagination) GetOffset() int {
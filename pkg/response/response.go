@@ -13,68 +13,124 @@ const (
 	UnauthorizedMessage        = "Invalid Credentials."
 	ForbiddenMessage           = "Forbidden"
 
-	AddressIsDefaultMessage        = "Address is default."
-	EmailAlreadyExistMessage       = "User already registered."
-	EmailAlreadyChangedMessage     = "Email already changed."
-	EmailSamePreviousEmailMessage  = "This email same as your current email."
-	EmailNotExistMessage           = "User not registered."
-	UserNotVerifyMessage           = "User not verify."
-	UserAlreadyExistMessage        = "User already exist."
-	UserNameAlreadyExistMessage    = "Username already exist."
-	PhoneNoAlreadyExistMessage     = "Phone no already exist."
-	UserNotExistMessage            = "User not exist."
-	AddressNotExistMessage         = "Address not exist."
-	PasswordSameOldPasswordMessage = "Your new password cannot be the same as your old password."
-	PasswordContainUsernameMessage = "Password contains username."
-	OTPAlreadyExpiredMessage       = "OTP already expired."
-	OTPIsNotValidMessage           = "OTP is not valid."
-	UserAlreadyHaveShop            = "User already have shop."
-	ShopAlreadyExists              = "Shop already exists."
-	QuantityReachedMaximum         = "Quantity has reached the maximum limit!"
-	ProductDetailNotExistMessage   = "Product Detail not exist."
-	ProductNotExistMessage         = "Product not exist."
-	ProductAlreadyHasPromoMessage  = "Product Already has Promotion"
-	ProductAlreadyInFavMessage     = "Product already in favorite."
-	PictureSizeTooBig              = "Picture size too big"
-	TransactionIDNotExist          = "Transaction not exist."
-	TransactionAlreadyExpired      = "Transaction already expired."
-	TransactionAlreadyFinished     = "Transaction already finished."
-	SelectShippingCourier          = "Select shipping Courier"
-	UnknownShop                    = "Unknown shop."
-	CartItemNotExist               = "Cart Item not exist."
-	CartIsEmpty                    = "Cart is Empty."
-	ProductQuantityNotAvailable    = "Product quantity not available."
-	ShopAddressNotFound            = "Shop address not found."
-	UserNotHaveShop                = "User not register shop"
-	DefaultAddressNotFound         = "Default address not found."
-	ShopCourierNotExist            = "Shop courier not exist."
-	WalletAlreadyActivated         = "Wallet already activated."
-	WalletIsNotActivated           = `Wallet is not activated.`
-	SealabsCardNotFound            = "Sealabs pay card not valid."
-	SealabsCardIsDefault           = "Default Sealabs card cannot be deleted."
-	SealabsCardAlreadyExist        = "Sealabs card already exist."
-	WalletIsBlocked                = "Wallet is temporarily blocked, please wait."
-	WalletPinIsInvalid             = "Wallet pin is invalid."
-	WalletBalanceNotEnough         = "Insufficient wallet balance, please top up!"
-	InvalidPaymentMethod           = "Invalid payment method."
-	OrderNotExistMessage           = "Order not exist."
-	OrderNotCompletedMessage       = "Order not completed."
-	OrderAlreadyWithdrawMessage    = "Order already withdraw."
-	InvalidPasswordMessage         = "Invalid password."
-	TransactionNotFound            = "Transaction not found."
-	RefundNotFound                 = "Refund not found."
-	RefundAlreadyFinished          = "Refund already finished."
-	RefundRejected                 = "Refund is rejected."
-	WalletHistoryNotFound          = "Wallet history not found."
-	OrderNotWaitingForSeller       = "Order not waiting for seller"
-	VoucherMarketplaceNotFound     = "Voucher Marketplace Not Found"
-	VoucherShopNotFound            = "Voucher Shop Not Found"
-	OrderUnderProgressRefund       = "Order is Under Progress Refunding"
-	InvalidRefund                  = "Invalid Refund"
-	OrderCannotToRefund            = "Order Cannot to Refund"
-	OrderHasAcceptedToRefund       = "Order Has Accepted to Refund"
-	OrderRefundHasBeenFinished     = "Order Refund Has Been Finished"
-	InvalidBuyOwnProducts          = "Invalid Buy Own Products."
+	AddressIsDefaultMessage           = "Address is default."
+	EmailAlreadyExistMessage          = "User already registered."
+	EmailAlreadyChangedMessage        = "Email already changed."
+	EmailSamePreviousEmailMessage     = "This email same as your current email."
+	EmailNotExistMessage              = "User not registered."
+	UserNotVerifyMessage              = "User not verify."
+	UserAlreadyExistMessage           = "User already exist."
+	UserNameAlreadyExistMessage       = "Username already exist."
+	PhoneNoAlreadyExistMessage        = "Phone no already exist."
+	UserNotExistMessage               = "User not exist."
+	AddressNotExistMessage            = "Address not exist."
+	PasswordSameOldPasswordMessage    = "Your new password cannot be the same as your old password."
+	PasswordContainUsernameMessage    = "Password contains username."
+	OTPAlreadyExpiredMessage          = "OTP already expired."
+	OTPIsNotValidMessage              = "OTP is not valid."
+	UserAlreadyHaveShop               = "User already have shop."
+	ShopAlreadyExists                 = "Shop already exists."
+	QuantityReachedMaximum            = "Quantity has reached the maximum limit!"
+	ProductDetailNotExistMessage      = "Product Detail not exist."
+	ProductNotExistMessage            = "Product not exist."
+	ProductAlreadyHasPromoMessage     = "Product Already has Promotion"
+	ProductAlreadyInFavMessage        = "Product already in favorite."
+	PictureSizeTooBig                 = "Picture size too big"
+	TransactionIDNotExist             = "Transaction not exist."
+	TransactionAlreadyExpired         = "Transaction already expired."
+	TransactionAlreadyFinished        = "Transaction already finished."
+	SelectShippingCourier             = "Select shipping Courier"
+	UnknownShop                       = "Unknown shop."
+	CartItemNotExist                  = "Cart Item not exist."
+	CartIsEmpty                       = "Cart is Empty."
+	ProductQuantityNotAvailable       = "Product quantity not available."
+	ShopAddressNotFound               = "Shop address not found."
+	UserNotHaveShop                   = "User not register shop"
+	DefaultAddressNotFound            = "Default address not found."
+	ShopCourierNotExist               = "Shop courier not exist."
+	WalletAlreadyActivated            = "Wallet already activated."
+	WalletIsNotActivated              = `Wallet is not activated.`
+	SealabsCardNotFound               = "Sealabs pay card not valid."
+	SealabsCardIsDefault              = "Default Sealabs card cannot be deleted."
+	SealabsCardAlreadyExist           = "Sealabs card already exist."
+	WalletIsBlocked                   = "Wallet is temporarily blocked, please wait."
+	WalletPinIsInvalid                = "Wallet pin is invalid."
+	WalletBalanceNotEnough            = "Insufficient wallet balance, please top up!"
+	InvalidPaymentMethod              = "Invalid payment method."
+	OrderNotExistMessage              = "Order not exist."
+	OrderNotCompletedMessage          = "Order not completed."
+	OrderAlreadyWithdrawMessage       = "Order already withdraw."
+	InvalidPasswordMessage            = "Invalid password."
+	TransactionNotFound               = "Transaction not found."
+	RefundNotFound                    = "Refund not found."
+	RefundAlreadyFinished             = "Refund already finished."
+	RefundRejected                    = "Refund is rejected."
+	WalletHistoryNotFound             = "Wallet history not found."
+	OrderNotWaitingForSeller          = "Order not waiting for seller"
+	VoucherMarketplaceNotFound        = "Voucher Marketplace Not Found"
+	VoucherShopNotFound               = "Voucher Shop Not Found"
+	OrderUnderProgressRefund          = "Order is Under Progress Refunding"
+	InvalidRefund                     = "Invalid Refund"
+	OrderCannotToRefund               = "Order Cannot to Refund"
+	OrderHasAcceptedToRefund          = "Order Has Accepted to Refund"
+	OrderRefundHasBeenFinished        = "Order Refund Has Been Finished"
+	InvalidBuyOwnProducts             = "Invalid Buy Own Products."
+	CallbackAlreadyReceived           = "Callback already received."
+	CallbackAmountNotMatch            = "Callback amount does not match transaction."
+	CallbackTransactionNotMatch       = "Callback transaction does not match."
+	CallbackStillProcessing           = "Callback is still being processed."
+	OrderStatusTransitionInvalid      = "Order status cannot be changed from %s to %s."
+	OrderStatusChanged                = "Order status has been changed, please refresh and try again."
+	OrderInPayoutMessage              = "Order already withdraw or requested for payout."
+	BankAccountNotFound               = "Bank account not found."
+	NoWithdrawableOrder               = "No withdrawable order."
+	PayoutNotFound                    = "Payout not found."
+	PayoutNotRequested                = "Payout is not in requested status."
+	PayoutBatchNotFound               = "Payout batch not found."
+	PayoutBatchEmpty                  = "No requested payout to batch."
+	PayoutBatchNotPending             = "Payout batch is not pending."
+	PayoutBatchNotApproved            = "Payout batch is not approved."
+	MarketplaceBalanceNotEnough       = "Insufficient marketplace balance for payout."
+	RefreshTokenReusedMessage         = "Refresh token reuse detected, session has been revoked."
+	SessionExpiredMessage             = "Session expired, please login again."
+	SessionNotFoundMessage            = "Session not found."
+	TOTPAlreadyEnabledMessage         = "Two-factor authentication is already enabled."
+	TOTPNotEnabledMessage             = "Two-factor authentication is not enabled."
+	TOTPInvalidCodeMessage            = "Two-factor authentication code is invalid."
+	TOTPRequiredMessage               = "Two-factor authentication code is required."
+	TooManyAttemptsMessage            = "Too many attempts, please try again later."
+	OIDCProviderNotFoundMessage       = "Identity provider not found."
+	OIDCStateInvalidMessage           = "Login request is invalid or expired, please try again."
+	OIDCEmailNotVerifiedMessage       = "Email is not verified by the identity provider."
+	IdentityAlreadyLinkedMessage      = "This identity is already linked to another account."
+	IdentityProviderLinkedMessage     = "Identity provider already linked."
+	IdentityNotFoundMessage           = "Identity not found."
+	IdentityEmailExistMessage         = "Email already registered, login and link this provider from your account."
+	IdentityLastLoginMethodMessage    = "Cannot detach the only login method, set a password first."
+	RoleNotFoundMessage               = "Role not found."
+	RoleNotAssignedMessage            = "Role is not assigned to this user."
+	RoleSelfChangeMessage             = "You cannot change your own roles."
+	ShopStaffNotFoundMessage          = "Staff not found."
+	ShopStaffAlreadyInvitedMessage    = "This email is already invited to the shop."
+	ShopStaffSelfInviteMessage        = "You cannot invite yourself."
+	ShopStaffAlreadyMemberMessage     = "You already belong to a shop."
+	InvitationNotFoundMessage         = "Invitation not found."
+	InvitationExpiredMessage          = "Invitation has expired."
+	InvitationEmailMismatchMessage    = "Invitation was sent to a different email."
+	AccountDeletionRequestedMessage   = "Account deletion is already requested."
+	AccountDeletionNotFoundMessage    = "Account deletion is not requested."
+	AccountDeletionShopOwnerMessage   = "Close your shop before deleting your account."
+	AccountDeletionActiveOrderMessage = "Finish or cancel your active orders before deleting your account."
+	AccountSuspendedMessage           = "Your account is suspended."
+	AccountBannedMessage              = "Your account is banned."
+	PasswordResetRequiredMessage      = "Your password must be reset, use forgot password to continue."
+	UserModerationSelfMessage         = "You cannot moderate your own account."
+	UserModerationAdminMessage        = "Administrator accounts cannot be moderated."
+	UserNotSuspendedMessage           = "User is not suspended or banned."
+	UserNotActiveMessage              = "User is suspended or banned."
+	ImpersonationNotFoundMessage      = "Impersonation session not found."
+	ImpersonationEndedMessage         = "Impersonation session has already ended."
+	ImpersonationReadOnlyMessage      = "Impersonation sessions are read only."
 )
 
 type JSONResponse struct {
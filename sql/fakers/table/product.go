@@ -86,11 +86,11 @@ func (f *ProductFaker) GenerateData(tx postgre.Transaction) error {
 
 func (f *ProductFaker) GenerateDataProduct(tx postgre.Transaction, id, categoryID, shopID uuid.UUID, csvProduct *ProductCSV) error {
 	name := faker.Username()
-	price := float64((rand.Intn(1000-100) + 100) * 1000)
+	price := model.Money((rand.Intn(1000-100) + 100) * 1000)
 	imageURL := "https://cf.shopee.co.id/file/76a0969b7d64065bc13493bf55df1849_tn"
 	if csvProduct != nil {
 		name = csvProduct.Name
-		price = csvProduct.Price
+		price = model.NewMoney(csvProduct.Price)
 		imageURL = csvProduct.ImageURL
 	}
 
@@ -132,7 +132,7 @@ func (f *ProductFaker) GetCSVData(filename string) ([]*ProductCSV, error) {
 	return data, nil
 }
 
-func (f *ProductFaker) GenerateDataProductDetail(tx postgre.Transaction, id, productID uuid.UUID, price, ratingAvg float64, imageURL string) error {
+func (f *ProductFaker) GenerateDataProductDetail(tx postgre.Transaction, id, productID uuid.UUID, price model.Money, ratingAvg float64, imageURL string) error {
 	data := f.GenerateProductDetail(id, productID, price)
 	_, err := tx.Exec(InsertProductDetailQuery, data.ID, data.ProductID, data.Price, data.Stock, data.Weight, data.Size, data.Hazardous, data.Condition, data.BulkPrice)
 	if err != nil {
@@ -153,10 +153,11 @@ func (f *ProductFaker) GenerateDataProductDetail(tx postgre.Transaction, id, pro
 
 func (f *ProductFaker) GenerateTransactions(tx postgre.Transaction, productDetail *model.ProductDetail, ratingAvg float64) error {
 	txID := uuid.New()
-	deliveryFee := float64(8000)
+	deliveryFee := model.Money(8000)
+	price := productDetail.Price
 	randomTime := time.Now().AddDate(0, 0, -1*rand.Intn(31))
 
-	_, errTx := tx.Exec(InsertTransactionQuery, txID, f.CardNumber, faker.Username(), productDetail.Price+deliveryFee, randomTime, randomTime)
+	_, errTx := tx.Exec(InsertTransactionQuery, txID, f.CardNumber, faker.Username(), price+deliveryFee, randomTime, randomTime)
 	if errTx != nil {
 		return errTx
 	}
@@ -169,12 +170,12 @@ func (f *ProductFaker) GenerateTransactions(tx postgre.Transaction, productDetai
 		return err
 	}
 
-	_, errOrder := tx.Exec(InsertOrderQuery, orderID, txID, f.ShopID, f.UserID, f.CourierID, constant.OrderStatusCompleted, productDetail.Price, deliveryFee, resiNo, buyerAddress, shopAddress, true, randomTime, randomTime)
+	_, errOrder := tx.Exec(InsertOrderQuery, orderID, txID, f.ShopID, f.UserID, f.CourierID, constant.OrderStatusCompleted, price, deliveryFee, resiNo, buyerAddress, shopAddress, true, randomTime, randomTime)
 	if errOrder != nil {
 		return errOrder
 	}
 
-	_, errItem := tx.Exec(InsertOrderItemQuery, orderID, productDetail.ID, 1, productDetail.Price, productDetail.Price, true)
+	_, errItem := tx.Exec(InsertOrderItemQuery, orderID, productDetail.ID, 1, price, price, true)
 	if errItem != nil {
 		return errItem
 	}
@@ -187,7 +188,7 @@ func (f *ProductFaker) GenerateTransactions(tx postgre.Transaction, productDetai
 	return nil
 }
 
-func (f *ProductFaker) GenerateProductDetail(id, productID uuid.UUID, price float64) *model.ProductDetail {
+func (f *ProductFaker) GenerateProductDetail(id, productID uuid.UUID, price model.Money) *model.ProductDetail {
 	return &model.ProductDetail{
 		ID:        id,
 		ProductID: productID,
@@ -201,7 +202,7 @@ func (f *ProductFaker) GenerateProductDetail(id, productID uuid.UUID, price floa
 	}
 }
 
-func (f *ProductFaker) GenerateProduct(id, categoryID, shopID uuid.UUID, name, imageURL string, price float64) *model.Product {
+func (f *ProductFaker) GenerateProduct(id, categoryID, shopID uuid.UUID, name, imageURL string, price model.Money) *model.Product {
 	return &model.Product{
 		ID:            id,
 		CategoryID:    categoryID,
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS "payment_callback"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "transaction_id" UUID NOT NULL,
+    "original_id" UUID,
+    "idempotency_key" varchar NOT NULL,
+    "callback_type" varchar NOT NULL,
+    "txn_id" varchar NOT NULL DEFAULT '',
+    "status" varchar NOT NULL DEFAULT '',
+    "message" varchar NOT NULL DEFAULT '',
+    "raw_body" text NOT NULL DEFAULT '',
+    "outcome" varchar NOT NULL,
+    "response_status" int,
+    "response_message" varchar NOT NULL DEFAULT '',
+    "received_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "processed_at" timestamptz
+);
+
+CREATE INDEX ON "payment_callback" ("transaction_id");
+
+CREATE INDEX ON "payment_callback" ("idempotency_key");
+
+CREATE UNIQUE INDEX "payment_callback_idempotency_key_original_idx" ON "payment_callback" ("idempotency_key")
+    WHERE "original_id" IS NULL AND "outcome" <> 'error';
+
+ALTER TABLE "payment_callback"
+    ADD FOREIGN KEY ("original_id") REFERENCES "payment_callback" ("id");
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS "job_run"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "job_name" varchar NOT NULL,
+    "instance" varchar NOT NULL DEFAULT '',
+    "started_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "finished_at" timestamptz,
+    "rows_affected" bigint NOT NULL DEFAULT 0,
+    "error" text NOT NULL DEFAULT ''
+);
+
+CREATE INDEX ON "job_run" ("job_name", "started_at" DESC);
@@ -0,0 +1,21 @@
+CREATE TABLE IF NOT EXISTS "order_status_history"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "order_id" UUID NOT NULL,
+    "from_order_status_id" int NOT NULL,
+    "to_order_status_id" int NOT NULL,
+    "actor" varchar NOT NULL,
+    "actor_id" UUID,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE INDEX ON "order_status_history" ("order_id", "created_at");
+
+ALTER TABLE "order_status_history"
+    ADD FOREIGN KEY ("order_id") REFERENCES "order" ("id");
+
+ALTER TABLE "order_status_history"
+    ADD FOREIGN KEY ("from_order_status_id") REFERENCES "order_status" ("id");
+
+ALTER TABLE "order_status_history"
+    ADD FOREIGN KEY ("to_order_status_id") REFERENCES "order_status" ("id");
@@ -0,0 +1,9 @@
+UPDATE "product_detail" AS "pd"
+SET "stock" = "pd"."stock" - "sr"."quantity"
+FROM (SELECT "product_detail_id", SUM("quantity") AS "quantity"
+      FROM "stock_reservation"
+      WHERE "status" = 'active'
+      GROUP BY "product_detail_id") AS "sr"
+WHERE "pd"."id" = "sr"."product_detail_id";
+
+DROP TABLE IF EXISTS "stock_reservation" CASCADE;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS "stock_reservation"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "order_id" UUID NOT NULL,
+    "order_item_id" UUID,
+    "product_detail_id" UUID NOT NULL,
+    "quantity" int NOT NULL,
+    "status" varchar NOT NULL DEFAULT 'active',
+    "expired_at" timestamptz NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "updated_at" timestamptz
+);
+
+CREATE INDEX ON "stock_reservation" ("product_detail_id", "status", "expired_at");
+
+CREATE INDEX ON "stock_reservation" ("order_id", "status");
+
+ALTER TABLE "stock_reservation"
+    ADD FOREIGN KEY ("order_id") REFERENCES "order" ("id");
+
+ALTER TABLE "stock_reservation"
+    ADD FOREIGN KEY ("order_item_id") REFERENCES "order_item" ("id");
+
+ALTER TABLE "stock_reservation"
+    ADD FOREIGN KEY ("product_detail_id") REFERENCES "product_detail" ("id");
+
+INSERT INTO "stock_reservation" ("order_id", "order_item_id", "product_detail_id", "quantity", "status", "expired_at")
+SELECT "oi"."order_id", "oi"."id", "oi"."product_detail_id", "oi"."quantity", 'active', "t"."expired_at"
+FROM "order_item" AS "oi"
+         INNER JOIN "order" AS "o" ON "o"."id" = "oi"."order_id"
+         INNER JOIN "transaction" AS "t" ON "t"."id" = "o"."transaction_id"
+WHERE "o"."order_status_id" = 1
+  AND "t"."paid_at" IS NULL
+  AND "t"."canceled_at" IS NULL;
+
+UPDATE "product_detail" AS "pd"
+SET "stock" = "pd"."stock" + "sr"."quantity"
+FROM (SELECT "product_detail_id", SUM("quantity") AS "quantity"
+      FROM "stock_reservation"
+      WHERE "status" = 'active'
+      GROUP BY "product_detail_id") AS "sr"
+WHERE "pd"."id" = "sr"."product_detail_id";
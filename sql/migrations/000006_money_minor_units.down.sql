@@ -0,0 +1,28 @@
+ALTER TABLE "promotion"
+    ALTER COLUMN "discount_fix_price" TYPE int USING "discount_fix_price"::int,
+    ALTER COLUMN "min_product_price" TYPE float USING "min_product_price"::float,
+    ALTER COLUMN "max_discount_price" TYPE float USING "max_discount_price"::float;
+
+ALTER TABLE "voucher"
+    ALTER COLUMN "discount_fix_price" TYPE int USING "discount_fix_price"::int,
+    ALTER COLUMN "min_product_price" TYPE float USING "min_product_price"::float,
+    ALTER COLUMN "max_discount_price" TYPE float USING "max_discount_price"::float;
+
+ALTER TABLE "wallet_history"
+    ALTER COLUMN "amount" TYPE float USING "amount"::float;
+
+ALTER TABLE "wallet"
+    ALTER COLUMN "balance" DROP DEFAULT,
+    ALTER COLUMN "balance" TYPE float USING "balance"::float,
+    ALTER COLUMN "balance" SET DEFAULT 0;
+
+ALTER TABLE "order_item"
+    ALTER COLUMN "item_price" TYPE float USING "item_price"::float,
+    ALTER COLUMN "total_price" TYPE float USING "total_price"::float;
+
+ALTER TABLE "order"
+    ALTER COLUMN "total_price" TYPE float USING "total_price"::float,
+    ALTER COLUMN "delivery_fee" TYPE float USING "delivery_fee"::float;
+
+ALTER TABLE "transaction"
+    ALTER COLUMN "total_price" TYPE float USING "total_price"::float;
@@ -0,0 +1,28 @@
+ALTER TABLE "transaction"
+    ALTER COLUMN "total_price" TYPE bigint USING ROUND("total_price")::bigint;
+
+ALTER TABLE "order"
+    ALTER COLUMN "total_price" TYPE bigint USING ROUND("total_price")::bigint,
+    ALTER COLUMN "delivery_fee" TYPE bigint USING ROUND("delivery_fee")::bigint;
+
+ALTER TABLE "order_item"
+    ALTER COLUMN "item_price" TYPE bigint USING ROUND("item_price")::bigint,
+    ALTER COLUMN "total_price" TYPE bigint USING ROUND("total_price")::bigint;
+
+ALTER TABLE "wallet"
+    ALTER COLUMN "balance" DROP DEFAULT,
+    ALTER COLUMN "balance" TYPE bigint USING ROUND("balance")::bigint,
+    ALTER COLUMN "balance" SET DEFAULT 0;
+
+ALTER TABLE "wallet_history"
+    ALTER COLUMN "amount" TYPE bigint USING ROUND("amount")::bigint;
+
+ALTER TABLE "voucher"
+    ALTER COLUMN "discount_fix_price" TYPE bigint USING "discount_fix_price"::bigint,
+    ALTER COLUMN "min_product_price" TYPE bigint USING ROUND("min_product_price")::bigint,
+    ALTER COLUMN "max_discount_price" TYPE bigint USING ROUND("max_discount_price")::bigint;
+
+ALTER TABLE "promotion"
+    ALTER COLUMN "discount_fix_price" TYPE bigint USING "discount_fix_price"::bigint,
+    ALTER COLUMN "min_product_price" TYPE bigint USING ROUND("min_product_price")::bigint,
+    ALTER COLUMN "max_discount_price" TYPE bigint USING ROUND("max_discount_price")::bigint;
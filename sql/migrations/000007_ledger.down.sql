@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "ledger_entry" CASCADE;
+DROP TABLE IF EXISTS "ledger_transaction" CASCADE;
+DROP TABLE IF EXISTS "ledger_account" CASCADE;
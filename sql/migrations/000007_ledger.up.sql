@@ -0,0 +1,79 @@
+CREATE TABLE IF NOT EXISTS "ledger_account"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "account_type" varchar NOT NULL,
+    "owner_id" varchar NOT NULL,
+    "wallet_id" UUID,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE TABLE IF NOT EXISTS "ledger_transaction"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "reference" varchar NOT NULL,
+    "description" varchar,
+    "transaction_id" UUID,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE TABLE IF NOT EXISTS "ledger_entry"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "ledger_transaction_id" UUID NOT NULL,
+    "account_id" UUID NOT NULL,
+    "direction" varchar NOT NULL CHECK ("direction" IN ('debit', 'credit')),
+    "amount" bigint NOT NULL CHECK ("amount" > 0),
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE UNIQUE INDEX ON "ledger_account" ("account_type", "owner_id");
+
+CREATE INDEX ON "ledger_account" ("wallet_id");
+
+CREATE INDEX ON "ledger_transaction" ("transaction_id");
+
+CREATE INDEX ON "ledger_entry" ("account_id");
+
+CREATE INDEX ON "ledger_entry" ("ledger_transaction_id");
+
+ALTER TABLE "ledger_account"
+    ADD FOREIGN KEY ("wallet_id") REFERENCES "wallet" ("id");
+
+ALTER TABLE "ledger_transaction"
+    ADD FOREIGN KEY ("transaction_id") REFERENCES "transaction" ("id");
+
+ALTER TABLE "ledger_entry"
+    ADD FOREIGN KEY ("ledger_transaction_id") REFERENCES "ledger_transaction" ("id");
+
+ALTER TABLE "ledger_entry"
+    ADD FOREIGN KEY ("account_id") REFERENCES "ledger_account" ("id");
+
+INSERT INTO "ledger_account" ("account_type", "owner_id")
+VALUES ('opening', 'opening');
+
+INSERT INTO "ledger_account" ("account_type", "owner_id", "wallet_id")
+SELECT CASE
+           WHEN "user_id" = '4df967a8-5b05-4d2a-bb72-da3921dce8fb' THEN 'escrow'
+           ELSE 'wallet' END,
+       "id"::varchar,
+       "id"
+FROM "wallet";
+
+WITH "opening" AS (
+    INSERT INTO "ledger_transaction" ("reference", "description")
+        VALUES ('opening_balance', 'Opening balance from wallet')
+        RETURNING "id")
+INSERT
+INTO "ledger_entry" ("ledger_transaction_id", "account_id", "direction", "amount")
+SELECT "o"."id", "a"."id", 'credit', "w"."balance"
+FROM "opening" AS "o",
+     "wallet" AS "w"
+         JOIN "ledger_account" AS "a" ON "a"."wallet_id" = "w"."id"
+WHERE "w"."balance" > 0
+UNION ALL
+SELECT "o"."id", "op"."id", 'debit', "w"."balance"
+FROM "opening" AS "o",
+     "wallet" AS "w",
+     "ledger_account" AS "op"
+WHERE "op"."account_type" = 'opening'
+  AND "w"."balance" > 0;
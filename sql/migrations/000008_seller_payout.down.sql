@@ -0,0 +1,6 @@
+ALTER TABLE "order"
+    DROP COLUMN IF EXISTS "payout_id";
+
+DROP TABLE IF EXISTS "payout" CASCADE;
+DROP TABLE IF EXISTS "payout_batch" CASCADE;
+DROP TABLE IF EXISTS "bank_account" CASCADE;
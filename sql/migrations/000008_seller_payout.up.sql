@@ -0,0 +1,72 @@
+CREATE TABLE IF NOT EXISTS "bank_account"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "shop_id" UUID NOT NULL,
+    "bank_name" varchar NOT NULL,
+    "account_number" varchar NOT NULL,
+    "account_holder" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS "payout_batch"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "status" varchar NOT NULL DEFAULT 'pending',
+    "total_amount" bigint NOT NULL DEFAULT 0,
+    "payout_count" int NOT NULL DEFAULT 0,
+    "created_by" UUID NOT NULL,
+    "approved_by" UUID,
+    "approved_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE TABLE IF NOT EXISTS "payout"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "shop_id" UUID NOT NULL,
+    "bank_account_id" UUID NOT NULL,
+    "payout_batch_id" UUID,
+    "amount" bigint NOT NULL DEFAULT 0,
+    "order_count" int NOT NULL DEFAULT 0,
+    "status" varchar NOT NULL DEFAULT 'requested',
+    "note" varchar,
+    "requested_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "approved_at" timestamptz,
+    "rejected_at" timestamptz
+);
+
+ALTER TABLE "order"
+    ADD COLUMN "payout_id" UUID;
+
+CREATE INDEX ON "bank_account" ("shop_id");
+
+CREATE INDEX ON "payout" ("shop_id", "requested_at");
+
+CREATE INDEX ON "payout" ("status");
+
+CREATE INDEX ON "payout" ("payout_batch_id");
+
+CREATE INDEX ON "order" ("payout_id");
+
+ALTER TABLE "bank_account"
+    ADD FOREIGN KEY ("shop_id") REFERENCES "shop" ("id");
+
+ALTER TABLE "payout"
+    ADD FOREIGN KEY ("shop_id") REFERENCES "shop" ("id");
+
+ALTER TABLE "payout"
+    ADD FOREIGN KEY ("bank_account_id") REFERENCES "bank_account" ("id");
+
+ALTER TABLE "payout"
+    ADD FOREIGN KEY ("payout_batch_id") REFERENCES "payout_batch" ("id");
+
+ALTER TABLE "payout_batch"
+    ADD FOREIGN KEY ("created_by") REFERENCES "user" ("id");
+
+ALTER TABLE "payout_batch"
+    ADD FOREIGN KEY ("approved_by") REFERENCES "user" ("id");
+
+ALTER TABLE "order"
+    ADD FOREIGN KEY ("payout_id") REFERENCES "payout" ("id");
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS "product_title_trgm_idx";
+
+DROP INDEX IF EXISTS "product_search_vector_idx";
+
+DROP TRIGGER IF EXISTS "category_search_vector_trigger" ON "category";
+
+DROP FUNCTION IF EXISTS category_search_vector_update();
+
+DROP TRIGGER IF EXISTS "product_search_vector_trigger" ON "product";
+
+DROP FUNCTION IF EXISTS product_search_vector_update();
+
+ALTER TABLE "product"
+    DROP COLUMN IF EXISTS "search_vector";
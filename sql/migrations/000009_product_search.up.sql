@@ -0,0 +1,40 @@
+ALTER TABLE "product"
+    ADD COLUMN "search_vector" tsvector;
+
+CREATE OR REPLACE FUNCTION product_search_vector_update() RETURNS trigger AS
+$$
+BEGIN
+    NEW."search_vector" :=
+            setweight(to_tsvector('simple', coalesce(NEW."title", '')), 'A') ||
+            setweight(to_tsvector('simple', coalesce(NEW."description", '')), 'B') ||
+            setweight(to_tsvector('simple', coalesce(
+                    (SELECT "name" FROM "category" WHERE "id" = NEW."category_id"), '')), 'C');
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "product_search_vector_trigger"
+    BEFORE INSERT OR UPDATE OF "title", "description", "category_id"
+    ON "product"
+    FOR EACH ROW
+EXECUTE PROCEDURE product_search_vector_update();
+
+CREATE OR REPLACE FUNCTION category_search_vector_update() RETURNS trigger AS
+$$
+BEGIN
+    UPDATE "product" SET "category_id" = "category_id" WHERE "category_id" = NEW."id";
+    RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER "category_search_vector_trigger"
+    AFTER UPDATE OF "name"
+    ON "category"
+    FOR EACH ROW
+EXECUTE PROCEDURE category_search_vector_update();
+
+UPDATE "product" SET "title" = "title";
+
+CREATE INDEX "product_search_vector_idx" ON "product" USING GIN ("search_vector");
+
+CREATE INDEX "product_title_trgm_idx" ON "product" USING GIN ("title" gin_trgm_ops);
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS "user_session"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "user_id" UUID NOT NULL,
+    "user_agent" varchar NOT NULL DEFAULT '',
+    "ip_address" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "last_used_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "expired_at" timestamptz NOT NULL,
+    "revoked_at" timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS "session_refresh_token"
+(
+    "id" UUID PRIMARY KEY,
+    "session_id" UUID NOT NULL,
+    "parent_id" UUID,
+    "token_hash" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "expired_at" timestamptz NOT NULL,
+    "used_at" timestamptz
+);
+
+CREATE INDEX ON "user_session" ("user_id", "revoked_at");
+
+CREATE UNIQUE INDEX ON "session_refresh_token" ("token_hash");
+
+CREATE INDEX ON "session_refresh_token" ("session_id");
+
+ALTER TABLE "user_session"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "session_refresh_token"
+    ADD FOREIGN KEY ("session_id") REFERENCES "user_session" ("id");
+
+ALTER TABLE "session_refresh_token"
+    ADD FOREIGN KEY ("parent_id") REFERENCES "session_refresh_token" ("id");
@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS "user_totp"
+(
+    "user_id" UUID PRIMARY KEY,
+    "secret" varchar NOT NULL,
+    "confirmed_at" timestamptz,
+    "last_used_step" bigint,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "updated_at" timestamptz
+);
+
+CREATE TABLE IF NOT EXISTS "user_recovery_code"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "user_id" UUID NOT NULL,
+    "code_hash" varchar NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "used_at" timestamptz
+);
+
+CREATE INDEX ON "user_recovery_code" ("user_id", "used_at");
+
+ALTER TABLE "user_totp"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "user_recovery_code"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
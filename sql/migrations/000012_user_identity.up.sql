@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS "user_identity"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "user_id" UUID NOT NULL,
+    "provider" varchar NOT NULL,
+    "subject" varchar NOT NULL,
+    "email" varchar,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE UNIQUE INDEX ON "user_identity" ("provider", "subject");
+
+CREATE UNIQUE INDEX ON "user_identity" ("user_id", "provider");
+
+ALTER TABLE "user_identity"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "user_role" CASCADE;
+DROP TABLE IF EXISTS "role_permission" CASCADE;
+DROP TABLE IF EXISTS "permission" CASCADE;
+
+DELETE FROM "role" WHERE "id" = 4;
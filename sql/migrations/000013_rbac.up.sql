@@ -0,0 +1,90 @@
+CREATE TABLE IF NOT EXISTS "permission"
+(
+    "id" serial PRIMARY KEY,
+    "name" varchar UNIQUE NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE TABLE IF NOT EXISTS "role_permission"
+(
+    "role_id" int NOT NULL,
+    "permission_id" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    PRIMARY KEY ("role_id", "permission_id")
+);
+
+CREATE TABLE IF NOT EXISTS "user_role"
+(
+    "user_id" UUID NOT NULL,
+    "role_id" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    PRIMARY KEY ("user_id", "role_id")
+);
+
+CREATE INDEX ON "user_role" ("role_id");
+
+ALTER TABLE "role_permission"
+    ADD FOREIGN KEY ("role_id") REFERENCES "role" ("id");
+
+ALTER TABLE "role_permission"
+    ADD FOREIGN KEY ("permission_id") REFERENCES "permission" ("id");
+
+ALTER TABLE "user_role"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "user_role"
+    ADD FOREIGN KEY ("role_id") REFERENCES "role" ("id");
+
+INSERT INTO "role" ("id", "name")
+VALUES (1, 'user'),
+       (2, 'seller'),
+       (3, 'admin'),
+       (4, 'finance')
+ON CONFLICT ("id") DO NOTHING;
+
+SELECT setval(pg_get_serial_sequence('role', 'id'), (SELECT MAX("id") FROM "role"));
+
+INSERT INTO "permission" ("name")
+VALUES ('voucher:read'),
+       ('voucher:write'),
+       ('refund:read'),
+       ('refund:write'),
+       ('transaction:read'),
+       ('payout:read'),
+       ('payout:write'),
+       ('session:read'),
+       ('session:write'),
+       ('lockout:read'),
+       ('lockout:write'),
+       ('category:write'),
+       ('banner:write'),
+       ('picture:write'),
+       ('role:read'),
+       ('role:write'),
+       ('shop:manage'),
+       ('product:write')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT 3, "id"
+FROM "permission"
+WHERE "name" NOT IN ('shop:manage', 'product:write')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT 4, "id"
+FROM "permission"
+WHERE "name" IN ('refund:read', 'refund:write', 'transaction:read', 'payout:read', 'payout:write')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT 2, "id"
+FROM "permission"
+WHERE "name" IN ('shop:manage', 'product:write')
+ON CONFLICT DO NOTHING;
+
+INSERT INTO "user_role" ("user_id", "role_id")
+SELECT "id", "role_id"
+FROM "user"
+WHERE "role_id" IS NOT NULL
+ON CONFLICT DO NOTHING;
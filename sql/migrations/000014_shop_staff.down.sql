@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "shop_audit_log" CASCADE;
+DROP TABLE IF EXISTS "shop_staff" CASCADE;
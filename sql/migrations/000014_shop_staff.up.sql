@@ -0,0 +1,53 @@
+CREATE TABLE IF NOT EXISTS "shop_staff"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "shop_id" UUID NOT NULL,
+    "user_id" UUID,
+    "email" varchar NOT NULL,
+    "scopes" varchar[] NOT NULL DEFAULT '{}',
+    "invite_token" varchar,
+    "invited_by" UUID NOT NULL,
+    "expired_at" timestamptz,
+    "accepted_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz
+);
+
+CREATE UNIQUE INDEX ON "shop_staff" ("shop_id", "email") WHERE "deleted_at" IS NULL;
+
+CREATE UNIQUE INDEX ON "shop_staff" ("user_id") WHERE "deleted_at" IS NULL AND "user_id" IS NOT NULL;
+
+CREATE UNIQUE INDEX ON "shop_staff" ("invite_token") WHERE "invite_token" IS NOT NULL;
+
+ALTER TABLE "shop_staff"
+    ADD FOREIGN KEY ("shop_id") REFERENCES "shop" ("id");
+
+ALTER TABLE "shop_staff"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "shop_staff"
+    ADD FOREIGN KEY ("invited_by") REFERENCES "user" ("id");
+
+CREATE TABLE IF NOT EXISTS "shop_audit_log"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "shop_id" UUID NOT NULL,
+    "actor_id" UUID NOT NULL,
+    "staff_id" UUID,
+    "action" varchar NOT NULL,
+    "path" varchar NOT NULL,
+    "status_code" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE INDEX ON "shop_audit_log" ("shop_id", "created_at");
+
+ALTER TABLE "shop_audit_log"
+    ADD FOREIGN KEY ("shop_id") REFERENCES "shop" ("id");
+
+ALTER TABLE "shop_audit_log"
+    ADD FOREIGN KEY ("actor_id") REFERENCES "user" ("id");
+
+ALTER TABLE "shop_audit_log"
+    ADD FOREIGN KEY ("staff_id") REFERENCES "shop_staff" ("id");
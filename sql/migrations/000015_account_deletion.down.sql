@@ -0,0 +1,3 @@
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS "deletion_requested_at",
+    DROP COLUMN IF EXISTS "deletion_scheduled_at";
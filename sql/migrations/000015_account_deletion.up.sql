@@ -0,0 +1,5 @@
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS "deletion_requested_at" timestamptz,
+    ADD COLUMN IF NOT EXISTS "deletion_scheduled_at" timestamptz;
+
+CREATE INDEX ON "user" ("deletion_scheduled_at") WHERE "deletion_scheduled_at" IS NOT NULL;
@@ -0,0 +1,13 @@
+DELETE FROM "role_permission"
+WHERE "permission_id" IN (SELECT "id" FROM "permission" WHERE "name" IN ('user:read', 'user:write', 'user:impersonate'));
+
+DELETE FROM "permission" WHERE "name" IN ('user:read', 'user:write', 'user:impersonate');
+
+DROP TABLE IF EXISTS "admin_audit_log" CASCADE;
+DROP TABLE IF EXISTS "impersonation_session" CASCADE;
+
+ALTER TABLE "user"
+    DROP COLUMN IF EXISTS "password_reset_required",
+    DROP COLUMN IF EXISTS "suspended_until",
+    DROP COLUMN IF EXISTS "status_reason",
+    DROP COLUMN IF EXISTS "status";
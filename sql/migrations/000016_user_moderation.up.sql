@@ -0,0 +1,60 @@
+ALTER TABLE "user"
+    ADD COLUMN IF NOT EXISTS "status" varchar NOT NULL DEFAULT 'active',
+    ADD COLUMN IF NOT EXISTS "status_reason" varchar NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS "suspended_until" timestamptz,
+    ADD COLUMN IF NOT EXISTS "password_reset_required" boolean NOT NULL DEFAULT false;
+
+CREATE INDEX ON "user" ("status") WHERE "status" <> 'active';
+
+CREATE TABLE IF NOT EXISTS "impersonation_session"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "admin_id" UUID NOT NULL,
+    "user_id" UUID NOT NULL,
+    "reason" varchar NOT NULL,
+    "expired_at" timestamptz NOT NULL,
+    "ended_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE INDEX ON "impersonation_session" ("user_id", "created_at");
+
+ALTER TABLE "impersonation_session"
+    ADD FOREIGN KEY ("admin_id") REFERENCES "user" ("id");
+
+ALTER TABLE "impersonation_session"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+CREATE TABLE IF NOT EXISTS "admin_audit_log"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "admin_id" UUID NOT NULL,
+    "user_id" UUID NOT NULL,
+    "impersonation_id" UUID,
+    "action" varchar NOT NULL,
+    "detail" varchar NOT NULL DEFAULT '',
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE INDEX ON "admin_audit_log" ("user_id", "created_at");
+
+ALTER TABLE "admin_audit_log"
+    ADD FOREIGN KEY ("admin_id") REFERENCES "user" ("id");
+
+ALTER TABLE "admin_audit_log"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "admin_audit_log"
+    ADD FOREIGN KEY ("impersonation_id") REFERENCES "impersonation_session" ("id");
+
+INSERT INTO "permission" ("name")
+VALUES ('user:read'),
+       ('user:write'),
+       ('user:impersonate')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT 3, "id"
+FROM "permission"
+WHERE "name" IN ('user:read', 'user:write', 'user:impersonate')
+ON CONFLICT DO NOTHING;
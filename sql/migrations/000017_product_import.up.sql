@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS "product_import_job"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "shop_id" UUID NOT NULL,
+    "user_id" UUID NOT NULL,
+    "status" varchar NOT NULL,
+    "total_rows" int NOT NULL DEFAULT 0,
+    "total_products" int NOT NULL DEFAULT 0,
+    "imported_products" int NOT NULL DEFAULT 0,
+    "failed_rows" int NOT NULL DEFAULT 0,
+    "errors" jsonb NOT NULL DEFAULT '[]',
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "finished_at" timestamptz
+);
+
+CREATE INDEX ON "product_import_job" ("shop_id", "created_at");
+
+ALTER TABLE "product_import_job"
+    ADD FOREIGN KEY ("shop_id") REFERENCES "shop" ("id");
+
+ALTER TABLE "product_import_job"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
@@ -0,0 +1,25 @@
+CREATE TABLE IF NOT EXISTS "product_variant_axis"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "product_id" UUID NOT NULL,
+    "name" varchar NOT NULL,
+    "position" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    UNIQUE ("product_id", "name")
+);
+
+CREATE TABLE IF NOT EXISTS "product_variant_option"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "axis_id" UUID NOT NULL,
+    "name" varchar NOT NULL,
+    "position" int NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    UNIQUE ("axis_id", "name")
+);
+
+ALTER TABLE "product_variant_axis"
+    ADD FOREIGN KEY ("product_id") REFERENCES "product" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "product_variant_option"
+    ADD FOREIGN KEY ("axis_id") REFERENCES "product_variant_axis" ("id") ON DELETE CASCADE;
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS "product_price_tier"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "product_detail_id" UUID NOT NULL,
+    "min_quantity" int NOT NULL,
+    "max_quantity" int,
+    "price" float NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    UNIQUE ("product_detail_id", "min_quantity")
+);
+
+ALTER TABLE "product_price_tier"
+    ADD FOREIGN KEY ("product_detail_id") REFERENCES "product_detail" ("id") ON DELETE CASCADE;
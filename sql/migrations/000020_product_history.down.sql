@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS "product_price_history" CASCADE;
+DROP TABLE IF EXISTS "product_version" CASCADE;
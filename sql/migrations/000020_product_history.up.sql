@@ -0,0 +1,36 @@
+CREATE TABLE IF NOT EXISTS "product_version"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "product_id" UUID NOT NULL,
+    "version" int NOT NULL,
+    "user_id" UUID,
+    "note" varchar NOT NULL DEFAULT '',
+    "snapshot" jsonb NOT NULL,
+    "changes" jsonb NOT NULL DEFAULT '[]',
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    UNIQUE ("product_id", "version")
+);
+
+CREATE TABLE IF NOT EXISTS "product_price_history"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "product_detail_id" UUID NOT NULL,
+    "price" float NOT NULL,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW())
+);
+
+CREATE INDEX ON "product_price_history" ("product_detail_id", "created_at");
+
+ALTER TABLE "product_version"
+    ADD FOREIGN KEY ("product_id") REFERENCES "product" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "product_version"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id");
+
+ALTER TABLE "product_price_history"
+    ADD FOREIGN KEY ("product_detail_id") REFERENCES "product_detail" ("id") ON DELETE CASCADE;
+
+INSERT INTO "product_price_history" ("product_detail_id", "price", "created_at")
+SELECT "id", "price", coalesce("updated_at", "created_at")
+FROM "product_detail"
+WHERE "deleted_at" IS NULL AND "price" IS NOT NULL;
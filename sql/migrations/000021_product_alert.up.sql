@@ -0,0 +1,26 @@
+CREATE TABLE IF NOT EXISTS "product_alert"
+(
+    "id" UUID PRIMARY KEY DEFAULT uuid_generate_v4(),
+    "user_id" UUID NOT NULL,
+    "product_detail_id" UUID NOT NULL,
+    "alert_type" varchar NOT NULL,
+    "target_price" float,
+    "is_triggered" boolean NOT NULL DEFAULT FALSE,
+    "unsubscribe_token" UUID NOT NULL DEFAULT uuid_generate_v4(),
+    "notified_at" timestamptz,
+    "created_at" timestamptz NOT NULL DEFAULT (NOW()),
+    "updated_at" timestamptz,
+    "deleted_at" timestamptz
+);
+
+CREATE UNIQUE INDEX ON "product_alert" ("user_id", "product_detail_id", "alert_type") WHERE "deleted_at" IS NULL;
+
+CREATE UNIQUE INDEX ON "product_alert" ("unsubscribe_token");
+
+CREATE INDEX ON "product_alert" ("product_detail_id");
+
+ALTER TABLE "product_alert"
+    ADD FOREIGN KEY ("user_id") REFERENCES "user" ("id") ON DELETE CASCADE;
+
+ALTER TABLE "product_alert"
+    ADD FOREIGN KEY ("product_detail_id") REFERENCES "product_detail" ("id") ON DELETE CASCADE;
@@ -0,0 +1,15 @@
+ALTER TABLE "product_alert"
+    ALTER COLUMN "target_price" TYPE float USING "target_price"::float;
+
+ALTER TABLE "product_price_history"
+    ALTER COLUMN "price" TYPE float USING "price"::float;
+
+ALTER TABLE "product_price_tier"
+    ALTER COLUMN "price" TYPE float USING "price"::float;
+
+ALTER TABLE "product_detail"
+    ALTER COLUMN "price" TYPE float USING "price"::float;
+
+ALTER TABLE "product"
+    ALTER COLUMN "min_price" TYPE float USING "min_price"::float,
+    ALTER COLUMN "max_price" TYPE float USING "max_price"::float;
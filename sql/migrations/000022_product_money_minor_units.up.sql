@@ -0,0 +1,15 @@
+ALTER TABLE "product"
+    ALTER COLUMN "min_price" TYPE bigint USING ROUND("min_price")::bigint,
+    ALTER COLUMN "max_price" TYPE bigint USING ROUND("max_price")::bigint;
+
+ALTER TABLE "product_detail"
+    ALTER COLUMN "price" TYPE bigint USING ROUND("price")::bigint;
+
+ALTER TABLE "product_price_tier"
+    ALTER COLUMN "price" TYPE bigint USING ROUND("price")::bigint;
+
+ALTER TABLE "product_price_history"
+    ALTER COLUMN "price" TYPE bigint USING ROUND("price")::bigint;
+
+ALTER TABLE "product_alert"
+    ALTER COLUMN "target_price" TYPE bigint USING ROUND("target_price")::bigint;
@@ -0,0 +1,14 @@
+DELETE FROM "role_permission"
+WHERE "permission_id" IN (SELECT "id" FROM "permission" WHERE "name" = 'user:moderate-exempt');
+
+DELETE FROM "permission" WHERE "name" = 'user:moderate-exempt';
+
+INSERT INTO "permission" ("name")
+VALUES ('product:write')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT 2, "id"
+FROM "permission"
+WHERE "name" = 'product:write'
+ON CONFLICT DO NOTHING;
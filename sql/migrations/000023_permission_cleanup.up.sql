@@ -0,0 +1,14 @@
+DELETE FROM "role_permission"
+WHERE "permission_id" IN (SELECT "id" FROM "permission" WHERE "name" = 'product:write');
+
+DELETE FROM "permission" WHERE "name" = 'product:write';
+
+INSERT INTO "permission" ("name")
+VALUES ('user:moderate-exempt')
+ON CONFLICT ("name") DO NOTHING;
+
+INSERT INTO "role_permission" ("role_id", "permission_id")
+SELECT "r"."id", "p"."id"
+FROM "role" AS "r", "permission" AS "p"
+WHERE "r"."id" IN (3, 4) AND "p"."name" = 'user:moderate-exempt'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS "payment_callback_txn_id_nonce_idx";
+
+ALTER TABLE "payment_callback"
+    DROP COLUMN IF EXISTS "nonce";
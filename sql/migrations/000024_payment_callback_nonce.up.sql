@@ -0,0 +1,4 @@
+ALTER TABLE "payment_callback"
+    ADD COLUMN IF NOT EXISTS "nonce" varchar NOT NULL DEFAULT '';
+
+CREATE INDEX "payment_callback_txn_id_nonce_idx" ON "payment_callback" ("txn_id", "nonce");